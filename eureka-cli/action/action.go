@@ -3,13 +3,15 @@ package action
 import (
 	"fmt"
 	"log/slog"
-	"net"
 	"slices"
-	"strconv"
 	"strings"
+	"time"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/viper"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -23,8 +25,6 @@ type Action struct {
 	Param                              *Param
 	Caser                              cases.Caser
 	VaultRootToken                     string
-	KeycloakAccessToken                string
-	KeycloakMasterAccessToken          string
 	ConfigProfileName                  string
 	ConfigLspURL                       string
 	ConfigFarURL                       string
@@ -33,7 +33,6 @@ type Action struct {
 	ConfigPortEnd                      int
 	ConfigManagementTopicSharing       bool
 	ConfigTopicSharingTenant           string
-	ConfigApplication                  map[string]any
 	ConfigApplicationName              string
 	ConfigApplicationVersion           string
 	ConfigApplicationID                string
@@ -46,21 +45,25 @@ type Action struct {
 	ConfigNamespacePlatformLspUI       string
 	ConfigGlobalEnv                    map[string]string
 	ConfigEnvFolio                     string
-	ConfigSidecarModule                map[string]any
-	ConfigSidecarModuleResources       map[string]any
+	ConfigSidecarModule                config.SidecarModule
 	ConfigSidecarModuleNativeBinaryCmd []string
-	ConfigBackendModules               map[string]any
-	ConfigFrontendModules              map[string]any
-	ConfigCustomFrontendModules        map[string]any
-	ConfigTenants                      map[string]any
-	ConfigRoles                        map[string]any
-	ConfigUsers                        map[string]any
-	ConfigRolesCapabilitySets          map[string]any
-	ConfigConsortiums                  map[string]any
+	ConfigBackendModules               map[string]config.BackendModule
+	ConfigFrontendModules              map[string]config.FrontendModule
+	ConfigCustomFrontendModules        map[string]config.FrontendModule
+	ConfigTenants                      map[string]config.Tenant
+	ConfigRoles                        map[string]config.Role
+	ConfigUsers                        map[string]config.User
+	ConfigConsortiums                  map[string]config.Consortium
 	ConfigExtraVolumes                 []string
+	Events                             events.Sink
 }
 
-func New(name string, gatewayURL string, actionParam *Param) *Action {
+// New creates an Action from the typed config of the modules, tenants, roles, users and consortiums and from the
+// remaining settings read by viper, a nil config is an empty one
+func New(name string, gatewayURL string, actionParam *Param, cfg *config.Config) *Action {
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
 	applicationName := viper.GetString(field.ApplicationName)
 	applicationVersion := viper.GetString(field.ApplicationVersion)
 	return &Action{
@@ -75,7 +78,6 @@ func New(name string, gatewayURL string, actionParam *Param) *Action {
 		ConfigRegistryURL:                  viper.GetString(field.RegistryURL),
 		ConfigManagementTopicSharing:       viper.GetBool(field.BackendModulesManagementTopicSharing),
 		ConfigTopicSharingTenant:           viper.GetString(field.EnvTopicSharingTenant),
-		ConfigApplication:                  viper.GetStringMap(field.Application),
 		ConfigApplicationName:              applicationName,
 		ConfigApplicationVersion:           applicationVersion,
 		ConfigApplicationID:                fmt.Sprintf("%s-%s", applicationName, applicationVersion),
@@ -88,17 +90,15 @@ func New(name string, gatewayURL string, actionParam *Param) *Action {
 		ConfigNamespacePlatformLspUI:       viper.GetString(field.NamespacesPlatformLspUI),
 		ConfigGlobalEnv:                    viper.GetStringMapString(field.Env),
 		ConfigEnvFolio:                     viper.GetString(field.EnvFolio),
-		ConfigSidecarModule:                viper.GetStringMap(field.SidecarModule),
-		ConfigSidecarModuleResources:       viper.GetStringMap(field.SidecarModuleResources),
+		ConfigSidecarModule:                cfg.SidecarModule,
 		ConfigSidecarModuleNativeBinaryCmd: GetSidecarModuleCmd(),
-		ConfigBackendModules:               viper.GetStringMap(field.BackendModules),
-		ConfigFrontendModules:              viper.GetStringMap(field.FrontendModules),
-		ConfigCustomFrontendModules:        viper.GetStringMap(field.CustomFrontendModules),
-		ConfigTenants:                      viper.GetStringMap(field.Tenants),
-		ConfigRoles:                        viper.GetStringMap(field.Roles),
-		ConfigUsers:                        viper.GetStringMap(field.Users),
-		ConfigRolesCapabilitySets:          viper.GetStringMap(field.RolesCapabilitySetsEntry),
-		ConfigConsortiums:                  viper.GetStringMap(field.Consortiums),
+		ConfigBackendModules:               cfg.BackendModules,
+		ConfigFrontendModules:              cfg.FrontendModules,
+		ConfigCustomFrontendModules:        cfg.CustomFrontendModules,
+		ConfigTenants:                      cfg.Tenants,
+		ConfigRoles:                        cfg.Roles,
+		ConfigUsers:                        cfg.Users,
+		ConfigConsortiums:                  cfg.Consortiums,
 		ConfigExtraVolumes:                 viper.GetStringSlice(field.ExtraVolumes),
 	}
 }
@@ -109,6 +109,19 @@ func (a *Action) GetRequestURL(port string, route string) string {
 	return fmt.Sprintf(a.GatewayURLTemplate, port) + route
 }
 
+// ==================== Events ====================
+
+func (a *Action) EmitEvent(event events.Event) {
+	if a.Events == nil {
+		return
+	}
+	event.Action = a.Name
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+	a.Events.Emit(event)
+}
+
 // ==================== Application ====================
 
 func (a *Action) IsChildApp() bool {
@@ -207,12 +220,10 @@ func (a *Action) GetPreReservedPort() (int, error) {
 }
 
 func (a *Action) isPortFree(portStart, portEnd int, port int) bool {
-	tcpListen, err := net.Listen("tcp", fmt.Sprintf(":%s", strconv.Itoa(port)))
-	if err != nil {
+	if !helpers.IsTCPPortFree(port) {
 		slog.Debug(a.Name, "text", "TCP port is reserved or already bound in range", "target", port, "start", portStart, "end", portEnd)
 		return false
 	}
-	defer func() { _ = tcpListen.Close() }()
 
 	return true
 }
@@ -5,9 +5,14 @@ const (
 	BuildAndPushUi              = "Build and push UI"
 	BuildSystem                 = "Build System"
 	BuildUi                     = "Build UI"
+	CheckDependencies           = "Check Dependencies"
 	CheckPorts                  = "Check Ports"
+	CheckRoutes                 = "Check Routes"
+	CollectDiagnostics          = "Collect Diagnostics"
+	ConfigDiff                  = "Config Diff"
 	CreateConsortiums           = "Create Consortiums"
 	CreatePortProxy             = "Create Port Proxy"
+	CreateProfile               = "Create Profile"
 	CreateRoles                 = "Create Roles"
 	CreateTenantEntitlements    = "Create Tenant Entitlements"
 	CreateTenants               = "Create Tenants"
@@ -20,13 +25,17 @@ const (
 	DeploySystem                = "Deploy System"
 	DeployUi                    = "Deploy UI"
 	DetachCapabilitySets        = "Detach Capability Sets"
+	Doctor                      = "Doctor"
+	GenerateProfile             = "Generate Profile"
 	GetEdgeApiKey               = "Get Edge Api Key"          //nolint:gosec // G101: Not a hardcoded credential, just an action name
 	GetKeycloakAccessToken      = "Get Keycloak Access Token" //nolint:gosec // G101: Not a hardcoded credential, just an action name
 	GetVaultRootToken           = "Get Vault Root Token"      //nolint:gosec // G101: Not a hardcoded credential, just an action name
 	InterceptModule             = "Intercept Module"
 	ListModules                 = "List Modules"
 	ListModuleVersions          = "List Module Versions"
+	ListOutdated                = "List Outdated"
 	ListSystem                  = "List System"
+	Logs                        = "Logs"
 	PurgeTenants                = "Purge Tenants"
 	ReindexIndices              = "Reindex Indices"
 	RemoveRoles                 = "Remove Roles"
@@ -35,6 +44,10 @@ const (
 	RemoveUsers                 = "Remove Users"
 	Root                        = "Root"
 	RunLocalModule              = "Run Local Module"
+	ShowConfig                  = "Show Config"
+	Stats                       = "Stats"
+	Status                      = "Status"
+	Timings                     = "Timings"
 	UndeployAdditionalSystem    = "Undeploy Additional System"
 	UndeployApplication         = "Undeploy Application"
 	UndeployManagement          = "Undeploy Management"
@@ -43,6 +56,9 @@ const (
 	UndeploySystem              = "Undeploy System"
 	UndeployUi                  = "Undeploy UI"
 	UpdateKeycloakPublicClients = "Update Keycloak Public Clients"
+	UpdateLock                  = "Update Lock"
 	UpdateModuleDiscovery       = "Update Module Discovery"
 	UpgradeModule               = "Upgrade Module"
+	ValidateConfig              = "Validate Config"
+	WaitReady                   = "Wait Ready"
 )
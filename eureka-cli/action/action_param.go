@@ -1,5 +1,7 @@
 package action
 
+import "time"
+
 // Param is a central container of all parameters
 // passed to the program by the user from the shell instance
 type Param struct {
@@ -7,48 +9,74 @@ type Param struct {
 	ApplicationName       string
 	ApplicationNames      []string
 	BuildImages           bool
+	CapabilitySets        bool
 	Cleanup               bool
 	ConfigFile            string
 	DefaultGateway        bool
 	EnableDebug           bool
 	EnableECSRequests     bool
+	EventsFd              int
+	FailOnOutdated        bool
+	Follow                bool
+	FromLock              bool
 	GatewayHostname       string
 	GatewayURL            string
 	ID                    string
 	KeepVolumes           bool
 	Length                int
+	Level                 string
+	Lock                  bool
+	MaxBuildsBehind       int
 	ModuleName            string
 	ModulePath            string
 	ModuleType            string
 	ModuleURL             string
 	ModuleVersion         string
+	Modules               []string
 	Namespace             string
+	NewProfile            string
 	OnlyRequired          bool
+	Output                string
+	OutputEvents          string
+	OutputFile            string
 	OverwriteFiles        bool
 	LinkedData            bool
+	Plan                  bool
 	PlatformLspURL        string
 	PrivatePort           int
 	Profile               string
 	PurgeSchemas          bool
+	Regex                 string
 	RemoveApplication     bool
 	Restore               bool
+	Resume                bool
+	RollbackOnFailure     bool
+	Runs                  int
+	Since                 string
 	SidecarURL            string
 	SingleTenant          bool
 	SkipApplication       bool
 	SkipModuleArtifact    bool
 	SkipModuleImage       bool
 	SkipCapabilitySets    bool
+	SkipConfigValidation  bool
+	SkipDependencyCheck   bool
 	SkipModuleDeployment  bool
 	SkipModuleDiscovery   bool
 	SkipRegistry          bool
 	SkipTenantEntitlement bool
 	SkipUI                bool
+	StrictDependencyCheck bool
+	Tail                  int
 	Tenant                string
 	TenantIDs             []string
+	Timeout               time.Duration
 	TokenType             string
 	UpdateCloned          bool
 	User                  string
 	Versions              int
+	Watch                 bool
+	Yes                   bool
 }
 
 // Flag holds the metadata for a CLI flag
@@ -69,46 +97,72 @@ var (
 	ApplicationName       = Flag{"applicationName", "", "Name of the child application that owns local modules"}
 	ApplicationNames      = Flag{"apps", "", "Application names"}
 	BuildImages           = Flag{"buildImages", "b", "Build Docker images"}
+	CapabilitySets        = Flag{"capabilitySets", "", "Also wait for the capability sets consumer group lag to reach zero"}
 	Cleanup               = Flag{"cleanup", "", "Perform a cleanup operation"}
 	ConfigFile            = Flag{"configFile", "c", "Use a specific config file"}
 	DefaultGateway        = Flag{"defaultGateway", "g", "Use default gateway in URLs, .e.g. http://host.docker.internal:{{port}} will be set automatically"}
 	EnableDebug           = Flag{"enableDebug", "d", "Enable debug"}
 	EnableECSRequests     = Flag{"enableEcsRequests", "", "Enable ECS requests"}
+	EventsFd              = Flag{"eventsFd", "", "File descriptor to write events to, defaults to stdout"}
+	FailOnOutdated        = Flag{"failOnOutdated", "", "Fail when a module is more than --maxBuildsBehind builds behind the registry"}
+	Follow                = Flag{"follow", "f", "Follow log output"}
+	FromLock              = Flag{"fromLock", "", "Deploy the module versions and image digests pinned in eureka.lock.yaml, ignoring the registry"}
 	GatewayHostname       = Flag{"gatewayHostname", "", "Gateway hostname"}
 	GatewayURL            = Flag{"gatewayURL", "", "Gateway URL"}
 	ID                    = Flag{"id", "i", "Module id, e.g. mod-orders:13.1.0-SNAPSHOT.1021"}
 	KeepVolumes           = Flag{"keepVolumes", "k", "Preserve system data volumes during undeployment"}
 	Length                = Flag{"length", "l", "Salt length"}
+	Level                 = Flag{"level", "", "Minimum log level, options: TRACE, DEBUG, INFO, WARN, ERROR, FATAL"}
+	Lock                  = Flag{"lock", "", "Write the module versions, the sidecar version and the image digests of the deployment to eureka.lock.yaml"}
+	MaxBuildsBehind       = Flag{"maxBuildsBehind", "", "Flag the modules whose deployed version is more than this number of builds behind the registry, e.g. 10"}
 	ModuleName            = Flag{"moduleName", "n", "Module name, e.g. mod-orders"}
 	ModulePath            = Flag{"modulePath", "", "Module path, e.g. the path of your module in IntelliJ"}
 	ModuleType            = Flag{"moduleType", "y", "Module type, e.g. management"}
 	ModuleURL             = Flag{"moduleUrl", "m", "Module URL, e.g. http://host.docker.internal:36002 or 36002 (if -g is used)"}
 	ModuleVersion         = Flag{"moduleVersion", "", "Module version, e.g. 13.1.0-SNAPSHOT.1093"}
+	Modules               = Flag{"modules", "", "Modules of the new profile, e.g. mod-orders,mod-finance"}
 	Namespace             = Flag{"namespace", "", "DockerHub namespace"}
+	NewProfile            = Flag{"newProfile", "", "Name of the new profile, e.g. acquisitions"}
 	OnlyRequired          = Flag{"onlyRequired", "q", "Use only required system containers"}
-	OverwriteFiles        = Flag{"overwriteFiles", "o", "Overwrite files in %s home directory"}
+	Output                = Flag{"output", "", "Output format, options: table, json"}
+	OutputEvents          = Flag{"outputEvents", "", "Write machine-readable events, options: json"}
+	OutputFile            = Flag{"outputFile", "", "Output file path, e.g. ./diagnostics.tar.gz"}
+	OverwriteFiles        = Flag{"overwriteFiles", "o", "Refresh files in %s home directory, merging local changes with upstream changes"}
 	LinkedData            = Flag{"linkedData", "", "Include Linked Data module in UI bundle"}
+	Plan                  = Flag{"plan", "", "Print the deployment plan without deploying anything"}
 	PlatformLspURL        = Flag{"platformLspURL", "", "Platform LSP UI url"}
 	PrivatePort           = Flag{"privatePort", "", "Private port e.g. 8081"}
 	Profile               = Flag{"profile", "p", "Use a specific profile, options: %s"}
 	PurgeSchemas          = Flag{"purgeSchemas", "", "Purge schemas in PostgreSQL on uninstallation"}
+	Regex                 = Flag{"regex", "", "Only show log lines matching a regular expression"}
 	RemoveApplication     = Flag{"removeApplication", "", "Remove application from the DB"}
 	Restore               = Flag{"restore", "r", "Restore module & sidecar"}
+	Resume                = Flag{"resume", "", "Resume from the first step that did not complete in the previous run"}
+	RollbackOnFailure     = Flag{"rollbackOnFailure", "", "Remove the resources created by the run when the deployment fails"}
+	Runs                  = Flag{"runs", "", "Number of runs to compare, e.g. 5"}
+	Since                 = Flag{"since", "", "Show logs since a timestamp or a relative duration, e.g. 10m"}
 	SidecarURL            = Flag{"sidecarUrl", "s", "Sidecar URL e.g. http://host.docker.internal:37002 or 37002 (if -g is used)"}
 	SingleTenant          = Flag{"singleTenant", "", "Use for Single Tenant workflow"}
 	SkipApplication       = Flag{"skipApplication", "", "Skip application operations"}
 	SkipModuleArtifact    = Flag{"skipModuleArtifact", "", "Skip building module artifact, i.e. the jar and its module descriptor"}
 	SkipModuleImage       = Flag{"skipModuleImage", "", "Skip building module image, i.e. the Docker image from a prebuilt jar artifact"}
 	SkipCapabilitySets    = Flag{"skipCapabilitySets", "", "Skip refreshing capability sets"}
+	SkipConfigValidation  = Flag{"skipConfigValidation", "", "Skip validating the config file before running a command"}
+	SkipDependencyCheck   = Flag{"skipDependencyCheck", "", "Skip checking the interfaces required by the modules before deploying them"}
 	SkipModuleDeployment  = Flag{"skipModuleDeployment", "", "Skip module & sidecar deployment"}
 	SkipModuleDiscovery   = Flag{"skipModuleDiscovery", "", "Skip module discovery update"}
 	SkipRegistry          = Flag{"skipRegistry", "", "Skip retrieving module registry versions"}
 	SkipTenantEntitlement = Flag{"skipTenantEntitlement", "", "Skip tenant entitlement operations"}
 	SkipUI                = Flag{"skipUi", "", "Skip UI build and deployment"}
+	StrictDependencyCheck = Flag{"strictDependencyCheck", "", "Fail instead of warning when an interface required by the modules is not provided"}
+	Tail                  = Flag{"tail", "", "Number of log lines to collect per container, e.g. 500"}
 	Tenant                = Flag{"tenant", "t", "Tenant"}
 	TenantIDs             = Flag{"ids", "", "Tenant ids"}
+	Timeout               = Flag{"timeout", "", "Maximum time to wait, e.g. 15m"}
 	TokenType             = Flag{"tokenType", "", "Token type"}
 	UpdateCloned          = Flag{"updateCloned", "u", "Update Git cloned projects"}
 	User                  = Flag{"user", "x", "User"}
 	Versions              = Flag{"versions", "v", "Number of versions, e.g. 5"}
+	Watch                 = Flag{"watch", "w", "Refresh the output periodically until interrupted"}
+	Yes                   = Flag{"yes", "", "Apply the changes without asking for confirmation"}
 )
@@ -1,10 +1,14 @@
 package action_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"runtime"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/spf13/viper"
@@ -25,7 +29,7 @@ func TestNew(t *testing.T) {
 		params := &action.Param{}
 
 		// Act
-		result := action.New("test-action", "http://localhost:%s", params)
+		result := action.New("test-action", "http://localhost:%s", params, nil)
 
 		// Assert
 		assert.NotNil(t, result)
@@ -33,8 +37,6 @@ func TestNew(t *testing.T) {
 		assert.Equal(t, "http://localhost:%s", result.GatewayURLTemplate)
 		assert.Equal(t, params, result.Param)
 		assert.Equal(t, "", result.VaultRootToken)
-		assert.Equal(t, "", result.KeycloakAccessToken)
-		assert.Equal(t, "", result.KeycloakMasterAccessToken)
 		assert.Equal(t, "test-app", result.ConfigApplicationName)
 		assert.Equal(t, "1.0.0", result.ConfigApplicationVersion)
 		assert.Equal(t, "test-app-1.0.0", result.ConfigApplicationID)
@@ -66,10 +68,10 @@ func TestNewGeneric_AllViperFields(t *testing.T) {
 				"VAR1": "value1",
 			},
 			field.SidecarModule: map[string]any{
-				"name": "sidecar-module",
-			},
-			field.SidecarModuleResources: map[string]any{
-				"memory": "512m",
+				"image": "folio-module-sidecar",
+				"resources": map[string]any{
+					"memory": 512,
+				},
 			},
 			field.BackendModules: map[string]any{
 				"mod-inventory": nil,
@@ -89,9 +91,6 @@ func TestNewGeneric_AllViperFields(t *testing.T) {
 			field.Users: map[string]any{
 				"testuser": nil,
 			},
-			field.RolesCapabilitySetsEntry: map[string]any{
-				"admin-caps": nil,
-			},
 			field.Consortiums: map[string]any{
 				"test-consortium": nil,
 			},
@@ -102,9 +101,11 @@ func TestNewGeneric_AllViperFields(t *testing.T) {
 		}()
 
 		params := &action.Param{}
+		cfg, err := config.Load()
+		assert.NoError(t, err)
 
 		// Act
-		result := action.New("full-test", "http://test:%s", params)
+		result := action.New("full-test", "http://test:%s", params, cfg)
 
 		// Assert - Check core fields
 		assert.Equal(t, "full-app", result.ConfigApplicationName)
@@ -122,15 +123,14 @@ func TestNewGeneric_AllViperFields(t *testing.T) {
 		// Assert - Check maps are loaded
 		assert.NotNil(t, result.ConfigApplicationDependencies)
 		assert.NotNil(t, result.ConfigGlobalEnv)
-		assert.NotNil(t, result.ConfigSidecarModule)
-		assert.NotNil(t, result.ConfigSidecarModuleResources)
+		assert.Equal(t, "folio-module-sidecar", result.ConfigSidecarModule.Image)
+		assert.Equal(t, int64(512), *result.ConfigSidecarModule.Resources.Memory)
 		assert.NotNil(t, result.ConfigBackendModules)
 		assert.NotNil(t, result.ConfigFrontendModules)
 		assert.NotNil(t, result.ConfigCustomFrontendModules)
 		assert.NotNil(t, result.ConfigTenants)
 		assert.NotNil(t, result.ConfigRoles)
 		assert.NotNil(t, result.ConfigUsers)
-		assert.NotNil(t, result.ConfigRolesCapabilitySets)
 		assert.NotNil(t, result.ConfigConsortiums)
 	})
 }
@@ -404,6 +404,37 @@ func TestGetRequestURL(t *testing.T) {
 	})
 }
 
+// ==================== Events Tests ====================
+
+func TestEmitEvent(t *testing.T) {
+	t.Run("TestEmitEvent_SetsActionAndTimestamp", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		act := &action.Action{Name: "Deploy Application", Events: events.NewJSONSink(&buf)}
+
+		// Act
+		act.EmitEvent(events.Event{Type: events.ModuleDeployed, Module: "mod-orders"})
+
+		// Assert
+		var event events.Event
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &event))
+		assert.Equal(t, events.ModuleDeployed, event.Type)
+		assert.Equal(t, "Deploy Application", event.Action)
+		assert.Equal(t, "mod-orders", event.Module)
+		assert.False(t, event.Timestamp.IsZero())
+	})
+
+	t.Run("TestEmitEvent_NoSink", func(t *testing.T) {
+		// Arrange
+		act := &action.Action{Name: "Deploy Application"}
+
+		// Act & Assert
+		assert.NotPanics(t, func() {
+			act.EmitEvent(events.Event{Type: events.StepStarted})
+		})
+	})
+}
+
 // ==================== Environment Variable Tests ====================
 
 func TestGetConfigEnvVars(t *testing.T) {
@@ -804,7 +835,7 @@ func TestIsChildApp(t *testing.T) {
 			})
 			defer vc.Reset()
 
-			act := action.New("test", "http://localhost:%s", &action.Param{})
+			act := action.New("test", "http://localhost:%s", &action.Param{}, nil)
 
 			// Act
 			result := act.IsChildApp()
@@ -883,7 +914,7 @@ func TestGetParentAppIDs(t *testing.T) {
 			})
 			defer vc.Reset()
 
-			act := action.New("test", "http://localhost:%s", &action.Param{})
+			act := action.New("test", "http://localhost:%s", &action.Param{}, nil)
 
 			// Act
 			result := act.GetParentAppIDs()
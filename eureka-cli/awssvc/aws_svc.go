@@ -17,7 +17,7 @@ import (
 
 // AWSProcessor defines the interface for AWS service operations
 type AWSProcessor interface {
-	GetAuthorizationToken() (string, error)
+	GetAuthorizationToken(ctx context.Context) (string, error)
 	GetECRNamespace() string
 	IsECRConfigured() bool
 }
@@ -32,12 +32,12 @@ func New(action *action.Action) *AWSSvc {
 	return &AWSSvc{Action: action}
 }
 
-func (as *AWSSvc) GetAuthorizationToken() (string, error) {
+func (as *AWSSvc) GetAuthorizationToken(ctx context.Context) (string, error) {
 	if !as.IsECRConfigured() {
 		return "", nil
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutAWSConfig)
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutAWSConfig)
 	defer cancel()
 
 	cfg, err := config.LoadDefaultConfig(ctx)
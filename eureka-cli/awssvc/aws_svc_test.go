@@ -1,6 +1,7 @@
 package awssvc
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"os"
@@ -118,7 +119,7 @@ func TestGetAuthorizationToken_NotConfigured(t *testing.T) {
 	defer cleanup()
 
 	// Act
-	token, err := svc.GetAuthorizationToken()
+	token, err := svc.GetAuthorizationToken(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -0,0 +1,163 @@
+package checkpointsvc
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// CheckpointProcessor defines the interface for deployment checkpoint operations
+type CheckpointProcessor interface {
+	Load(resume bool) error
+	IsCompleted(step string) bool
+	MarkCompleted(step string) error
+	MarkSkipped(step string)
+	GetSkipped() []string
+	Clear() error
+}
+
+// CheckpointSvc persists completed deployment steps under the home directory
+// so that a failed pipeline can be resumed from the first unfinished step
+type CheckpointSvc struct {
+	Action     *action.Action
+	FilePath   string
+	checkpoint models.Checkpoint
+	skipped    []string
+	mu         sync.Mutex
+}
+
+// New creates a new CheckpointSvc instance
+func New(action *action.Action) *CheckpointSvc {
+	return &CheckpointSvc{Action: action}
+}
+
+// PartitionStep returns the checkpoint name of a step executed for a consortium and tenant type partition
+func PartitionStep(step string, consortiumName string, tenantType constant.TenantType) string {
+	return fmt.Sprintf("%s/%s-%s", step, consortiumName, tenantType)
+}
+
+func (cs *CheckpointSvc) Load(resume bool) error {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	filePath, err := cs.getFilePath()
+	if err != nil {
+		return err
+	}
+	cs.skipped = nil
+
+	if !resume {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		cs.checkpoint = cs.newCheckpoint()
+		return nil
+	}
+
+	var checkpoint models.Checkpoint
+	if err := helpers.ReadJSONFromFile(filePath, &checkpoint); err != nil {
+		if !os.IsNotExist(err) {
+			return errors.CheckpointReadFailed(filePath, err)
+		}
+		slog.Info(cs.Action.Name, "text", "No checkpoint found, starting from the first step", "profile", cs.Action.ConfigProfileName)
+		cs.checkpoint = cs.newCheckpoint()
+		return nil
+	}
+	if checkpoint.Action != cs.Action.Name {
+		return errors.CheckpointMismatch(filePath, "action", checkpoint.Action, cs.Action.Name)
+	}
+	if checkpoint.Profile != cs.Action.ConfigProfileName {
+		return errors.CheckpointMismatch(filePath, "profile", checkpoint.Profile, cs.Action.ConfigProfileName)
+	}
+	cs.checkpoint = checkpoint
+	slog.Info(cs.Action.Name, "text", "Resuming from checkpoint", "profile", checkpoint.Profile, "completed", len(checkpoint.Steps), "updatedAt", checkpoint.UpdatedAt.Format(time.RFC3339))
+
+	return nil
+}
+
+func (cs *CheckpointSvc) newCheckpoint() models.Checkpoint {
+	return models.Checkpoint{
+		Profile:   cs.Action.ConfigProfileName,
+		Action:    cs.Action.Name,
+		StartedAt: time.Now(),
+	}
+}
+
+func (cs *CheckpointSvc) IsCompleted(step string) bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return slices.ContainsFunc(cs.checkpoint.Steps, func(s models.CheckpointStep) bool {
+		return s.Name == step
+	})
+}
+
+func (cs *CheckpointSvc) MarkCompleted(step string) error {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	filePath, err := cs.getFilePath()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	cs.checkpoint.Steps = append(cs.checkpoint.Steps, models.CheckpointStep{Name: step, CompletedAt: now})
+	cs.checkpoint.UpdatedAt = now
+
+	return helpers.WriteJSONToFile(filePath, cs.checkpoint)
+}
+
+func (cs *CheckpointSvc) MarkSkipped(step string) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.skipped = append(cs.skipped, step)
+	slog.Info(cs.Action.Name, "text", "Skipping step completed in the previous run", "step", step)
+}
+
+func (cs *CheckpointSvc) GetSkipped() []string {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return slices.Clone(cs.skipped)
+}
+
+func (cs *CheckpointSvc) Clear() error {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	filePath, err := cs.getFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	cs.checkpoint = cs.newCheckpoint()
+
+	return nil
+}
+
+func (cs *CheckpointSvc) getFilePath() (string, error) {
+	if cs.FilePath != "" {
+		return cs.FilePath, nil
+	}
+
+	homeDir, err := helpers.GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, fmt.Sprintf(constant.CheckpointFilePattern, cs.Action.ConfigProfileName)), nil
+}
@@ -0,0 +1,243 @@
+package checkpointsvc_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/checkpointsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSvc(t *testing.T) *checkpointsvc.CheckpointSvc {
+	t.Helper()
+
+	action := testhelpers.NewMockAction()
+	action.ConfigProfileName = "combined"
+	svc := checkpointsvc.New(action)
+	svc.FilePath = filepath.Join(t.TempDir(), "combined_checkpoint.json")
+
+	return svc
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+
+	// Act
+	svc := checkpointsvc.New(action)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+}
+
+func TestPartitionStep(t *testing.T) {
+	// Act
+	step := checkpointsvc.PartitionStep("Create Roles", "eureka", constant.Central)
+
+	// Assert
+	assert.Equal(t, "Create Roles/eureka-central", step)
+}
+
+// ==================== Load Tests ====================
+
+func TestLoad_WithoutResume_RemovesExistingFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, helpers.WriteJSONToFile(svc.FilePath, models.Checkpoint{
+		Steps: []models.CheckpointStep{{Name: "Deploy System"}},
+	}))
+
+	// Act
+	err := svc.Load(false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, svc.IsCompleted("Deploy System"))
+	_, statErr := os.Stat(svc.FilePath)
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestLoad_WithResume_ReadsExistingFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, helpers.WriteJSONToFile(svc.FilePath, models.Checkpoint{
+		Profile: "combined",
+		Action:  "test-action",
+		Steps:   []models.CheckpointStep{{Name: "Deploy System"}, {Name: "Deploy Management"}},
+	}))
+
+	// Act
+	err := svc.Load(true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, svc.IsCompleted("Deploy System"))
+	assert.True(t, svc.IsCompleted("Deploy Management"))
+	assert.False(t, svc.IsCompleted("Deploy Modules"))
+}
+
+func TestLoad_WithResume_RejectsOtherAction(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, helpers.WriteJSONToFile(svc.FilePath, models.Checkpoint{
+		Profile: "combined",
+		Action:  "Deploy Application",
+		Steps:   []models.CheckpointStep{{Name: "Deploy System"}},
+	}))
+
+	// Act
+	err := svc.Load(true)
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "written for action Deploy Application")
+	assert.False(t, svc.IsCompleted("Deploy System"))
+}
+
+func TestLoad_WithResume_RejectsOtherProfile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, helpers.WriteJSONToFile(svc.FilePath, models.Checkpoint{
+		Profile: "ecs",
+		Action:  "test-action",
+		Steps:   []models.CheckpointStep{{Name: "Deploy System"}},
+	}))
+
+	// Act
+	err := svc.Load(true)
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "written for profile ecs, not combined")
+	assert.False(t, svc.IsCompleted("Deploy System"))
+}
+
+func TestLoad_WithResume_NoFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+
+	// Act
+	err := svc.Load(true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, svc.IsCompleted("Deploy System"))
+}
+
+func TestLoad_WithResume_CorruptFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, os.WriteFile(svc.FilePath, []byte("{not-json"), 0600))
+
+	// Act
+	err := svc.Load(true)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read checkpoint file")
+}
+
+// ==================== MarkCompleted Tests ====================
+
+func TestMarkCompleted_PersistsStep(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, svc.Load(false))
+
+	// Act
+	err := svc.MarkCompleted("Deploy System")
+
+	// Assert
+	assert.NoError(t, err)
+	var checkpoint models.Checkpoint
+	assert.NoError(t, helpers.ReadJSONFromFile(svc.FilePath, &checkpoint))
+	assert.Equal(t, "combined", checkpoint.Profile)
+	assert.Len(t, checkpoint.Steps, 1)
+	assert.Equal(t, "Deploy System", checkpoint.Steps[0].Name)
+	assert.False(t, checkpoint.Steps[0].CompletedAt.IsZero())
+}
+
+func TestMarkCompleted_ResumedRunKeepsPreviousSteps(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, svc.Load(false))
+	assert.NoError(t, svc.MarkCompleted("Deploy System"))
+	resumed := newTestSvc(t)
+	resumed.FilePath = svc.FilePath
+	assert.NoError(t, resumed.Load(true))
+
+	// Act
+	err := resumed.MarkCompleted("Deploy Management")
+
+	// Assert
+	assert.NoError(t, err)
+	var checkpoint models.Checkpoint
+	assert.NoError(t, helpers.ReadJSONFromFile(svc.FilePath, &checkpoint))
+	assert.Len(t, checkpoint.Steps, 2)
+}
+
+// ==================== MarkSkipped Tests ====================
+
+func TestMarkSkipped_ReturnsSkippedInOrder(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+
+	// Act
+	svc.MarkSkipped("Deploy System")
+	svc.MarkSkipped("Deploy Management")
+
+	// Assert
+	assert.Equal(t, []string{"Deploy System", "Deploy Management"}, svc.GetSkipped())
+}
+
+// ==================== Clear Tests ====================
+
+func TestClear_RemovesFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, svc.Load(false))
+	assert.NoError(t, svc.MarkCompleted("Deploy System"))
+
+	// Act
+	err := svc.Clear()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, svc.IsCompleted("Deploy System"))
+	_, statErr := os.Stat(svc.FilePath)
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestClear_NoFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+
+	// Act
+	err := svc.Clear()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestGetFilePath_DefaultsToHomeDir(t *testing.T) {
+	// Arrange
+	homeDir := testhelpers.SetTempConfigDir(t)
+	action := testhelpers.NewMockAction()
+	action.ConfigProfileName = "ecs"
+	svc := checkpointsvc.New(action)
+	assert.NoError(t, svc.Load(false))
+
+	// Act
+	err := svc.MarkCompleted("Deploy System")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(homeDir, "ecs_checkpoint.json"))
+}
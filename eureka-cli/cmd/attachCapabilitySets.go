@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -45,21 +46,23 @@ var attachCapabilitySetsCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.AttachCapabilitySets(consortiumName, tenantType, time.Duration(0*time.Second), false)
+			return run.AttachCapabilitySets(cmd.Context(), consortiumName, tenantType, time.Duration(0*time.Second), false)
 		})
 	},
 }
 
-func (run *Run) AttachCapabilitySets(consortiumName string, tenantType constant.TenantType, initialWait time.Duration, forceRefresh bool) error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+func (run *Run) AttachCapabilitySets(ctx context.Context, consortiumName string, tenantType constant.TenantType, initialWait time.Duration, forceRefresh bool) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.Password)
+	if err != nil {
 		return err
 	}
 
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
-		if initialWait > 0 {
-			time.Sleep(initialWait)
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
+		if err := helpers.Sleep(ctx, initialWait); err != nil {
+			return err
 		}
-		if err := run.updateRealmAccessTokenSettingsAndRelogin(configTenant); err != nil {
+		ctx, err := run.updateRealmAccessTokenSettingsAndRelogin(ctx, configTenant)
+		if err != nil {
 			return err
 		}
 
@@ -79,7 +82,7 @@ func (run *Run) AttachCapabilitySets(consortiumName string, tenantType constant.
 		skipPoll := false
 		var record capabilitySetsRecord
 		if err := helpers.ReadJSONFromFile(filePath, &record); err == nil && record.Total > 0 {
-			liveCount, countErr := run.Config.KeycloakSvc.CountCapabilitySets(configTenant)
+			liveCount, countErr := run.Config.KeycloakSvc.CountCapabilitySets(ctx, configTenant)
 			if countErr != nil {
 				slog.Warn(run.Config.Action.Name, "text", "Could not count capability sets, polling broker", "tenant", configTenant, "error", countErr)
 			} else if liveCount == record.Total {
@@ -96,15 +99,15 @@ func (run *Run) AttachCapabilitySets(consortiumName string, tenantType constant.
 		if !skipPoll {
 			topicConfigTenant := run.Config.Action.GetKafkaTopicConfigTenant(configTenant)
 			slog.Info(run.Config.Action.Name, "text", "POLLING FOR CAPABILITY SETS CREATION", "topicConfigTenant", topicConfigTenant)
-			if err := run.Config.KafkaSvc.PollConsumerGroup(topicConfigTenant); err != nil {
+			if err := run.Config.KafkaSvc.PollConsumerGroup(ctx, topicConfigTenant); err != nil {
 				return err
 			}
 		}
-		if err := run.Config.KeycloakSvc.AttachCapabilitySetsToRoles(configTenant); err != nil {
+		if err := run.Config.KeycloakSvc.AttachCapabilitySetsToRoles(ctx, configTenant); err != nil {
 			return err
 		}
 
-		count, err := run.Config.KeycloakSvc.CountCapabilitySets(configTenant)
+		count, err := run.Config.KeycloakSvc.CountCapabilitySets(ctx, configTenant)
 		if err != nil {
 			slog.Warn(run.Config.Action.Name, "text", "Could not count capability sets, skipping persistence", "tenant", configTenant, "error", err)
 			return nil
@@ -119,16 +122,17 @@ func (run *Run) AttachCapabilitySets(consortiumName string, tenantType constant.
 	})
 }
 
-func (run *Run) updateRealmAccessTokenSettingsAndRelogin(configTenant string) error {
-	if err := run.Config.KeycloakSvc.UpdateRealmAccessTokenSettings(configTenant, constant.KeycloakTenantRealmAccessTokenLifespan); err != nil {
-		return err
+func (run *Run) updateRealmAccessTokenSettingsAndRelogin(ctx context.Context, configTenant string) (context.Context, error) {
+	if err := run.Config.KeycloakSvc.UpdateRealmAccessTokenSettings(ctx, configTenant, constant.KeycloakTenantRealmAccessTokenLifespan); err != nil {
+		return nil, err
 	}
-	if err := run.setKeycloakAccessTokenIntoContext(configTenant); err != nil {
-		return err
+	ctx, err := run.setKeycloakAccessTokenIntoContext(ctx, configTenant)
+	if err != nil {
+		return nil, err
 	}
 	slog.Info(run.Config.Action.Name, "text", "New access token was set into context", "tenant", configTenant)
 
-	return nil
+	return ctx, nil
 }
 
 func init() {
@@ -60,9 +60,7 @@ func (run *Run) BuildAndPushUi() error {
 	if err := run.Config.DockerClient.PushImage(params.Namespace, imageName); err != nil {
 		return err
 	}
-	slog.Info(run.Config.Action.Name, "text", "Command completed", "duration", time.Since(start))
-
-	return nil
+	return run.CompleteCommand(start)
 }
 
 func init() {
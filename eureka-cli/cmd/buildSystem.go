@@ -40,9 +40,7 @@ var buildSystemCmd = &cobra.Command{
 		if err := run.BuildSystem(); err != nil {
 			return err
 		}
-		slog.Info(run.Config.Action.Name, "text", "Command completed", "duration", time.Since(start))
-
-		return nil
+		return run.CompleteCommand(start)
 	},
 }
 
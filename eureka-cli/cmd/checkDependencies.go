@@ -0,0 +1,252 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// checkDependenciesCmd represents the checkDependencies command
+var checkDependenciesCmd = &cobra.Command{
+	Use:   "checkDependencies",
+	Short: "Check module dependencies",
+	Long: `Resolve the interfaces required by the modules of the planned application against the interfaces
+provided by its modules, by the management modules and by the modules of its parent applications, using the module
+descriptors.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.CheckDependencies)
+		if err != nil {
+			return err
+		}
+
+		return run.CheckDependencies(cmd.Context())
+	},
+}
+
+func (run *Run) CheckDependencies(ctx context.Context) error {
+	unsatisfied, err := run.GetUnsatisfiedInterfaces(ctx)
+	if err != nil {
+		return err
+	}
+	if err := writeUnsatisfiedInterfaces(os.Stdout, unsatisfied, params.Output); err != nil {
+		return err
+	}
+
+	return newUnsatisfiedInterfacesError(unsatisfied)
+}
+
+// CheckModuleDependencies reports the interfaces required by the modules of the planned application that are not
+// provided before the deployment starts, with --strictDependencyCheck the deployment fails instead of failing during
+// the entitlement
+func (run *Run) CheckModuleDependencies(ctx context.Context) error {
+	if params.SkipDependencyCheck {
+		return nil
+	}
+	unsatisfied, err := run.GetUnsatisfiedInterfaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	return run.reportUnsatisfiedInterfaces(unsatisfied)
+}
+
+// reportUnsatisfiedInterfaces warns about the unsatisfied interfaces, they only fail the command with --strictDependencyCheck
+func (run *Run) reportUnsatisfiedInterfaces(unsatisfied []models.UnsatisfiedInterface) error {
+	if params.StrictDependencyCheck {
+		return newUnsatisfiedInterfacesError(unsatisfied)
+	}
+	for _, u := range unsatisfied {
+		slog.Warn(run.Config.Action.Name, "text", "Interface required by a module is not provided", "interface", u.String())
+	}
+	if len(unsatisfied) > 0 {
+		slog.Warn(run.Config.Action.Name, "text", "Entitlement may fail, use --strictDependencyCheck to stop before deploying", "unsatisfied", len(unsatisfied))
+	}
+
+	return nil
+}
+
+// GetUnsatisfiedInterfaces loads the module descriptors of the planned application and resolves the interfaces
+// its modules require, the modules of the parent applications of a child application provide interfaces too
+func (run *Run) GetUnsatisfiedInterfaces(ctx context.Context) ([]models.UnsatisfiedInterface, error) {
+	slog.Info(run.Config.Action.Name, "text", "CHECKING MODULE DEPENDENCIES")
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, false, true)
+	if err != nil {
+		return nil, err
+	}
+	run.Config.RegistrySvc.ResolveModuleMetadata(modules)
+
+	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
+	if err != nil {
+		return nil, err
+	}
+	frontendModules, err := run.Config.ModuleProps.ReadFrontendModules(false)
+	if err != nil {
+		return nil, err
+	}
+
+	extract := &models.RegistryExtract{
+		Modules:           modules,
+		BackendModules:    backendModules,
+		FrontendModules:   frontendModules,
+		ModuleDescriptors: make(map[string]any),
+	}
+	applicationPayload, err := run.Config.ManagementSvc.BuildApplicationPayload(ctx, extract)
+	if err != nil {
+		return nil, err
+	}
+	moduleDescriptors, err := run.Config.ManagementSvc.GetApplicationModuleDescriptors(ctx, extract, applicationPayload)
+	if err != nil {
+		return nil, err
+	}
+	managementModuleDescriptors, err := run.getManagementModuleDescriptors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parentModuleDescriptors, err := run.getParentModuleDescriptors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return run.Config.ManagementSvc.CheckModuleDependencies(moduleDescriptors, slices.Concat(managementModuleDescriptors, parentModuleDescriptors)), nil
+}
+
+// getManagementModuleDescriptors returns the module descriptors of the management modules of the profile, they provide
+// the interfaces of the management components that the modules of an application can require
+func (run *Run) getManagementModuleDescriptors(ctx context.Context) ([]any, error) {
+	managementModules, err := run.Config.ModuleProps.ReadBackendModules(true, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(managementModules) == 0 {
+		return nil, nil
+	}
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, false, false)
+	if err != nil {
+		return nil, err
+	}
+	run.Config.RegistrySvc.ResolveModuleMetadata(modules)
+
+	return run.Config.ManagementSvc.GetManagementModuleDescriptors(ctx, modules, managementModules)
+}
+
+func (run *Run) getParentModuleDescriptors(ctx context.Context) ([]any, error) {
+	parentAppIDs := run.Config.Action.GetParentAppIDs()
+	if len(parentAppIDs) == 0 {
+		return nil, nil
+	}
+	// The parent applications are reached through the gateway, which is only running once they are deployed
+	var netErr net.Error
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
+		if errors.As(err, &netErr) {
+			return nil, apperrors.ParentApplicationNotReachable(parentAppIDs, err)
+		}
+		return nil, err
+	}
+	parentModuleDescriptors, err := run.Config.ManagementSvc.GetParentModuleDescriptors(ctx, parentAppIDs)
+	if err != nil {
+		if errors.As(err, &netErr) {
+			return nil, apperrors.ParentApplicationNotReachable(parentAppIDs, err)
+		}
+		return nil, err
+	}
+
+	return parentModuleDescriptors, nil
+}
+
+func newUnsatisfiedInterfacesError(unsatisfied []models.UnsatisfiedInterface) error {
+	if len(unsatisfied) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(unsatisfied))
+	for _, u := range unsatisfied {
+		descriptions = append(descriptions, u.String())
+	}
+
+	return apperrors.ModuleDependenciesUnsatisfied(descriptions)
+}
+
+func writeUnsatisfiedInterfaces(w io.Writer, unsatisfied []models.UnsatisfiedInterface, output string) error {
+	switch output {
+	case constant.JSONOutput:
+		if unsatisfied == nil {
+			unsatisfied = []models.UnsatisfiedInterface{}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(unsatisfied)
+	case constant.TableOutput, "":
+		return writeUnsatisfiedInterfacesTable(w, unsatisfied)
+	default:
+		return apperrors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writeUnsatisfiedInterfacesTable(w io.Writer, unsatisfied []models.UnsatisfiedInterface) error {
+	if len(unsatisfied) == 0 {
+		_, _ = fmt.Fprintln(w, "All interfaces required by the modules are provided")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MODULE\tINTERFACE\tREQUIRED\tOPTIONAL\tPROVIDED")
+	for _, u := range unsatisfied {
+		provided := strings.Join(u.Provided, ", ")
+		if provided == "" {
+			provided = "-"
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join([]string{u.ModuleID, u.Interface, u.Version, strconv.FormatBool(u.Optional), provided}, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintf(w, "%d interface(s) required by the modules are not provided in a compatible version\n", len(unsatisfied))
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(checkDependenciesCmd)
+	checkDependenciesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+
+	if err := checkDependenciesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(apperrors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== CheckDependencies Tests ====================
+
+var testManagementModuleDescriptors = []any{
+	map[string]any{"id": "mgr-tenant-entitlements-4.0.0", "provides": []any{map[string]any{"id": "entitlements", "version": "1.0"}}},
+}
+
+// expectPlannedApplication expects the application planned from the registries and the module config to contain
+// mod-orders, with the management modules providing the entitlements interface
+func expectPlannedApplication(mockManagement *MockManagementSvc, mockRegistrySvc *MockRegistrySvc, mockModuleProps *MockModuleProps) {
+	managementModules := map[string]models.BackendModule{"mgr-tenant-entitlements": {DeployModule: true}}
+	mockRegistrySvc.On("GetModules", false, true).Return(&models.ProxyModulesByRegistry{}, nil)
+	mockRegistrySvc.On("GetModules", false, false).Return(&models.ProxyModulesByRegistry{}, nil)
+	mockRegistrySvc.On("ResolveModuleMetadata", mock.Anything).Return()
+	mockModuleProps.On("ReadBackendModules", true, false).Return(managementModules, nil)
+	mockModuleProps.On("ReadBackendModules", false, false).Return(map[string]models.BackendModule{}, nil)
+	mockManagement.On("GetManagementModuleDescriptors", mock.Anything, managementModules).Return(testManagementModuleDescriptors, nil)
+	mockModuleProps.On("ReadFrontendModules", false).Return(map[string]models.FrontendModule{}, nil)
+	mockManagement.On("BuildApplicationPayload", mock.Anything).Return(&models.ApplicationPayload{
+		BackendModules: []map[string]string{{"id": "mod-orders-13.0.0", "name": "mod-orders", "version": "13.0.0"}},
+	}, nil)
+	mockManagement.On("GetApplicationModuleDescriptors", mock.Anything, mock.Anything).Return([]any{
+		map[string]any{"id": "mod-orders-13.0.0"},
+	}, nil)
+}
+
+func TestGetUnsatisfiedInterfaces_ResolvesPlannedApplication(t *testing.T) {
+	// Arrange
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.CheckDependencies, withModuleProps(mockModuleProps), withRegistrySvc(mockRegistrySvc))
+	expectPlannedApplication(mockManagement, mockRegistrySvc, mockModuleProps)
+	unsatisfied := []models.UnsatisfiedInterface{
+		{ModuleID: "mod-orders-13.0.0", Interface: "finance.funds", Version: "3.0", Provided: []string{}},
+	}
+	mockManagement.On("CheckModuleDependencies", []any{map[string]any{"id": "mod-orders-13.0.0"}}, testManagementModuleDescriptors).Return(unsatisfied)
+
+	// Act
+	result, err := run.GetUnsatisfiedInterfaces(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, unsatisfied, result)
+	mockKeycloak.AssertNotCalled(t, "GetMasterAccessToken", mock.Anything)
+	mockManagement.AssertNotCalled(t, "GetParentModuleDescriptors", mock.Anything)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestGetUnsatisfiedInterfaces_ChildApplicationUsesParentModules(t *testing.T) {
+	// Arrange
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.CheckDependencies, withModuleProps(mockModuleProps), withRegistrySvc(mockRegistrySvc))
+	expectPlannedApplication(mockManagement, mockRegistrySvc, mockModuleProps)
+	run.Config.Action.ConfigApplicationDependencies = map[string]any{
+		"name":    "app-combined",
+		"version": "1.0.0",
+	}
+	parentModuleDescriptors := []any{map[string]any{"id": "mod-finance-5.0.0"}}
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+	mockManagement.On("GetParentModuleDescriptors", []string{"app-combined-1.0.0"}).Return(parentModuleDescriptors, nil)
+	mockManagement.On("CheckModuleDependencies", mock.Anything, slices.Concat(testManagementModuleDescriptors, parentModuleDescriptors)).Return(nil)
+
+	// Act
+	result, err := run.GetUnsatisfiedInterfaces(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+	mockKeycloak.AssertExpectations(t)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestGetUnsatisfiedInterfaces_ParentApplicationNotReachable(t *testing.T) {
+	// Arrange
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.CheckDependencies, withModuleProps(mockModuleProps), withRegistrySvc(mockRegistrySvc))
+	expectPlannedApplication(mockManagement, mockRegistrySvc, mockModuleProps)
+	run.Config.Action.ConfigApplicationDependencies = map[string]any{
+		"name":    "app-combined",
+		"version": "1.0.0",
+	}
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+
+	// Act
+	result, err := run.GetUnsatisfiedInterfaces(context.Background())
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "app-combined-1.0.0")
+	assert.True(t, errors.Is(err, apperrors.ErrDeploymentFailed))
+	mockManagement.AssertNotCalled(t, "CheckModuleDependencies", mock.Anything, mock.Anything)
+}
+
+func TestCheckModuleDependencies_UnsatisfiedWarns(t *testing.T) {
+	// Arrange
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, _, _, _, _ := newTestRun(action.CheckDependencies, withModuleProps(mockModuleProps), withRegistrySvc(mockRegistrySvc))
+	expectPlannedApplication(mockManagement, mockRegistrySvc, mockModuleProps)
+	mockManagement.On("CheckModuleDependencies", mock.Anything, mock.Anything).Return([]models.UnsatisfiedInterface{
+		{ModuleID: "mod-orders-13.0.0", Interface: "orders-storage.po-lines", Version: "12.0", Provided: []string{"11.1"}},
+	})
+
+	// Act
+	err := run.CheckModuleDependencies(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestCheckModuleDependencies_UnsatisfiedStrict(t *testing.T) {
+	// Arrange
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, _, _, _, _ := newTestRun(action.CheckDependencies, withModuleProps(mockModuleProps), withRegistrySvc(mockRegistrySvc))
+	expectPlannedApplication(mockManagement, mockRegistrySvc, mockModuleProps)
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{StrictDependencyCheck: true}
+	mockManagement.On("CheckModuleDependencies", mock.Anything, mock.Anything).Return([]models.UnsatisfiedInterface{
+		{ModuleID: "mod-orders-13.0.0", Interface: "orders-storage.po-lines", Version: "12.0", Provided: []string{"11.1"}},
+	})
+
+	// Act
+	err := run.CheckModuleDependencies(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, apperrors.ErrNotFound))
+	assert.Contains(t, err.Error(), "mod-orders-13.0.0 requires orders-storage.po-lines 12.0, provided 11.1")
+}
+
+func TestCheckModuleDependencies_Skipped(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, _, _ := newTestRun(action.DeployApplication)
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{SkipDependencyCheck: true}
+
+	// Act
+	err := run.CheckModuleDependencies(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertNotCalled(t, "BuildApplicationPayload", mock.Anything)
+}
+
+func TestCheckLocalModuleDependencies_ResolvesAgainstBaseAndLocalApplications(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, _, _ := newTestRun(action.RunLocalModule)
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{ModuleName: "mod-x", ID: "mod-x-1.0.1"}
+	mockModuleProps := &MockModuleProps{}
+	run.Config.ModuleProps = mockModuleProps
+	mockModuleProps.On("ReadBackendModules", true, false).Return(map[string]models.BackendModule{}, nil)
+
+	newModuleDescriptor := map[string]any{"id": "mod-x-1.0.1"}
+	baseApp := map[string]any{"moduleDescriptors": []any{map[string]any{"id": "mod-users-19.5.0"}}}
+	mockManagement.On("GetLatestApplicationByName", "app-local").Return(map[string]any{
+		"moduleDescriptors": []any{
+			map[string]any{"id": "mod-x-1.0.0"},
+			map[string]any{"id": "mod-y-2.0.0"},
+		},
+	}, nil)
+	mockManagement.On("CheckModuleDependencies", []any{newModuleDescriptor}, []any{
+		map[string]any{"id": "mod-users-19.5.0"},
+		map[string]any{"id": "mod-y-2.0.0"},
+	}).Return(nil)
+
+	// Act
+	err := run.checkLocalModuleDependencies(context.Background(), "app-local", baseApp, newModuleDescriptor)
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestCheckLocalModuleDependencies_WithoutBuiltDescriptor(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, _, _ := newTestRun(action.RunLocalModule)
+
+	// Act
+	err := run.checkLocalModuleDependencies(context.Background(), "app-local", map[string]any{}, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertNotCalled(t, "GetLatestApplicationByName", mock.Anything)
+}
+
+func TestWriteUnsatisfiedInterfaces_Table(t *testing.T) {
+	// Arrange
+	unsatisfied := []models.UnsatisfiedInterface{
+		{ModuleID: "mod-invoice-5.0.0", Interface: "finance.funds", Version: "3.0", Provided: []string{}},
+		{ModuleID: "mod-orders-13.0.0", Interface: "orders-storage.po-lines", Version: "12.0", Optional: true, Provided: []string{"11.1"}},
+	}
+	var out bytes.Buffer
+
+	// Act
+	err := writeUnsatisfiedInterfaces(&out, unsatisfied, constant.TableOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "MODULE")
+	assert.Regexp(t, `mod-invoice-5.0.0\s+finance.funds\s+3.0\s+false\s+-`, out.String())
+	assert.Regexp(t, `mod-orders-13.0.0\s+orders-storage.po-lines\s+12.0\s+true\s+11.1`, out.String())
+	assert.Contains(t, out.String(), "2 interface(s) required by the modules are not provided in a compatible version")
+}
+
+func TestWriteUnsatisfiedInterfaces_NoneUnsatisfied(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+
+	// Act
+	tableErr := writeUnsatisfiedInterfaces(&out, nil, constant.TableOutput)
+	jsonErr := writeUnsatisfiedInterfaces(&out, nil, constant.JSONOutput)
+
+	// Assert
+	assert.NoError(t, tableErr)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, "All interfaces required by the modules are provided\n[]\n", out.String())
+}
+
+func TestWriteUnsatisfiedInterfaces_UnsupportedFormat(t *testing.T) {
+	// Act
+	err := writeUnsatisfiedInterfaces(&bytes.Buffer{}, nil, "yaml")
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os/exec"
@@ -41,17 +42,17 @@ var checkPortsCmd = &cobra.Command{
 			return err
 		}
 
-		return run.CheckPorts()
+		return run.CheckPorts(cmd.Context())
 	},
 }
 
-func (run *Run) CheckPorts() error {
+func (run *Run) CheckPorts(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "CHECKING CONTAINER PORTS")
 	if err := run.deployNetcatContainer(); err != nil {
 		return err
 	}
 
-	modules, err := run.getDeployedModules()
+	modules, err := run.getDeployedModules(ctx)
 	if err != nil {
 		return err
 	}
@@ -70,7 +71,7 @@ func (run *Run) deployNetcatContainer() error {
 	return run.Config.ExecSvc.ExecFromDir(preparedCommand, homeDir)
 }
 
-func (run *Run) getDeployedModules() ([]container.Summary, error) {
+func (run *Run) getDeployedModules(ctx context.Context) ([]container.Summary, error) {
 	dockerClient, err := run.Config.DockerClient.Create()
 	if err != nil {
 		return nil, err
@@ -78,7 +79,7 @@ func (run *Run) getDeployedModules() ([]container.Summary, error) {
 	defer run.Config.DockerClient.Close(dockerClient)
 
 	filters := make(client.Filters).Add("name", fmt.Sprintf(constant.ProfileContainerPattern, run.Config.Action.ConfigProfileName))
-	containers, err := run.Config.ModuleSvc.GetDeployedModules(dockerClient, filters)
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,185 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// checkRoutesCmd represents the checkRoutes command
+var checkRoutesCmd = &cobra.Command{
+	Use:          "checkRoutes",
+	Short:        "Check Kong routes",
+	Long:         `Compare the Kong routes of every module with the handlers provided by its module descriptor.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.CheckRoutes)
+		if err != nil {
+			return err
+		}
+
+		return run.CheckRoutes(cmd.Context())
+	},
+}
+
+func (run *Run) CheckRoutes(ctx context.Context) error {
+	coverages, err := run.GetRouteCoverage(ctx, params.ApplicationName, params.ModuleName)
+	if err != nil {
+		return err
+	}
+	if err := writeRouteCoverage(os.Stdout, coverages, params.Output); err != nil {
+		return err
+	}
+	if mismatched := countMismatchedModules(coverages); mismatched > 0 {
+		return errors.KongRoutesMismatched(mismatched)
+	}
+
+	return nil
+}
+
+// GetRouteCoverage compares the Kong routes with the module descriptors of the latest version of an application,
+// the application of the config is used when no name is given and the modules can be narrowed down to one module
+func (run *Run) GetRouteCoverage(ctx context.Context, applicationName, moduleName string) ([]models.ModuleRouteCoverage, error) {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
+		return nil, err
+	}
+	if applicationName == "" {
+		applicationName = run.Config.Action.ConfigApplicationName
+	}
+
+	app, err := run.Config.ManagementSvc.GetLatestApplicationByName(ctx, applicationName)
+	if err != nil {
+		return nil, err
+	}
+	if app == nil {
+		return nil, errors.ApplicationNotFound(applicationName)
+	}
+
+	var moduleDescriptors []any
+	for _, value := range helpers.GetAnySlice(app, "moduleDescriptors") {
+		moduleDescriptor, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		if moduleName != "" && !helpers.MatchesModuleName(helpers.GetString(moduleDescriptor, "id"), moduleName) {
+			continue
+		}
+		moduleDescriptors = append(moduleDescriptors, moduleDescriptor)
+	}
+	if moduleName != "" && len(moduleDescriptors) == 0 {
+		return nil, errors.ModuleNotInApplication(moduleName, applicationName)
+	}
+
+	return run.Config.KongSvc.CheckRouteCoverage(ctx, moduleDescriptors)
+}
+
+func countMismatchedModules(coverages []models.ModuleRouteCoverage) int {
+	var mismatched int
+	for _, coverage := range coverages {
+		if len(coverage.Missing) > 0 || len(coverage.Extra) > 0 {
+			mismatched++
+		}
+	}
+
+	return mismatched
+}
+
+func writeRouteCoverage(w io.Writer, coverages []models.ModuleRouteCoverage, output string) error {
+	switch output {
+	case constant.JSONOutput:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(coverages)
+	case constant.TableOutput, "":
+		return writeRouteCoverageTable(w, coverages)
+	default:
+		return errors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writeRouteCoverageTable(w io.Writer, coverages []models.ModuleRouteCoverage) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MODULE\tEXPECTED\tACTUAL\tMISSING\tEXTRA")
+	for _, coverage := range coverages {
+		_, _ = fmt.Fprintln(tw, strings.Join([]string{
+			coverage.ModuleID,
+			strconv.Itoa(coverage.Expected),
+			strconv.Itoa(coverage.Actual),
+			strconv.Itoa(len(coverage.Missing)),
+			strconv.Itoa(len(coverage.Extra)),
+		}, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	mismatched := countMismatchedModules(coverages)
+	_, _ = fmt.Fprintln(w)
+	if mismatched == 0 {
+		_, _ = fmt.Fprintf(w, "Kong routes of %d module(s) match their module descriptors\n", len(coverages))
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "Kong routes of %d module(s) do not match their module descriptors:\n", mismatched)
+	for _, coverage := range coverages {
+		for _, expression := range coverage.Missing {
+			_, _ = fmt.Fprintf(w, "  - %s missing %s\n", coverage.ModuleID, expression)
+		}
+		for _, expression := range coverage.Extra {
+			_, _ = fmt.Fprintf(w, "  - %s extra %s\n", coverage.ModuleID, expression)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(checkRoutesCmd)
+	checkRoutesCmd.PersistentFlags().StringVarP(&params.ApplicationName, action.ApplicationName.Long, action.ApplicationName.Short, "", action.ApplicationName.Description)
+	checkRoutesCmd.PersistentFlags().StringVarP(&params.ModuleName, action.ModuleName.Long, action.ModuleName.Short, "", action.ModuleName.Description)
+	checkRoutesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+
+	if err := checkRoutesCmd.RegisterFlagCompletionFunc(action.ModuleName.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return helpers.GetBackendModuleNames(viper.GetStringMap(field.BackendModules)), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+	if err := checkRoutesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
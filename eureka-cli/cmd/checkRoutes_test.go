@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== CheckRoutes Tests ====================
+
+func TestGetRouteCoverage_FiltersByModuleName(t *testing.T) {
+	// Arrange
+	mockKong := &MockKongSvc{}
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.CheckRoutes, withApplicationName("app-combined"), withKongSvc(mockKong))
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
+	ordersDescriptor := map[string]any{"id": "mod-orders-13.1.0"}
+	mockManagement.On("GetLatestApplicationByName", "app-combined").Return(map[string]any{
+		"id":                "app-combined-1.0.0",
+		"moduleDescriptors": []any{map[string]any{"id": "mod-users-19.5.0"}, ordersDescriptor, map[string]any{"id": "mod-orders-storage-13.1.0"}},
+	}, nil)
+	expected := []models.ModuleRouteCoverage{{ModuleID: "mod-orders-13.1.0", Expected: 2, Actual: 2, Missing: []string{}, Extra: []string{}}}
+	mockKong.On("CheckRouteCoverage", []any{ordersDescriptor}).Return(expected, nil)
+
+	// Act
+	coverages, err := run.GetRouteCoverage(context.Background(), "", "mod-orders")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, coverages)
+	mockManagement.AssertExpectations(t)
+	mockKong.AssertExpectations(t)
+}
+
+func TestGetRouteCoverage_ApplicationNotFound(t *testing.T) {
+	// Arrange
+	mockKong := &MockKongSvc{}
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.CheckRoutes, withApplicationName("app-combined"), withKongSvc(mockKong))
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
+	mockManagement.On("GetLatestApplicationByName", "app-local").Return(nil, nil)
+
+	// Act
+	_, err := run.GetRouteCoverage(context.Background(), "app-local", "")
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "app-local")
+}
+
+func TestGetRouteCoverage_ModuleNotInApplication(t *testing.T) {
+	// Arrange
+	mockKong := &MockKongSvc{}
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.CheckRoutes, withApplicationName("app-combined"), withKongSvc(mockKong))
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
+	mockManagement.On("GetLatestApplicationByName", "app-combined").Return(map[string]any{
+		"moduleDescriptors": []any{map[string]any{"id": "mod-users-19.5.0"}},
+	}, nil)
+
+	// Act
+	_, err := run.GetRouteCoverage(context.Background(), "", "mod-orders")
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "module mod-orders is not part of application app-combined")
+	mockKong.AssertNotCalled(t, "CheckRouteCoverage", mock.Anything)
+}
+
+func TestWriteRouteCoverage_Table(t *testing.T) {
+	// Arrange
+	coverages := []models.ModuleRouteCoverage{
+		{ModuleID: "mod-orders-13.1.0", Expected: 2, Actual: 2, Missing: []string{`(http.path == "/orders" && http.method == "GET")`}, Extra: []string{`(http.path == "/legacy" && http.method == "GET")`}},
+		{ModuleID: "mod-users-19.5.0", Expected: 1, Actual: 1, Missing: []string{}, Extra: []string{}},
+	}
+	var out bytes.Buffer
+
+	// Act
+	err := writeRouteCoverage(&out, coverages, constant.TableOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "MODULE")
+	assert.Contains(t, out.String(), "Kong routes of 1 module(s) do not match their module descriptors:")
+	assert.Contains(t, out.String(), `  - mod-orders-13.1.0 missing (http.path == "/orders" && http.method == "GET")`)
+	assert.Contains(t, out.String(), `  - mod-orders-13.1.0 extra (http.path == "/legacy" && http.method == "GET")`)
+	assert.Equal(t, 1, countMismatchedModules(coverages))
+}
+
+func TestWriteRouteCoverage_JSON(t *testing.T) {
+	// Arrange
+	coverages := []models.ModuleRouteCoverage{{ModuleID: "mod-users-19.5.0", Expected: 1, Actual: 1, Missing: []string{}, Extra: []string{}}}
+	var out bytes.Buffer
+
+	// Act
+	err := writeRouteCoverage(&out, coverages, constant.JSONOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	var decoded []models.ModuleRouteCoverage
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, coverages, decoded)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"os/exec"
@@ -14,11 +15,18 @@ import (
 	"slices"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/checkpointsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/locksvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/logsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/modulesvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
@@ -36,7 +44,7 @@ type MockManagementSvc struct {
 	mock.Mock
 }
 
-func (m *MockManagementSvc) GetTenants(consortiumName string, tenantType constant.TenantType) ([]any, error) {
+func (m *MockManagementSvc) GetTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) ([]any, error) {
 	args := m.Called(consortiumName, tenantType)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -44,17 +52,27 @@ func (m *MockManagementSvc) GetTenants(consortiumName string, tenantType constan
 	return args.Get(0).([]any), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateTenants() error {
+func (m *MockManagementSvc) CreateTenants(ctx context.Context) error {
 	args := m.Called()
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveTenants(consortiumName string, tenantType constant.TenantType) error {
+func (m *MockManagementSvc) RemoveTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	args := m.Called(consortiumName, tenantType)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) GetApplications() (models.ApplicationsResponse, error) {
+func (m *MockManagementSvc) RemoveTenant(ctx context.Context, tenantName string) error {
+	args := m.Called(tenantName)
+	return args.Error(0)
+}
+
+func (m *MockManagementSvc) GetTenantType(tenant config.Tenant) string {
+	args := m.Called(tenant)
+	return args.String(0)
+}
+
+func (m *MockManagementSvc) GetApplications(ctx context.Context) (models.ApplicationsResponse, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
 		return models.ApplicationsResponse{}, args.Error(1)
@@ -62,7 +80,7 @@ func (m *MockManagementSvc) GetApplications() (models.ApplicationsResponse, erro
 	return args.Get(0).(models.ApplicationsResponse), args.Error(1)
 }
 
-func (m *MockManagementSvc) GetLatestApplication() (map[string]any, error) {
+func (m *MockManagementSvc) GetLatestApplication(ctx context.Context) (map[string]any, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -70,7 +88,7 @@ func (m *MockManagementSvc) GetLatestApplication() (map[string]any, error) {
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
-func (m *MockManagementSvc) GetLatestApplicationByName(appName string) (map[string]any, error) {
+func (m *MockManagementSvc) GetLatestApplicationByName(ctx context.Context, appName string) (map[string]any, error) {
 	args := m.Called(appName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -78,27 +96,35 @@ func (m *MockManagementSvc) GetLatestApplicationByName(appName string) (map[stri
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateApplication(extract *models.RegistryExtract) error {
+func (m *MockManagementSvc) CreateApplication(ctx context.Context, extract *models.RegistryExtract) error {
 	args := m.Called(extract)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) CreateNewApplication(r *models.ApplicationUpgradeRequest) error {
+func (m *MockManagementSvc) BuildApplicationPayload(ctx context.Context, extract *models.RegistryExtract) (*models.ApplicationPayload, error) {
+	args := m.Called(extract)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ApplicationPayload), args.Error(1)
+}
+
+func (m *MockManagementSvc) CreateNewApplication(ctx context.Context, r *models.ApplicationUpgradeRequest) error {
 	args := m.Called(r)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveApplication(applicationID string) error {
+func (m *MockManagementSvc) RemoveApplication(ctx context.Context, applicationID string) error {
 	args := m.Called(applicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveApplications(applicationName, ignoreApplicationID string) error {
+func (m *MockManagementSvc) RemoveApplications(ctx context.Context, applicationName, ignoreApplicationID string) error {
 	args := m.Called(applicationName, ignoreApplicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) GetModuleDiscovery(name string) (models.ModuleDiscoveryResponse, error) {
+func (m *MockManagementSvc) GetModuleDiscovery(ctx context.Context, name string) (models.ModuleDiscoveryResponse, error) {
 	args := m.Called(name)
 	if args.Get(0) == nil {
 		return models.ModuleDiscoveryResponse{}, args.Error(1)
@@ -106,22 +132,30 @@ func (m *MockManagementSvc) GetModuleDiscovery(name string) (models.ModuleDiscov
 	return args.Get(0).(models.ModuleDiscoveryResponse), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateNewModuleDiscovery(newDiscoveryModules []map[string]string) error {
+func (m *MockManagementSvc) GetModuleDiscoveries(ctx context.Context) (models.ModuleDiscoveryResponse, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return models.ModuleDiscoveryResponse{}, args.Error(1)
+	}
+	return args.Get(0).(models.ModuleDiscoveryResponse), args.Error(1)
+}
+
+func (m *MockManagementSvc) CreateNewModuleDiscovery(ctx context.Context, newDiscoveryModules []map[string]string) error {
 	args := m.Called(newDiscoveryModules)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) UpdateModuleDiscovery(id string, restore bool, privatePort int, sidecarURL string) error {
+func (m *MockManagementSvc) UpdateModuleDiscovery(ctx context.Context, id string, restore bool, privatePort int, sidecarURL string) error {
 	args := m.Called(id, restore, privatePort, sidecarURL)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveModuleDiscovery(id string) error {
+func (m *MockManagementSvc) RemoveModuleDiscovery(ctx context.Context, id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) GetTenantEntitlements(tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
+func (m *MockManagementSvc) GetTenantEntitlements(ctx context.Context, tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
 	args := m.Called(tenantName, includeModules)
 	if args.Get(0) == nil {
 		return models.TenantEntitlementResponse{}, args.Error(1)
@@ -129,57 +163,103 @@ func (m *MockManagementSvc) GetTenantEntitlements(tenantName string, includeModu
 	return args.Get(0).(models.TenantEntitlementResponse), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateTenantEntitlement(consortiumName string, tenantType constant.TenantType) error {
+func (m *MockManagementSvc) CreateTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	args := m.Called(consortiumName, tenantType)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) CreateTenantEntitlementForApplication(consortiumName string, tenantType constant.TenantType, applicationID string) error {
+func (m *MockManagementSvc) CreateTenantEntitlementForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string) error {
 	args := m.Called(consortiumName, tenantType, applicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
+func (m *MockManagementSvc) UpgradeTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
 	args := m.Called(consortiumName, tenantType, newApplicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveTenantEntitlements(consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error {
+func (m *MockManagementSvc) RemoveTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error {
 	args := m.Called(consortiumName, tenantType, purgeSchemas)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveTenantEntitlementsForApplication(consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error {
+func (m *MockManagementSvc) RemoveTenantEntitlementsForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error {
 	args := m.Called(consortiumName, tenantType, applicationID, purgeSchemas)
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) RemoveTenantEntitlement(ctx context.Context, tenantName string, applicationID string, purgeSchemas bool) error {
+	args := m.Called(tenantName, applicationID, purgeSchemas)
+	return args.Error(0)
+}
+
+func (m *MockManagementSvc) GetApplicationModuleDescriptors(ctx context.Context, extract *models.RegistryExtract, applicationPayload *models.ApplicationPayload) ([]any, error) {
+	args := m.Called(extract, applicationPayload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]any), args.Error(1)
+}
+
+func (m *MockManagementSvc) GetParentModuleDescriptors(ctx context.Context, applicationIDs []string) ([]any, error) {
+	args := m.Called(applicationIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]any), args.Error(1)
+}
+
+func (m *MockManagementSvc) GetManagementModuleDescriptors(ctx context.Context, modules *models.ProxyModulesByRegistry, managementModules map[string]models.BackendModule) ([]any, error) {
+	args := m.Called(modules, managementModules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]any), args.Error(1)
+}
+
+func (m *MockManagementSvc) CheckModuleDependencies(moduleDescriptors []any, providerModuleDescriptors []any) []models.UnsatisfiedInterface {
+	args := m.Called(moduleDescriptors, providerModuleDescriptors)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]models.UnsatisfiedInterface)
+}
+
+func (m *MockManagementSvc) ResolveModuleDependencies(moduleDescriptors []any, moduleNames []string, preferredModuleNames []string) ([]string, []models.UnsatisfiedInterface) {
+	args := m.Called(moduleDescriptors, moduleNames, preferredModuleNames)
+	var unsatisfied []models.UnsatisfiedInterface
+	if args.Get(1) != nil {
+		unsatisfied = args.Get(1).([]models.UnsatisfiedInterface)
+	}
+	return args.Get(0).([]string), unsatisfied
+}
+
 // MockKeycloakSvc is a mock for keycloaksvc.KeycloakProcessor
 type MockKeycloakSvc struct {
 	mock.Mock
 }
 
-func (m *MockKeycloakSvc) GetAccessToken(tenantName string) (string, error) {
+func (m *MockKeycloakSvc) GetAccessToken(ctx context.Context, tenantName string) (string, error) {
 	args := m.Called(tenantName)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) GetMasterAccessToken(grantType constant.KeycloakGrantType) (string, error) {
+func (m *MockKeycloakSvc) GetMasterAccessToken(ctx context.Context, grantType constant.KeycloakGrantType) (string, error) {
 	args := m.Called(grantType)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) UpdateRealmAccessTokenSettings(tenantName string, lifespan int) error {
+func (m *MockKeycloakSvc) UpdateRealmAccessTokenSettings(ctx context.Context, tenantName string, lifespan int) error {
 	args := m.Called(tenantName, lifespan)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) UpdatePublicClientSettings(tenantName string, url string) error {
+func (m *MockKeycloakSvc) UpdatePublicClientSettings(ctx context.Context, tenantName string, url string) error {
 	args := m.Called(tenantName, url)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) GetUsers(tenantName string) ([]any, error) {
+func (m *MockKeycloakSvc) GetUsers(ctx context.Context, tenantName string) ([]any, error) {
 	args := m.Called(tenantName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -187,17 +267,22 @@ func (m *MockKeycloakSvc) GetUsers(tenantName string) ([]any, error) {
 	return args.Get(0).([]any), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) CreateUsers(configTenant string) error {
+func (m *MockKeycloakSvc) CreateUsers(ctx context.Context, configTenant string) error {
 	args := m.Called(configTenant)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) RemoveUsers(tenantName string) error {
+func (m *MockKeycloakSvc) RemoveUsers(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) GetRoles(headers map[string]string) ([]any, error) {
+func (m *MockKeycloakSvc) RemoveUser(ctx context.Context, tenantName string, username string) error {
+	args := m.Called(tenantName, username)
+	return args.Error(0)
+}
+
+func (m *MockKeycloakSvc) GetRoles(ctx context.Context, headers map[string]string) ([]any, error) {
 	args := m.Called(headers)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -205,7 +290,7 @@ func (m *MockKeycloakSvc) GetRoles(headers map[string]string) ([]any, error) {
 	return args.Get(0).([]any), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) GetRoleByName(roleName string, headers map[string]string) (map[string]any, error) {
+func (m *MockKeycloakSvc) GetRoleByName(ctx context.Context, roleName string, headers map[string]string) (map[string]any, error) {
 	args := m.Called(roleName, headers)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -213,17 +298,22 @@ func (m *MockKeycloakSvc) GetRoleByName(roleName string, headers map[string]stri
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) CreateRoles(configTenant string) error {
+func (m *MockKeycloakSvc) CreateRoles(ctx context.Context, configTenant string) error {
 	args := m.Called(configTenant)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) RemoveRoles(tenantName string) error {
+func (m *MockKeycloakSvc) RemoveRoles(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) GetCapabilitySets(headers map[string]string) ([]any, error) {
+func (m *MockKeycloakSvc) RemoveRole(ctx context.Context, tenantName string, roleName string) error {
+	args := m.Called(tenantName, roleName)
+	return args.Error(0)
+}
+
+func (m *MockKeycloakSvc) GetCapabilitySets(ctx context.Context, headers map[string]string) ([]any, error) {
 	args := m.Called(headers)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -231,7 +321,7 @@ func (m *MockKeycloakSvc) GetCapabilitySets(headers map[string]string) ([]any, e
 	return args.Get(0).([]any), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) GetCapabilitySetsByName(headers map[string]string, capabilityName string) ([]any, error) {
+func (m *MockKeycloakSvc) GetCapabilitySetsByName(ctx context.Context, headers map[string]string, capabilityName string) ([]any, error) {
 	args := m.Called(headers, capabilityName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -239,12 +329,12 @@ func (m *MockKeycloakSvc) GetCapabilitySetsByName(headers map[string]string, cap
 	return args.Get(0).([]any), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) HasCapabilitySets(tenantName string) (bool, error) {
+func (m *MockKeycloakSvc) HasCapabilitySets(ctx context.Context, tenantName string) (bool, error) {
 	args := m.Called(tenantName)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockKeycloakSvc) CountCapabilitySets(tenantName string) (int, error) {
+func (m *MockKeycloakSvc) CountCapabilitySets(ctx context.Context, tenantName string) (int, error) {
 	args := m.Called(tenantName)
 	return args.Int(0), args.Error(1)
 }
@@ -259,12 +349,12 @@ func (m *MockKeycloakSvc) DetachCapabilitySets(tenantName string) error {
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
+func (m *MockKeycloakSvc) AttachCapabilitySetsToRoles(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
 
-func (m *MockKeycloakSvc) DetachCapabilitySetsFromRoles(tenantName string) error {
+func (m *MockKeycloakSvc) DetachCapabilitySetsFromRoles(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
@@ -327,14 +417,15 @@ type MockModuleSvc struct {
 	mock.Mock
 }
 
-func (m *MockModuleSvc) GetVaultRootToken(client *client.Client) (string, error) {
+func (m *MockModuleSvc) GetVaultRootToken(ctx context.Context, client *client.Client) (string, error) {
 	args := m.Called(client)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockModuleSvc) CheckModuleReadiness(wg *sync.WaitGroup, errCh chan<- error, moduleName string, port int) {
+func (m *MockModuleSvc) CheckModuleReadiness(ctx context.Context, wg *sync.WaitGroup, errCh chan<- error, moduleName string, port int) {
 	defer wg.Done()
-	m.Called(wg, errCh, moduleName, port)
+	// The wait group is not passed on, the mock would read it while the other readiness checks call Done
+	m.Called(moduleName, port)
 }
 
 func (m *MockModuleSvc) GetBackendModule(containers *models.Containers, moduleName string) (*models.BackendModule, *models.ProxyModule) {
@@ -381,7 +472,7 @@ func (m *MockModuleSvc) GetSidecarEnv(containers *models.Containers, module *mod
 	return args.Get(0).([]string)
 }
 
-func (m *MockModuleSvc) GetDeployedModules(cli *client.Client, f client.Filters) ([]container.Summary, error) {
+func (m *MockModuleSvc) GetDeployedModules(ctx context.Context, cli *client.Client, f client.Filters) ([]container.Summary, error) {
 	args := m.Called(cli, f)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -389,7 +480,7 @@ func (m *MockModuleSvc) GetDeployedModules(cli *client.Client, f client.Filters)
 	return args.Get(0).([]container.Summary), args.Error(1)
 }
 
-func (m *MockModuleSvc) GetModule(cli *client.Client, moduleName string) ([]container.Summary, error) {
+func (m *MockModuleSvc) GetModule(ctx context.Context, cli *client.Client, moduleName string) ([]container.Summary, error) {
 	args := m.Called(cli, moduleName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -397,12 +488,17 @@ func (m *MockModuleSvc) GetModule(cli *client.Client, moduleName string) ([]cont
 	return args.Get(0).([]container.Summary), args.Error(1)
 }
 
-func (m *MockModuleSvc) PullModule(cli *client.Client, imageName string) error {
+func (m *MockModuleSvc) PullModule(ctx context.Context, cli *client.Client, imageName string) error {
 	args := m.Called(cli, imageName)
 	return args.Error(0)
 }
 
-func (m *MockModuleSvc) DeployModules(cli *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, int, error) {
+func (m *MockModuleSvc) PinImage(ctx context.Context, cli *client.Client, imageName string, digest string) error {
+	args := m.Called(cli, imageName, digest)
+	return args.Error(0)
+}
+
+func (m *MockModuleSvc) DeployModules(ctx context.Context, cli *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, int, error) {
 	args := m.Called(cli, containers, sidecarImage, sidecarResources)
 	if args.Get(0) == nil {
 		return nil, args.Int(1), args.Error(2)
@@ -410,60 +506,239 @@ func (m *MockModuleSvc) DeployModules(cli *client.Client, containers *models.Con
 	return args.Get(0).(map[string]int), args.Int(1), args.Error(2)
 }
 
-func (m *MockModuleSvc) DeployModule(cli *client.Client, container *models.Container) error {
+func (m *MockModuleSvc) DeployModule(ctx context.Context, cli *client.Client, container *models.Container) error {
 	args := m.Called(cli, container)
 	return args.Error(0)
 }
 
-func (m *MockModuleSvc) UndeployModuleByNamePattern(cli *client.Client, pattern string) error {
+func (m *MockModuleSvc) UndeployModuleByNamePattern(ctx context.Context, cli *client.Client, pattern string) error {
 	args := m.Called(cli, pattern)
 	return args.Error(0)
 }
 
-func (m *MockModuleSvc) UndeployModuleAndSidecarPair(cli *client.Client, pair *modulesvc.ModulePair) error {
+func (m *MockModuleSvc) UndeployModuleAndSidecarPair(ctx context.Context, cli *client.Client, pair *modulesvc.ModulePair) error {
 	args := m.Called(cli, pair)
 	return args.Error(0)
 }
 
-func (m *MockModuleSvc) DeployCustomModule(cli *client.Client, pair *modulesvc.ModulePair) error {
+func (m *MockModuleSvc) DeployCustomModule(ctx context.Context, cli *client.Client, pair *modulesvc.ModulePair) error {
 	args := m.Called(cli, pair)
 	return args.Error(0)
 }
 
-func (m *MockModuleSvc) DeployCustomSidecar(cli *client.Client, pair *modulesvc.ModulePair) error {
+func (m *MockModuleSvc) DeployCustomSidecar(ctx context.Context, cli *client.Client, pair *modulesvc.ModulePair) error {
 	args := m.Called(cli, pair)
 	return args.Error(0)
 }
 
-func (m *MockModuleSvc) CheckModuleAndSidecarReadiness(pair *modulesvc.ModulePair) error {
+func (m *MockModuleSvc) CheckModuleAndSidecarReadiness(ctx context.Context, pair *modulesvc.ModulePair) error {
 	args := m.Called(pair)
 	return args.Error(0)
 }
 
+func (m *MockModuleSvc) GetDockerMemory(ctx context.Context, cli *client.Client) (int64, error) {
+	args := m.Called(cli)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockModuleSvc) GetDockerVersion(ctx context.Context, cli *client.Client) (string, error) {
+	args := m.Called(cli)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockModuleSvc) ImageExists(ctx context.Context, cli *client.Client, imageName string) (bool, error) {
+	args := m.Called(cli, imageName)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockModuleSvc) GetImageDigest(ctx context.Context, cli *client.Client, imageName string) (string, error) {
+	args := m.Called(cli, imageName)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockModuleSvc) InspectContainer(ctx context.Context, cli *client.Client, containerName string) (container.InspectResponse, error) {
+	args := m.Called(cli, containerName)
+	return args.Get(0).(container.InspectResponse), args.Error(1)
+}
+
+func (m *MockModuleSvc) GetContainerStats(ctx context.Context, cli *client.Client, containerNames []string) (map[string]container.StatsResponse, error) {
+	args := m.Called(cli, containerNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]container.StatsResponse), args.Error(1)
+}
+
+func (m *MockModuleSvc) CountOOMKills(ctx context.Context, cli *client.Client, since time.Time) (map[string]int, error) {
+	args := m.Called(cli, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
 // MockInterceptModuleSvc is a mock for interceptmodulesvc.InterceptModuleProcessor
 type MockInterceptModuleSvc struct {
 	mock.Mock
 }
 
-func (m *MockInterceptModuleSvc) DeployDefaultModuleAndSidecarPair(cli *client.Client, pair *modulesvc.ModulePair) error {
+func (m *MockInterceptModuleSvc) DeployDefaultModuleAndSidecarPair(ctx context.Context, cli *client.Client, pair *modulesvc.ModulePair) error {
 	args := m.Called(cli, pair)
 	return args.Error(0)
 }
 
-func (m *MockInterceptModuleSvc) DeployCustomSidecarForInterception(cli *client.Client, pair *modulesvc.ModulePair) error {
+func (m *MockInterceptModuleSvc) DeployCustomSidecarForInterception(ctx context.Context, cli *client.Client, pair *modulesvc.ModulePair) error {
 	args := m.Called(cli, pair)
 	return args.Error(0)
 }
 
+// MockUpgradeModuleSvc is a mock for upgrademodulesvc.UpgradeModuleProcessor
+type MockUpgradeModuleSvc struct {
+	mock.Mock
+}
+
+func (m *MockUpgradeModuleSvc) SetNewModuleVersionAndIDIntoContext() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockUpgradeModuleSvc) SetDefaultNamespaceIntoContext() {
+	m.Called()
+}
+
+func (m *MockUpgradeModuleSvc) BuildModuleArtifact(moduleName, moduleVersion, modulePath string) error {
+	args := m.Called(moduleName, moduleVersion, modulePath)
+	return args.Error(0)
+}
+
+func (m *MockUpgradeModuleSvc) CleanModuleArtifact(moduleName, modulePath string) error {
+	args := m.Called(moduleName, modulePath)
+	return args.Error(0)
+}
+
+func (m *MockUpgradeModuleSvc) BuildModuleImage(namespace, moduleName, moduleVersion, modulePath string) error {
+	args := m.Called(namespace, moduleName, moduleVersion, modulePath)
+	return args.Error(0)
+}
+
+func (m *MockUpgradeModuleSvc) ReadModuleDescriptor(moduleName, moduleVersion, modulePath string) (map[string]any, error) {
+	args := m.Called(moduleName, moduleVersion, modulePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m *MockUpgradeModuleSvc) ResolveModuleIdentity(modulePath string) (string, string, error) {
+	args := m.Called(modulePath)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockUpgradeModuleSvc) GetModuleDescriptorPath(modulePath string) (string, error) {
+	args := m.Called(modulePath)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockUpgradeModuleSvc) UpdateBackendModules(moduleName, newModuleVersion string, shouldBuild bool, oldBackendModules []any) ([]map[string]any, []map[string]string, string, error) {
+	args := m.Called(moduleName, newModuleVersion, shouldBuild, oldBackendModules)
+	if args.Get(0) == nil {
+		return nil, nil, "", args.Error(3)
+	}
+	return args.Get(0).([]map[string]any), args.Get(1).([]map[string]string), args.String(2), args.Error(3)
+}
+
+func (m *MockUpgradeModuleSvc) UpdateFrontendModules(shouldBuild bool, oldFrontendModules []any) []map[string]any {
+	args := m.Called(shouldBuild, oldFrontendModules)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]map[string]any)
+}
+
+func (m *MockUpgradeModuleSvc) UpdateBackendModuleDescriptors(moduleName, oldModuleID string, newModuleDescriptor map[string]any, oldBackendModuleDescriptors []any) []any {
+	args := m.Called(moduleName, oldModuleID, newModuleDescriptor, oldBackendModuleDescriptors)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]any)
+}
+
+func (m *MockUpgradeModuleSvc) DeployModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error {
+	args := m.Called(client, pair)
+	return args.Error(0)
+}
+
+// MockKongSvc is a mock for kongsvc.KongProcessor
+type MockKongSvc struct {
+	mock.Mock
+}
+
+func (m *MockKongSvc) CheckRouteReadiness(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockKongSvc) CheckRouteExists(ctx context.Context, routeID string) (bool, *models.KongRoute, error) {
+	args := m.Called(routeID)
+	return args.Bool(0), args.Get(1).(*models.KongRoute), args.Error(2)
+}
+
+func (m *MockKongSvc) FindRouteByExpressions(ctx context.Context, expressions []string) ([]*models.KongRoute, error) {
+	args := m.Called(expressions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.KongRoute), args.Error(1)
+}
+
+func (m *MockKongSvc) ListAllRoutes(ctx context.Context) ([]models.KongRoute, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KongRoute), args.Error(1)
+}
+
+func (m *MockKongSvc) GetExpectedRouteExpressions(moduleDescriptor map[string]any) []string {
+	args := m.Called(moduleDescriptor)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]string)
+}
+
+func (m *MockKongSvc) CheckRouteCoverage(ctx context.Context, moduleDescriptors []any) ([]models.ModuleRouteCoverage, error) {
+	args := m.Called(moduleDescriptors)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ModuleRouteCoverage), args.Error(1)
+}
+
+// MockLogSvc is a mock for logsvc.LogProcessor
+type MockLogSvc struct {
+	mock.Mock
+}
+
+func (m *MockLogSvc) StreamLogs(ctx context.Context, reader logsvc.ContainerLogReader, containerNames []string, w io.Writer) error {
+	args := m.Called(reader, containerNames, w)
+	return args.Error(0)
+}
+
+func (m *MockLogSvc) ReadLogs(ctx context.Context, reader logsvc.ContainerLogReader, containerName string, tail int) ([]byte, error) {
+	args := m.Called(reader, containerName, tail)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 // Helper function to create a test Run instance with mocks
-func newTestRun(actionName string) (*Run, *MockManagementSvc, *MockKeycloakSvc, *MockVaultClient, *MockDockerClient, *MockModuleSvc) {
+func newTestRun(actionName string, opts ...testRunOption) (*Run, *MockManagementSvc, *MockKeycloakSvc, *MockVaultClient, *MockDockerClient, *MockModuleSvc) {
 	mockAction := testhelpers.NewMockAction()
 	mockAction.Name = actionName
-	mockAction.KeycloakMasterAccessToken = "master-token"
-	mockAction.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"name": "test-tenant",
-		},
+	mockAction.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 
 	mockHTTP := &testhelpers.MockHTTPClient{}
@@ -484,13 +759,114 @@ func newTestRun(actionName string) (*Run, *MockManagementSvc, *MockKeycloakSvc,
 			ManagementSvc: mockManagement,
 			KeycloakSvc:   mockKeycloak,
 			ModuleSvc:     mockModule,
+			ReadinessSvc:  &testhelpers.MockReadinessSvc{},
 		},
 	}
 
 	run := &Run{Config: config}
+	for _, opt := range opts {
+		opt(run)
+	}
+
 	return run, mockManagement, mockKeycloak, mockVault, mockDocker, mockModule
 }
 
+// testRunOption sets up a service or config value of the run created by newTestRun
+type testRunOption func(run *Run)
+
+func withProfileName(profileName string) testRunOption {
+	return func(run *Run) {
+		run.Config.Action.ConfigProfileName = profileName
+	}
+}
+
+func withApplicationName(applicationName string) testRunOption {
+	return func(run *Run) {
+		run.Config.Action.ConfigApplicationName = applicationName
+	}
+}
+
+func withBackendModules(backendModules map[string]config.BackendModule) testRunOption {
+	return func(run *Run) {
+		run.Config.Action.ConfigBackendModules = backendModules
+	}
+}
+
+func withFrontendModules(frontendModules map[string]config.FrontendModule) testRunOption {
+	return func(run *Run) {
+		run.Config.Action.ConfigFrontendModules = frontendModules
+	}
+}
+
+// withDockerClient expects the docker client to be created and closed by the command
+func withDockerClient() testRunOption {
+	return func(run *Run) {
+		mockDocker := run.Config.DockerClient.(*MockDockerClient)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+	}
+}
+
+func withHTTPClient(mockHTTP *testhelpers.MockHTTPClient) testRunOption {
+	return func(run *Run) {
+		run.Config.HTTPClient = mockHTTP
+	}
+}
+
+func withExecSvc(mockExec *MockExecSvc) testRunOption {
+	return func(run *Run) {
+		run.Config.ExecSvc = mockExec
+	}
+}
+
+func withKongSvc(mockKong *MockKongSvc) testRunOption {
+	return func(run *Run) {
+		run.Config.KongSvc = mockKong
+	}
+}
+
+func withLogSvc(mockLog *MockLogSvc) testRunOption {
+	return func(run *Run) {
+		run.Config.LogSvc = mockLog
+	}
+}
+
+func withRegistrySvc(mockRegistry *MockRegistrySvc) testRunOption {
+	return func(run *Run) {
+		run.Config.RegistrySvc = mockRegistry
+	}
+}
+
+func withModuleProps(mockModuleProps *MockModuleProps) testRunOption {
+	return func(run *Run) {
+		run.Config.ModuleProps = mockModuleProps
+	}
+}
+
+func withConfigSvc() testRunOption {
+	return func(run *Run) {
+		run.Config.ConfigSvc = configsvc.New(run.Config.Action)
+	}
+}
+
+// withCheckpointFile uses a checkpoint service that persists the checkpoint to the file
+func withCheckpointFile(filePath string) testRunOption {
+	return func(run *Run) {
+		checkpointSvc := checkpointsvc.New(run.Config.Action)
+		checkpointSvc.FilePath = filePath
+		run.Config.CheckpointSvc = checkpointSvc
+	}
+}
+
+// withLockFile uses a lock service that reads and writes the lockfile
+func withLockFile(filePath string) testRunOption {
+	return func(run *Run) {
+		lockSvc := locksvc.New(run.Config.Action)
+		lockSvc.FilePath = filePath
+		run.Config.LockSvc = lockSvc
+	}
+}
+
 // MockExecSvc is a mock for execsvc.CommandRunner
 type MockExecSvc struct {
 	mock.Mock
@@ -561,12 +937,12 @@ type MockSearchSvc struct {
 	mock.Mock
 }
 
-func (m *MockSearchSvc) ReindexInventoryRecords(tenantName string) error {
+func (m *MockSearchSvc) ReindexInventoryRecords(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
 
-func (m *MockSearchSvc) ReindexInstanceRecords(tenantName string) error {
+func (m *MockSearchSvc) ReindexInstanceRecords(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
@@ -581,7 +957,12 @@ func (m *MockKafkaSvc) CheckBrokerReadiness() error {
 	return args.Error(0)
 }
 
-func (m *MockKafkaSvc) PollConsumerGroup(tenantName string) error {
+func (m *MockKafkaSvc) PollConsumerGroup(ctx context.Context, tenantName string) error {
+	args := m.Called(tenantName)
+	return args.Error(0)
+}
+
+func (m *MockKafkaSvc) CheckConsumerGroupLag(ctx context.Context, tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
@@ -612,7 +993,7 @@ func (m *MockRegistrySvc) GetNamespace(version string) string {
 	return args.String(0)
 }
 
-func (m *MockRegistrySvc) GetModules(verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error) {
+func (m *MockRegistrySvc) GetModules(ctx context.Context, verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error) {
 	args := m.Called(verbose, forceRefresh)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -620,11 +1001,39 @@ func (m *MockRegistrySvc) GetModules(verbose bool, forceRefresh bool) (*models.P
 	return args.Get(0).(*models.ProxyModulesByRegistry), args.Error(1)
 }
 
+func (m *MockRegistrySvc) FetchModules(ctx context.Context, verbose bool) (*models.ProxyModulesByRegistry, error) {
+	args := m.Called(verbose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProxyModulesByRegistry), args.Error(1)
+}
+
+func (m *MockRegistrySvc) GetModuleDescriptors(ctx context.Context, forceRefresh bool) (*models.PlatformModuleDescriptors, error) {
+	args := m.Called(forceRefresh)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PlatformModuleDescriptors), args.Error(1)
+}
+
+func (m *MockRegistrySvc) GetPlatformDescriptor(ctx context.Context) (*models.PlatformDescriptor, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PlatformDescriptor), args.Error(1)
+}
+
 func (m *MockRegistrySvc) ResolveModuleMetadata(modules *models.ProxyModulesByRegistry) {
 	m.Called(modules)
 }
 
-func (m *MockRegistrySvc) GetAuthorizationToken() (string, error) {
+func (m *MockRegistrySvc) PinModuleVersions(modules []models.ApplicationModule) {
+	m.Called(modules)
+}
+
+func (m *MockRegistrySvc) GetAuthorizationToken(ctx context.Context) (string, error) {
 	args := m.Called()
 	return args.String(0), args.Error(1)
 }
@@ -639,7 +1048,7 @@ func TestCreateTenants_Success(t *testing.T) {
 	mockManagement.On("CreateTenants").Return(nil)
 
 	// Act
-	err := run.CreateTenants()
+	err := run.CreateTenants(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -655,7 +1064,7 @@ func TestCreateTenants_GetTokenError(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", expectedError)
 
 	// Act
-	err := run.CreateTenants()
+	err := run.CreateTenants(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -673,7 +1082,7 @@ func TestCreateTenants_CreateTenantsError(t *testing.T) {
 	mockManagement.On("CreateTenants").Return(expectedError)
 
 	// Act
-	err := run.CreateTenants()
+	err := run.CreateTenants(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -692,7 +1101,7 @@ func TestRemoveTenants_Success(t *testing.T) {
 	mockManagement.On("RemoveTenants", mock.Anything, mock.Anything).Return(nil)
 
 	// Act
-	err := run.RemoveTenants(constant.NoneConsortium, constant.Default)
+	err := run.RemoveTenants(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -708,7 +1117,7 @@ func TestRemoveTenants_GetTokenError(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", expectedError)
 
 	// Act
-	err := run.RemoveTenants(constant.NoneConsortium, constant.Default)
+	err := run.RemoveTenants(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -726,7 +1135,7 @@ func TestRemoveTenants_RemoveTenantsError(t *testing.T) {
 	mockManagement.On("RemoveTenants", mock.Anything, mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.RemoveTenants(constant.NoneConsortium, constant.Default)
+	err := run.RemoveTenants(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -752,7 +1161,7 @@ func TestCreateUsers_Success(t *testing.T) {
 	mockKeycloak.On("CreateUsers", "test-tenant").Return(nil)
 
 	// Act
-	err := run.CreateUsers(constant.NoneConsortium, constant.Default)
+	err := run.CreateUsers(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -775,7 +1184,7 @@ func TestCreateUsers_GetTenantsError(t *testing.T) {
 		Return(nil, expectedError)
 
 	// Act
-	err := run.CreateUsers(constant.NoneConsortium, constant.Default)
+	err := run.CreateUsers(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -800,7 +1209,7 @@ func TestCreateUsers_CreateUsersError(t *testing.T) {
 	mockKeycloak.On("CreateUsers", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.CreateUsers(constant.NoneConsortium, constant.Default)
+	err := run.CreateUsers(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -824,7 +1233,7 @@ func TestCreateUsers_GetAccessTokenError(t *testing.T) {
 	mockKeycloak.On("GetAccessToken", "test-tenant").Return("", expectedError)
 
 	// Act
-	err := run.CreateUsers(constant.NoneConsortium, constant.Default)
+	err := run.CreateUsers(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -847,7 +1256,7 @@ func TestRemoveUsers_Success(t *testing.T) {
 	mockKeycloak.On("RemoveUsers", "test-tenant").Return(nil)
 
 	// Act
-	err := run.RemoveUsers(constant.NoneConsortium, constant.Default)
+	err := run.RemoveUsers(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -871,7 +1280,7 @@ func TestRemoveUsers_RemoveUsersError(t *testing.T) {
 	mockKeycloak.On("RemoveUsers", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.RemoveUsers(constant.NoneConsortium, constant.Default)
+	err := run.RemoveUsers(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -894,7 +1303,7 @@ func TestCreateRoles_Success(t *testing.T) {
 	mockKeycloak.On("CreateRoles", "test-tenant").Return(nil)
 
 	// Act
-	err := run.CreateRoles(constant.NoneConsortium, constant.Default)
+	err := run.CreateRoles(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -918,7 +1327,7 @@ func TestCreateRoles_CreateRolesError(t *testing.T) {
 	mockKeycloak.On("CreateRoles", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.CreateRoles(constant.NoneConsortium, constant.Default)
+	err := run.CreateRoles(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -941,7 +1350,7 @@ func TestRemoveRoles_Success(t *testing.T) {
 	mockKeycloak.On("RemoveRoles", "test-tenant").Return(nil)
 
 	// Act
-	err := run.RemoveRoles(constant.NoneConsortium, constant.Default)
+	err := run.RemoveRoles(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -965,7 +1374,7 @@ func TestRemoveRoles_RemoveRolesError(t *testing.T) {
 	mockKeycloak.On("RemoveRoles", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.RemoveRoles(constant.NoneConsortium, constant.Default)
+	err := run.RemoveRoles(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -1136,13 +1545,11 @@ func TestReindexIndices_Success(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.ReindexIndices)
 	mockSearchSvc := &MockSearchSvc{}
 	run.Config.SearchSvc = mockSearchSvc
-	run.Config.Action.ConfigConsortiums = map[string]any{
-		"test-consortium": map[string]any{
-			"name": "test-consortium",
-		},
+	run.Config.Action.ConfigConsortiums = map[string]config.Consortium{
+		"test-consortium": {},
 	}
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-consortium-central": map[string]any{},
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-consortium-central": {},
 	}
 
 	mockDocker.On("Create").Return(nil, nil)
@@ -1155,7 +1562,7 @@ func TestReindexIndices_Success(t *testing.T) {
 	mockSearchSvc.On("ReindexInstanceRecords", "test-consortium-central").Return(nil)
 
 	// Act
-	err := run.ReindexIndices("test-consortium", constant.Central)
+	err := run.ReindexIndices(context.Background(), "test-consortium", constant.Central)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1170,13 +1577,11 @@ func TestReindexIndices_InventoryError(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.ReindexIndices)
 	mockSearchSvc := &MockSearchSvc{}
 	run.Config.SearchSvc = mockSearchSvc
-	run.Config.Action.ConfigConsortiums = map[string]any{
-		"test-consortium": map[string]any{
-			"name": "test-consortium",
-		},
+	run.Config.Action.ConfigConsortiums = map[string]config.Consortium{
+		"test-consortium": {},
 	}
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-consortium-central": map[string]any{},
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-consortium-central": {},
 	}
 
 	expectedError := assert.AnError
@@ -1189,7 +1594,7 @@ func TestReindexIndices_InventoryError(t *testing.T) {
 	mockSearchSvc.On("ReindexInventoryRecords", "test-consortium-central").Return(expectedError)
 
 	// Act
-	err := run.ReindexIndices("test-consortium", constant.Central)
+	err := run.ReindexIndices(context.Background(), "test-consortium", constant.Central)
 
 	// Assert
 	assert.Error(t, err)
@@ -1206,9 +1611,9 @@ func TestDeployUi_Success(t *testing.T) {
 	mockUISvc := &MockUISvc{}
 	run.Config.TenantSvc = mockTenantSvc
 	run.Config.UISvc = mockUISvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 	params.PlatformLspURL = "http://localhost:3000"
@@ -1224,7 +1629,7 @@ func TestDeployUi_Success(t *testing.T) {
 	mockUISvc.On("DeployContainer", "test-tenant", "test-image", 3000).Return(nil)
 
 	// Act
-	err := run.DeployUi(constant.NoneConsortium, constant.Default)
+	err := run.DeployUi(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1239,9 +1644,9 @@ func TestBuildUi_Success(t *testing.T) {
 	mockUISvc := &MockUISvc{}
 	run.Config.TenantSvc = mockTenantSvc
 	run.Config.UISvc = mockUISvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -1263,9 +1668,9 @@ func TestBuildUi_SkipsTenantWithoutUI(t *testing.T) {
 	run, _, _, _, _, _ := newTestRun(action.BuildUi)
 	mockUISvc := &MockUISvc{}
 	run.Config.UISvc = mockUISvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": false,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: false,
 		},
 	}
 
@@ -1284,9 +1689,9 @@ func TestBuildUi_BuildImageError(t *testing.T) {
 	mockUISvc := &MockUISvc{}
 	run.Config.TenantSvc = mockTenantSvc
 	run.Config.UISvc = mockUISvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -1312,7 +1717,7 @@ func TestDeployUi_SkipUi(t *testing.T) {
 	t.Cleanup(func() { params.SkipUI = false })
 
 	// Act
-	err := run.DeployUi(constant.NoneConsortium, constant.Default)
+	err := run.DeployUi(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1324,9 +1729,9 @@ func TestDeployUi_SetConfigError(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.DeployUi)
 	mockTenantSvc := &testhelpers.MockTenantSvc{}
 	run.Config.TenantSvc = mockTenantSvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -1340,7 +1745,7 @@ func TestDeployUi_SetConfigError(t *testing.T) {
 	mockTenantSvc.On("SetConfigTenantParams", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.DeployUi(constant.NoneConsortium, constant.Default)
+	err := run.DeployUi(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -1355,9 +1760,9 @@ func TestDeployUi_PrepareImageError(t *testing.T) {
 	mockUISvc := &MockUISvc{}
 	run.Config.TenantSvc = mockTenantSvc
 	run.Config.UISvc = mockUISvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -1372,7 +1777,7 @@ func TestDeployUi_PrepareImageError(t *testing.T) {
 	mockUISvc.On("PrepareImage", "test-tenant").Return("", expectedError)
 
 	// Act
-	err := run.DeployUi(constant.NoneConsortium, constant.Default)
+	err := run.DeployUi(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -1460,7 +1865,7 @@ func TestCheckPorts_Success(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.CheckPorts()
+	err := run.CheckPorts(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -1479,7 +1884,7 @@ func TestCheckPorts_ExecError(t *testing.T) {
 	mockExecSvc.On("ExecFromDir", mock.Anything, mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.CheckPorts()
+	err := run.CheckPorts(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -1505,7 +1910,7 @@ func TestUpdateModuleDiscovery_Success(t *testing.T) {
 	mockManagement.On("UpdateModuleDiscovery", "module-id-123", false, 8080, mock.Anything).Return(nil)
 
 	// Act
-	err := run.UpdateModuleDiscovery()
+	err := run.UpdateModuleDiscovery(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -1522,7 +1927,7 @@ func TestUpdateModuleDiscovery_GetModuleError(t *testing.T) {
 	mockManagement.On("GetModuleDiscovery", "test-module").Return(models.ModuleDiscoveryResponse{}, expectedError)
 
 	// Act
-	err := run.UpdateModuleDiscovery()
+	err := run.UpdateModuleDiscovery(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -1546,7 +1951,7 @@ func TestUpdateModuleDiscovery_UpdateError(t *testing.T) {
 	mockManagement.On("UpdateModuleDiscovery", "module-id-123", true, mock.Anything, mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.UpdateModuleDiscovery()
+	err := run.UpdateModuleDiscovery(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -1579,7 +1984,7 @@ func TestUndeployModule_Success(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployModule()
+	err := run.UndeployModule(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -1597,7 +2002,7 @@ func TestUndeployModule_UndeployError(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployModule()
+	err := run.UndeployModule(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -1653,7 +2058,7 @@ func TestListModuleVersions_WithID(t *testing.T) {
 	mockHTTP.On("GetReturnRawBytes", mock.Anything, mock.Anything).Return([]byte(`{"id":"test-module-1.0.0"}`), nil)
 
 	// Act
-	err := run.ListModuleVersions()
+	err := run.ListModuleVersions(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -1679,7 +2084,7 @@ func TestListModuleVersions_WithModuleName(t *testing.T) {
 		}).Return(nil)
 
 	// Act
-	err := run.ListModuleVersions()
+	err := run.ListModuleVersions(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -1709,7 +2114,7 @@ func TestAttachCapabilitySets_Success(t *testing.T) {
 	mockKeycloak.On("CountCapabilitySets", "test-tenant").Return(530, nil)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1747,7 +2152,7 @@ func TestAttachCapabilitySets_FilePersisted_SkipsPoll(t *testing.T) {
 	mockKeycloak.On("CountCapabilitySets", "test-tenant").Return(530, nil)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1771,7 +2176,7 @@ func TestAttachCapabilitySets_GetMasterTokenError(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", expectedError)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert
 	assert.Error(t, err)
@@ -1793,7 +2198,7 @@ func TestAttachCapabilitySets_UpdateRealmError(t *testing.T) {
 	mockKeycloak.On("UpdateRealmAccessTokenSettings", mock.Anything, mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert
 	assert.Error(t, err)
@@ -1821,7 +2226,7 @@ func TestAttachCapabilitySets_AttachError(t *testing.T) {
 	mockKeycloak.On("AttachCapabilitySetsToRoles", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert
 	assert.Error(t, err)
@@ -1853,7 +2258,7 @@ func TestAttachCapabilitySets_ForceRefresh_DeletesFile(t *testing.T) {
 	mockKeycloak.On("CountCapabilitySets", "test-tenant").Return(200, nil)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, true)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, true)
 
 	// Assert — poll must have been called since file was deleted
 	assert.NoError(t, err)
@@ -1892,7 +2297,7 @@ func TestAttachCapabilitySets_CountChanged_Polls(t *testing.T) {
 	mockKeycloak.On("CountCapabilitySets", "test-tenant").Return(200, nil)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert — poll called; file updated to live count
 	assert.NoError(t, err)
@@ -1929,7 +2334,7 @@ func TestAttachCapabilitySets_PreCheckCountError_Polls(t *testing.T) {
 	mockKeycloak.On("CountCapabilitySets", "test-tenant").Return(300, nil)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert — poll called despite file existing; function succeeds
 	assert.NoError(t, err)
@@ -1958,7 +2363,7 @@ func TestAttachCapabilitySets_PostAttachCountError_ReturnsNil(t *testing.T) {
 	mockKeycloak.On("CountCapabilitySets", "test-tenant").Return(0, assert.AnError)
 
 	// Act
-	err := run.AttachCapabilitySets(constant.NoneConsortium, constant.Default, 0, false)
+	err := run.AttachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default, 0, false)
 
 	// Assert — count error is non-fatal; no file written
 	assert.NoError(t, err)
@@ -1981,7 +2386,7 @@ func TestDetachCapabilitySets_Success(t *testing.T) {
 	mockKeycloak.On("DetachCapabilitySetsFromRoles", "test-tenant").Return(nil)
 
 	// Act
-	err := run.DetachCapabilitySets(constant.NoneConsortium, constant.Default)
+	err := run.DetachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2001,7 +2406,7 @@ func TestDetachCapabilitySets_DetachError(t *testing.T) {
 	mockKeycloak.On("DetachCapabilitySetsFromRoles", "test-tenant").Return(assert.AnError)
 
 	// Act
-	err := run.DetachCapabilitySets(constant.NoneConsortium, constant.Default)
+	err := run.DetachCapabilitySets(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert - function continues despite error
 	assert.NoError(t, err)
@@ -2015,9 +2420,9 @@ func TestUpdateKeycloakPublicClients_Success(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.UpdateKeycloakPublicClients)
 	mockTenantSvc := &testhelpers.MockTenantSvc{}
 	run.Config.TenantSvc = mockTenantSvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -2031,7 +2436,7 @@ func TestUpdateKeycloakPublicClients_Success(t *testing.T) {
 	mockKeycloak.On("UpdatePublicClientSettings", "test-tenant", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UpdateKeycloakPublicClients(constant.NoneConsortium, constant.Default)
+	err := run.UpdateKeycloakPublicClients(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2044,9 +2449,9 @@ func TestUpdateKeycloakPublicClients_SetConfigError(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.UpdateKeycloakPublicClients)
 	mockTenantSvc := &testhelpers.MockTenantSvc{}
 	run.Config.TenantSvc = mockTenantSvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -2060,7 +2465,7 @@ func TestUpdateKeycloakPublicClients_SetConfigError(t *testing.T) {
 	mockTenantSvc.On("SetConfigTenantParams", "test-tenant").Return(expectedError)
 
 	// Act
-	err := run.UpdateKeycloakPublicClients(constant.NoneConsortium, constant.Default)
+	err := run.UpdateKeycloakPublicClients(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -2073,9 +2478,9 @@ func TestUpdateKeycloakPublicClients_UpdateClientError(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.UpdateKeycloakPublicClients)
 	mockTenantSvc := &testhelpers.MockTenantSvc{}
 	run.Config.TenantSvc = mockTenantSvc
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"deploy-ui": true,
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			DeployUI: true,
 		},
 	}
 
@@ -2090,7 +2495,7 @@ func TestUpdateKeycloakPublicClients_UpdateClientError(t *testing.T) {
 	mockKeycloak.On("UpdatePublicClientSettings", "test-tenant", mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.UpdateKeycloakPublicClients(constant.NoneConsortium, constant.Default)
+	err := run.UpdateKeycloakPublicClients(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -2110,7 +2515,7 @@ func TestGetVaultRootToken_Success(t *testing.T) {
 	mockModule.On("GetVaultRootToken", mock.Anything).Return(expectedToken, nil)
 
 	// Act
-	err := run.GetVaultRootToken()
+	err := run.GetVaultRootToken(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2127,7 +2532,7 @@ func TestGetVaultRootToken_CreateClientError(t *testing.T) {
 	mockDocker.On("Create").Return(nil, expectedError)
 
 	// Act
-	err := run.GetVaultRootToken()
+	err := run.GetVaultRootToken(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -2145,7 +2550,7 @@ func TestGetVaultRootToken_GetTokenError(t *testing.T) {
 	mockModule.On("GetVaultRootToken", mock.Anything).Return("", expectedError)
 
 	// Act
-	err := run.GetVaultRootToken()
+	err := run.GetVaultRootToken(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -2164,7 +2569,7 @@ func TestGetKeycloakAccessToken_MasterCustomToken(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return(expectedToken, nil)
 
 	// Act
-	token, err := run.GetKeycloakAccessToken(constant.MasterCustomToken, "")
+	token, err := run.GetKeycloakAccessToken(context.Background(), constant.MasterCustomToken, "")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2180,7 +2585,7 @@ func TestGetKeycloakAccessToken_MasterAdminCLIToken(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return(expectedToken, nil)
 
 	// Act
-	token, err := run.GetKeycloakAccessToken(constant.MasterAdminCLIToken, "")
+	token, err := run.GetKeycloakAccessToken(context.Background(), constant.MasterAdminCLIToken, "")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2193,11 +2598,10 @@ func TestGetKeycloakAccessToken_TenantToken(t *testing.T) {
 	run, _, mockKeycloak, _, _, _ := newTestRun(action.GetKeycloakAccessToken)
 
 	expectedToken := "tenant-token"
-	run.Config.Action.KeycloakAccessToken = expectedToken
 	mockKeycloak.On("GetAccessToken", "test-tenant").Return(expectedToken, nil)
 
 	// Act
-	token, err := run.GetKeycloakAccessToken("tenant", "test-tenant")
+	token, err := run.GetKeycloakAccessToken(context.Background(), "tenant", "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2210,7 +2614,7 @@ func TestGetKeycloakAccessToken_TenantTokenMissingTenant(t *testing.T) {
 	run, _, _, _, _, _ := newTestRun(action.GetKeycloakAccessToken)
 
 	// Act
-	_, err := run.GetKeycloakAccessToken("tenant", "")
+	_, err := run.GetKeycloakAccessToken(context.Background(), "tenant", "")
 
 	// Assert
 	assert.Error(t, err)
@@ -2225,7 +2629,7 @@ func TestGetKeycloakAccessToken_GetAccessTokenError(t *testing.T) {
 	mockKeycloak.On("GetAccessToken", "test-tenant").Return("", expectedError)
 
 	// Act
-	_, err := run.GetKeycloakAccessToken("tenant", "test-tenant")
+	_, err := run.GetKeycloakAccessToken(context.Background(), "tenant", "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2283,7 +2687,7 @@ func TestCreateTenantEntitlements_Success(t *testing.T) {
 	mockManagement.On("CreateTenantEntitlement", mock.Anything, mock.Anything).Return(nil)
 
 	// Act
-	err := run.CreateTenantEntitlements(constant.NoneConsortium, constant.Default)
+	err := run.CreateTenantEntitlements(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2299,7 +2703,7 @@ func TestCreateTenantEntitlements_GetMasterTokenError(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", expectedError)
 
 	// Act
-	err := run.CreateTenantEntitlements(constant.NoneConsortium, constant.Default)
+	err := run.CreateTenantEntitlements(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -2316,7 +2720,7 @@ func TestCreateTenantEntitlements_CreateError(t *testing.T) {
 	mockManagement.On("CreateTenantEntitlement", mock.Anything, mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.CreateTenantEntitlements(constant.NoneConsortium, constant.Default)
+	err := run.CreateTenantEntitlements(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -2335,7 +2739,7 @@ func TestRemoveTenantEntitlements_Success(t *testing.T) {
 	mockManagement.On("RemoveTenantEntitlements", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// Act
-	err := run.RemoveTenantEntitlements(constant.NoneConsortium, constant.Default)
+	err := run.RemoveTenantEntitlements(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2351,7 +2755,7 @@ func TestRemoveTenantEntitlements_GetMasterTokenError(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", expectedError)
 
 	// Act
-	err := run.RemoveTenantEntitlements(constant.NoneConsortium, constant.Default)
+	err := run.RemoveTenantEntitlements(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -2368,7 +2772,7 @@ func TestRemoveTenantEntitlements_RemoveError(t *testing.T) {
 	mockManagement.On("RemoveTenantEntitlements", mock.Anything, mock.Anything, mock.Anything).Return(expectedError)
 
 	// Act
-	err := run.RemoveTenantEntitlements(constant.NoneConsortium, constant.Default)
+	err := run.RemoveTenantEntitlements(context.Background(), constant.NoneConsortium, constant.Default)
 
 	// Assert
 	assert.Error(t, err)
@@ -2385,13 +2789,11 @@ func TestReindexIndices_InstanceError(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.ReindexIndices)
 	mockSearchSvc := &MockSearchSvc{}
 	run.Config.SearchSvc = mockSearchSvc
-	run.Config.Action.ConfigConsortiums = map[string]any{
-		"test-consortium": map[string]any{
-			"name": "test-consortium",
-		},
+	run.Config.Action.ConfigConsortiums = map[string]config.Consortium{
+		"test-consortium": {},
 	}
-	run.Config.Action.ConfigTenants = map[string]any{
-		"test-consortium-central": map[string]any{},
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{
+		"test-consortium-central": {},
 	}
 
 	expectedError := assert.AnError
@@ -2405,7 +2807,7 @@ func TestReindexIndices_InstanceError(t *testing.T) {
 	mockSearchSvc.On("ReindexInstanceRecords", "test-consortium-central").Return(expectedError)
 
 	// Act
-	err := run.ReindexIndices("test-consortium", constant.Central)
+	err := run.ReindexIndices(context.Background(), "test-consortium", constant.Central)
 
 	// Assert
 	assert.Error(t, err)
@@ -2427,7 +2829,7 @@ func TestUndeployUI_Success(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployUI()
+	err := run.UndeployUI(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2447,7 +2849,7 @@ func TestUndeployUI_UndeployError(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployUI()
+	err := run.UndeployUI(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -2534,7 +2936,7 @@ func TestUndeployModules_WithoutRemoveApplication(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployModules(false)
+	err := run.UndeployModules(context.Background(), false)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2552,7 +2954,7 @@ func TestUndeployModules_WithRemoveApplication(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployModules(true)
+	err := run.UndeployModules(context.Background(), true)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2569,7 +2971,7 @@ func TestUndeployModules_UndeployError(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.UndeployModules(false)
+	err := run.UndeployModules(context.Background(), false)
 
 	// Assert
 	assert.Error(t, err)
@@ -2594,13 +2996,13 @@ func TestDeployModules_Success(t *testing.T) {
 	mockModule.On("GetVaultRootToken", mock.Anything).Return("", nil)
 	mockModule.On("GetSidecarImage", mock.Anything).Return("test-sidecar:latest", false, nil)
 	mockModule.On("DeployModules", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(map[string]int{"test-module": 8080}, 1, nil)
-	mockModule.On("CheckModuleReadiness", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockModule.On("CheckModuleReadiness", mock.Anything, mock.Anything).Return()
 	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
 	mockManagement.On("CreateApplication", mock.Anything).Return(nil)
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.DeployModules()
+	err := run.DeployModules(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2628,7 +3030,7 @@ func TestDeployModules_AllAlreadyDeployed_SkipsHealthcheck(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.DeployModules()
+	err := run.DeployModules(context.Background())
 
 	// Assert — no CheckModuleReadiness call
 	assert.NoError(t, err)
@@ -2647,7 +3049,7 @@ func TestDeploySystem_Success(t *testing.T) {
 	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, bytes.Buffer{}, nil)
 
 	// Act
-	err := run.DeploySystem()
+	err := run.DeploySystem(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2668,13 +3070,62 @@ func TestDeploySystem_AlreadyRunning_SkipsSleep(t *testing.T) {
 	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(stdout, bytes.Buffer{}, nil)
 
 	// Act
-	err := run.DeploySystem()
+	err := run.DeploySystem(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
 	mockExecSvc.AssertExpectations(t)
 }
 
+func TestDeploySystem_NewContainers_WaitsForReadiness(t *testing.T) {
+	testhelpers.SetTempHome(t)
+
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeploySystem)
+	mockExecSvc := &MockExecSvc{}
+	mockReadinessSvc := &testhelpers.MockReadinessSvc{}
+	run.Config.ExecSvc = mockExecSvc
+	run.Config.ReadinessSvc = mockReadinessSvc
+	params.BuildImages = false
+
+	var stderr bytes.Buffer
+	stderr.WriteString("Container eureka-postgres  Started\n")
+	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, stderr, nil)
+	mockReadinessSvc.On("WaitForSystem").Return(nil)
+
+	// Act
+	err := run.DeploySystem(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockExecSvc.AssertExpectations(t)
+	mockReadinessSvc.AssertExpectations(t)
+}
+
+func TestDeploySystem_NewContainers_ReadinessError(t *testing.T) {
+	testhelpers.SetTempHome(t)
+
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeploySystem)
+	mockExecSvc := &MockExecSvc{}
+	mockReadinessSvc := &testhelpers.MockReadinessSvc{}
+	run.Config.ExecSvc = mockExecSvc
+	run.Config.ReadinessSvc = mockReadinessSvc
+	params.BuildImages = false
+
+	var stderr bytes.Buffer
+	stderr.WriteString("Container eureka-postgres  Created\n")
+	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, stderr, nil)
+	mockReadinessSvc.On("WaitForSystem").Return(assert.AnError)
+
+	// Act
+	err := run.DeploySystem(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	mockReadinessSvc.AssertExpectations(t)
+}
+
 func TestDeploySystem_ExecError(t *testing.T) {
 	testhelpers.SetTempHome(t)
 
@@ -2688,7 +3139,7 @@ func TestDeploySystem_ExecError(t *testing.T) {
 	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, bytes.Buffer{}, expectedError)
 
 	// Act
-	err := run.DeploySystem()
+	err := run.DeploySystem(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -2705,7 +3156,7 @@ func TestDeployAdditionalSystem_NoContainers_Skips(t *testing.T) {
 	// ConfigBackendModules is nil — no containers matched, returns early
 
 	// Act
-	err := run.DeployAdditionalSystem()
+	err := run.DeployAdditionalSystem(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2719,8 +3170,8 @@ func TestDeployAdditionalSystem_NewContainers_Sleeps(t *testing.T) {
 	run, _, _, _, _, _ := newTestRun(action.DeployAdditionalSystem)
 	mockExecSvc := &MockExecSvc{}
 	run.Config.ExecSvc = mockExecSvc
-	run.Config.Action.ConfigBackendModules = map[string]any{
-		constant.ModSearchModule: map[string]any{field.ModuleDeployModuleEntry: true},
+	run.Config.Action.ConfigBackendModules = map[string]config.BackendModule{
+		constant.ModSearchModule: {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	var stderr bytes.Buffer
@@ -2728,7 +3179,7 @@ func TestDeployAdditionalSystem_NewContainers_Sleeps(t *testing.T) {
 	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, stderr, nil)
 
 	// Act
-	err := run.DeployAdditionalSystem()
+	err := run.DeployAdditionalSystem(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2742,8 +3193,8 @@ func TestDeployAdditionalSystem_AlreadyRunning_SkipsSleep(t *testing.T) {
 	run, _, _, _, _, _ := newTestRun(action.DeployAdditionalSystem)
 	mockExecSvc := &MockExecSvc{}
 	run.Config.ExecSvc = mockExecSvc
-	run.Config.Action.ConfigBackendModules = map[string]any{
-		constant.ModSearchModule: map[string]any{field.ModuleDeployModuleEntry: true},
+	run.Config.Action.ConfigBackendModules = map[string]config.BackendModule{
+		constant.ModSearchModule: {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	var stdout bytes.Buffer
@@ -2751,7 +3202,7 @@ func TestDeployAdditionalSystem_AlreadyRunning_SkipsSleep(t *testing.T) {
 	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(stdout, bytes.Buffer{}, nil)
 
 	// Act
-	err := run.DeployAdditionalSystem()
+	err := run.DeployAdditionalSystem(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2765,15 +3216,15 @@ func TestDeployAdditionalSystem_ExecError(t *testing.T) {
 	run, _, _, _, _, _ := newTestRun(action.DeployAdditionalSystem)
 	mockExecSvc := &MockExecSvc{}
 	run.Config.ExecSvc = mockExecSvc
-	run.Config.Action.ConfigBackendModules = map[string]any{
-		constant.ModSearchModule: map[string]any{field.ModuleDeployModuleEntry: true},
+	run.Config.Action.ConfigBackendModules = map[string]config.BackendModule{
+		constant.ModSearchModule: {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	expectedError := assert.AnError
 	mockExecSvc.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, bytes.Buffer{}, expectedError)
 
 	// Act
-	err := run.DeployAdditionalSystem()
+	err := run.DeployAdditionalSystem(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -2807,7 +3258,7 @@ func TestInterceptModule_Success(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.InterceptModule()
+	err := run.InterceptModule(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -2839,7 +3290,7 @@ func TestInterceptModule_InterceptError(t *testing.T) {
 	mockDocker.On("Close", mock.Anything).Return(nil)
 
 	// Act
-	err := run.InterceptModule()
+	err := run.InterceptModule(context.Background())
 
 	// Assert
 	assert.Error(t, err)
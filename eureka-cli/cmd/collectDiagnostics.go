@@ -0,0 +1,333 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// collectDiagnosticsCmd represents the collectDiagnostics command
+var collectDiagnosticsCmd = &cobra.Command{
+	Use:   "collectDiagnostics",
+	Short: "Collect diagnostics",
+	Long:  `Collect the redacted run logs, containers, config and management state of the environment into a single archive for bug reports.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.CollectDiagnostics)
+		if err != nil {
+			return err
+		}
+
+		return run.CollectDiagnostics(cmd.Context())
+	},
+}
+
+func (run *Run) CollectDiagnostics(ctx context.Context) error {
+	slog.Info(run.Config.Action.Name, "text", "COLLECTING DIAGNOSTICS")
+	files, problems := run.GetDiagnosticsFiles(ctx)
+	if len(problems) > 0 {
+		files = append(files, models.DiagnosticsFile{Name: "problems.txt", Content: []byte(strings.Join(problems, "\n") + "\n")})
+	}
+
+	filePath := params.OutputFile
+	if filePath == "" {
+		filePath = fmt.Sprintf(constant.DiagnosticsFilePattern, time.Now().Format(constant.LogTimestampFormat))
+	}
+	if err := writeDiagnosticsArchive(filePath, files); err != nil {
+		return err
+	}
+	slog.Info(run.Config.Action.Name, "text", "Collected diagnostics", "file", filePath, "files", len(files), "problems", len(problems))
+
+	return nil
+}
+
+// GetDiagnosticsFiles collects the files of the diagnostics bundle, a source that cannot be read
+// is recorded as a problem so that a broken environment still produces a bundle
+func (run *Run) GetDiagnosticsFiles(ctx context.Context) ([]models.DiagnosticsFile, []string) {
+	var (
+		files    []models.DiagnosticsFile
+		problems []string
+	)
+	addFile := func(name string, content []byte) {
+		files = append(files, models.DiagnosticsFile{Name: name, Content: content})
+	}
+	addJSONFile := func(name string, value any) {
+		var buffer bytes.Buffer
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s cannot be encoded: %v", name, err))
+			return
+		}
+		addFile(name, buffer.Bytes())
+	}
+	addProblem := func(problem string, args ...any) {
+		problems = append(problems, fmt.Sprintf(problem, args...))
+	}
+
+	facts := models.HostFacts{
+		CollectedAt: time.Now().UTC(),
+		Profile:     run.Config.Action.ConfigProfileName,
+		CLIVersion:  Version,
+		CLICommit:   Commit,
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		CPUs:        runtime.NumCPU(),
+	}
+
+	if content, err := getRedactedConfig(viper.ConfigFileUsed()); err != nil {
+		addProblem("config cannot be read: %v", err)
+	} else {
+		addFile(filepath.Join("config", filepath.Base(viper.ConfigFileUsed())), content)
+	}
+
+	homeDir, err := helpers.GetHomeDirPath()
+	if err != nil {
+		addProblem("home directory cannot be resolved: %v", err)
+	} else {
+		if content, err := os.ReadFile(filepath.Join(homeDir, constant.ModulesFile)); err != nil {
+			addProblem("%s cannot be read: %v", constant.ModulesFile, err)
+		} else {
+			addFile(constant.ModulesFile, content)
+		}
+		runLogs, err := getLatestRunLogs(filepath.Join(homeDir, constant.LogDir), run.Config.Action.ConfigProfileName, logFilePath)
+		if err != nil {
+			addProblem("run logs cannot be listed: %v", err)
+		}
+		for _, runLog := range runLogs {
+			if content, err := os.ReadFile(runLog); err != nil {
+				addProblem("run log %s cannot be read: %v", filepath.Base(runLog), err)
+			} else {
+				addFile(filepath.Join(constant.LogDir, filepath.Base(runLog)), helpers.RedactLogSecrets(content))
+			}
+		}
+	}
+
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		addProblem("docker client cannot be created: %v", err)
+		addJSONFile("host.json", facts)
+		return files, problems
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	if facts.DockerVersion, err = run.Config.ModuleSvc.GetDockerVersion(ctx, dockerClient); err != nil {
+		addProblem("docker version cannot be read: %v", err)
+	}
+	if facts.DockerMemory, err = run.Config.ModuleSvc.GetDockerMemory(ctx, dockerClient); err != nil {
+		addProblem("docker memory cannot be read: %v", err)
+	}
+	addJSONFile("host.json", facts)
+
+	containerNames, err := run.getDiagnosticsContainerNames(ctx, dockerClient)
+	if err != nil {
+		addProblem("containers cannot be listed: %v", err)
+	}
+
+	// The --tail flag binds the same params field shared with logs (whose default is 0), so its default
+	// cannot be relied on here; fall back to the diagnostics tail when it is not set.
+	tail := params.Tail
+	if tail <= 0 {
+		tail = constant.DefaultDiagnosticsTail
+	}
+	for _, containerName := range containerNames {
+		inspect, err := run.Config.ModuleSvc.InspectContainer(ctx, dockerClient, containerName)
+		if err != nil {
+			addProblem("container %s cannot be inspected: %v", containerName, err)
+		} else {
+			if inspect.Config != nil {
+				inspect.Config.Env = helpers.RedactEnvSecrets(inspect.Config.Env)
+			}
+			addJSONFile(filepath.Join("containers", containerName, "inspect.json"), inspect)
+		}
+
+		logs, err := run.Config.LogSvc.ReadLogs(ctx, dockerClient, containerName, tail)
+		if err != nil {
+			addProblem("container %s logs cannot be read: %v", containerName, err)
+		} else {
+			addFile(filepath.Join("containers", containerName, "container.log"), helpers.RedactLogSecrets(logs))
+		}
+	}
+
+	ctx, err = run.setMasterAccessTokens(ctx, dockerClient)
+	if err != nil {
+		addProblem("access token cannot be obtained: %v", err)
+		return files, problems
+	}
+	if applications, err := run.Config.ManagementSvc.GetApplications(ctx); err != nil {
+		addProblem("applications cannot be listed: %v", err)
+	} else {
+		addJSONFile(filepath.Join("management", "applications.json"), applications)
+	}
+	if discoveries, err := run.Config.ManagementSvc.GetModuleDiscoveries(ctx); err != nil {
+		addProblem("module discovery cannot be listed: %v", err)
+	} else {
+		addJSONFile(filepath.Join("management", "module-discovery.json"), discoveries)
+	}
+
+	tenants, err := run.Config.ManagementSvc.GetTenants(ctx, constant.NoneConsortium, constant.All)
+	if err != nil {
+		addProblem("tenants cannot be listed: %v", err)
+		return files, problems
+	}
+	addJSONFile(filepath.Join("management", "tenants.json"), tenants)
+
+	entitlements := make(map[string]models.TenantEntitlementResponse, len(tenants))
+	for _, value := range tenants {
+		entry, ok := value.(map[string]any)
+		if !ok {
+			addProblem("tenant entry %v has an unexpected type", value)
+			continue
+		}
+		tenantName := helpers.GetString(entry, "name")
+		response, err := run.Config.ManagementSvc.GetTenantEntitlements(ctx, tenantName, true)
+		if err != nil {
+			addProblem("tenant %s entitlements cannot be listed: %v", tenantName, err)
+			continue
+		}
+		entitlements[tenantName] = response
+	}
+	addJSONFile(filepath.Join("management", "entitlements.json"), entitlements)
+
+	return files, problems
+}
+
+func (run *Run) getDiagnosticsContainerNames(ctx context.Context, dockerClient *client.Client) ([]string, error) {
+	var containerNames []string
+	for _, filters := range []client.Filters{
+		make(client.Filters).Add("name", constant.AllContainerPattern),
+		make(client.Filters).Add("label", constant.DockerComposeProjectLabel),
+	} {
+		containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, filters)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range containers {
+			containerNames = append(containerNames, strings.TrimPrefix(c.Names[0], "/"))
+		}
+	}
+	slices.Sort(containerNames)
+
+	return slices.Compact(containerNames), nil
+}
+
+// getRedactedConfig returns the config the run is using, i.e. the config file merged with the profiles it extends
+// and its local override file, with the values of the secret keys redacted
+func getRedactedConfig(configFile string) ([]byte, error) {
+	mc, err := configsvc.LoadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+	content, err := mc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return helpers.RedactConfigSecrets(content)
+}
+
+// getLatestRunLogs returns the latest run logs of a profile together with their timing reports, the log of the current run is skipped
+func getLatestRunLogs(logDir string, profile string, currentLogFile string) ([]string, error) {
+	runLogs, err := filepath.Glob(filepath.Join(logDir, fmt.Sprintf("%s-*%s", profile, constant.LogFileSuffix)))
+	if err != nil {
+		return nil, err
+	}
+	runLogs = slices.DeleteFunc(runLogs, func(runLog string) bool {
+		timestamp := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(runLog), profile+"-"), constant.LogFileSuffix)
+		_, err := time.Parse(constant.LogTimestampFormat, timestamp)
+
+		return err != nil || runLog == currentLogFile
+	})
+	slices.Sort(runLogs)
+	if len(runLogs) > constant.DiagnosticsRunLogs {
+		runLogs = runLogs[len(runLogs)-constant.DiagnosticsRunLogs:]
+	}
+
+	var files []string
+	for _, runLog := range runLogs {
+		files = append(files, runLog)
+		timingReport := strings.TrimSuffix(runLog, constant.LogFileSuffix) + constant.TimingReportSuffix
+		if _, err := os.Stat(timingReport); err == nil {
+			files = append(files, timingReport)
+		}
+	}
+
+	return files, nil
+}
+
+func writeDiagnosticsArchive(filePath string, files []models.DiagnosticsFile) error {
+	archiveFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer helpers.CloseFile(archiveFile)
+
+	rootDir := strings.TrimSuffix(filepath.Base(filePath), ".tar.gz")
+	return writeDiagnosticsBundle(archiveFile, rootDir, files)
+}
+
+func writeDiagnosticsBundle(w io.Writer, rootDir string, files []models.DiagnosticsFile) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	modTime := time.Now()
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    filepath.ToSlash(filepath.Join(rootDir, file.Name)),
+			Mode:    0600,
+			Size:    int64(len(file.Content)),
+			ModTime: modTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(file.Content); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+func init() {
+	rootCmd.AddCommand(collectDiagnosticsCmd)
+	collectDiagnosticsCmd.PersistentFlags().StringVarP(&params.OutputFile, action.OutputFile.Long, action.OutputFile.Short, "", action.OutputFile.Description)
+	collectDiagnosticsCmd.PersistentFlags().IntVarP(&params.Tail, action.Tail.Long, action.Tail.Short, constant.DefaultDiagnosticsTail, action.Tail.Description)
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== CollectDiagnostics Tests ====================
+
+func TestGetDiagnosticsFiles_CollectsEverything(t *testing.T) {
+	// Arrange
+	homeDir := filepath.Join(testhelpers.SetTempHome(t), constant.ConfigDir)
+	assert.NoError(t, os.MkdirAll(filepath.Join(homeDir, constant.LogDir), 0700))
+	configFile := filepath.Join(homeDir, "config.combined.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("environment:\n  DB_HOST: postgres.eureka\n  DB_PASSWORD: supersecret\n"), 0600))
+	assert.NoError(t, os.WriteFile(configsvc.GetLocalConfigFile(configFile), []byte("environment:\n  AWS_SECRET_ACCESS_KEY: localsecret\n"), 0600))
+	viper.Reset()
+	viper.SetConfigFile(configFile)
+	t.Cleanup(viper.Reset)
+	mockLog := &MockLogSvc{}
+	run, mockManagement, mockKeycloak, _, _, mockModule := newTestRun(action.CollectDiagnostics, withProfileName("combined"), withLogSvc(mockLog), withDockerClient())
+	assert.NoError(t, os.WriteFile(filepath.Join(homeDir, constant.ModulesFile), []byte(`{"mod-orders":"13.1.0"}`), 0600))
+	for _, name := range []string{"combined-20260101-100000.log", "combined-20260102-100000.log", "combined-20260102-100000.timings.json", "ecs-20260103-100000.log"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(homeDir, constant.LogDir, name), []byte(name+"\nDB_PASSWORD=runsecret\n"), 0600))
+	}
+	mockModule.On("GetDockerVersion", mock.Anything).Return("28.1.1", nil)
+	mockModule.On("GetDockerMemory", mock.Anything).Return(int64(16<<30), nil)
+	mockModule.On("GetDeployedModules", mock.Anything, make(client.Filters).Add("name", constant.AllContainerPattern)).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}},
+	}, nil)
+	mockModule.On("GetDeployedModules", mock.Anything, make(client.Filters).Add("label", constant.DockerComposeProjectLabel)).Return([]container.Summary{
+		{Names: []string{"/postgres"}},
+	}, nil)
+	mockModule.On("InspectContainer", mock.Anything, mock.Anything).Return(container.InspectResponse{
+		Config: &container.Config{Env: []string{"DB_HOST=postgres.eureka", "DB_PASSWORD=supersecret", "SECRET_STORE_VAULT_TOKEN=vaulttoken"}},
+	}, nil)
+	mockLog.On("ReadLogs", mock.Anything, mock.Anything, constant.DefaultDiagnosticsTail).Return([]byte("2026-01-01T10:00:00Z INFO started\nAuthorization: Bearer containertoken\n"), nil)
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("vault-token", nil)
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("master-token", nil)
+	mockManagement.On("GetApplications").Return(models.ApplicationsResponse{TotalRecords: 1}, nil)
+	mockManagement.On("GetModuleDiscoveries").Return(models.ModuleDiscoveryResponse{TotalRecords: 2}, nil)
+	mockManagement.On("GetTenants", constant.NoneConsortium, constant.TenantType(constant.All)).Return([]any{map[string]any{"name": "diku"}}, nil)
+	mockManagement.On("GetTenantEntitlements", "diku", true).Return(models.TenantEntitlementResponse{TotalRecords: 1}, nil)
+
+	// Act
+	files, problems := run.GetDiagnosticsFiles(context.Background())
+
+	// Assert
+	assert.Empty(t, problems)
+	contents := make(map[string]string, len(files))
+	for _, file := range files {
+		contents[filepath.ToSlash(file.Name)] = string(file.Content)
+	}
+	assert.ElementsMatch(t, []string{
+		"config/config.combined.yaml",
+		"modules.json",
+		"logs/combined-20260101-100000.log",
+		"logs/combined-20260102-100000.log",
+		"logs/combined-20260102-100000.timings.json",
+		"host.json",
+		"containers/eureka-combined-mod-orders/inspect.json",
+		"containers/eureka-combined-mod-orders/container.log",
+		"containers/postgres/inspect.json",
+		"containers/postgres/container.log",
+		"management/applications.json",
+		"management/module-discovery.json",
+		"management/tenants.json",
+		"management/entitlements.json",
+	}, slices.Collect(maps.Keys(contents)))
+	assert.Contains(t, contents["config/config.combined.yaml"], "DB_PASSWORD: <redacted>")
+	assert.NotContains(t, contents["config/config.combined.yaml"], "supersecret")
+	assert.Contains(t, contents["config/config.combined.yaml"], "AWS_SECRET_ACCESS_KEY: <redacted>")
+	assert.NotContains(t, contents["config/config.combined.yaml"], "localsecret")
+	assert.Contains(t, contents["containers/postgres/inspect.json"], "DB_PASSWORD=<redacted>")
+	assert.NotContains(t, contents["containers/postgres/inspect.json"], "supersecret")
+	assert.Contains(t, contents["containers/postgres/inspect.json"], "SECRET_STORE_VAULT_TOKEN=<redacted>")
+	assert.NotContains(t, contents["containers/postgres/inspect.json"], "vaulttoken")
+	assert.Equal(t, "combined-20260101-100000.log\nDB_PASSWORD=<redacted>\n", contents["logs/combined-20260101-100000.log"])
+	assert.Equal(t, "2026-01-01T10:00:00Z INFO started\nAuthorization: Bearer <redacted>\n", contents["containers/postgres/container.log"])
+	assert.Contains(t, contents["host.json"], `"dockerVersion": "28.1.1"`)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestGetDiagnosticsFiles_DockerUnavailable(t *testing.T) {
+	// Arrange
+	homeDir := filepath.Join(testhelpers.SetTempHome(t), constant.ConfigDir)
+	viper.Reset()
+	viper.SetConfigFile(filepath.Join(homeDir, "missing.yaml"))
+	t.Cleanup(viper.Reset)
+	run, mockManagement, _, _, mockDocker, _ := newTestRun(action.CollectDiagnostics)
+	mockDocker.On("Create").Return(nil, errors.New("daemon unreachable"))
+
+	// Act
+	files, problems := run.GetDiagnosticsFiles(context.Background())
+
+	// Assert
+	assert.Len(t, files, 1)
+	assert.Equal(t, "host.json", files[0].Name)
+	assert.Len(t, problems, 3)
+	assert.Contains(t, problems[0], "config cannot be read")
+	assert.Contains(t, problems[1], "modules.json cannot be read")
+	assert.Contains(t, problems[2], "docker client cannot be created: daemon unreachable")
+	mockManagement.AssertNotCalled(t, "GetApplications")
+}
+
+func TestWriteDiagnosticsBundle(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	files := []models.DiagnosticsFile{
+		{Name: "host.json", Content: []byte(`{"os":"linux"}`)},
+		{Name: filepath.Join("containers", "postgres", "container.log"), Content: []byte("ready\n")},
+	}
+
+	// Act
+	err := writeDiagnosticsBundle(&buf, "eureka-diagnostics-20260101-100000", files)
+
+	// Assert
+	assert.NoError(t, err)
+	gzipReader, err := gzip.NewReader(&buf)
+	assert.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	contents := make(map[string]string)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.NoError(t, err)
+		content, err := io.ReadAll(tarReader)
+		assert.NoError(t, err)
+		contents[header.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{
+		"eureka-diagnostics-20260101-100000/host.json":                         `{"os":"linux"}`,
+		"eureka-diagnostics-20260101-100000/containers/postgres/container.log": "ready\n",
+	}, contents)
+}
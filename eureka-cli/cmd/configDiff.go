@@ -0,0 +1,101 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/spf13/cobra"
+)
+
+// configDiffCmd represents the configDiff command
+var configDiffCmd = &cobra.Command{
+	Use:          "configDiff",
+	Short:        "Show upstream config changes",
+	Long:         `Show the changes made upstream to the config and misc files since they were last copied to the home directory.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ConfigDiff)
+		if err != nil {
+			return err
+		}
+
+		return run.ConfigDiff()
+	},
+}
+
+func (run *Run) ConfigDiff() error {
+	homeDir, err := helpers.GetHomeDirPath()
+	if err != nil {
+		return err
+	}
+
+	return writeConfigDiff(os.Stdout, homeDir, runFs)
+}
+
+// writeConfigDiff writes the diff between the upstream version of every file last copied to the home directory and
+// its embedded version, a file copied by an older release has no upstream version and is compared with the local file
+func writeConfigDiff(w io.Writer, homeDir string, srcFs fs.FS) error {
+	var changed int
+	err := fs.WalkDir(srcFs, ".", func(path string, dir fs.DirEntry, err error) error {
+		if err != nil || dir.IsDir() || helpers.IsLocalConfigFile(path) {
+			return err
+		}
+
+		upstream, err := fs.ReadFile(srcFs, path)
+		if err != nil {
+			return err
+		}
+		fromFile := filepath.Join(constant.UpstreamDir, path)
+		base, err := os.ReadFile(filepath.Join(homeDir, fromFile))
+		if errors.Is(err, fs.ErrNotExist) {
+			fromFile = path
+			base, err = os.ReadFile(filepath.Join(homeDir, path))
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		diff, err := helpers.UnifiedDiff(fromFile, path+" (embedded)", base, upstream)
+		if err != nil || diff == "" {
+			return err
+		}
+		changed++
+		_, err = fmt.Fprint(w, diff)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if changed == 0 {
+		_, err = fmt.Fprintln(w, "No upstream changes since the files were last copied")
+	}
+
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(configDiffCmd)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ==================== ConfigDiff Tests ====================
+
+func TestWriteConfigDiff_ShowsUpstreamChanges(t *testing.T) {
+	// Arrange
+	homeDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, constant.UpstreamDir), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, constant.UpstreamDir, "config.combined.yaml"), []byte("version: 1.0.0\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.combined.yaml"), []byte("version: 1.5.0\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.ecs.yaml"), []byte("version: 1.0.0\n"), 0600))
+	srcFs := fstest.MapFS{
+		"config.combined.yaml":       {Data: []byte("version: 2.0.0\n")},
+		"config.combined.local.yaml": {Data: []byte("version: 3.0.0\n")},
+		"config.ecs.yaml":            {Data: []byte("version: 1.0.0\n")},
+	}
+	var out bytes.Buffer
+
+	// Act
+	err := writeConfigDiff(&out, homeDir, srcFs)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "--- .upstream/config.combined.yaml\n+++ config.combined.yaml (embedded)\n@@ -1 +1 @@\n-version: 1.0.0\n+version: 2.0.0\n", out.String())
+}
+
+func TestWriteConfigDiff_NoChanges(t *testing.T) {
+	// Arrange
+	homeDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.combined.yaml"), []byte("version: 1.0.0\n"), 0600))
+	var out bytes.Buffer
+
+	// Act
+	err := writeConfigDiff(&out, homeDir, fstest.MapFS{"config.combined.yaml": {Data: []byte("version: 1.0.0\n")}})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "No upstream changes since the files were last copied\n", out.String())
+}
+
+func TestWriteMergeConflicts(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+
+	// Act
+	writeMergeConflicts(&out, []helpers.MergeConflict{{Path: "config.combined.yaml", Line: 3, Local: []string{"a: 1\n"}, Upstream: []string{"a: 2\n"}}}, nil)
+
+	// Assert
+	assert.Equal(t, "Kept 1 local change(s) that conflict with upstream changes, the upstream lines (+) were not applied:\n"+
+		"config.combined.yaml:3\n-a: 1\n+a: 2\nUse configDiff to review all upstream changes\n", out.String())
+}
+
+func TestWriteMergeConflicts_Unmerged(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	diff := "--- config.combined.yaml\n+++ .upstream/config.combined.yaml\n@@ -1 +1 @@\n-a: 1\n+a: 2\n"
+
+	// Act
+	writeMergeConflicts(&out, nil, []helpers.UnmergedFile{{Path: "config.combined.yaml", Diff: diff}})
+
+	// Assert
+	assert.Equal(t, "Kept 1 file(s) that had no upstream copy to merge with, the upstream changes (+) were not applied:\n"+
+		diff+"The upstream versions were saved to the .upstream directory as the base of the next refresh\n", out.String())
+}
@@ -16,13 +16,13 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
-	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/cobra"
 )
 
@@ -37,21 +37,20 @@ var createConsortiumsCmd = &cobra.Command{
 			return err
 		}
 
-		return run.CreateConsortium()
+		return run.CreateConsortium(cmd.Context())
 	},
 }
 
-func (run *Run) CreateConsortium() error {
+func (run *Run) CreateConsortium(ctx context.Context) error {
 	if !action.IsSet(field.Consortiums) {
 		return nil
 	}
-	if err := run.GetVaultRootToken(); err != nil {
+	if err := run.GetVaultRootToken(ctx); err != nil {
 		return err
 	}
 
 	for consortium, properties := range run.Config.Action.ConfigConsortiums {
-		entry := properties.(map[string]any)
-		if !helpers.GetBool(entry, field.ConsortiumCreateConsortiumEntry) {
+		if !properties.CreateConsortium {
 			slog.Info(run.Config.Action.Name, "text", "IGNORING CREATION OF CONSORTIUM", "consortium", consortium)
 			continue
 		}
@@ -60,13 +59,13 @@ func (run *Run) CreateConsortium() error {
 		if centralTenant == "" {
 			return errors.ConsortiumMissingCentralTenant(consortium)
 		}
-		_, err := run.GetKeycloakAccessToken(constant.DefaultToken, centralTenant)
+		_, err := run.GetKeycloakAccessToken(ctx, constant.DefaultToken, centralTenant)
 		if err != nil {
 			return err
 		}
 
 		slog.Info(run.Config.Action.Name, "text", "CREATING CONSORTIUM", "consortium", consortium)
-		consortiumID, err := run.Config.ConsortiumSvc.CreateConsortium(centralTenant, consortium)
+		consortiumID, err := run.Config.ConsortiumSvc.CreateConsortium(ctx, centralTenant, consortium)
 		if err != nil {
 			return err
 		}
@@ -75,16 +74,16 @@ func (run *Run) CreateConsortium() error {
 
 		slog.Info(run.Config.Action.Name, "text", "ADDING TENANTS TO CONSORTIUM", "tenants", consortiumTenants, "count", len(consortiumTenants), "consortium", consortium)
 		adminUsername := run.Config.ConsortiumSvc.GetAdminUsername(centralTenant, consortiumUsers)
-		if err := run.Config.ConsortiumSvc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername); err != nil {
+		if err := run.Config.ConsortiumSvc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername); err != nil {
 			return err
 		}
-		if !helpers.GetBool(entry, field.ConsortiumEnableCentralOrderingEntry) {
+		if !properties.EnableCentralOrdering {
 			slog.Warn(run.Config.Action.Name, "text", "Ignoring enablement of central ordering", "tenant", centralTenant, "consortium", consortium)
 			continue
 		}
 
 		slog.Info(run.Config.Action.Name, "text", "ENABLING CENTRAL ORDERING", "tenant", centralTenant, "consortium", consortium)
-		if err := run.Config.ConsortiumSvc.EnableCentralOrdering(centralTenant); err != nil {
+		if err := run.Config.ConsortiumSvc.EnableCentralOrdering(ctx, centralTenant); err != nil {
 			return err
 		}
 	}
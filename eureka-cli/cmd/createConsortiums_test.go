@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== CreateConsortium Tests ====================
+
+func TestCreateConsortium_NotSet(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.CreateConsortiums)
+	run.Config.Action.ConfigConsortiums = nil // No consortiums configured
+
+	// Act
+	err := run.CreateConsortium(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// createProfileCmd represents the createProfile command
+var createProfileCmd = &cobra.Command{
+	Use:          "createProfile",
+	Short:        "Create profile",
+	Long:         `Create a new profile in the home directory from the config of an existing profile with a subset of its backend modules.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.CreateProfile)
+		if err != nil {
+			return err
+		}
+
+		profileDirs, err := helpers.GetProfileDirs()
+		if err != nil {
+			return err
+		}
+
+		return run.CreateProfile(viper.ConfigFileUsed(), profileDirs[0], profileDirs)
+	},
+}
+
+func (run *Run) CreateProfile(sourceConfigFile, homeDir string, profileDirs []string) error {
+	configFile, err := getNewProfileConfigFile(homeDir)
+	if err != nil {
+		return err
+	}
+
+	content, err := run.Config.ConfigSvc.ScaffoldProfile(sourceConfigFile, getProfileConfigFiles(profileDirs), params.NewProfile, params.Modules)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(configFile, content, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Created profile %s in %s, use it with -p %s\n", params.NewProfile, configFile, params.NewProfile)
+
+	return nil
+}
+
+// getNewProfileConfigFile returns the config file of the new profile in the home directory, the profile name
+// is validated so that the config file cannot be written outside of it
+func getNewProfileConfigFile(homeDir string) (string, error) {
+	if !helpers.IsValidProfileName(params.NewProfile) {
+		return "", errors.ProfileNameInvalid(params.NewProfile)
+	}
+	configFile := helpers.GetProfileConfigFile(homeDir, params.NewProfile)
+	if _, err := os.Stat(configFile); err == nil {
+		return "", errors.ProfileAlreadyExists(configFile)
+	}
+
+	return configFile, nil
+}
+
+// getProfileConfigFiles returns the config files of the profiles discovered in the profile directories
+func getProfileConfigFiles(profileDirs []string) []string {
+	var configFiles []string
+	for _, profile := range helpers.GetProfiles(profileDirs) {
+		if configFile, err := helpers.FindProfileConfigFile(profile, profileDirs); err == nil {
+			configFiles = append(configFiles, configFile)
+		}
+	}
+
+	return configFiles
+}
+
+func init() {
+	rootCmd.AddCommand(createProfileCmd)
+	createProfileCmd.PersistentFlags().StringVarP(&params.NewProfile, action.NewProfile.Long, action.NewProfile.Short, "", action.NewProfile.Description)
+	createProfileCmd.PersistentFlags().StringSliceVarP(&params.Modules, action.Modules.Long, action.Modules.Short, nil, action.Modules.Description)
+
+	if err := createProfileCmd.MarkPersistentFlagRequired(action.NewProfile.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.NewProfile, err).Error())
+		os.Exit(1)
+	}
+
+	if err := createProfileCmd.RegisterFlagCompletionFunc(action.Modules.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return helpers.GetBackendModuleNames(viper.GetStringMap(field.BackendModules)), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ==================== CreateProfile Tests ====================
+
+func TestCreateProfile_Success(t *testing.T) {
+	// Arrange
+	sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(sourceConfigFile, []byte("profile:\n  name: test\nbackend-modules:\n  mod-orders:\n  mod-users:\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.CreateProfile, withConfigSvc())
+	homeDir := t.TempDir()
+	params.NewProfile, params.Modules = "orders", []string{"mod-orders"}
+	t.Cleanup(func() { params.NewProfile, params.Modules = "", nil })
+
+	// Act
+	err := run.CreateProfile(sourceConfigFile, homeDir, []string{homeDir})
+
+	// Assert
+	assert.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(homeDir, "config.orders.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "name: orders")
+	assert.Contains(t, string(content), "mod-orders:")
+	assert.NotContains(t, string(content), "mod-users")
+}
+
+func TestCreateProfile_AlreadyExists(t *testing.T) {
+	// Arrange
+	sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(sourceConfigFile, []byte("profile:\n  name: test\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.CreateProfile, withConfigSvc())
+	homeDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.orders.yaml"), []byte("profile:\n"), 0600))
+	params.NewProfile = "orders"
+	t.Cleanup(func() { params.NewProfile = "" })
+
+	// Act
+	err := run.CreateProfile(sourceConfigFile, homeDir, []string{homeDir})
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+}
+
+func TestCreateProfile_InvalidName(t *testing.T) {
+	for _, name := range []string{"../orders", "a/b"} {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+			require.NoError(t, os.WriteFile(sourceConfigFile, []byte("profile:\n  name: test\n"), 0600))
+			run, _, _, _, _, _ := newTestRun(action.CreateProfile, withConfigSvc())
+			homeDir := t.TempDir()
+			params.NewProfile = name
+			t.Cleanup(func() { params.NewProfile = "" })
+
+			// Act
+			err := run.CreateProfile(sourceConfigFile, homeDir, []string{homeDir})
+
+			// Assert
+			assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+			assert.Contains(t, err.Error(), "profile name "+name+" is invalid")
+		})
+	}
+}
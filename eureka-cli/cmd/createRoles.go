@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,15 +36,15 @@ var createRolesCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.CreateRoles(consortiumName, tenantType)
+			return run.CreateRoles(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) CreateRoles(consortiumName string, tenantType constant.TenantType) error {
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+func (run *Run) CreateRoles(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		slog.Info(run.Config.Action.Name, "text", "CREATING ROLES", "tenant", configTenant)
-		return run.Config.KeycloakSvc.CreateRoles(configTenant)
+		return run.Config.KeycloakSvc.CreateRoles(ctx, configTenant)
 	})
 }
 
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,18 +36,19 @@ var createTenantEntitlementsCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.CreateTenantEntitlements(consortiumName, tenantType)
+			return run.CreateTenantEntitlements(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) CreateTenantEntitlements(consortiumName string, tenantType constant.TenantType) error {
+func (run *Run) CreateTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	slog.Info(run.Config.Action.Name, "text", "CREATING TENANT ENTITLEMENTS")
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	return run.Config.ManagementSvc.CreateTenantEntitlement(consortiumName, tenantType)
+	return run.Config.ManagementSvc.CreateTenantEntitlement(ctx, consortiumName, tenantType)
 }
 
 func init() {
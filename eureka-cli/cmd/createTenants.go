@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -34,17 +35,18 @@ var createTenantsCmd = &cobra.Command{
 			return err
 		}
 
-		return run.CreateTenants()
+		return run.CreateTenants(cmd.Context())
 	},
 }
 
-func (run *Run) CreateTenants() error {
+func (run *Run) CreateTenants(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "CREATING TENANTS")
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	return run.Config.ManagementSvc.CreateTenants()
+	return run.Config.ManagementSvc.CreateTenants(ctx)
 }
 
 func init() {
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,15 +36,15 @@ var createUsersCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.CreateUsers(consortiumName, tenantType)
+			return run.CreateUsers(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) CreateUsers(consortiumName string, tenantType constant.TenantType) error {
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+func (run *Run) CreateUsers(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		slog.Info(run.Config.Action.Name, "text", "CREATING USERS", "tenant", configTenant)
-		return run.Config.KeycloakSvc.CreateUsers(configTenant)
+		return run.Config.KeycloakSvc.CreateUsers(ctx, configTenant)
 	})
 }
 
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,11 +36,11 @@ var deployAdditionalSystemCmd = &cobra.Command{
 			return err
 		}
 
-		return run.DeployAdditionalSystem()
+		return run.DeployAdditionalSystem(cmd.Context())
 	},
 }
 
-func (run *Run) DeployAdditionalSystem() error {
+func (run *Run) DeployAdditionalSystem(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING ADDITIONAL SYSTEM CONTAINERS")
 	finalRequiredContainers := helpers.AppendRequiredContainers(run.Config.Action.Name, []string{}, run.Config.Action.ConfigBackendModules)
 	if len(finalRequiredContainers) == 0 {
@@ -48,7 +49,10 @@ func (run *Run) DeployAdditionalSystem() error {
 	}
 
 	subCommand := append([]string{"compose", "--progress", "plain", "--ansi", "never", "--project-name", "eureka", "up", "--detach"}, finalRequiredContainers...)
-	return run.dockerComposeUp(subCommand, constant.DeployAdditionalSystemWait, "additional system")
+	// Additional system containers have no readiness probe and keep the fixed wait
+	return run.dockerComposeUp(ctx, subCommand, func(ctx context.Context) error {
+		return helpers.Sleep(ctx, constant.DeployAdditionalSystemWait)
+	}, "additional system")
 }
 
 func init() {
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== DeployAdditionalSystem Tests ====================
+
+func TestDeployAdditionalSystem_NoContainers(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployAdditionalSystem)
+	run.Config.Action.ConfigBackendModules = nil // No additional containers
+
+	// Act
+	err := run.DeployAdditionalSystem(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// Note: Full testing of DeployAdditionalSystem and UndeployAdditionalSystem with containers
+// requires mocking ExecSvc.ExecFromDir and ExecSvc.Exec, which execute docker compose commands.
+// The core logic is minimal (building docker compose command), so integration tests are more appropriate.
@@ -16,18 +16,27 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"net"
+	"os"
 	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
 	"github.com/spf13/cobra"
 )
 
+const (
+	pingKongStatusStep             = "Ping Kong Status"
+	validateParentApplicationsStep = "Validate Parent Applications"
+)
+
 // deployApplicationCmd represents the deployApplication command
 var deployApplicationCmd = &cobra.Command{
 	Use:     "deployApplication",
@@ -42,122 +51,172 @@ var deployApplicationCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if params.Lock && params.FromLock {
+			return apperrors.LockWithFromLock()
+		}
+		var lockfile *models.Lockfile
+		if params.FromLock {
+			if lockfile, err = run.UseLockfile(); err != nil {
+				return err
+			}
+		}
+		if params.Plan {
+			plan, err := run.PlanDeployment(cmd.Context(), !run.Config.Action.IsChildApp(), !run.Config.Action.IsChildApp())
+			if err != nil {
+				return err
+			}
+			return run.PrintPlan(plan)
+		}
+		if params.Resume && params.Cleanup {
+			return apperrors.ResumeWithCleanup()
+		}
+		if err := run.CheckModuleDependencies(cmd.Context()); err != nil {
+			return err
+		}
+		if lockfile != nil {
+			if err := run.PinLockedImages(cmd.Context(), lockfile); err != nil {
+				return err
+			}
+		}
+		if err := run.Config.CheckpointSvc.Load(params.Resume); err != nil {
+			return err
+		}
+		run.Hooks = append(run.Hooks, run.CheckpointHook())
 
 		if params.Cleanup {
-			err = run.DeployApplicationWithCleanup()
+			err = run.DeployApplicationWithCleanup(cmd.Context())
 		} else {
-			err = run.DeployApplicationWithoutCleanup()
+			err = run.DeployApplicationWithoutCleanup(cmd.Context())
 		}
 		if err != nil {
+			if params.RollbackOnFailure {
+				return run.RollbackFailedDeployment(cmd.Context(), err)
+			}
+			slog.Warn(run.Config.Action.Name, "text", "Deployment did not complete, rerun with --resume to continue from the failed step")
 			return err
 		}
-		slog.Info(run.Config.Action.Name, "text", "Command completed", "duration", time.Since(start))
-
-		return nil
+		if err := run.CompleteCheckpoints(); err != nil {
+			return err
+		}
+		if params.Lock {
+			if err := run.WriteLockfile(cmd.Context()); err != nil {
+				return err
+			}
+		}
+		return run.CompleteCommand(start)
 	},
 }
 
-func (run *Run) DeployApplicationWithCleanup() error {
+// RollbackFailedDeployment rolls back the failed deployment and starts the next run from the first step,
+// since the checkpoints of the rolled back steps no longer reflect the environment
+func (run *Run) RollbackFailedDeployment(ctx context.Context, deployErr error) error {
+	if err := run.RollbackDeployment(ctx); err != nil {
+		slog.Warn(run.Config.Action.Name, "text", "Rollback did not complete, some resources may need to be removed manually", "error", err)
+	}
+	if err := run.Config.CheckpointSvc.Clear(); err != nil {
+		slog.Warn(run.Config.Action.Name, "text", "Could not clear deployment checkpoints", "error", err)
+	}
+
+	return deployErr
+}
+
+func (run *Run) DeployApplicationWithCleanup(ctx context.Context) error {
 	if run.Config.Action.IsChildApp() {
-		if err := run.UndeployChildApplication(); err != nil {
+		if err := run.UndeployChildApplication(ctx); err != nil {
 			return err
 		}
-		return run.DeployChildApplication()
+		return run.DeployChildApplication(ctx)
 	}
-	if err := run.UndeployApplication(); err != nil {
+	if err := run.UndeployApplication(ctx); err != nil {
 		return err
 	}
 
-	return run.DeployApplication()
+	return run.DeployApplication(ctx)
 }
 
-func (run *Run) DeployApplicationWithoutCleanup() error {
+func (run *Run) DeployApplicationWithoutCleanup(ctx context.Context) error {
 	if run.Config.Action.IsChildApp() {
-		return run.DeployChildApplication()
+		return run.DeployChildApplication(ctx)
 	}
 
-	return run.DeployApplication()
+	return run.DeployApplication(ctx)
 }
 
-func (run *Run) DeployApplication() error {
-	if err := run.DeploySystem(); err != nil {
-		return err
-	}
-	if err := run.PingKongStatus(); err != nil {
-		return err
-	}
-	if err := run.DeployManagement(); err != nil {
-		return err
-	}
-	if err := run.DeployModules(); err != nil {
-		return err
-	}
-	if err := run.CreateTenants(); err != nil {
-		return err
-	}
-	if err := run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.CreateTenantEntitlements(consortiumName, tenantType); err != nil {
-			return err
-		}
-		if err := run.CreateRoles(consortiumName, tenantType); err != nil {
-			return err
-		}
-		if err := run.CreateUsers(consortiumName, tenantType); err != nil {
-			return err
-		}
-		if err := run.AttachCapabilitySets(consortiumName, tenantType, constant.DeployApplicationPartitionWait, true); err != nil {
-			return err
-		}
-		if consortiumName != constant.NoneConsortium {
-			time.Sleep(constant.DeployApplicationPartitionWait)
-		}
+func (run *Run) DeployApplication(ctx context.Context) error {
+	graph := run.NewStepGraph().
+		Add(runconfig.Step{Name: action.DeploySystem, Run: run.DeploySystem}).
+		Add(runconfig.Step{Name: pingKongStatusStep, Needs: []string{action.DeploySystem}, Repeatable: true, Run: run.PingKongStatus}).
+		Add(runconfig.Step{Name: action.DeployManagement, Needs: []string{pingKongStatusStep}, Run: run.DeployManagement}).
+		Add(runconfig.Step{Name: action.DeployModules, Needs: []string{action.DeployManagement}, Run: run.DeployModules}).
+		Add(runconfig.Step{Name: action.CreateTenants, Needs: []string{action.DeployModules}, Run: run.CreateTenants})
 
-		return nil
-	}); err != nil {
-		return err
+	// Partitions run in order so that central tenants are set up before member tenants, a partition is entitled
+	// only after the capability sets of the previous partition are attached
+	var (
+		partitions                = run.GetPartitions()
+		previousPartition         = action.CreateTenants
+		attachCapabilitySetsSteps []string
+	)
+	for _, p := range partitions {
+		entitlementStep := p.PartitionStep(action.CreateTenantEntitlements)
+		graph.Add(run.newPartitionStep(p, action.CreateTenantEntitlements, []string{previousPartition}, run.CreateTenantEntitlements)).
+			Add(run.newPartitionStep(p, action.CreateRoles, []string{entitlementStep}, run.CreateRoles)).
+			Add(run.newPartitionStep(p, action.CreateUsers, []string{p.PartitionStep(action.CreateRoles)}, run.CreateUsers)).
+			Add(run.newPartitionStep(p, action.AttachCapabilitySets, []string{p.PartitionStep(action.CreateUsers)}, func(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+				// Entitlement waits for the capability consumer group of every tenant, so no initial wait is needed here
+				return run.AttachCapabilitySets(ctx, consortiumName, tenantType, 0*time.Second, true)
+			}))
+		previousPartition = p.PartitionStep(action.AttachCapabilitySets)
+		attachCapabilitySetsSteps = append(attachCapabilitySetsSteps, previousPartition)
 	}
-	if err := run.CreateConsortium(); err != nil {
-		return err
-	}
-	return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.DeployUi(consortiumName, tenantType); err != nil {
-			return err
-		}
-		if err := run.UpdateKeycloakPublicClients(consortiumName, tenantType); err != nil {
-			return err
-		}
-		if helpers.IsModuleEnabled(constant.ModSearchModule, run.Config.Action.ConfigBackendModules) {
-			return run.ReindexIndices(consortiumName, tenantType)
+	graph.Add(runconfig.Step{Name: action.CreateConsortiums, Needs: attachCapabilitySetsSteps, Run: run.CreateConsortium})
+
+	isSearchEnabled := helpers.IsModuleEnabled(constant.ModSearchModule, run.Config.Action.ConfigBackendModules)
+	for _, p := range partitions {
+		graph.Add(run.newPartitionStep(p, action.DeployUi, []string{action.CreateConsortiums}, run.DeployUi)).
+			Add(run.newPartitionStep(p, action.UpdateKeycloakPublicClients, []string{action.CreateConsortiums}, run.UpdateKeycloakPublicClients))
+		if isSearchEnabled {
+			graph.Add(run.newPartitionStep(p, action.ReindexIndices, []string{action.CreateConsortiums}, run.ReindexIndices))
 		}
+	}
 
-		return nil
-	})
+	return graph.Run(ctx)
 }
 
-func (run *Run) DeployChildApplication() error {
-	if err := run.ValidateParentApplications(); err != nil {
-		return err
-	}
-	if err := run.DeployAdditionalSystem(); err != nil {
-		return err
-	}
-	if err := run.DeployModules(); err != nil {
-		return err
+func (run *Run) DeployChildApplication(ctx context.Context) error {
+	graph := run.NewStepGraph().
+		Add(runconfig.Step{Name: validateParentApplicationsStep, Repeatable: true, Run: run.ValidateParentApplications}).
+		Add(runconfig.Step{Name: action.DeployAdditionalSystem, Needs: []string{validateParentApplicationsStep}, Run: run.DeployAdditionalSystem}).
+		Add(runconfig.Step{Name: action.DeployModules, Needs: []string{action.DeployAdditionalSystem}, Run: run.DeployModules})
+
+	previousPartition := action.DeployModules
+	for _, p := range run.GetPartitions() {
+		entitlementStep := p.PartitionStep(action.CreateTenantEntitlements)
+		graph.Add(run.newPartitionStep(p, action.CreateTenantEntitlements, []string{previousPartition}, run.CreateTenantEntitlements)).
+			Add(run.newPartitionStep(p, action.DetachCapabilitySets, []string{entitlementStep}, run.DetachCapabilitySets)).
+			Add(run.newPartitionStep(p, action.AttachCapabilitySets, []string{p.PartitionStep(action.DetachCapabilitySets)}, func(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+				return run.AttachCapabilitySets(ctx, consortiumName, tenantType, 0*time.Second, true)
+			}))
+		previousPartition = p.PartitionStep(action.AttachCapabilitySets)
 	}
-	return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.CreateTenantEntitlements(consortiumName, tenantType); err != nil {
-			return err
-		}
-		if err := run.DetachCapabilitySets(consortiumName, tenantType); err != nil {
-			return err
-		}
 
-		return run.AttachCapabilitySets(consortiumName, tenantType, 0*time.Second, true)
-	})
+	return graph.Run(ctx)
+}
+
+// newPartitionStep declares a step for a partition
+func (run *Run) newPartitionStep(p Partition, step string, needs []string, fn func(context.Context, string, constant.TenantType) error) runconfig.Step {
+	return runconfig.Step{
+		Name:  p.PartitionStep(step),
+		Needs: needs,
+		Run: func(ctx context.Context) error {
+			return fn(ctx, p.ConsortiumName, p.TenantType)
+		},
+	}
 }
 
-func (run *Run) ValidateParentApplications() error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+func (run *Run) ValidateParentApplications(ctx context.Context) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		var netErr net.Error
 		if errors.As(err, &netErr) {
 			return apperrors.ParentApplicationNotReachable(run.Config.Action.GetParentAppIDs(), err)
@@ -165,7 +224,7 @@ func (run *Run) ValidateParentApplications() error {
 		return err
 	}
 
-	apps, err := run.Config.ManagementSvc.GetApplications()
+	apps, err := run.Config.ManagementSvc.GetApplications(ctx)
 	if err != nil {
 		var netErr net.Error
 		if errors.As(err, &netErr) {
@@ -204,4 +263,18 @@ func init() {
 	deployApplicationCmd.PersistentFlags().BoolVarP(&params.KeepVolumes, action.KeepVolumes.Long, action.KeepVolumes.Short, false, action.KeepVolumes.Description)
 	deployApplicationCmd.PersistentFlags().BoolVarP(&params.SkipRegistry, action.SkipRegistry.Long, action.SkipRegistry.Short, false, action.SkipRegistry.Description)
 	deployApplicationCmd.PersistentFlags().BoolVarP(&params.SkipUI, action.SkipUI.Long, action.SkipUI.Short, false, action.SkipUI.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.SkipDependencyCheck, action.SkipDependencyCheck.Long, action.SkipDependencyCheck.Short, false, action.SkipDependencyCheck.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.StrictDependencyCheck, action.StrictDependencyCheck.Long, action.StrictDependencyCheck.Short, false, action.StrictDependencyCheck.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.Resume, action.Resume.Long, action.Resume.Short, false, action.Resume.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.RollbackOnFailure, action.RollbackOnFailure.Long, action.RollbackOnFailure.Short, false, action.RollbackOnFailure.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.Lock, action.Lock.Long, action.Lock.Short, false, action.Lock.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.FromLock, action.FromLock.Long, action.FromLock.Short, false, action.FromLock.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.Plan, action.Plan.Long, action.Plan.Short, false, action.Plan.Description)
+	deployApplicationCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+	if err := deployApplicationCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(apperrors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/checkpointsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// skipAndRecordSteps skips every step of the graph and records the order in which the steps were started
+func skipAndRecordSteps(run *Run) func() []string {
+	var (
+		mu    sync.Mutex
+		steps []string
+	)
+	run.Hooks = append(run.Hooks, runconfig.StepHook{
+		Before: func(step *runconfig.Step) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			steps = append(steps, step.Name)
+			return true
+		},
+	})
+
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(steps)
+	}
+}
+
+// ==================== DeployApplication Tests ====================
+
+func TestDeployApplication_EntitlesPartitionAfterPreviousPartitionCapabilitySets(t *testing.T) {
+	// Arrange
+	viper.Set(field.Consortiums, map[string]any{"eureka": map[string]any{}})
+	defer viper.Set(field.Consortiums, nil)
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigConsortiums = map[string]config.Consortium{"eureka": {}}
+	getSteps := skipAndRecordSteps(run)
+
+	// Act
+	err := run.DeployApplication(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	steps := getSteps()
+	centralAttach := slices.Index(steps, checkpointsvc.PartitionStep(action.AttachCapabilitySets, "eureka", constant.Central))
+	memberEntitlement := slices.Index(steps, checkpointsvc.PartitionStep(action.CreateTenantEntitlements, "eureka", constant.Member))
+	assert.NotEqual(t, -1, centralAttach)
+	assert.Greater(t, memberEntitlement, centralAttach)
+}
+
+func TestDeployChildApplication_EntitlesPartitionAfterPreviousPartitionCapabilitySets(t *testing.T) {
+	// Arrange
+	viper.Set(field.Consortiums, map[string]any{"eureka": map[string]any{}})
+	defer viper.Set(field.Consortiums, nil)
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigConsortiums = map[string]config.Consortium{"eureka": {}}
+	getSteps := skipAndRecordSteps(run)
+
+	// Act
+	err := run.DeployChildApplication(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	steps := getSteps()
+	centralAttach := slices.Index(steps, checkpointsvc.PartitionStep(action.AttachCapabilitySets, "eureka", constant.Central))
+	memberEntitlement := slices.Index(steps, checkpointsvc.PartitionStep(action.CreateTenantEntitlements, "eureka", constant.Member))
+	assert.NotEqual(t, -1, centralAttach)
+	assert.Greater(t, memberEntitlement, centralAttach)
+}
+
+func TestRollbackFailedDeployment_ClearsCheckpoints(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	run.Config.JournalSvc = journalsvc.New(run.Config.Action)
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	assert.NoError(t, run.Config.CheckpointSvc.MarkCompleted(action.DeploySystem))
+
+	// Act
+	err := run.RollbackFailedDeployment(context.Background(), assert.AnError)
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.False(t, run.Config.CheckpointSvc.IsCompleted(action.DeploySystem))
+}
+
+func TestDeployApplication_Success(t *testing.T) {
+	t.Skip("DeployApplication requires extensive mocking of multiple sub-commands; tested via integration tests")
+}
+
+// Note: DeployApplication and UndeployApplication are complex orchestration functions
+// that call multiple other commands (DeploySystem, DeployManagement, DeployModules, etc.).
+// These are better suited for integration tests rather than unit tests since they would
+// require mocking every dependency of all sub-commands. The individual commands
+// (DeploySystem, DeployManagement, etc.) have their own comprehensive unit tests.
+
+// ==================== ValidateParentApplications Tests ====================
+
+func TestValidateParentApplications_AllPresent(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigApplicationDependencies = map[string]any{
+		"name":    "app-combined",
+		"version": "1.0.0",
+	}
+
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+	mockManagement.On("GetApplications").Return(models.ApplicationsResponse{
+		ApplicationDescriptors: []map[string]any{
+			{"id": "app-combined-1.0.0", "name": "app-combined"},
+		},
+		TotalRecords: 1,
+	}, nil)
+
+	// Act
+	err := run.ValidateParentApplications(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockKeycloak.AssertExpectations(t)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestValidateParentApplications_ParentMissing(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigApplicationDependencies = map[string]any{
+		"name":    "app-combined",
+		"version": "1.0.0",
+	}
+
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+	mockManagement.On("GetApplications").Return(models.ApplicationsResponse{
+		ApplicationDescriptors: []map[string]any{},
+		TotalRecords:           0,
+	}, nil)
+
+	// Act
+	err := run.ValidateParentApplications(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "app-combined-1.0.0")
+	mockKeycloak.AssertExpectations(t)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestValidateParentApplications_MultipleParentsMissing(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigApplicationDependencies = map[string]any{
+		"dep1": map[string]any{"name": "app-combined", "version": "1.0.0"},
+		"dep2": map[string]any{"name": "app-platform", "version": "2.0.0"},
+	}
+
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+	mockManagement.On("GetApplications").Return(models.ApplicationsResponse{
+		ApplicationDescriptors: []map[string]any{},
+		TotalRecords:           0,
+	}, nil)
+
+	// Act
+	err := run.ValidateParentApplications(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "parent application(s) not registered in mgr-applications")
+	mockKeycloak.AssertExpectations(t)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestValidateParentApplications_MultipleParentsOnePresent(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigApplicationDependencies = map[string]any{
+		"dep1": map[string]any{"name": "app-combined", "version": "1.0.0"},
+		"dep2": map[string]any{"name": "app-platform", "version": "2.0.0"},
+	}
+
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+	mockManagement.On("GetApplications").Return(models.ApplicationsResponse{
+		ApplicationDescriptors: []map[string]any{
+			{"id": "app-combined-1.0.0", "name": "app-combined"},
+		},
+		TotalRecords: 1,
+	}, nil)
+
+	// Act
+	err := run.ValidateParentApplications(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "app-platform-2.0.0")
+	assert.NotContains(t, err.Error(), "app-combined-1.0.0")
+	mockKeycloak.AssertExpectations(t)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestValidateParentApplications_GetApplicationsError(t *testing.T) {
+	t.Run("NetworkError_WrapsWithNotReachable", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+		run.Config.Action.ConfigApplicationDependencies = map[string]any{
+			"name": "app-combined", "version": "1.0.0",
+		}
+		netErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+		mockManagement.On("GetApplications").Return(models.ApplicationsResponse{}, netErr)
+
+		// Act
+		err := run.ValidateParentApplications(context.Background())
+
+		// Assert
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, netErr))
+		assert.Contains(t, err.Error(), "parent application services unreachable")
+		mockKeycloak.AssertExpectations(t)
+		mockManagement.AssertExpectations(t)
+	})
+
+	t.Run("NonNetworkError_PassesThrough", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+		run.Config.Action.ConfigApplicationDependencies = map[string]any{
+			"name": "app-combined", "version": "1.0.0",
+		}
+		expectedError := errors.New("mgr-applications returned 500")
+
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("token", nil)
+		mockManagement.On("GetApplications").Return(models.ApplicationsResponse{}, expectedError)
+
+		// Act
+		err := run.ValidateParentApplications(context.Background())
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		mockKeycloak.AssertExpectations(t)
+		mockManagement.AssertExpectations(t)
+	})
+}
+
+func TestValidateParentApplications_TokenError(t *testing.T) {
+	t.Run("NetworkError_WrapsWithNotReachable", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+		run.Config.Action.ConfigApplicationDependencies = map[string]any{
+			"name": "app-combined", "version": "1.0.0",
+		}
+		netErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", netErr)
+
+		// Act
+		err := run.ValidateParentApplications(context.Background())
+
+		// Assert
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, netErr))
+		assert.Contains(t, err.Error(), "parent application services unreachable")
+		mockKeycloak.AssertExpectations(t)
+	})
+
+	t.Run("NonNetworkError_PassesThrough", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.DeployApplication)
+		run.Config.Action.ConfigApplicationDependencies = map[string]any{
+			"name": "app-combined", "version": "1.0.0",
+		}
+		expectedError := errors.New("keycloak returned 401")
+
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("", expectedError)
+
+		// Act
+		err := run.ValidateParentApplications(context.Background())
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		mockKeycloak.AssertExpectations(t)
+	})
+}
@@ -16,12 +16,13 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
-	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/spf13/cobra"
 )
@@ -37,11 +38,11 @@ var deployManagementCmd = &cobra.Command{
 			return err
 		}
 
-		return run.DeployManagement()
+		return run.DeployManagement(cmd.Context())
 	},
 }
 
-func (run *Run) DeployManagement() error {
+func (run *Run) DeployManagement(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULES")
 	backendModules, err := run.Config.ModuleProps.ReadBackendModules(true, true)
 	if err != nil {
@@ -49,7 +50,7 @@ func (run *Run) DeployManagement() error {
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULE REGISTRIES")
-	modules, err := run.Config.RegistrySvc.GetModules(true, true)
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, true, true)
 	if err != nil {
 		return err
 	}
@@ -60,12 +61,12 @@ func (run *Run) DeployManagement() error {
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
-	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+	if err := run.setVaultRootTokenIntoContext(ctx, client); err != nil {
 		return err
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING MANAGEMENT MODULES")
-	newlyDeployed, totalMatched, err := run.Config.ModuleSvc.DeployModules(client, &models.Containers{
+	newlyDeployed, totalMatched, err := run.Config.ModuleSvc.DeployModules(ctx, client, &models.Containers{
 		Modules:        modules,
 		BackendModules: backendModules,
 		IsManagement:   true,
@@ -79,25 +80,28 @@ func (run *Run) DeployManagement() error {
 	if len(newlyDeployed) == 0 {
 		slog.Info(run.Config.Action.Name, "text", "All management modules already deployed, skipping healthchecks")
 	} else {
-		time.Sleep(constant.DeployManagementWait)
+		if err := helpers.Sleep(ctx, constant.DeployManagementWait); err != nil {
+			return err
+		}
 
 		slog.Info(run.Config.Action.Name, "text", "WAITING FOR MANAGEMENT MODULES TO BECOME READY")
-		if err := run.CheckDeployedModuleReadiness(constant.Management, newlyDeployed); err != nil {
+		if err := run.CheckDeployedModuleReadiness(ctx, constant.Management, newlyDeployed); err != nil {
 			return err
 		}
 
 		slog.Info(run.Config.Action.Name, "text", "WAITING FOR KONG ROUTES TO BECOME READY")
-		if err := run.Config.KongSvc.CheckRouteReadiness(); err != nil {
+		if err := run.Config.KongSvc.CheckRouteReadiness(ctx); err != nil {
 			return err
 		}
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "UPDATING REALM SETTINGS")
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+	ctx, err = run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.Password)
+	if err != nil {
 		return err
 	}
 
-	return run.Config.KeycloakSvc.UpdateRealmAccessTokenSettings(constant.KeycloakMasterRealm, constant.KeycloakMasterRealmAccessTokenLifespan)
+	return run.Config.KeycloakSvc.UpdateRealmAccessTokenSettings(ctx, constant.KeycloakMasterRealm, constant.KeycloakMasterRealmAccessTokenLifespan)
 }
 
 func init() {
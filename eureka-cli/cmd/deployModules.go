@@ -16,8 +16,9 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
-	"time"
+	"os"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
@@ -37,12 +38,19 @@ var deployModulesCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if params.Plan {
+			plan, err := run.PlanDeployment(cmd.Context(), false, false)
+			if err != nil {
+				return err
+			}
+			return run.PrintPlan(plan)
+		}
 
-		return run.DeployModules()
+		return run.DeployModules(cmd.Context())
 	},
 }
 
-func (run *Run) DeployModules() error {
+func (run *Run) DeployModules(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULES")
 	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, true)
 	if err != nil {
@@ -56,7 +64,7 @@ func (run *Run) DeployModules() error {
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULE REGISTRIES")
-	modules, err := run.Config.RegistrySvc.GetModules(true, true)
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, true, true)
 	if err != nil {
 		return err
 	}
@@ -67,7 +75,7 @@ func (run *Run) DeployModules() error {
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
-	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+	if err := run.setVaultRootTokenIntoContext(ctx, client); err != nil {
 		return err
 	}
 
@@ -84,15 +92,15 @@ func (run *Run) DeployModules() error {
 
 	slog.Info(run.Config.Action.Name, "text", "Using sidecar image", "image", sidecarImage, "pullImage", pullSidecarImage)
 	if pullSidecarImage {
-		err = run.Config.ModuleSvc.PullModule(client, sidecarImage)
+		err = run.Config.ModuleSvc.PullModule(ctx, client, sidecarImage)
 		if err != nil {
 			return err
 		}
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING MODULES")
-	sidecarResources := helpers.CreateResources(false, run.Config.Action.ConfigSidecarModuleResources)
-	newlyDeployed, totalMatched, err := run.Config.ModuleSvc.DeployModules(client, containers, sidecarImage, sidecarResources)
+	sidecarResources := helpers.CreateResources(false, run.Config.Action.ConfigSidecarModule.Resources)
+	newlyDeployed, totalMatched, err := run.Config.ModuleSvc.DeployModules(ctx, client, containers, sidecarImage, sidecarResources)
 	if err != nil {
 		return err
 	}
@@ -102,20 +110,23 @@ func (run *Run) DeployModules() error {
 	if len(newlyDeployed) == 0 {
 		slog.Info(run.Config.Action.Name, "text", "All modules already deployed, skipping healthchecks")
 	} else {
-		time.Sleep(constant.DeployModulesWait)
+		if err := helpers.Sleep(ctx, constant.DeployModulesWait); err != nil {
+			return err
+		}
 
 		slog.Info(run.Config.Action.Name, "text", "WAITING FOR MODULES TO BECOME READY")
-		if err := run.CheckDeployedModuleReadiness(constant.Module, newlyDeployed); err != nil {
+		if err := run.CheckDeployedModuleReadiness(ctx, constant.Module, newlyDeployed); err != nil {
 			return err
 		}
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "CREATING APPLICATION")
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err = run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	return run.Config.ManagementSvc.CreateApplication(&models.RegistryExtract{
+	return run.Config.ManagementSvc.CreateApplication(ctx, &models.RegistryExtract{
 		Modules:           modules,
 		BackendModules:    backendModules,
 		FrontendModules:   frontendModules,
@@ -126,4 +137,12 @@ func (run *Run) DeployModules() error {
 func init() {
 	rootCmd.AddCommand(deployModulesCmd)
 	deployModulesCmd.PersistentFlags().BoolVarP(&params.SkipRegistry, action.SkipRegistry.Long, action.SkipRegistry.Short, false, action.SkipRegistry.Description)
+	deployModulesCmd.PersistentFlags().BoolVarP(&params.Plan, action.Plan.Long, action.Plan.Short, false, action.Plan.Description)
+	deployModulesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+	if err := deployModulesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
 }
@@ -16,11 +16,11 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os/exec"
 	"strings"
-	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
@@ -39,11 +39,11 @@ var deploySystemCmd = &cobra.Command{
 			return err
 		}
 
-		return run.DeploySystem()
+		return run.DeploySystem(cmd.Context())
 	},
 }
 
-func (run *Run) DeploySystem() error {
+func (run *Run) DeploySystem(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING SYSTEM CONTAINERS")
 	if params.BuildImages {
 		if err := run.BuildSystem(); err != nil {
@@ -58,10 +58,10 @@ func (run *Run) DeploySystem() error {
 		subCommand = append(subCommand, finalRequiredContainers...)
 	}
 
-	return run.dockerComposeUp(subCommand, constant.DeploySystemWait, "system")
+	return run.dockerComposeUp(ctx, subCommand, run.Config.ReadinessSvc.WaitForSystem, "system")
 }
 
-func (run *Run) dockerComposeUp(subCommand []string, wait time.Duration, label string) error {
+func (run *Run) dockerComposeUp(ctx context.Context, subCommand []string, waitReady func(context.Context) error, label string) error {
 	homeDir, err := helpers.GetHomeMiscDir()
 	if err != nil {
 		return err
@@ -77,7 +77,9 @@ func (run *Run) dockerComposeUp(subCommand []string, wait time.Duration, label s
 	combined := stdout.String() + stderr.String()
 	if strings.Contains(combined, " Started") || strings.Contains(combined, " Created") {
 		slog.Info(run.Config.Action.Name, "text", "WAITING FOR "+strings.ToUpper(label)+" CONTAINERS TO BECOME READY")
-		time.Sleep(wait)
+		if err := waitReady(ctx); err != nil {
+			return err
+		}
 		slog.Info(run.Config.Action.Name, "text", fmt.Sprintf("All %s containers are ready", label))
 	} else {
 		slog.Info(run.Config.Action.Name, "text", fmt.Sprintf("All %s containers already running, skipping wait", label))
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -36,18 +37,18 @@ var deployUiCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.DeployUi(consortiumName, tenantType)
+			return run.DeployUi(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) DeployUi(consortiumName string, tenantType constant.TenantType) error {
+func (run *Run) DeployUi(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	if params.SkipUI {
 		slog.Info(run.Config.Action.Name, "text", "SKIPPING UI DEPLOYMENT")
 		return nil
 	}
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING UI", "consortium", consortiumName)
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		if helpers.IsUIEnabled(configTenant, run.Config.Action.ConfigTenants) {
 			if err := run.Config.TenantSvc.SetConfigTenantParams(configTenant); err != nil {
 				return err
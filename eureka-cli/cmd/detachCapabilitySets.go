@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,15 +36,15 @@ var detachCapabilitySetsCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.DetachCapabilitySets(consortiumName, tenantType)
+			return run.DetachCapabilitySets(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) DetachCapabilitySets(consortiumName string, tenantType constant.TenantType) error {
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+func (run *Run) DetachCapabilitySets(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		slog.Info(run.Config.Action.Name, "text", "DETACHING CAPABILITY SETS", "tenant", configTenant)
-		if err := run.Config.KeycloakSvc.DetachCapabilitySetsFromRoles(configTenant); err != nil {
+		if err := run.Config.KeycloakSvc.DetachCapabilitySetsFromRoles(ctx, configTenant); err != nil {
 			slog.Warn(run.Config.Action.Name, "text", "Capability sets detachment was unsuccessful", "tenant", configTenant, "error", err)
 		}
 
@@ -0,0 +1,311 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:          "doctor",
+	Short:        "Check host prerequisites",
+	Long:         `Check the host prerequisites of the profile before deploying it.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// The gateway URL is one of the checks, so the run is created without it
+		run, err := newRun(action.Doctor, "")
+		if err != nil {
+			return err
+		}
+
+		return run.Doctor(cmd.Context())
+	},
+}
+
+// deploymentNeeds represents the memory and application port range a profile needs, derived from its config
+type deploymentNeeds struct {
+	systemContainers int
+	modules          int
+	sidecars         int
+	memory           int64
+	ports            int
+}
+
+func (run *Run) Doctor(ctx context.Context) error {
+	checks := run.RunDoctorChecks(ctx)
+	writeDoctorChecks(os.Stdout, checks)
+
+	failed := 0
+	for _, check := range checks {
+		if check.Status == constant.DoctorCheckFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.DoctorChecksFailed(failed)
+	}
+
+	return nil
+}
+
+// RunDoctorChecks runs every host prerequisite check, the checks that need the Docker daemon are skipped when it is unreachable
+func (run *Run) RunDoctorChecks(ctx context.Context) []models.DoctorCheck {
+	needs := run.getDeploymentNeeds()
+	checks := []models.DoctorCheck{run.checkSystemHostnames(), run.checkGatewayURL()}
+
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return append(checks, newDockerDaemonFailedCheck(err), run.checkApplicationPorts(ctx, needs, nil))
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	dockerMemory, err := run.Config.ModuleSvc.GetDockerMemory(ctx, dockerClient)
+	if err != nil {
+		return append(checks, newDockerDaemonFailedCheck(err), run.checkApplicationPorts(ctx, needs, nil))
+	}
+	checks = append(checks,
+		models.DoctorCheck{Name: "Docker daemon", Status: constant.DoctorCheckOK, Detail: "Docker daemon is reachable"},
+		checkDockerMemory(needs, dockerMemory),
+		run.checkApplicationPorts(ctx, needs, dockerClient),
+	)
+	if needs.sidecars > 0 {
+		checks = append(checks, run.checkSidecarImage(ctx, dockerClient))
+	}
+
+	return checks
+}
+
+func newDockerDaemonFailedCheck(err error) models.DoctorCheck {
+	return models.DoctorCheck{
+		Name:   "Docker daemon",
+		Status: constant.DoctorCheckFail,
+		Detail: fmt.Sprintf("Docker daemon is unreachable: %v", err),
+		Fix:    "Start the container daemon, e.g. Rancher Desktop with the dockerd (Moby) engine, and check that DOCKER_HOST points to it",
+	}
+}
+
+// getDeploymentNeeds sums the memory limits of the required system containers, the enabled modules and sidecars
+// and the number of ports the modules reserve from application.port-start..port-end, the optional system
+// containers that are only started without --onlyRequired are not included
+func (run *Run) getDeploymentNeeds() deploymentNeeds {
+	var (
+		needs          deploymentNeeds
+		sidecarMemory  = helpers.CreateResources(false, run.Config.Action.ConfigSidecarModule.Resources).Memory
+		systemMemory   = constant.GetSystemContainerMemory()
+		isSidecarOwner = func(name string) bool {
+			return !strings.HasPrefix(name, constant.ManagementModulePattern) && !strings.HasPrefix(name, constant.EdgeModulePattern)
+		}
+	)
+	for _, container := range helpers.AppendRequiredContainers(run.Config.Action.Name, constant.GetInitialRequiredContainers(), run.Config.Action.ConfigBackendModules) {
+		needs.systemContainers++
+		needs.memory += helpers.ConvertMemory(helpers.MibToBytes, systemMemory[container])
+	}
+	for name, module := range run.Config.Action.ConfigBackendModules {
+		if !module.IsDeployModule() {
+			continue
+		}
+
+		needs.modules++
+		needs.memory += helpers.CreateResources(true, module.Resources).Memory
+		if module.Port == nil {
+			needs.ports++
+		}
+		if isSidecarOwner(name) && module.IsDeploySidecar() {
+			// Module debug, sidecar server and sidecar debug ports
+			needs.sidecars++
+			needs.memory += sidecarMemory
+			needs.ports += 3
+		} else {
+			// Module debug port
+			needs.ports++
+		}
+	}
+
+	return needs
+}
+
+func (run *Run) checkSystemHostnames() models.DoctorCheck {
+	check := models.DoctorCheck{Name: "Hosts file"}
+	var unresolved []string
+	for _, hostname := range constant.GetSystemHostnames() {
+		if err := helpers.IsHostnameReachable(run.Config.Action.Name, hostname); err != nil {
+			unresolved = append(unresolved, hostname)
+		}
+	}
+	if len(unresolved) == 0 {
+		check.Status = constant.DoctorCheckOK
+		check.Detail = "All system hostnames resolve"
+		return check
+	}
+
+	check.Status = constant.DoctorCheckFail
+	check.Detail = fmt.Sprintf("Hostnames do not resolve: %s", strings.Join(unresolved, ", "))
+	if runtime.GOOS == "windows" {
+		check.Fix = "Run .\\misc\\scripts\\add-hosts.ps1 in PowerShell as Administrator, or add 127.0.0.1 entries for them to C:\\Windows\\System32\\drivers\\etc\\hosts"
+	} else {
+		check.Fix = "Run sudo ./misc/scripts/add-hosts.sh, or add 127.0.0.1 entries for them to /etc/hosts"
+	}
+
+	return check
+}
+
+func (run *Run) checkGatewayURL() models.DoctorCheck {
+	check := models.DoctorCheck{Name: "Gateway URL"}
+	gatewayURL, err := action.GetGatewayURL(run.Config.Action.Name)
+	if err != nil {
+		check.Status = constant.DoctorCheckFail
+		check.Detail = err.Error()
+		check.Fix = fmt.Sprintf("Make %s resolve on the host, or set %s in the config to a hostname of the Docker host", constant.DockerHostname, field.ApplicationGatewayHostname)
+		return check
+	}
+
+	check.Status = constant.DoctorCheckOK
+	check.Detail = fmt.Sprintf("Modules reach the host at %s", gatewayURL)
+	if strings.Contains(gatewayURL, constant.DockerGatewayIP) {
+		check.Detail += fmt.Sprintf(", %s does not resolve", constant.DockerHostname)
+	}
+
+	return check
+}
+
+func checkDockerMemory(needs deploymentNeeds, dockerMemory int64) models.DoctorCheck {
+	check := models.DoctorCheck{
+		Name: "Docker memory",
+		Detail: fmt.Sprintf("%d system container(s), %d module(s) and %d sidecar(s) need %d MiB, the Docker daemon has %d MiB",
+			needs.systemContainers, needs.modules, needs.sidecars, helpers.ConvertMemory(helpers.BytesToMib, needs.memory), helpers.ConvertMemory(helpers.BytesToMib, dockerMemory)),
+	}
+	if dockerMemory >= needs.memory {
+		check.Status = constant.DoctorCheckOK
+		return check
+	}
+
+	check.Status = constant.DoctorCheckFail
+	check.Fix = "Increase the memory of the container daemon, e.g. in Rancher Desktop Preferences > Virtual Machine, " +
+		"or lower the memory of the modules with their resources.memory entries"
+
+	return check
+}
+
+// checkApplicationPorts counts the free ports in the application port range, ports published by the
+// containers of an existing environment are counted as free because redeploying it releases them
+func (run *Run) checkApplicationPorts(ctx context.Context, needs deploymentNeeds, dockerClient *client.Client) models.DoctorCheck {
+	portStart, portEnd := run.Config.Action.ConfigApplicationPortStart, run.Config.Action.ConfigApplicationPortEnd
+	check := models.DoctorCheck{Name: "Application ports"}
+
+	var environmentPorts []int
+	if dockerClient != nil {
+		containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, make(client.Filters).Add("name", constant.AllContainerPattern))
+		if err == nil {
+			for _, c := range containers {
+				for _, port := range c.Ports {
+					environmentPorts = append(environmentPorts, int(port.PublicPort))
+				}
+			}
+		}
+	}
+
+	var free int
+	var bound []string
+	for port := portStart; port <= portEnd; port++ {
+		if slices.Contains(environmentPorts, port) || helpers.IsTCPPortFree(port) {
+			free++
+			continue
+		}
+		bound = append(bound, fmt.Sprint(port))
+	}
+	check.Detail = fmt.Sprintf("%d of %d ports in %d-%d are free, %d are needed", free, portEnd-portStart+1, portStart, portEnd, needs.ports)
+	if len(bound) > 0 {
+		check.Detail += fmt.Sprintf(", bound by other processes: %s", strings.Join(bound, ", "))
+	}
+
+	switch {
+	case free < needs.ports:
+		check.Status = constant.DoctorCheckFail
+		check.Fix = fmt.Sprintf("Stop the processes listening on the bound ports, or widen %s..%s in the config", field.ApplicationPortStart, field.ApplicationPortEnd)
+	case len(bound) > 0:
+		check.Status = constant.DoctorCheckWarn
+	default:
+		check.Status = constant.DoctorCheckOK
+	}
+
+	return check
+}
+
+func (run *Run) checkSidecarImage(ctx context.Context, dockerClient *client.Client) models.DoctorCheck {
+	check := models.DoctorCheck{Name: "Sidecar image"}
+	sidecarModule := run.Config.Action.ConfigSidecarModule
+	image := sidecarModule.Image
+	if !sidecarModule.CustomNamespace {
+		check.Status = constant.DoctorCheckWarn
+		check.Detail = fmt.Sprintf("Sidecar image %s is pulled from Docker Hub, the published JVM sidecar images fail to start", image)
+		check.Fix = "Build a native sidecar image locally, see Using a native folio-module-sidecar in the README"
+		return check
+	}
+
+	version := sidecarModule.Version
+	if version == "" {
+		check.Status = constant.DoctorCheckFail
+		check.Detail = fmt.Sprintf("Locally built sidecar image %s has no version", image)
+		check.Fix = "Set sidecar-module.version in the config, e.g. latest"
+		return check
+	}
+
+	imageName := fmt.Sprintf("%s:%s", image, version)
+	exists, err := run.Config.ModuleSvc.ImageExists(ctx, dockerClient, imageName)
+	switch {
+	case err != nil:
+		check.Status = constant.DoctorCheckFail
+		check.Detail = fmt.Sprintf("Sidecar image %s cannot be inspected: %v", imageName, err)
+	case !exists:
+		check.Status = constant.DoctorCheckFail
+		check.Detail = fmt.Sprintf("Locally built sidecar image %s is missing", imageName)
+		check.Fix = "Build the native sidecar image, see Using a native folio-module-sidecar in the README"
+	default:
+		check.Status = constant.DoctorCheckOK
+		check.Detail = fmt.Sprintf("Locally built sidecar image %s exists", imageName)
+	}
+
+	return check
+}
+
+func writeDoctorChecks(w io.Writer, checks []models.DoctorCheck) {
+	for _, check := range checks {
+		_, _ = fmt.Fprintf(w, "[%-4s] %s: %s\n", strings.ToUpper(check.Status), check.Name, check.Detail)
+		if check.Fix != "" {
+			_, _ = fmt.Fprintf(w, "       Fix: %s\n", check.Fix)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
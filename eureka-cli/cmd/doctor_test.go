@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Doctor Tests ====================
+
+func TestGetDeploymentNeeds(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Doctor)
+	run.Config.Action.ConfigSidecarModule.Resources = config.Resources{Memory: helpers.Int64Ptr(200)}
+	run.Config.Action.ConfigBackendModules = map[string]config.BackendModule{
+		"mod-orders":    {},
+		"mod-users":     {Resources: config.Resources{Memory: helpers.Int64Ptr(1024)}, DeploySidecar: helpers.BoolPtr(false)},
+		"mod-notes":     {DeployModule: helpers.BoolPtr(false)},
+		"mgr-tenants":   {Port: helpers.IntPtr(9902)},
+		"edge-orders":   {},
+		"mod-inventory": {Port: helpers.IntPtr(9131)},
+	}
+
+	// Act
+	needs := run.getDeploymentNeeds()
+
+	// Assert
+	assert.Equal(t, 8, needs.systemContainers)
+	assert.Equal(t, 5, needs.modules)
+	assert.Equal(t, 2, needs.sidecars)
+	// 8117 MiB of the required system containers followed by the modules and sidecars
+	assert.Equal(t, helpers.ConvertMemory(helpers.MibToBytes, 8117+4*constant.ModuleMemory+1024+2*200), needs.memory)
+	// mod-orders 4, mod-users 2, mgr-tenants 1, edge-orders 2 and mod-inventory 3
+	assert.Equal(t, 12, needs.ports)
+}
+
+func TestCheckDockerMemory(t *testing.T) {
+	needs := deploymentNeeds{systemContainers: 8, modules: 2, sidecars: 1, memory: helpers.ConvertMemory(helpers.MibToBytes, 2048)}
+
+	t.Run("enough memory", func(t *testing.T) {
+		// Act
+		check := checkDockerMemory(needs, helpers.ConvertMemory(helpers.MibToBytes, 4096))
+
+		// Assert
+		assert.Equal(t, constant.DoctorCheckOK, check.Status)
+		assert.Equal(t, "8 system container(s), 2 module(s) and 1 sidecar(s) need 2048 MiB, the Docker daemon has 4096 MiB", check.Detail)
+		assert.Empty(t, check.Fix)
+	})
+
+	t.Run("not enough memory", func(t *testing.T) {
+		// Act
+		check := checkDockerMemory(needs, helpers.ConvertMemory(helpers.MibToBytes, 1024))
+
+		// Assert
+		assert.Equal(t, constant.DoctorCheckFail, check.Status)
+		assert.NotEmpty(t, check.Fix)
+	})
+}
+
+func TestCheckApplicationPorts(t *testing.T) {
+	// Arrange
+	listener, err := net.Listen("tcp", ":0")
+	assert.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+	port := listener.Addr().(*net.TCPAddr).Port
+	needs := deploymentNeeds{ports: 1}
+
+	t.Run("port bound by another process", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.Doctor)
+		run.Config.Action.ConfigApplicationPortStart, run.Config.Action.ConfigApplicationPortEnd = port, port
+
+		// Act
+		check := run.checkApplicationPorts(context.Background(), needs, nil)
+
+		// Assert
+		assert.Equal(t, constant.DoctorCheckFail, check.Status)
+		assert.Contains(t, check.Detail, fmt.Sprintf("bound by other processes: %d", port))
+		assert.NotEmpty(t, check.Fix)
+	})
+
+	t.Run("port published by the environment", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, mockModule := newTestRun(action.Doctor)
+		run.Config.Action.ConfigApplicationPortStart, run.Config.Action.ConfigApplicationPortEnd = port, port
+		mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+			{Names: []string{"/eureka-combined-mod-orders"}, Ports: []container.PortSummary{{PrivatePort: 8081, PublicPort: uint16(port)}}},
+		}, nil)
+
+		// Act
+		check := run.checkApplicationPorts(context.Background(), needs, &client.Client{})
+
+		// Assert
+		assert.Equal(t, constant.DoctorCheckOK, check.Status)
+		assert.Equal(t, fmt.Sprintf("1 of 1 ports in %[1]d-%[1]d are free, 1 are needed", port), check.Detail)
+	})
+}
+
+func TestCheckSidecarImage(t *testing.T) {
+	tests := []struct {
+		name           string
+		sidecarModule  config.SidecarModule
+		exists         bool
+		expectedStatus string
+	}{
+		{
+			name:           "image pulled from Docker Hub",
+			sidecarModule:  config.SidecarModule{Image: "folio-module-sidecar"},
+			expectedStatus: constant.DoctorCheckWarn,
+		},
+		{
+			name:           "local image without version",
+			sidecarModule:  config.SidecarModule{Image: "folio-module-sidecar-native", CustomNamespace: true},
+			expectedStatus: constant.DoctorCheckFail,
+		},
+		{
+			name:           "local image missing",
+			sidecarModule:  config.SidecarModule{Image: "folio-module-sidecar-native", CustomNamespace: true, Version: "latest"},
+			expectedStatus: constant.DoctorCheckFail,
+		},
+		{
+			name:           "local image exists",
+			sidecarModule:  config.SidecarModule{Image: "folio-module-sidecar-native", CustomNamespace: true, Version: "latest"},
+			exists:         true,
+			expectedStatus: constant.DoctorCheckOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			run, _, _, _, _, mockModule := newTestRun(action.Doctor)
+			run.Config.Action.ConfigSidecarModule = tt.sidecarModule
+			mockModule.On("ImageExists", mock.Anything, "folio-module-sidecar-native:latest").Return(tt.exists, nil)
+
+			// Act
+			check := run.checkSidecarImage(context.Background(), nil)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, check.Status)
+			assert.Equal(t, tt.expectedStatus == constant.DoctorCheckOK, check.Fix == "")
+		})
+	}
+}
+
+func TestRunDoctorChecks_DockerDaemonUnreachable(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, mockModule := newTestRun(action.Doctor)
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return()
+	mockModule.On("GetDockerMemory", mock.Anything).Return(int64(0), assert.AnError)
+
+	// Act
+	checks := run.RunDoctorChecks(context.Background())
+
+	// Assert
+	var names []string
+	for _, check := range checks {
+		names = append(names, check.Name)
+	}
+	assert.Equal(t, []string{"Hosts file", "Gateway URL", "Docker daemon", "Application ports"}, names)
+	assert.Equal(t, constant.DoctorCheckFail, checks[2].Status)
+	mockModule.AssertNotCalled(t, "ImageExists", mock.Anything, mock.Anything)
+}
+
+func TestWriteDoctorChecks(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	checks := []models.DoctorCheck{
+		{Name: "Docker daemon", Status: constant.DoctorCheckOK, Detail: "Docker daemon is reachable"},
+		{Name: "Hosts file", Status: constant.DoctorCheckFail, Detail: "Hostnames do not resolve: kong.eureka", Fix: "Run sudo ./misc/scripts/add-hosts.sh"},
+	}
+
+	// Act
+	writeDoctorChecks(&buf, checks)
+
+	// Assert
+	assert.Equal(t, "[OK  ] Docker daemon: Docker daemon is reachable\n"+
+		"[FAIL] Hosts file: Hostnames do not resolve: kong.eureka\n"+
+		"       Fix: Run sudo ./misc/scripts/add-hosts.sh\n", buf.String())
+}
@@ -0,0 +1,188 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+// generateProfileCmd represents the generateProfile command
+var generateProfileCmd = &cobra.Command{
+	Use:   "generateProfile",
+	Short: "Generate profile",
+	Long: `Generate a new profile in the home directory with the modules and the modules providing the interfaces they require,
+resolved from the module descriptors of the platform applications.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.GenerateProfile)
+		if err != nil {
+			return err
+		}
+
+		profileDirs, err := helpers.GetProfileDirs()
+		if err != nil {
+			return err
+		}
+
+		return run.GenerateProfile(cmd.Context(), viper.ConfigFileUsed(), profileDirs[0], profileDirs)
+	},
+}
+
+func (run *Run) GenerateProfile(ctx context.Context, sourceConfigFile, homeDir string, profileDirs []string) error {
+	configFile, err := getNewProfileConfigFile(homeDir)
+	if err != nil {
+		return err
+	}
+
+	moduleDescriptors, err := run.Config.RegistrySvc.GetModuleDescriptors(ctx, false)
+	if err != nil {
+		return err
+	}
+	backendModuleNames, frontendModuleNames, err := run.resolveProfileModules(moduleDescriptors)
+	if err != nil {
+		return err
+	}
+
+	content, err := run.Config.ConfigSvc.GenerateProfile(sourceConfigFile, getProfileConfigFiles(profileDirs), params.NewProfile, backendModuleNames, frontendModuleNames)
+	if err != nil {
+		return err
+	}
+
+	// deploySystem derives the required system containers from the backend modules of the profile with --onlyRequired
+	var settings map[string]any
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return err
+	}
+	generatedConfig, err := config.Decode(settings)
+	if err != nil {
+		return err
+	}
+	containers := helpers.AppendRequiredContainers(run.Config.Action.Name, constant.GetInitialRequiredContainers(), generatedConfig.BackendModules)
+	header := fmt.Sprintf("# Generated by generateProfile for %s\n# Required system containers, deployed by deploySystem --onlyRequired: %s\n",
+		strings.Join(params.Modules, ", "), strings.Join(containers, ", "))
+	if err := os.WriteFile(configFile, append([]byte(header), content...), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Generated profile %s with %d backend and %d frontend modules in %s, use it with -p %s\n",
+		params.NewProfile, len(backendModuleNames), len(frontendModuleNames), configFile, params.NewProfile)
+	fmt.Printf("Required system containers: %s\n", strings.Join(containers, ", "))
+
+	return nil
+}
+
+// resolveProfileModules returns the backend and frontend modules of the new profile, the platform modules and the
+// requested modules are resolved with the modules providing the interfaces they require, preferring the modules of
+// the source profile, the frontend modules of the source profile are kept when the backend modules satisfy them
+func (run *Run) resolveProfileModules(moduleDescriptors *models.PlatformModuleDescriptors) ([]string, []string, error) {
+	descriptorNames := make(map[string]bool)
+	for _, value := range slices.Concat(moduleDescriptors.ModuleDescriptors, moduleDescriptors.UIModuleDescriptors) {
+		if moduleDescriptor, ok := value.(map[string]any); ok {
+			descriptorNames[helpers.GetModuleNameFromID(helpers.GetString(moduleDescriptor, "id"))] = true
+		}
+	}
+	var missing []string
+	for _, moduleName := range params.Modules {
+		if !descriptorNames[moduleName] {
+			missing = append(missing, moduleName)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, nil, errors.ModulesNotInRegistry(missing)
+	}
+
+	moduleNames := constant.GetPlatformModules()
+	for _, moduleName := range params.Modules {
+		if !slices.Contains(moduleNames, moduleName) {
+			moduleNames = append(moduleNames, moduleName)
+		}
+	}
+	preferredModuleNames := slices.Collect(maps.Keys(run.Config.Action.ConfigBackendModules))
+	resolved, unsatisfied := run.Config.ManagementSvc.ResolveModuleDependencies(
+		slices.Concat(moduleDescriptors.ModuleDescriptors, moduleDescriptors.UIModuleDescriptors), moduleNames, preferredModuleNames)
+	for _, u := range unsatisfied {
+		slog.Warn(run.Config.Action.Name, "text", "Interface required by a module is not provided by any module", "interface", u.String())
+	}
+
+	var backendModuleNames, frontendModuleNames []string
+	for _, moduleName := range resolved {
+		if strings.HasPrefix(moduleName, constant.FrontendModulePrefix) {
+			frontendModuleNames = append(frontendModuleNames, moduleName)
+		} else {
+			backendModuleNames = append(backendModuleNames, moduleName)
+		}
+	}
+
+	var backendModuleDescriptors []any
+	uiModuleDescriptors := make(map[string]any)
+	for _, value := range moduleDescriptors.ModuleDescriptors {
+		if moduleDescriptor, ok := value.(map[string]any); ok && slices.Contains(backendModuleNames, helpers.GetModuleNameFromID(helpers.GetString(moduleDescriptor, "id"))) {
+			backendModuleDescriptors = append(backendModuleDescriptors, moduleDescriptor)
+		}
+	}
+	for _, value := range moduleDescriptors.UIModuleDescriptors {
+		if moduleDescriptor, ok := value.(map[string]any); ok {
+			uiModuleDescriptors[helpers.GetModuleNameFromID(helpers.GetString(moduleDescriptor, "id"))] = moduleDescriptor
+		}
+	}
+	for _, moduleName := range slices.Sorted(maps.Keys(run.Config.Action.ConfigFrontendModules)) {
+		if slices.Contains(frontendModuleNames, moduleName) {
+			continue
+		}
+		// A frontend module without a descriptor, e.g. stripes, cannot be checked and is kept
+		uiModuleDescriptor, ok := uiModuleDescriptors[moduleName]
+		if ok && slices.ContainsFunc(run.Config.ManagementSvc.CheckModuleDependencies([]any{uiModuleDescriptor}, backendModuleDescriptors), func(u models.UnsatisfiedInterface) bool {
+			return !u.Optional
+		}) {
+			continue
+		}
+		frontendModuleNames = append(frontendModuleNames, moduleName)
+	}
+	slices.Sort(backendModuleNames)
+	slices.Sort(frontendModuleNames)
+
+	return backendModuleNames, frontendModuleNames, nil
+}
+
+func init() {
+	rootCmd.AddCommand(generateProfileCmd)
+	generateProfileCmd.PersistentFlags().StringVarP(&params.NewProfile, action.NewProfile.Long, action.NewProfile.Short, "", action.NewProfile.Description)
+	generateProfileCmd.PersistentFlags().StringSliceVarP(&params.Modules, action.Modules.Long, action.Modules.Short, nil, action.Modules.Description)
+
+	if err := generateProfileCmd.MarkPersistentFlagRequired(action.NewProfile.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.NewProfile, err).Error())
+		os.Exit(1)
+	}
+	if err := generateProfileCmd.MarkPersistentFlagRequired(action.Modules.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Modules, err).Error())
+		os.Exit(1)
+	}
+}
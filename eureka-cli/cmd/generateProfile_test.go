@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+// ==================== GenerateProfile Tests ====================
+
+// testSourceConfig is the config the profiles are generated from
+const testSourceConfig = "profile:\n  name: test\napplication:\n  name: app-test\n  port-start: 30000\n  port-end: 30999\n" +
+	"backend-modules:\n  mgr-tenants:\n  mod-users:\n    disable-system-user: true\n  mod-invoice:\n" +
+	"frontend-modules:\n  folio_stripes-core:\n  folio_users:\n  folio_invoice:\n"
+
+func arrangeSearchProfile(mockManagement *MockManagementSvc, mockRegistrySvc *MockRegistrySvc) {
+	params.NewProfile, params.Modules = "search", []string{"mod-search"}
+	moduleDescriptors := &models.PlatformModuleDescriptors{
+		ModuleDescriptors: []any{
+			map[string]any{"id": "mod-search-5.0.0"},
+			map[string]any{"id": "mod-users-19.5.0"},
+		},
+		UIModuleDescriptors: []any{
+			map[string]any{"id": "folio_users-12.0.0"},
+			map[string]any{"id": "folio_invoice-7.0.0"},
+		},
+	}
+	mockRegistrySvc.On("GetModuleDescriptors", false).Return(moduleDescriptors, nil)
+	mockManagement.On("ResolveModuleDependencies", mock.Anything, append(constant.GetPlatformModules(), "mod-search"), mock.Anything).
+		Return(append(constant.GetPlatformModules(), "mod-search", "mod-users"), nil)
+	mockManagement.On("CheckModuleDependencies", []any{map[string]any{"id": "folio_users-12.0.0"}}, mock.Anything).Return(nil)
+	mockManagement.On("CheckModuleDependencies", []any{map[string]any{"id": "folio_invoice-7.0.0"}}, mock.Anything).
+		Return([]models.UnsatisfiedInterface{{ModuleID: "folio_invoice-7.0.0", Interface: "invoice", Version: "7.0"}})
+}
+
+func TestGenerateProfile_Success(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, _, _, _, _ := newTestRun(action.GenerateProfile, withRegistrySvc(mockRegistrySvc), withConfigSvc(),
+		withBackendModules(map[string]config.BackendModule{"mgr-tenants": {}, "mod-users": {}}),
+		withFrontendModules(map[string]config.FrontendModule{"folio_stripes-core": {}, "folio_users": {}, "folio_invoice": {}}))
+	sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(sourceConfigFile, []byte(testSourceConfig), 0600))
+	homeDir := t.TempDir()
+	arrangeSearchProfile(mockManagement, mockRegistrySvc)
+
+	// Act
+	err := run.GenerateProfile(context.Background(), sourceConfigFile, homeDir, []string{homeDir})
+
+	// Assert
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(homeDir, "config.search.yaml"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "# Generated by generateProfile for mod-search\n# Required system containers, deployed by deploySystem --onlyRequired: "))
+	assert.Contains(t, string(content), "opensearch")
+	var generated map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &generated))
+	assert.Equal(t, map[string]any{"name": "app-search", "port-start": 31000, "port-end": 31999}, generated["application"])
+	assert.Equal(t, []string{"mgr-tenants", "mod-login-keycloak", "mod-roles-keycloak", "mod-scheduler", "mod-search", "mod-users", "mod-users-keycloak"},
+		slices.Sorted(maps.Keys(generated["backend-modules"].(map[string]any))))
+	assert.Equal(t, map[string]any{"disable-system-user": true}, generated["backend-modules"].(map[string]any)["mod-users"])
+	assert.Equal(t, map[string]any{"folio_stripes-core": nil, "folio_users": nil}, generated["frontend-modules"])
+	mockRegistrySvc.AssertExpectations(t)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestGenerateProfile_DeploySystemDerivesRequiredContainers(t *testing.T) {
+	// Arrange
+	testhelpers.SetTempHome(t)
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, mockManagement, _, _, _, _ := newTestRun(action.GenerateProfile, withRegistrySvc(mockRegistrySvc), withConfigSvc(),
+		withBackendModules(map[string]config.BackendModule{"mgr-tenants": {}, "mod-users": {}}),
+		withFrontendModules(map[string]config.FrontendModule{"folio_stripes-core": {}, "folio_users": {}, "folio_invoice": {}}))
+	sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(sourceConfigFile, []byte(testSourceConfig), 0600))
+	homeDir := t.TempDir()
+	arrangeSearchProfile(mockManagement, mockRegistrySvc)
+	require.NoError(t, run.GenerateProfile(context.Background(), sourceConfigFile, homeDir, []string{homeDir}))
+	content, err := os.ReadFile(filepath.Join(homeDir, "config.search.yaml"))
+	require.NoError(t, err)
+	var generated map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &generated))
+	generatedConfig, err := config.Decode(generated)
+	require.NoError(t, err)
+	headerContainers := strings.Split(strings.TrimPrefix(strings.SplitN(string(content), "\n", 3)[1], "# Required system containers, deployed by deploySystem --onlyRequired: "), ", ")
+
+	deployRun, _, _, _, _, _ := newTestRun(action.DeploySystem)
+	deployRun.Config.Action.ConfigBackendModules = generatedConfig.BackendModules
+	mockExecSvc := &MockExecSvc{}
+	deployRun.Config.ExecSvc = mockExecSvc
+	params.BuildImages, params.OnlyRequired = false, true
+	var composeArgs []string
+	mockExecSvc.On("ExecReturnOutput", mock.Anything).Run(func(args mock.Arguments) {
+		composeArgs = args.Get(0).(*exec.Cmd).Args
+	}).Return(bytes.Buffer{}, bytes.Buffer{}, nil)
+
+	// Act
+	err = deployRun.DeploySystem(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, headerContainers, constant.OpenSearchContainer)
+	assert.Equal(t, headerContainers, composeArgs[len(composeArgs)-len(headerContainers):])
+}
+
+func TestGenerateProfile_ModuleNotInRegistry(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, _, _, _, _, _ := newTestRun(action.GenerateProfile, withRegistrySvc(mockRegistrySvc), withConfigSvc(),
+		withBackendModules(map[string]config.BackendModule{"mgr-tenants": {}, "mod-users": {}}),
+		withFrontendModules(map[string]config.FrontendModule{"folio_stripes-core": {}, "folio_users": {}, "folio_invoice": {}}))
+	sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(sourceConfigFile, []byte(testSourceConfig), 0600))
+	homeDir := t.TempDir()
+	params.NewProfile, params.Modules = "orders", []string{"mod-orders"}
+	mockRegistrySvc.On("GetModuleDescriptors", false).Return(&models.PlatformModuleDescriptors{}, nil)
+
+	// Act
+	err := run.GenerateProfile(context.Background(), sourceConfigFile, homeDir, []string{homeDir})
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "mod-orders")
+	assert.NoFileExists(t, filepath.Join(homeDir, "config.orders.yaml"))
+}
+
+func TestGenerateProfile_AlreadyExists(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, _, _, _, _, _ := newTestRun(action.GenerateProfile, withRegistrySvc(mockRegistrySvc), withConfigSvc(),
+		withBackendModules(map[string]config.BackendModule{"mgr-tenants": {}, "mod-users": {}}),
+		withFrontendModules(map[string]config.FrontendModule{"folio_stripes-core": {}, "folio_users": {}, "folio_invoice": {}}))
+	sourceConfigFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(sourceConfigFile, []byte(testSourceConfig), 0600))
+	homeDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.orders.yaml"), []byte("profile:\n"), 0600))
+	params.NewProfile, params.Modules = "orders", []string{"mod-orders"}
+
+	// Act
+	err := run.GenerateProfile(context.Background(), sourceConfigFile, homeDir, []string{homeDir})
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	mockRegistrySvc.AssertNotCalled(t, "GetModuleDescriptors", mock.Anything)
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/cobra"
 )
 
@@ -36,11 +38,11 @@ var getKeycloakAccessTokenCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		if err := run.GetVaultRootToken(); err != nil {
+		if err := run.GetVaultRootToken(cmd.Context()); err != nil {
 			return err
 		}
 
-		accessToken, err := run.GetKeycloakAccessToken(params.TokenType, params.Tenant)
+		accessToken, err := run.GetKeycloakAccessToken(cmd.Context(), params.TokenType, params.Tenant)
 		if err != nil {
 			return err
 		}
@@ -50,50 +52,51 @@ var getKeycloakAccessTokenCmd = &cobra.Command{
 	},
 }
 
-func (run *Run) GetKeycloakAccessToken(tokenType, tenant string) (string, error) {
+func (run *Run) GetKeycloakAccessToken(ctx context.Context, tokenType, tenant string) (string, error) {
 	switch tokenType {
 	case constant.MasterCustomToken:
-		if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+		if err != nil {
 			return "", err
 		}
 
-		return run.Config.Action.KeycloakMasterAccessToken, nil
+		return helpers.GetMasterAccessToken(ctx), nil
 	case constant.MasterAdminCLIToken:
-		if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+		ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.Password)
+		if err != nil {
 			return "", err
 		}
 
-		return run.Config.Action.KeycloakMasterAccessToken, nil
+		return helpers.GetMasterAccessToken(ctx), nil
 	default:
 		if tenant == "" {
 			return "", errors.RequiredParameterMissing("tenant")
 		}
-		if err := run.setKeycloakAccessTokenIntoContext(tenant); err != nil {
+		ctx, err := run.setKeycloakAccessTokenIntoContext(ctx, tenant)
+		if err != nil {
 			return "", err
 		}
 
-		return run.Config.Action.KeycloakAccessToken, nil
+		return helpers.GetAccessToken(ctx), nil
 	}
 }
 
-func (run *Run) setKeycloakAccessTokenIntoContext(tenant string) error {
-	accessToken, err := run.Config.KeycloakSvc.GetAccessToken(tenant)
+func (run *Run) setKeycloakAccessTokenIntoContext(ctx context.Context, tenant string) (context.Context, error) {
+	accessToken, err := run.Config.KeycloakSvc.GetAccessToken(ctx, tenant)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	run.Config.Action.KeycloakAccessToken = accessToken
 
-	return nil
+	return helpers.WithAccessToken(ctx, accessToken), nil
 }
 
-func (run *Run) setKeycloakMasterAccessTokenIntoContext(grantType constant.KeycloakGrantType) error {
-	accessToken, err := run.Config.KeycloakSvc.GetMasterAccessToken(grantType)
+func (run *Run) setKeycloakMasterAccessTokenIntoContext(ctx context.Context, grantType constant.KeycloakGrantType) (context.Context, error) {
+	accessToken, err := run.Config.KeycloakSvc.GetMasterAccessToken(ctx, grantType)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	run.Config.Action.KeycloakMasterAccessToken = accessToken
 
-	return nil
+	return helpers.WithMasterAccessToken(ctx, accessToken), nil
 }
 
 func init() {
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -33,7 +34,7 @@ var getVaultRootTokenCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		if err := run.GetVaultRootToken(); err != nil {
+		if err := run.GetVaultRootToken(cmd.Context()); err != nil {
 			return err
 		}
 		fmt.Println(run.Config.Action.VaultRootToken)
@@ -42,18 +43,18 @@ var getVaultRootTokenCmd = &cobra.Command{
 	},
 }
 
-func (run *Run) GetVaultRootToken() error {
+func (run *Run) GetVaultRootToken(ctx context.Context) error {
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
 
-	return run.setVaultRootTokenIntoContext(client)
+	return run.setVaultRootTokenIntoContext(ctx, client)
 }
 
-func (run *Run) setVaultRootTokenIntoContext(client *client.Client) error {
-	rootToken, err := run.Config.ModuleSvc.GetVaultRootToken(client)
+func (run *Run) setVaultRootTokenIntoContext(ctx context.Context, client *client.Client) error {
+	rootToken, err := run.Config.ModuleSvc.GetVaultRootToken(ctx, client)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 	"os"
 
@@ -41,15 +42,16 @@ var interceptModuleCmd = &cobra.Command{
 			return err
 		}
 
-		return run.InterceptModule()
+		return run.InterceptModule(cmd.Context())
 	},
 }
 
-func (run *Run) InterceptModule() error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+func (run *Run) InterceptModule(ctx context.Context) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
-	if err := run.setModuleDiscoveryDataIntoContext(); err != nil {
+	if err := run.setModuleDiscoveryDataIntoContext(ctx); err != nil {
 		return err
 	}
 
@@ -59,7 +61,7 @@ func (run *Run) InterceptModule() error {
 		return err
 	}
 
-	modules, err := run.Config.RegistrySvc.GetModules(false, false)
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, false, false)
 	if err != nil {
 		return err
 	}
@@ -70,7 +72,7 @@ func (run *Run) InterceptModule() error {
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
-	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+	if err := run.setVaultRootTokenIntoContext(ctx, client); err != nil {
 		return err
 	}
 
@@ -85,9 +87,9 @@ func (run *Run) InterceptModule() error {
 		IsManagement:   false,
 	}
 	if params.Restore {
-		return run.Config.InterceptModuleSvc.DeployDefaultModuleAndSidecarPair(client, pair)
+		return run.Config.InterceptModuleSvc.DeployDefaultModuleAndSidecarPair(ctx, client, pair)
 	} else {
-		return run.Config.InterceptModuleSvc.DeployCustomSidecarForInterception(client, pair)
+		return run.Config.InterceptModuleSvc.DeployCustomSidecarForInterception(ctx, client, pair)
 	}
 }
 
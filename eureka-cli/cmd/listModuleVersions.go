@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -42,21 +43,21 @@ var listModuleVersionsCmd = &cobra.Command{
 			return err
 		}
 
-		return run.ListModuleVersions()
+		return run.ListModuleVersions(cmd.Context())
 	},
 }
 
-func (run *Run) ListModuleVersions() error {
+func (run *Run) ListModuleVersions(ctx context.Context) error {
 	if params.ID != "" {
-		return run.getModuleDescriptorByID()
+		return run.getModuleDescriptorByID(ctx)
 	}
 
-	return run.listModuleVersionsSortedDescendingOrder()
+	return run.listModuleVersionsSortedDescendingOrder(ctx)
 }
 
-func (run *Run) getModuleDescriptorByID() error {
+func (run *Run) getModuleDescriptorByID(ctx context.Context) error {
 	requestURL := fmt.Sprintf("%s/_/proxy/modules/%s", run.Config.Action.ConfigRegistryURL, params.ID)
-	respBytes, err := run.Config.HTTPClient.GetReturnRawBytes(requestURL, map[string]string{})
+	respBytes, err := run.Config.HTTPClient.GetReturnRawBytes(ctx, requestURL, map[string]string{})
 	if err != nil {
 		return err
 	}
@@ -68,11 +69,9 @@ func (run *Run) getModuleDescriptorByID() error {
 	return nil
 }
 
-func (run *Run) listModuleVersionsSortedDescendingOrder() error {
-	requestURL := fmt.Sprintf("%s/_/proxy/modules", run.Config.Action.ConfigRegistryURL)
-
-	var decodedResponse models.ProxyModulesResponse
-	if err := run.Config.HTTPClient.GetRetryReturnStruct(requestURL, map[string]string{}, &decodedResponse); err != nil {
+func (run *Run) listModuleVersionsSortedDescendingOrder(ctx context.Context) error {
+	decodedResponse, err := run.getRegistryModules(ctx)
+	if err != nil {
 		return err
 	}
 
@@ -98,6 +97,17 @@ func (run *Run) listModuleVersionsSortedDescendingOrder() error {
 	return nil
 }
 
+func (run *Run) getRegistryModules(ctx context.Context) (models.ProxyModulesResponse, error) {
+	requestURL := fmt.Sprintf("%s/_/proxy/modules", run.Config.Action.ConfigRegistryURL)
+
+	var decodedResponse models.ProxyModulesResponse
+	if err := run.Config.HTTPClient.GetRetryReturnStruct(ctx, requestURL, map[string]string{}, &decodedResponse); err != nil {
+		return nil, err
+	}
+
+	return decodedResponse, nil
+}
+
 func init() {
 	rootCmd.AddCommand(listModuleVersionsCmd)
 	listModuleVersionsCmd.PersistentFlags().StringVarP(&params.ModuleName, action.ModuleName.Long, action.ModuleName.Short, "", action.ModuleName.Description)
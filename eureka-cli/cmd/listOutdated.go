@@ -0,0 +1,234 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+)
+
+// listOutdatedCmd represents the listOutdated command
+var listOutdatedCmd = &cobra.Command{
+	Use:   "listOutdated",
+	Short: "List outdated modules",
+	Long: `Compare the versions of the deployed modules with their latest versions in the LSP platform descriptor and in
+the module registry, flagging the modules that are too many builds behind the registry. Use --failOnOutdated to fail
+when a module is flagged, e.g. in CI.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ListOutdated)
+		if err != nil {
+			return err
+		}
+
+		return run.ListOutdated(cmd.Context())
+	},
+}
+
+func (run *Run) ListOutdated(ctx context.Context) error {
+	modules, err := run.GetOutdatedModules(ctx)
+	if err != nil {
+		return err
+	}
+	if err := writeOutdatedModules(os.Stdout, modules, params.Output, params.MaxBuildsBehind); err != nil {
+		return err
+	}
+
+	outdated := 0
+	for _, module := range modules {
+		if module.Outdated {
+			outdated++
+		}
+	}
+	if outdated > 0 && params.FailOnOutdated {
+		return errors.ModulesOutdated(outdated, params.MaxBuildsBehind)
+	}
+
+	return nil
+}
+
+// GetOutdatedModules compares the deployed modules with the latest versions of a fresh LSP platform descriptor and of
+// the module registry, a module is behind the registry by the number of registry versions greater than its version,
+// the local file of module versions used by the deployment is left unchanged
+func (run *Run) GetOutdatedModules(ctx context.Context) ([]models.OutdatedModule, error) {
+	deployedVersions, err := run.getDeployedModuleVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lspModules, err := run.Config.RegistrySvc.FetchModules(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	run.Config.RegistrySvc.ResolveModuleMetadata(lspModules)
+	lspVersions := make(map[string]string)
+	for _, module := range slices.Concat(lspModules.FolioModules, lspModules.EurekaModules) {
+		if module.Metadata.Version != nil {
+			lspVersions[module.Metadata.Name] = *module.Metadata.Version
+		}
+	}
+
+	registryModules, err := run.getRegistryModules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registryVersions := make(map[string][]string)
+	for _, module := range registryModules {
+		moduleName := helpers.GetModuleNameFromID(module.ID)
+		if _, ok := deployedVersions[moduleName]; ok {
+			registryVersions[moduleName] = append(registryVersions[moduleName], helpers.GetModuleVersionFromID(module.ID))
+		}
+	}
+
+	modules := make([]models.OutdatedModule, 0, len(deployedVersions))
+	for _, moduleName := range helpers.SortedMapKeys(deployedVersions) {
+		module := models.OutdatedModule{Module: moduleName, Deployed: deployedVersions[moduleName], LSP: lspVersions[moduleName]}
+		for _, version := range registryVersions[moduleName] {
+			if module.Registry == "" || helpers.IsVersionGreater(version, module.Registry) {
+				module.Registry = version
+			}
+			if module.Deployed != "" && helpers.IsVersionGreater(version, module.Deployed) {
+				module.BuildsBehind++
+			}
+		}
+		module.Outdated = module.BuildsBehind > params.MaxBuildsBehind
+		modules = append(modules, module)
+	}
+
+	return modules, nil
+}
+
+// getDeployedModuleVersions reads the versions of the deployed modules from the tags of their container images, the
+// modules of the latest application fill in the modules whose image is not tagged with a version, e.g. an image
+// whose tag was moved to a newer image
+func (run *Run) getDeployedModuleVersions(ctx context.Context) (map[string]string, error) {
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	profileName := run.Config.Action.ConfigProfileName
+	filters := make(client.Filters).Add("name", fmt.Sprintf(constant.ProfileContainerPattern, profileName), constant.ManagementContainerPattern)
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string)
+	for _, c := range containers {
+		moduleName := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(c.Names[0], "/"), "eureka-"), profileName+"-")
+		if strings.HasSuffix(moduleName, "-sc") {
+			continue
+		}
+		version := ""
+		if !strings.HasPrefix(c.Image, "sha256:") {
+			_, version = helpers.SplitImageTag(c.Image)
+		}
+		versions[moduleName] = version
+	}
+
+	ctx, err = run.setMasterAccessTokens(ctx, dockerClient)
+	if err != nil {
+		slog.Warn(run.Config.Action.Name, "text", "Application modules cannot be read, using the container images only", "error", err)
+		return versions, nil
+	}
+	app, err := run.Config.ManagementSvc.GetLatestApplication(ctx)
+	if err != nil {
+		slog.Warn(run.Config.Action.Name, "text", "Application modules cannot be read, using the container images only", "error", err)
+		return versions, nil
+	}
+	for _, value := range helpers.GetAnySlice(app, "modules") {
+		module, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		moduleName := helpers.GetString(module, "name")
+		if version, ok := versions[moduleName]; ok && version == "" {
+			versions[moduleName] = helpers.GetString(module, "version")
+		}
+	}
+
+	return versions, nil
+}
+
+func writeOutdatedModules(w io.Writer, modules []models.OutdatedModule, output string, maxBuildsBehind int) error {
+	switch output {
+	case constant.JSONOutput:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(modules)
+	case constant.TableOutput, "":
+		return writeOutdatedModulesTable(w, modules, maxBuildsBehind)
+	default:
+		return errors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writeOutdatedModulesTable(w io.Writer, modules []models.OutdatedModule, maxBuildsBehind int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MODULE\tDEPLOYED\tLSP\tREGISTRY\tBEHIND\tOUTDATED")
+	outdated := 0
+	for _, m := range modules {
+		if m.Outdated {
+			outdated++
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join([]string{m.Module, formatStatusValue(m.Deployed), formatStatusValue(m.LSP), formatStatusValue(m.Registry),
+			strconv.Itoa(m.BuildsBehind), strconv.FormatBool(m.Outdated)}, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(w)
+	if outdated == 0 {
+		_, _ = fmt.Fprintf(w, "All modules are at most %d build(s) behind the registry\n", maxBuildsBehind)
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "%d module(s) are more than %d build(s) behind the registry\n", outdated, maxBuildsBehind)
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(listOutdatedCmd)
+	listOutdatedCmd.PersistentFlags().BoolVarP(&params.FailOnOutdated, action.FailOnOutdated.Long, action.FailOnOutdated.Short, false, action.FailOnOutdated.Description)
+	listOutdatedCmd.PersistentFlags().IntVarP(&params.MaxBuildsBehind, action.MaxBuildsBehind.Long, action.MaxBuildsBehind.Short, 10, action.MaxBuildsBehind.Description)
+	listOutdatedCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+
+	if err := listOutdatedCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
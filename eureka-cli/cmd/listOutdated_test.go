@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ==================== ListOutdated Tests ====================
+
+// expectOutdatedModules expects the deployed containers of the combined profile and the registry versions of their modules
+func expectOutdatedModules(mockModule *MockModuleSvc, mockRegistrySvc *MockRegistrySvc, mockHTTP *testhelpers.MockHTTPClient) {
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}, Image: "folioorg/mod-orders:13.1.0-SNAPSHOT.1090"},
+		{Names: []string{"/eureka-combined-mod-orders-sc"}, Image: "folioorg/folio-module-sidecar:3.0.0"},
+		{Names: []string{"/eureka-combined-mod-users"}, Image: "sha256:0123456789ab"},
+		{Names: []string{"/eureka-mgr-tenants"}, Image: "folioorg/mgr-tenants:3.0.0"},
+	}, nil)
+	mockRegistrySvc.On("FetchModules", false).Return(&models.ProxyModulesByRegistry{
+		FolioModules: []*models.ProxyModule{
+			{ID: "mod-orders-13.1.0-SNAPSHOT.1093", Metadata: models.ProxyModuleMetadata{Name: "mod-orders", Version: helpers.StringPtr("13.1.0-SNAPSHOT.1093")}},
+			{ID: "mod-users-19.5.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users", Version: helpers.StringPtr("19.5.0")}},
+		},
+	}, nil)
+	mockRegistrySvc.On("ResolveModuleMetadata", mock.Anything).Return()
+	mockHTTP.On("GetRetryReturnStruct", "https://registry.example.org/_/proxy/modules", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(2).(*models.ProxyModulesResponse)
+			*resp = models.ProxyModulesResponse{
+				{ID: "mod-orders-13.1.0-SNAPSHOT.1090"},
+				{ID: "mod-orders-13.1.0-SNAPSHOT.1091"},
+				{ID: "mod-orders-13.1.0-SNAPSHOT.1092"},
+				{ID: "mod-orders-13.1.0-SNAPSHOT.1093"},
+				{ID: "mod-orders-storage-14.0.0"},
+				{ID: "mod-users-19.5.0"},
+			}
+		}).Return(nil)
+}
+
+func TestGetOutdatedModules_Success(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	run, mockManagement, mockKeycloak, _, _, mockModule := newTestRun(action.ListOutdated, withProfileName("combined"), withRegistrySvc(mockRegistrySvc), withHTTPClient(mockHTTP), withDockerClient())
+	run.Config.Action.ConfigRegistryURL = "https://registry.example.org"
+	expectOutdatedModules(mockModule, mockRegistrySvc, mockHTTP)
+	params = action.Param{MaxBuildsBehind: 2}
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("vault-token", nil)
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("master-token", nil)
+	mockManagement.On("GetLatestApplication").Return(map[string]any{"modules": []any{
+		map[string]any{"name": "mod-orders", "version": "13.1.0-SNAPSHOT.1089"},
+		map[string]any{"name": "mod-users", "version": "19.4.0"},
+	}}, nil)
+
+	// Act
+	modules, err := run.GetOutdatedModules(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []models.OutdatedModule{
+		{Module: "mgr-tenants", Deployed: "3.0.0"},
+		{Module: "mod-orders", Deployed: "13.1.0-SNAPSHOT.1090", LSP: "13.1.0-SNAPSHOT.1093", Registry: "13.1.0-SNAPSHOT.1093", BuildsBehind: 3, Outdated: true},
+		{Module: "mod-users", Deployed: "19.4.0", LSP: "19.5.0", Registry: "19.5.0", BuildsBehind: 1},
+	}, modules)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestGetOutdatedModules_ApplicationNotReadable(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	run, mockManagement, _, _, _, mockModule := newTestRun(action.ListOutdated, withProfileName("combined"), withRegistrySvc(mockRegistrySvc), withHTTPClient(mockHTTP), withDockerClient())
+	run.Config.Action.ConfigRegistryURL = "https://registry.example.org"
+	expectOutdatedModules(mockModule, mockRegistrySvc, mockHTTP)
+	params = action.Param{MaxBuildsBehind: 10}
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("", assert.AnError)
+
+	// Act
+	modules, err := run.GetOutdatedModules(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, modules, 3)
+	assert.Equal(t, models.OutdatedModule{Module: "mod-users", LSP: "19.5.0", Registry: "19.5.0"}, modules[2])
+	assert.False(t, modules[1].Outdated)
+	mockManagement.AssertNotCalled(t, "GetLatestApplication")
+}
+
+func TestListOutdated_ReturnsErrorWhenOutdated(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	run, _, _, _, _, mockModule := newTestRun(action.ListOutdated, withProfileName("combined"), withRegistrySvc(mockRegistrySvc), withHTTPClient(mockHTTP), withDockerClient())
+	run.Config.Action.ConfigRegistryURL = "https://registry.example.org"
+	expectOutdatedModules(mockModule, mockRegistrySvc, mockHTTP)
+	params = action.Param{Output: constant.JSONOutput, FailOnOutdated: true}
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("", assert.AnError)
+
+	// Act
+	err := run.ListOutdated(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrNotReady)
+	assert.Contains(t, err.Error(), "1 module(s) are more than 0 build(s) behind the registry")
+}
+
+func TestListOutdated_ReportsOutdatedWithoutFailing(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	run, _, _, _, _, mockModule := newTestRun(action.ListOutdated, withProfileName("combined"), withRegistrySvc(mockRegistrySvc), withHTTPClient(mockHTTP), withDockerClient())
+	run.Config.Action.ConfigRegistryURL = "https://registry.example.org"
+	expectOutdatedModules(mockModule, mockRegistrySvc, mockHTTP)
+	params = action.Param{Output: constant.JSONOutput}
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("", assert.AnError)
+
+	// Act
+	err := run.ListOutdated(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockRegistrySvc.AssertNotCalled(t, "GetModules", mock.Anything, mock.Anything)
+}
+
+func TestWriteOutdatedModules(t *testing.T) {
+	modules := []models.OutdatedModule{
+		{Module: "mgr-tenants", Deployed: "3.0.0"},
+		{Module: "mod-orders", Deployed: "13.1.0", LSP: "13.2.0", Registry: "13.3.0", BuildsBehind: 12, Outdated: true},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writeOutdatedModules(&buf, modules, constant.TableOutput, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "MODULE       DEPLOYED  LSP     REGISTRY  BEHIND  OUTDATED")
+		assert.Contains(t, buf.String(), "mgr-tenants  3.0.0     -       -         0       false")
+		assert.Contains(t, buf.String(), "1 module(s) are more than 10 build(s) behind the registry")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writeOutdatedModules(&buf, modules, constant.JSONOutput, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		var decoded []models.OutdatedModule
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, modules, decoded)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		// Act
+		err := writeOutdatedModules(&bytes.Buffer{}, modules, "yaml", 10)
+
+		// Assert
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,126 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Show module logs",
+	Long: fmt.Sprintf(`Show the logs of a module and its sidecar, or of every container of the profile, interleaved by timestamp.
+Without --since or --tail only the last %d lines of every container are shown.`, constant.DefaultLogsTail),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Logs)
+		if err != nil {
+			return err
+		}
+
+		return run.Logs(cmd.Context())
+	},
+}
+
+func (run *Run) Logs(ctx context.Context) error {
+	if params.ModuleName == "" && !params.All {
+		return errors.RequiredParameterMissing(fmt.Sprintf("%s or %s", action.ModuleName.Long, action.All.Long))
+	}
+
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	containerNames, err := run.getLogContainerNames(ctx, dockerClient, params.ModuleName, params.All)
+	if err != nil {
+		return err
+	}
+
+	return run.Config.LogSvc.StreamLogs(ctx, dockerClient, containerNames, os.Stdout)
+}
+
+func (run *Run) getLogContainerNames(ctx context.Context, dockerClient *client.Client, moduleName string, all bool) ([]string, error) {
+	pattern := run.getLogContainerPattern(moduleName, all)
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, make(client.Filters).Add("name", pattern))
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, errors.LogContainersNotFound(pattern)
+	}
+
+	containerNames := make([]string, 0, len(containers))
+	for _, c := range containers {
+		containerNames = append(containerNames, strings.TrimPrefix(c.Names[0], "/"))
+	}
+	slices.Sort(containerNames)
+
+	return containerNames, nil
+}
+
+func (run *Run) getLogContainerPattern(moduleName string, all bool) string {
+	currentProfile := run.Config.Action.ConfigProfileName
+	if all {
+		return fmt.Sprintf(constant.ProfileContainerPattern, currentProfile)
+	}
+	// Management modules are shared by the profiles and have no sidecar
+	if strings.HasPrefix(moduleName, constant.ManagementModulePattern) {
+		return fmt.Sprintf("^eureka-%s$", moduleName)
+	}
+
+	return fmt.Sprintf(constant.SingleModuleOrSidecarContainerPattern, currentProfile, moduleName)
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+	logsCmd.PersistentFlags().StringVarP(&params.ModuleName, action.ModuleName.Long, action.ModuleName.Short, "", action.ModuleName.Description)
+	logsCmd.PersistentFlags().BoolVarP(&params.All, action.All.Long, action.All.Short, false, action.All.Description)
+	logsCmd.PersistentFlags().BoolVarP(&params.Follow, action.Follow.Long, action.Follow.Short, false, action.Follow.Description)
+	logsCmd.PersistentFlags().StringVarP(&params.Since, action.Since.Long, action.Since.Short, "", action.Since.Description)
+	logsCmd.PersistentFlags().IntVarP(&params.Tail, action.Tail.Long, action.Tail.Short, 0, action.Tail.Description)
+	logsCmd.PersistentFlags().StringVarP(&params.Level, action.Level.Long, action.Level.Short, "", action.Level.Description)
+	logsCmd.PersistentFlags().StringVarP(&params.Regex, action.Regex.Long, action.Regex.Short, "", action.Regex.Description)
+
+	if err := logsCmd.RegisterFlagCompletionFunc(action.ModuleName.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return helpers.GetBackendModuleNames(viper.GetStringMap(field.BackendModules)), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+	if err := logsCmd.RegisterFlagCompletionFunc(action.Level.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetLogLevels(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Logs Tests ====================
+
+func TestLogs_ModuleAndSidecar(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{ModuleName: "mod-orders"}
+	mockLog := &MockLogSvc{}
+	run, _, _, _, _, mockModule := newTestRun(action.Logs, withProfileName("combined"), withLogSvc(mockLog), withDockerClient())
+	expectedFilters := make(client.Filters).Add("name", "^(eureka-combined-)(mod-orders|mod-orders-sc)$")
+	mockModule.On("GetDeployedModules", mock.Anything, expectedFilters).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders-sc"}},
+		{Names: []string{"/eureka-combined-mod-orders"}},
+	}, nil)
+	mockLog.On("StreamLogs", mock.Anything, []string{"eureka-combined-mod-orders", "eureka-combined-mod-orders-sc"}, os.Stdout).Return(nil)
+
+	// Act
+	err := run.Logs(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockModule.AssertExpectations(t)
+	mockLog.AssertExpectations(t)
+}
+
+func TestLogs_RequiresModuleNameOrAll(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{}
+	mockLog := &MockLogSvc{}
+	run, _, _, _, _, mockModule := newTestRun(action.Logs, withProfileName("combined"), withLogSvc(mockLog), withDockerClient())
+
+	// Act
+	err := run.Logs(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "moduleName or all parameter required")
+	mockModule.AssertNotCalled(t, "GetDeployedModules", mock.Anything, mock.Anything)
+	mockLog.AssertNotCalled(t, "StreamLogs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLogs_NoContainers(t *testing.T) {
+	// Arrange
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{All: true}
+	mockLog := &MockLogSvc{}
+	run, _, _, _, _, mockModule := newTestRun(action.Logs, withProfileName("combined"), withLogSvc(mockLog), withDockerClient())
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{}, nil)
+
+	// Act
+	err := run.Logs(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "no containers match ^eureka-combined")
+	mockLog.AssertNotCalled(t, "StreamLogs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetLogContainerPattern(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Logs)
+	run.Config.Action.ConfigProfileName = "combined"
+
+	// Act & Assert
+	assert.Equal(t, "^eureka-combined", run.getLogContainerPattern("", true))
+	assert.Equal(t, "^(eureka-combined-)(mod-orders|mod-orders-sc)$", run.getLogContainerPattern("mod-orders", false))
+	assert.Equal(t, "^eureka-mgr-tenants$", run.getLogContainerPattern("mgr-tenants", false))
+}
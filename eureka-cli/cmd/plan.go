@@ -0,0 +1,230 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+func (run *Run) PlanDeployment(ctx context.Context, includeManagement bool, includeTenants bool) (*models.DeploymentPlan, error) {
+	slog.Info(run.Config.Action.Name, "text", "PLANNING DEPLOYMENT")
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, false, true)
+	if err != nil {
+		return nil, err
+	}
+	run.Config.RegistrySvc.ResolveModuleMetadata(modules)
+
+	plan := &models.DeploymentPlan{
+		Profile:       run.Config.Action.ConfigProfileName,
+		ApplicationID: run.Config.Action.ConfigApplicationID,
+	}
+	if includeManagement {
+		managementModules, err := run.Config.ModuleProps.ReadBackendModules(true, false)
+		if err != nil {
+			return nil, err
+		}
+		plan.Modules = append(plan.Modules, run.planModules(modules, managementModules, true)...)
+	}
+
+	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
+	if err != nil {
+		return nil, err
+	}
+	frontendModules, err := run.Config.ModuleProps.ReadFrontendModules(false)
+	if err != nil {
+		return nil, err
+	}
+	plan.Modules = append(plan.Modules, run.planModules(modules, backendModules, false)...)
+
+	if slices.ContainsFunc(plan.Modules, func(m models.PlannedModule) bool { return m.Sidecar }) {
+		sidecarImage, _, err := run.Config.ModuleSvc.GetSidecarImage(modules.EurekaModules)
+		if err != nil {
+			return nil, err
+		}
+		plan.SidecarImage = sidecarImage
+	}
+
+	plan.Application, err = run.Config.ManagementSvc.BuildApplicationPayload(ctx, &models.RegistryExtract{
+		Modules:           modules,
+		BackendModules:    backendModules,
+		FrontendModules:   frontendModules,
+		ModuleDescriptors: make(map[string]any),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if includeTenants {
+		plan.Tenants, plan.Roles, plan.Users = run.planTenantsRolesAndUsers()
+	}
+
+	return plan, nil
+}
+
+func (run *Run) planModules(modules *models.ProxyModulesByRegistry, backendModules map[string]models.BackendModule, managementOnly bool) []models.PlannedModule {
+	var planned []models.PlannedModule
+	for _, registryModules := range [][]*models.ProxyModule{modules.FolioModules, modules.EurekaModules} {
+		for _, module := range registryModules {
+			isManagementModule := strings.Contains(module.Metadata.Name, constant.ManagementModulePattern)
+			if isManagementModule != managementOnly {
+				continue
+			}
+			backendModule, exists := backendModules[module.Metadata.Name]
+			if !exists || !backendModule.DeployModule {
+				continue
+			}
+
+			version := run.Config.ModuleSvc.GetModuleImageVersion(backendModule, module)
+			image := run.Config.ModuleSvc.GetModuleImage(&models.ProxyModule{
+				ID:       module.ID,
+				Metadata: models.ProxyModuleMetadata{Name: module.Metadata.Name, SidecarName: module.Metadata.SidecarName, Version: &version},
+			})
+			plannedModule := models.PlannedModule{
+				Name:        module.Metadata.Name,
+				Version:     version,
+				Image:       image,
+				Management:  isManagementModule,
+				ServerPort:  backendModule.ModuleExposedServerPort,
+				DebugPort:   backendModule.ModuleExposedDebugPort,
+				PrivatePort: backendModule.PrivatePort,
+				Sidecar:     backendModule.DeploySidecar,
+				MemoryMib:   helpers.ConvertMemory(helpers.BytesToMib, backendModule.ModuleResources.Memory),
+			}
+			if backendModule.DeploySidecar {
+				plannedModule.SidecarServerPort = backendModule.SidecarExposedServerPort
+				plannedModule.SidecarDebugPort = backendModule.SidecarExposedDebugPort
+			}
+			planned = append(planned, plannedModule)
+		}
+	}
+	slices.SortFunc(planned, func(a, b models.PlannedModule) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return planned
+}
+
+func (run *Run) planTenantsRolesAndUsers() ([]models.PlannedTenant, []models.PlannedRole, []models.PlannedUser) {
+	var (
+		tenants []models.PlannedTenant
+		roles   []models.PlannedRole
+		users   []models.PlannedUser
+	)
+	for _, tenantName := range helpers.SortedMapKeys(run.Config.Action.ConfigTenants) {
+		tenants = append(tenants, models.PlannedTenant{
+			Name:        tenantName,
+			Description: run.Config.ManagementSvc.GetTenantType(run.Config.Action.ConfigTenants[tenantName]),
+		})
+	}
+	for _, roleName := range helpers.SortedMapKeys(run.Config.Action.ConfigRoles) {
+		role := run.Config.Action.ConfigRoles[roleName]
+		roles = append(roles, models.PlannedRole{
+			Name:           roleName,
+			Tenant:         role.Tenant,
+			CapabilitySets: role.CapabilitySets,
+		})
+	}
+	for _, username := range helpers.SortedMapKeys(run.Config.Action.ConfigUsers) {
+		user := run.Config.Action.ConfigUsers[username]
+		users = append(users, models.PlannedUser{
+			Name:   username,
+			Tenant: user.Tenant,
+			Roles:  user.Roles,
+		})
+	}
+
+	return tenants, roles, users
+}
+
+func (run *Run) PrintPlan(plan *models.DeploymentPlan) error {
+	return writePlan(os.Stdout, plan, params.Output)
+}
+
+func writePlan(w io.Writer, plan *models.DeploymentPlan, output string) error {
+	switch output {
+	case constant.JSONOutput:
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(plan)
+	case constant.TableOutput, "":
+		return writePlanTable(w, plan)
+	default:
+		return errors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writePlanTable(w io.Writer, plan *models.DeploymentPlan) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "PROFILE\t%s\n", plan.Profile)
+	_, _ = fmt.Fprintf(tw, "APPLICATION\t%s\n", plan.ApplicationID)
+	if plan.SidecarImage != "" {
+		_, _ = fmt.Fprintf(tw, "SIDECAR IMAGE\t%s\n", plan.SidecarImage)
+	}
+	if plan.Application != nil {
+		_, _ = fmt.Fprintf(tw, "APPLICATION MODULES\t%d backend, %d ui, %d discovery\n",
+			len(plan.Application.BackendModules), len(plan.Application.FrontendModules), len(plan.Application.DiscoveryModules))
+	}
+
+	_, _ = fmt.Fprintln(tw, "\nMODULE\tVERSION\tIMAGE\tPORT\tDEBUG PORT\tPRIVATE PORT\tSIDECAR PORT\tMEMORY (MIB)")
+	for _, m := range plan.Modules {
+		sidecarPort := "-"
+		if m.Sidecar {
+			sidecarPort = fmt.Sprintf("%d", m.SidecarServerPort)
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\t%s\t%d\n", m.Name, m.Version, m.Image, m.ServerPort, m.DebugPort, m.PrivatePort, sidecarPort, m.MemoryMib)
+	}
+
+	if plan.Application != nil && len(plan.Application.FrontendModules) > 0 {
+		_, _ = fmt.Fprintln(tw, "\nUI MODULE\tVERSION")
+		for _, m := range plan.Application.FrontendModules {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\n", m["name"], m["version"])
+		}
+	}
+	if len(plan.Tenants) > 0 {
+		_, _ = fmt.Fprintln(tw, "\nTENANT\tDESCRIPTION")
+		for _, t := range plan.Tenants {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\n", t.Name, t.Description)
+		}
+	}
+	if len(plan.Roles) > 0 {
+		_, _ = fmt.Fprintln(tw, "\nROLE\tTENANT\tCAPABILITY SETS")
+		for _, r := range plan.Roles {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Name, r.Tenant, strings.Join(r.CapabilitySets, ", "))
+		}
+	}
+	if len(plan.Users) > 0 {
+		_, _ = fmt.Fprintln(tw, "\nUSER\tTENANT\tROLES")
+		for _, u := range plan.Users {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", u.Name, u.Tenant, strings.Join(u.Roles, ", "))
+		}
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Plan Tests ====================
+
+func TestPlanDeployment_ResolvesWithoutDeploying(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, mockDocker, mockModule := newTestRun(action.DeployApplication)
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run.Config.ModuleProps = mockModuleProps
+	run.Config.RegistrySvc = mockRegistrySvc
+	run.Config.Action.ConfigProfileName = "combined"
+	run.Config.Action.ConfigApplicationID = "app-combined-1.0.0"
+	run.Config.Action.ConfigRoles = map[string]config.Role{
+		"admin-role": {Tenant: "test-tenant", CapabilitySets: []string{"all"}},
+	}
+	run.Config.Action.ConfigUsers = map[string]config.User{
+		"admin": {Tenant: "test-tenant", Roles: []string{"admin-role"}},
+	}
+
+	version := "1.0.0"
+	modules := &models.ProxyModulesByRegistry{
+		FolioModules: []*models.ProxyModule{
+			{ID: "mod-users-1.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users", Version: &version}},
+			{ID: "mod-skipped-1.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-skipped", Version: &version}},
+		},
+		EurekaModules: []*models.ProxyModule{
+			{ID: "mgr-tenants-1.0.0", Metadata: models.ProxyModuleMetadata{Name: "mgr-tenants", Version: &version}},
+		},
+	}
+	mockRegistrySvc.On("GetModules", false, true).Return(modules, nil)
+	mockRegistrySvc.On("ResolveModuleMetadata", mock.Anything).Return()
+	mockModuleProps.On("ReadBackendModules", true, false).Return(map[string]models.BackendModule{
+		"mgr-tenants": {DeployModule: true, ModuleExposedServerPort: 9902},
+	}, nil)
+	mockModuleProps.On("ReadBackendModules", false, false).Return(map[string]models.BackendModule{
+		"mod-users":   {DeployModule: true, DeploySidecar: true, ModuleExposedServerPort: 9031, SidecarExposedServerPort: 19031, PrivatePort: 8081},
+		"mod-skipped": {DeployModule: false},
+	}, nil)
+	mockModuleProps.On("ReadFrontendModules", false).Return(map[string]models.FrontendModule{}, nil)
+	mockModule.On("GetModuleImageVersion", mock.Anything, mock.Anything).Return("1.0.0")
+	mockModule.On("GetModuleImage", mock.Anything).Return("folioorg/module:1.0.0")
+	mockModule.On("GetSidecarImage", mock.Anything).Return("folioorg/folio-module-sidecar:3.0.0", true, nil)
+	mockManagement.On("BuildApplicationPayload", mock.Anything).Return(&models.ApplicationPayload{
+		BackendModules: []map[string]string{{"name": "mod-users", "version": "1.0.0"}},
+	}, nil)
+	mockManagement.On("GetTenantType", mock.Anything).Return("no-consortium-default")
+
+	// Act
+	plan, err := run.PlanDeployment(context.Background(), true, true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "combined", plan.Profile)
+	assert.Equal(t, "app-combined-1.0.0", plan.ApplicationID)
+	assert.Equal(t, "folioorg/folio-module-sidecar:3.0.0", plan.SidecarImage)
+	assert.Len(t, plan.Modules, 2)
+	assert.Equal(t, "mgr-tenants", plan.Modules[0].Name)
+	assert.True(t, plan.Modules[0].Management)
+	assert.Equal(t, "mod-users", plan.Modules[1].Name)
+	assert.Equal(t, 19031, plan.Modules[1].SidecarServerPort)
+	assert.Equal(t, []models.PlannedTenant{{Name: "test-tenant", Description: "no-consortium-default"}}, plan.Tenants)
+	assert.Equal(t, []string{"all"}, plan.Roles[0].CapabilitySets)
+	assert.Equal(t, []string{"admin-role"}, plan.Users[0].Roles)
+	mockDocker.AssertNotCalled(t, "Create")
+	mockManagement.AssertNotCalled(t, "CreateApplication", mock.Anything)
+}
+
+func TestPlanDeployment_GetModulesError(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployModules)
+	mockRegistrySvc := &MockRegistrySvc{}
+	run.Config.RegistrySvc = mockRegistrySvc
+	mockRegistrySvc.On("GetModules", false, true).Return(nil, assert.AnError)
+
+	// Act
+	plan, err := run.PlanDeployment(context.Background(), false, false)
+
+	// Assert
+	assert.Nil(t, plan)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestWritePlan_Formats(t *testing.T) {
+	plan := &models.DeploymentPlan{
+		Profile:       "combined",
+		ApplicationID: "app-combined-1.0.0",
+		Modules: []models.PlannedModule{
+			{Name: "mod-users", Version: "1.0.0", Image: "folioorg/mod-users:1.0.0", ServerPort: 9031},
+		},
+		Application: &models.ApplicationPayload{},
+		Tenants:     []models.PlannedTenant{{Name: "diku", Description: "no-consortium-default"}},
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writePlan(&buf, plan, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		var decoded models.DeploymentPlan
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, "mod-users", decoded.Modules[0].Name)
+		assert.Equal(t, "diku", decoded.Tenants[0].Name)
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writePlan(&buf, plan, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "app-combined-1.0.0")
+		assert.Contains(t, buf.String(), "folioorg/mod-users:1.0.0")
+		assert.Contains(t, buf.String(), "diku")
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writePlan(&buf, plan, "yaml")
+
+		// Assert
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+		assert.Empty(t, buf.String())
+	})
+}
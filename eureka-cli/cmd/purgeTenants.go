@@ -55,7 +55,7 @@ var purgeTenantsCmd = &cobra.Command{
 				}
 
 				var decodedResponse models.TenantEntitlementResponse
-				err = run.Config.HTTPClient.DeleteWithPayloadReturnStruct(fmt.Sprintf("%s%s", requestURL, "?purge=true"), payload, map[string]string{}, &decodedResponse)
+				err = run.Config.HTTPClient.DeleteWithPayloadReturnStruct(cmd.Context(), fmt.Sprintf("%s%s", requestURL, "?purge=true"), payload, map[string]string{}, &decodedResponse)
 				if err != nil {
 					slog.Warn(run.Config.Action.Name, "text", "Purge of tenant entitlements was unsuccessful", "tenant", key, "error", err)
 				} else {
@@ -71,7 +71,7 @@ var purgeTenantsCmd = &cobra.Command{
 				return err
 			}
 
-			err = run.Config.HTTPClient.Delete(fmt.Sprintf("%s%s", requestURL, "?purgeKafkaTopics=true"), map[string]string{})
+			err = run.Config.HTTPClient.Delete(cmd.Context(), fmt.Sprintf("%s%s", requestURL, "?purgeKafkaTopics=true"), map[string]string{})
 			if err != nil {
 				slog.Warn(run.Config.Action.Name, "text", "Purge of tenants was unsuccessful", "tenant", tenantID, "error", err)
 			}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -37,19 +38,19 @@ var reindexIndicesCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.ReindexIndices(consortiumName, tenantType)
+			return run.ReindexIndices(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) ReindexIndices(consortiumName string, tenantType constant.TenantType) error {
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+func (run *Run) ReindexIndices(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		if action.IsSet(field.Consortiums) && tenantType == fmt.Sprintf("%s-%s", consortiumName, constant.Central) {
 			slog.Info(run.Config.Action.Name, "text", "REINDEXING INDICES", "tenant", configTenant)
-			if err := run.Config.SearchSvc.ReindexInventoryRecords(configTenant); err != nil {
+			if err := run.Config.SearchSvc.ReindexInventoryRecords(ctx, configTenant); err != nil {
 				return err
 			}
-			if err := run.Config.SearchSvc.ReindexInstanceRecords(configTenant); err != nil {
+			if err := run.Config.SearchSvc.ReindexInstanceRecords(ctx, configTenant); err != nil {
 				return err
 			}
 		}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,15 +36,15 @@ var removeRolesCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.RemoveRoles(consortiumName, tenantType)
+			return run.RemoveRoles(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) RemoveRoles(consortiumName string, tenantType constant.TenantType) error {
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+func (run *Run) RemoveRoles(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		slog.Info(run.Config.Action.Name, "text", "REMOVING ROLES", "tenant", configTenant)
-		return run.Config.KeycloakSvc.RemoveRoles(configTenant)
+		return run.Config.KeycloakSvc.RemoveRoles(ctx, configTenant)
 	})
 }
 
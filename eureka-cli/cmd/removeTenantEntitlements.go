@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,18 +36,19 @@ var removeTenantEntitlementsCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.RemoveTenantEntitlements(consortiumName, tenantType)
+			return run.RemoveTenantEntitlements(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) RemoveTenantEntitlements(consortiumName string, tenantType constant.TenantType) error {
+func (run *Run) RemoveTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	slog.Info(run.Config.Action.Name, "text", "REMOVING TENANT ENTITLEMENTS")
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	return run.Config.ManagementSvc.RemoveTenantEntitlements(consortiumName, tenantType, params.PurgeSchemas)
+	return run.Config.ManagementSvc.RemoveTenantEntitlements(ctx, consortiumName, tenantType, params.PurgeSchemas)
 }
 
 func init() {
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,18 +36,19 @@ var removeTenantsCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.RemoveTenants(consortiumName, tenantType)
+			return run.RemoveTenants(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) RemoveTenants(consortiumName string, tenantType constant.TenantType) error {
+func (run *Run) RemoveTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	slog.Info(run.Config.Action.Name, "text", "REMOVING TENANTS")
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	return run.Config.ManagementSvc.RemoveTenants(consortiumName, tenantType)
+	return run.Config.ManagementSvc.RemoveTenants(ctx, consortiumName, tenantType)
 }
 
 func init() {
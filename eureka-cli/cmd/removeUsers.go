@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -35,15 +36,15 @@ var removeUsersCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.RemoveUsers(consortiumName, tenantType)
+			return run.RemoveUsers(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) RemoveUsers(consortiumName string, tenantType constant.TenantType) error {
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+func (run *Run) RemoveUsers(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		slog.Info(run.Config.Action.Name, "text", "REMOVING USERS", "tenant", configTenant)
-		return run.Config.KeycloakSvc.RemoveUsers(configTenant)
+		return run.Config.KeycloakSvc.RemoveUsers(ctx, configTenant)
 	})
 }
 
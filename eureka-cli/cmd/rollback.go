@@ -0,0 +1,116 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/client"
+)
+
+// RollbackDeployment removes the resources journaled by the run in reverse creation order,
+// resources that existed before the run are never journaled and are left untouched
+func (run *Run) RollbackDeployment(ctx context.Context) error {
+	entries := run.Config.JournalSvc.GetEntries()
+	if len(entries) == 0 {
+		slog.Info(run.Config.Action.Name, "text", "No resources were created, nothing to roll back")
+		return nil
+	}
+
+	// An interrupted run is rolled back as well, so the rollback must outlive the cancelled root context
+	ctx = context.WithoutCancel(ctx)
+
+	slog.Info(run.Config.Action.Name, "text", "ROLLING BACK DEPLOYMENT", "resources", len(entries))
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	var (
+		errs                    []error
+		skipManagementResources bool
+	)
+	if slices.ContainsFunc(entries, func(entry models.JournalEntry) bool { return entry.Kind != journalsvc.ContainerKind }) {
+		tokenCtx, err := run.setMasterAccessTokens(ctx, client)
+		if err != nil {
+			slog.Warn(run.Config.Action.Name, "text", "Cannot obtain access tokens, only containers will be rolled back", "error", err)
+			errs = append(errs, err)
+			skipManagementResources = true
+		} else {
+			ctx = tokenCtx
+		}
+	}
+	for _, entry := range slices.Backward(entries) {
+		if skipManagementResources && entry.Kind != journalsvc.ContainerKind {
+			continue
+		}
+		if err := run.rollbackEntry(ctx, client, entry); err != nil {
+			err = apperrors.RollbackFailed(entry.Kind, entry.Name, err)
+			slog.Warn(run.Config.Action.Name, "text", "Rollback of resource was unsuccessful", "kind", entry.Kind, "name", entry.Name, "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		slog.Info(run.Config.Action.Name, "text", "Rolled back resource", "kind", entry.Kind, "name", entry.Name, "tenant", entry.Tenant)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (run *Run) setMasterAccessTokens(ctx context.Context, client *client.Client) (context.Context, error) {
+	if err := run.setVaultRootTokenIntoContext(ctx, client); err != nil {
+		return nil, err
+	}
+
+	return run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+}
+
+func (run *Run) rollbackEntry(ctx context.Context, client *client.Client, entry models.JournalEntry) error {
+	switch entry.Kind {
+	case journalsvc.ContainerKind:
+		return run.Config.ModuleSvc.UndeployModuleByNamePattern(ctx, client, fmt.Sprintf("^%s$", entry.Name))
+	case journalsvc.ApplicationKind:
+		return run.Config.ManagementSvc.RemoveApplication(ctx, entry.Name)
+	case journalsvc.ModuleDiscoveryKind:
+		return run.Config.ManagementSvc.RemoveModuleDiscovery(ctx, entry.Name)
+	case journalsvc.TenantKind:
+		return run.Config.ManagementSvc.RemoveTenant(ctx, entry.Name)
+	case journalsvc.TenantEntitledKind:
+		return run.Config.ManagementSvc.RemoveTenantEntitlement(ctx, entry.Tenant, entry.Name, true)
+	case journalsvc.RoleKind:
+		ctx, err := run.setKeycloakAccessTokenIntoContext(ctx, entry.Tenant)
+		if err != nil {
+			return err
+		}
+		return run.Config.KeycloakSvc.RemoveRole(ctx, entry.Tenant, entry.Name)
+	case journalsvc.UserKind:
+		ctx, err := run.setKeycloakAccessTokenIntoContext(ctx, entry.Tenant)
+		if err != nil {
+			return err
+		}
+		return run.Config.KeycloakSvc.RemoveUser(ctx, entry.Tenant, entry.Name)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Rollback Tests ====================
+
+func TestRollbackDeployment_RemovesJournaledResourcesInReverse(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.DeployApplication)
+	act := run.Config.Action
+	act.ConfigTenants = map[string]config.Tenant{"diku": {}, "existing": {}}
+	act.ConfigRoles = map[string]config.Role{"admin": {Tenant: "diku"}, "viewer": {Tenant: "diku"}}
+	act.ConfigUsers = map[string]config.User{"diku_admin": {Tenant: "diku"}}
+	journal := journalsvc.New(act)
+	run.Config.JournalSvc = journal
+	journal.Emit(events.Event{Type: events.ModuleDeployed, Module: "eureka-combined-mod-orders"})
+	journal.Emit(events.Event{Type: events.ApplicationCreated, Application: "app-combined-1.0.0"})
+	journal.Emit(events.Event{Type: events.ModuleDiscoveryCreated, Module: "mod-orders-13.0.0"})
+	journal.Emit(events.Event{Type: events.TenantCreated, Tenant: "diku"})
+	journal.Emit(events.Event{Type: events.TenantEntitled, Tenant: "diku", Application: "app-combined-1.0.0"})
+	journal.Emit(events.Event{Type: events.RoleCreated, Tenant: "diku", Role: "admin"})
+	journal.Emit(events.Event{Type: events.UserCreated, Tenant: "diku", User: "diku_admin"})
+
+	var order []string
+	record := func(name string) func(mock.Arguments) {
+		return func(mock.Arguments) { order = append(order, name) }
+	}
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return()
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("vault-token", nil)
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("master-token", nil)
+	mockKeycloak.On("GetAccessToken", "diku").Return("diku-token", nil)
+	mockKeycloak.On("RemoveUser", "diku", "diku_admin").Run(record("user")).Return(nil)
+	mockKeycloak.On("RemoveRole", "diku", "admin").Run(record("role")).Return(nil)
+	mockManagement.On("RemoveTenantEntitlement", "diku", "app-combined-1.0.0", true).Run(record("entitlement")).Return(nil)
+	mockManagement.On("RemoveTenant", "diku").Run(record("tenant")).Return(nil)
+	mockManagement.On("RemoveModuleDiscovery", "mod-orders-13.0.0").Run(record("discovery")).Return(nil)
+	mockManagement.On("RemoveApplication", "app-combined-1.0.0").Run(record("application")).Return(nil)
+	mockModule.On("UndeployModuleByNamePattern", mock.Anything, "^eureka-combined-mod-orders$").Run(record("container")).Return(nil)
+	originalTenants, originalRoles, originalUsers := act.ConfigTenants, act.ConfigRoles, act.ConfigUsers
+
+	// Act
+	err := run.RollbackDeployment(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user", "role", "entitlement", "tenant", "discovery", "application", "container"}, order)
+	assert.Equal(t, originalTenants, act.ConfigTenants)
+	assert.Equal(t, originalRoles, act.ConfigRoles)
+	assert.Equal(t, originalUsers, act.ConfigUsers)
+	mockManagement.AssertNotCalled(t, "RemoveTenants", mock.Anything, mock.Anything)
+	mockKeycloak.AssertNotCalled(t, "RemoveRoles", mock.Anything)
+	mockKeycloak.AssertNotCalled(t, "RemoveUsers", mock.Anything)
+	mockManagement.AssertExpectations(t)
+	mockKeycloak.AssertExpectations(t)
+	mockModule.AssertExpectations(t)
+}
+
+func TestRollbackDeployment_ContinuesAfterFailure(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.DeployApplication)
+	journal := journalsvc.New(run.Config.Action)
+	run.Config.JournalSvc = journal
+	journal.Emit(events.Event{Type: events.ModuleDeployed, Module: "eureka-combined-mod-orders"})
+	journal.Emit(events.Event{Type: events.ApplicationCreated, Application: "app-combined-1.0.0"})
+
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return()
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("vault-token", nil)
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("master-token", nil)
+	mockManagement.On("RemoveApplication", "app-combined-1.0.0").Return(assert.AnError)
+	mockModule.On("UndeployModuleByNamePattern", mock.Anything, "^eureka-combined-mod-orders$").Return(nil)
+
+	// Act
+	err := run.RollbackDeployment(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "failed to roll back application app-combined-1.0.0")
+	mockModule.AssertExpectations(t)
+}
+
+func TestRollbackDeployment_NothingCreated(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, _ := newTestRun(action.DeployApplication)
+	run.Config.JournalSvc = journalsvc.New(run.Config.Action)
+
+	// Act
+	err := run.RollbackDeployment(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockDocker.AssertNotCalled(t, "Create")
+}
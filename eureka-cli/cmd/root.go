@@ -16,27 +16,34 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"embed"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	runFs  *embed.FS
-	logger *slog.Logger
-	params action.Param
+	runFs       *embed.FS
+	logger      *slog.Logger
+	logFilePath string
+	eventSink   events.Sink
+	params      action.Param
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -49,7 +56,21 @@ var rootCmd = &cobra.Command{
 
 func Execute(fs *embed.FS) {
 	runFs = fs
-	err := rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		// Restore the default signal handling so that a second Ctrl-C terminates the process immediately
+		<-ctx.Done()
+		stop()
+	}()
+
+	err := rootCmd.ExecuteContext(ctx)
+	if err != nil && ctx.Err() != nil {
+		slog.Warn(rootCmd.Name(), "text", "Command interrupted")
+	}
+	if exitCode := errors.GetExitCode(err); exitCode > 1 {
+		_, _ = fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(exitCode)
+	}
 	cobra.CheckErr(err)
 }
 
@@ -69,13 +90,22 @@ func initConfig() {
 	err = viper.ReadInConfig()
 	cobra.CheckErr(err)
 
+	err = readMergedConfig()
+	cobra.CheckErr(err)
+
 	logger, err = setDefaultLogger(homeDir)
 	cobra.CheckErr(err)
+
+	eventSink, err = newEventSink()
+	cobra.CheckErr(err)
 }
 
 func setConfig(params *action.Param, homeDir string) {
 	if params.ConfigFile == "" {
 		viper.AddConfigPath(homeDir)
+		if profilesDir := os.Getenv(constant.ProfilesDirEnv); profilesDir != "" {
+			viper.AddConfigPath(profilesDir)
+		}
 		viper.SetConfigType(constant.ConfigType)
 		if params.Profile == "" {
 			params.Profile = constant.GetDefaultProfile()
@@ -89,6 +119,29 @@ func setConfig(params *action.Param, homeDir string) {
 	}
 }
 
+// readMergedConfig replaces the config read by viper with the config merged on top of the profiles it extends
+// and with its local override file merged on top of it, the references to environment variables and files are resolved
+func readMergedConfig() error {
+	mc, err := configsvc.LoadConfig(viper.ConfigFileUsed())
+	if err != nil {
+		return err
+	}
+	interpolated, err := mc.Interpolate(os.LookupEnv, os.ReadFile)
+	if err != nil {
+		return err
+	}
+	if len(mc.Layers) == 1 && !interpolated {
+		return nil
+	}
+
+	content, err := mc.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return viper.ReadConfig(bytes.NewReader(content))
+}
+
 func setDefaultLogger(homeDir string) (*slog.Logger, error) {
 	logLevel := slog.LevelInfo
 	if params.EnableDebug {
@@ -100,15 +153,19 @@ func setDefaultLogger(homeDir string) (*slog.Logger, error) {
 		return nil, err
 	}
 	timestamp := time.Now().Format(constant.LogTimestampFormat)
-	logFilePath := filepath.Join(logDir, fmt.Sprintf("%s-%s.log", params.Profile, timestamp))
+	logFilePath = filepath.Join(logDir, fmt.Sprintf("%s-%s%s", params.Profile, timestamp, constant.LogFileSuffix))
 
 	logFile, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
 		return nil, err
 	}
 
-	multiWriter := io.MultiWriter(os.Stdout, logFile)
-	logger := slog.New(slog.NewTextHandler(multiWriter, &slog.HandlerOptions{
+	var writer io.Writer = io.MultiWriter(os.Stdout, logFile)
+	if params.Output == constant.JSONOutput || isEventsOnStdout() {
+		// Keep stdout machine-readable, the log file still receives every record
+		writer = logFile
+	}
+	logger := slog.New(slog.NewTextHandler(writer, &slog.HandlerOptions{
 		Level:     logLevel,
 		AddSource: true,
 	}))
@@ -121,32 +178,95 @@ func setDefaultLogger(homeDir string) (*slog.Logger, error) {
 	return logger, nil
 }
 
+func newEventSink() (events.Sink, error) {
+	switch params.OutputEvents {
+	case "":
+		return nil, nil
+	case constant.JSONOutput:
+		if params.EventsFd < 1 {
+			return nil, errors.EventsFdUnsupported(params.EventsFd)
+		}
+		if isEventsOnStdout() {
+			return events.NewJSONSink(os.Stdout), nil
+		}
+		eventsFile := os.NewFile(uintptr(params.EventsFd), "events")
+		if _, err := eventsFile.Stat(); err != nil {
+			return nil, errors.EventsFdInvalid(params.EventsFd, err)
+		}
+
+		return events.NewJSONSink(eventsFile), nil
+	default:
+		return nil, errors.UnsupportedOutputFormat(params.OutputEvents)
+	}
+}
+
+func isEventsOnStdout() bool {
+	return params.OutputEvents != "" && params.EventsFd == 1
+}
+
 func copyHomeDirFiles(homeDir string, overwriteFiles bool) {
 	if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
 		if overwriteFiles {
-			fmt.Printf("Overwriting files in %s home directory\n\n", homeDir)
+			fmt.Printf("Refreshing files in %s home directory\n\n", homeDir)
 		} else {
 			fmt.Printf("Creating missing files in %s home directory\n\n", homeDir)
 		}
 	}
-	err := helpers.CopyMultipleFiles(homeDir, runFs)
+	conflicts, unmerged, err := helpers.MergeMultipleFiles(homeDir, runFs)
 	cobra.CheckErr(err)
+	writeMergeConflicts(os.Stderr, conflicts, unmerged)
 
 	if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
 		fmt.Println()
 	}
 }
 
+func writeMergeConflicts(w io.Writer, conflicts []helpers.MergeConflict, unmerged []helpers.UnmergedFile) {
+	if len(conflicts) > 0 {
+		_, _ = fmt.Fprintf(w, "Kept %d local change(s) that conflict with upstream changes, the upstream lines (+) were not applied:\n", len(conflicts))
+		for _, conflict := range conflicts {
+			_, _ = fmt.Fprint(w, conflict.String())
+		}
+		_, _ = fmt.Fprintln(w, "Use configDiff to review all upstream changes")
+	}
+	if len(unmerged) > 0 {
+		_, _ = fmt.Fprintf(w, "Kept %d file(s) that had no upstream copy to merge with, the upstream changes (+) were not applied:\n", len(unmerged))
+		for _, file := range unmerged {
+			_, _ = fmt.Fprint(w, file.Diff)
+		}
+		_, _ = fmt.Fprintf(w, "The upstream versions were saved to the %s directory as the base of the next refresh\n", constant.UpstreamDir)
+	}
+}
+
+// getProfiles returns the embedded profiles and the profiles discovered in the profile directories
+func getProfiles() []string {
+	dirs, err := helpers.GetProfileDirs()
+	if err != nil {
+		return constant.GetProfiles()
+	}
+
+	return helpers.GetProfiles(dirs)
+}
+
 func init() {
-	profiles := constant.GetProfiles()
+	profiles := getProfiles()
 	cobra.OnInitialize(initConfig)
 	rootCmd.PersistentFlags().StringVarP(&params.Profile, action.Profile.Long, action.Profile.Short, "combined", fmt.Sprintf(action.Profile.Description, profiles))
 	rootCmd.PersistentFlags().StringVarP(&params.ConfigFile, action.ConfigFile.Long, action.ConfigFile.Short, "", action.ConfigFile.Description)
 	rootCmd.PersistentFlags().BoolVarP(&params.OverwriteFiles, action.OverwriteFiles.Long, action.OverwriteFiles.Short, false, fmt.Sprintf(action.OverwriteFiles.Description, constant.ConfigDir))
 	rootCmd.PersistentFlags().BoolVarP(&params.EnableDebug, action.EnableDebug.Long, action.EnableDebug.Short, false, action.EnableDebug.Description)
+	rootCmd.PersistentFlags().StringVarP(&params.OutputEvents, action.OutputEvents.Long, action.OutputEvents.Short, "", action.OutputEvents.Description)
+	rootCmd.PersistentFlags().IntVarP(&params.EventsFd, action.EventsFd.Long, action.EventsFd.Short, 1, action.EventsFd.Description)
+	rootCmd.PersistentFlags().BoolVarP(&params.SkipConfigValidation, action.SkipConfigValidation.Long, action.SkipConfigValidation.Short, false, action.SkipConfigValidation.Description)
 
 	if err := rootCmd.RegisterFlagCompletionFunc(action.Profile.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		return profiles, cobra.ShellCompDirectiveNoFileComp
+		return getProfiles(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+	if err := rootCmd.RegisterFlagCompletionFunc(action.OutputEvents.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{constant.JSONOutput}, cobra.ShellCompDirectiveNoFileComp
 	}); err != nil {
 		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
 		os.Exit(1)
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== NewEventSink Tests ====================
+
+// eventsFiles keeps the files handed to newEventSink by descriptor referenced, the sink owns the descriptor,
+// closing or collecting the test's file as well would close the descriptor a second time after it is reused
+var eventsFiles []*os.File
+
+func TestNewEventSink(t *testing.T) {
+	t.Cleanup(func() {
+		params.OutputEvents = ""
+		params.EventsFd = 1
+	})
+
+	t.Run("TestNewEventSink_Disabled", func(t *testing.T) {
+		params.OutputEvents, params.EventsFd = "", 1
+
+		sink, err := newEventSink()
+
+		assert.NoError(t, err)
+		assert.Nil(t, sink)
+		assert.False(t, isEventsOnStdout())
+	})
+
+	t.Run("TestNewEventSink_JSONOnStdout", func(t *testing.T) {
+		params.OutputEvents, params.EventsFd = constant.JSONOutput, 1
+
+		sink, err := newEventSink()
+
+		assert.NoError(t, err)
+		assert.IsType(t, &events.JSONSink{}, sink)
+		assert.True(t, isEventsOnStdout())
+	})
+
+	t.Run("TestNewEventSink_RejectsStdin", func(t *testing.T) {
+		params.OutputEvents, params.EventsFd = constant.JSONOutput, 0
+
+		sink, err := newEventSink()
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+		assert.Contains(t, err.Error(), "events file descriptor 0 is not supported")
+		assert.Nil(t, sink)
+	})
+
+	t.Run("TestNewEventSink_JSONOnFileDescriptor", func(t *testing.T) {
+		file, err := os.CreateTemp(t.TempDir(), "events")
+		assert.NoError(t, err)
+		eventsFiles = append(eventsFiles, file)
+		params.OutputEvents, params.EventsFd = constant.JSONOutput, int(file.Fd())
+
+		sink, err := newEventSink()
+
+		assert.NoError(t, err)
+		assert.IsType(t, &events.JSONSink{}, sink)
+		assert.False(t, isEventsOnStdout())
+		sink.Emit(events.Event{Type: events.StepStarted, Step: action.DeployModules})
+		content, err := os.ReadFile(file.Name())
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), action.DeployModules)
+	})
+
+	t.Run("TestNewEventSink_InvalidFileDescriptor", func(t *testing.T) {
+		params.OutputEvents, params.EventsFd = constant.JSONOutput, 987
+
+		sink, err := newEventSink()
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+		assert.Nil(t, sink)
+	})
+
+	t.Run("TestNewEventSink_UnsupportedFormat", func(t *testing.T) {
+		params.OutputEvents, params.EventsFd = "yaml", 1
+
+		sink, err := newEventSink()
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+		assert.Nil(t, sink)
+	})
+}
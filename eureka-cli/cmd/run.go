@@ -16,69 +16,206 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/checkpointsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/folio-org/eureka-setup/eureka-cli/timingsvc"
+	"github.com/spf13/viper"
 )
 
 // Run is a container that holds the RunConfig instance
 type Run struct {
 	Config *runconfig.RunConfig
+	Hooks  []runconfig.StepHook
 }
 
+// Partition is a consortium and tenant type pair that tenant scoped steps are executed for
+type Partition struct {
+	ConsortiumName string
+	TenantType     constant.TenantType
+}
+
+// configCommands are run against an invalid config to report or inspect it, so the config is not validated before them
+var configCommands = []string{action.ConfigDiff, action.ShowConfig, action.ValidateConfig}
+
 func New(name string) (*Run, error) {
 	gatewayURLTemplate, err := action.GetGatewayURLTemplate(name)
 	if err != nil {
 		return nil, err
 	}
-	action := action.New(name, gatewayURLTemplate, &params)
+
+	return newRun(name, gatewayURLTemplate)
+}
+
+func newRun(name string, gatewayURLTemplate string) (*Run, error) {
+	isConfigCommand := slices.Contains(configCommands, name)
+	validateConfig := !params.SkipConfigValidation && !isConfigCommand
+	// The config commands inspect a config that cannot be decoded, the other commands fail after the validation
+	// of the config file has reported the problems with their key paths
+	cfg, decodeErr := config.Load()
+	action := action.New(name, gatewayURLTemplate, &params, cfg)
 
 	runConfig, err := runconfig.New(action, logger)
 	if err != nil {
 		return nil, err
 	}
+	action.Events = events.NewMultiSink(eventSink, runConfig.TimingSvc, runConfig.JournalSvc)
+
+	run := &Run{Config: runConfig}
+	if validateConfig {
+		if err := run.checkConfig(viper.ConfigFileUsed()); err != nil {
+			return nil, err
+		}
+	}
+	if decodeErr != nil && !isConfigCommand {
+		return nil, decodeErr
+	}
+	if validateConfig {
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
-	return &Run{Config: runConfig}, nil
+	return run, nil
 }
 
-func (run *Run) PingKongStatus() error {
+// CompleteCommand logs the command duration and writes the timing report of the run next to its log
+func (run *Run) CompleteCommand(start time.Time) error {
+	duration := time.Since(start)
+	slog.Info(run.Config.Action.Name, "text", "Command completed", "duration", duration)
+	if logFilePath == "" {
+		return nil
+	}
+
+	reportPath := timingsvc.GetReportPath(logFilePath)
+	if err := run.Config.TimingSvc.Save(reportPath, duration); err != nil {
+		return err
+	}
+	slog.Info(run.Config.Action.Name, "text", "Saved timing report", "path", reportPath)
+
+	return nil
+}
+
+func (run *Run) PingKongStatus(ctx context.Context) error {
 	requestURL := run.Config.Action.GetRequestURL(constant.KongAdminPort, "/status")
-	return run.Config.HTTPClient.PingRetry(requestURL)
+	return run.Config.HTTPClient.PingRetry(ctx, requestURL)
 }
 
-func (run *Run) ConsortiumPartition(fn func(string, constant.TenantType) error) error {
+func (run *Run) GetPartitions() []Partition {
 	if !action.IsSet(field.Consortiums) {
-		return fn(constant.NoneConsortium, constant.Default)
+		return []Partition{{ConsortiumName: constant.NoneConsortium, TenantType: constant.Default}}
 	}
-	for consortiumName := range run.Config.Action.ConfigConsortiums {
+
+	var partitions []Partition
+	for _, consortiumName := range helpers.SortedMapKeys(run.Config.Action.ConfigConsortiums) {
 		for _, tenantType := range constant.GetTenantTypes() {
-			if err := fn(consortiumName, tenantType); err != nil {
+			partitions = append(partitions, Partition{ConsortiumName: consortiumName, TenantType: tenantType})
+		}
+	}
+
+	return partitions
+}
+
+func (run *Run) ConsortiumPartition(fn func(string, constant.TenantType) error) error {
+	for _, partition := range run.GetPartitions() {
+		if err := fn(partition.ConsortiumName, partition.TenantType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (run *Run) NewStepGraph() *runconfig.StepGraph {
+	// The events hook comes last so that steps skipped by an earlier hook are not reported as started
+	hooks := append(slices.Clone(run.Hooks), run.EventsHook())
+	return runconfig.NewStepGraph(run.Config.Action, constant.StepGraphWorkers, hooks...)
+}
+
+// EventsHook emits a started event before every step and a finished or failed event after it
+func (run *Run) EventsHook() runconfig.StepHook {
+	return runconfig.StepHook{
+		Before: func(step *runconfig.Step) bool {
+			run.Config.Action.EmitEvent(events.Event{Type: events.StepStarted, Step: step.Name})
+			return false
+		},
+		After: func(step *runconfig.Step, duration time.Duration, err error) error {
+			event := events.Event{Type: events.StepFinished, Step: step.Name, DurationMs: duration.Milliseconds()}
+			if err != nil {
+				event.Type = events.StepFailed
+				event.Error = err.Error()
+			}
+			run.Config.Action.EmitEvent(event)
+
+			return err
+		},
+	}
+}
+
+// CheckpointHook skips steps completed in a previous run and records every completed step
+func (run *Run) CheckpointHook() runconfig.StepHook {
+	return runconfig.StepHook{
+		Before: func(step *runconfig.Step) bool {
+			if step.Repeatable || !run.Config.CheckpointSvc.IsCompleted(step.Name) {
+				return false
+			}
+			run.Config.CheckpointSvc.MarkSkipped(step.Name)
+
+			return true
+		},
+		After: func(step *runconfig.Step, duration time.Duration, err error) error {
+			if err != nil || step.Repeatable {
 				return err
 			}
+
+			return run.Config.CheckpointSvc.MarkCompleted(step.Name)
+		},
+	}
+}
+
+// PartitionStep returns the step name of a step executed for a partition
+func (p Partition) PartitionStep(step string) string {
+	return checkpointsvc.PartitionStep(step, p.ConsortiumName, p.TenantType)
+}
+
+func (run *Run) CompleteCheckpoints() error {
+	skipped := run.Config.CheckpointSvc.GetSkipped()
+	if len(skipped) > 0 {
+		slog.Info(run.Config.Action.Name, "text", "RESUME SUMMARY", "skipped", len(skipped))
+		for _, step := range skipped {
+			slog.Info(run.Config.Action.Name, "text", "Skipped step", "step", step)
 		}
 	}
 
-	return nil
+	return run.Config.CheckpointSvc.Clear()
 }
 
-func (run *Run) TenantPartition(consortiumName string, tenantType constant.TenantType, fn func(string, string) error) error {
+// TenantPartition calls fn for every configured tenant of the partition with a context that carries the access tokens of the tenant
+func (run *Run) TenantPartition(ctx context.Context, consortiumName string, tenantType constant.TenantType, fn func(context.Context, string, string) error) error {
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
 		return err
 	}
-	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+	if err := run.setVaultRootTokenIntoContext(ctx, client); err != nil {
 		return err
 	}
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err = run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	tenants, err := run.Config.ManagementSvc.GetTenants(consortiumName, tenantType)
+	tenants, err := run.Config.ManagementSvc.GetTenants(ctx, consortiumName, tenantType)
 	if err != nil {
 		return err
 	}
@@ -89,11 +226,12 @@ func (run *Run) TenantPartition(consortiumName string, tenantType constant.Tenan
 		if !helpers.HasTenant(configTenant, run.Config.Action.ConfigTenants) {
 			continue
 		}
-		if err := run.setKeycloakAccessTokenIntoContext(configTenant); err != nil {
+		tenantCtx, err := run.setKeycloakAccessTokenIntoContext(ctx, configTenant)
+		if err != nil {
 			return err
 		}
 		configDescription := helpers.GetString(entry, "description")
-		if err = fn(configTenant, configDescription); err != nil {
+		if err = fn(tenantCtx, configTenant, configDescription); err != nil {
 			return err
 		}
 	}
@@ -101,7 +239,7 @@ func (run *Run) TenantPartition(consortiumName string, tenantType constant.Tenan
 	return nil
 }
 
-func (run *Run) CheckDeployedModuleReadiness(moduleType string, modules map[string]int) error {
+func (run *Run) CheckDeployedModuleReadiness(ctx context.Context, moduleType string, modules map[string]int) error {
 	var (
 		wg    sync.WaitGroup
 		errCh = make(chan error, len(modules))
@@ -109,7 +247,7 @@ func (run *Run) CheckDeployedModuleReadiness(moduleType string, modules map[stri
 
 	wg.Add(len(modules))
 	for deployedModule := range modules {
-		go run.Config.ModuleSvc.CheckModuleReadiness(&wg, errCh, deployedModule, modules[deployedModule])
+		go run.Config.ModuleSvc.CheckModuleReadiness(ctx, &wg, errCh, deployedModule, modules[deployedModule])
 	}
 	wg.Wait()
 	close(errCh)
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
@@ -50,12 +52,13 @@ FOLIO LSP/FAR can be developed, integration-tested and demoed without publishing
 			return err
 		}
 
-		return run.RunLocalModule()
+		return run.RunLocalModule(cmd.Context())
 	},
 }
 
-func (run *Run) RunLocalModule() error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+func (run *Run) RunLocalModule(ctx context.Context) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 	if err := run.validateModulePath(params.ModulePath); err != nil {
@@ -81,7 +84,7 @@ func (run *Run) RunLocalModule() error {
 		shouldBuild   = !helpers.IsFolioNamespace(params.Namespace)
 	)
 
-	baseApp, err := run.Config.ManagementSvc.GetLatestApplication()
+	baseApp, err := run.Config.ManagementSvc.GetLatestApplication(ctx)
 	if err != nil {
 		return err
 	}
@@ -126,20 +129,24 @@ func (run *Run) RunLocalModule() error {
 		}
 	}
 
+	if err := run.checkLocalModuleDependencies(ctx, appName, baseApp, newModuleDescriptor); err != nil {
+		return err
+	}
+
 	if !params.SkipModuleDeployment {
-		if err := run.deployLocalModuleAndSidecarPair(descriptorPath); err != nil {
+		if err := run.deployLocalModuleAndSidecarPair(ctx, descriptorPath); err != nil {
 			return err
 		}
 	}
 
-	newAppID, isNew, keepAppID, discovery, err := run.buildOrMergeLocalApp(appName, baseAppName, baseAppVersion, shouldBuild, newModuleDescriptor)
+	newAppID, isNew, keepAppID, discovery, err := run.buildOrMergeLocalApp(ctx, appName, baseAppName, baseAppVersion, shouldBuild, newModuleDescriptor)
 	if err != nil {
 		return err
 	}
 
 	if !params.SkipModuleDiscovery {
-		if err := run.Config.ManagementSvc.CreateNewModuleDiscovery(discovery); err != nil {
-			if cleanupErr := run.cleanupLocalAppOnFailure(appName, keepAppID); cleanupErr != nil {
+		if err := run.Config.ManagementSvc.CreateNewModuleDiscovery(ctx, discovery); err != nil {
+			if cleanupErr := run.cleanupLocalAppOnFailure(ctx, appName, keepAppID); cleanupErr != nil {
 				return cleanupErr
 			}
 
@@ -147,8 +154,8 @@ func (run *Run) RunLocalModule() error {
 		}
 	}
 	if !params.SkipTenantEntitlement {
-		if err := run.entitleTenantsToLocalApp(isNew, newAppID); err != nil {
-			if cleanupErr := run.cleanupLocalAppOnFailure(appName, keepAppID); cleanupErr != nil {
+		if err := run.entitleTenantsToLocalApp(ctx, isNew, newAppID); err != nil {
+			if cleanupErr := run.cleanupLocalAppOnFailure(ctx, appName, keepAppID); cleanupErr != nil {
 				return cleanupErr
 			}
 
@@ -157,7 +164,7 @@ func (run *Run) RunLocalModule() error {
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "REMOVING SUPERSEDED LOCAL APPLICATIONS", "name", appName)
-	if err := run.Config.ManagementSvc.RemoveApplications(appName, newAppID); err != nil {
+	if err := run.Config.ManagementSvc.RemoveApplications(ctx, appName, newAppID); err != nil {
 		return err
 	}
 	if params.Cleanup {
@@ -209,14 +216,50 @@ func nextLocalModuleVersion(currentVersion string) (string, error) {
 	return parsed.IncPatch().String(), nil
 }
 
-func (run *Run) reserveUsedHostPorts() error {
+// checkLocalModuleDependencies resolves the interfaces required by the built module against the modules of the base
+// application, of its parent applications and of the local application, a module that is not built is checked by
+// the management components since its descriptor is only referenced by the local application
+func (run *Run) checkLocalModuleDependencies(ctx context.Context, appName string, baseApp map[string]any, newModuleDescriptor map[string]any) error {
+	if params.SkipDependencyCheck || newModuleDescriptor == nil {
+		return nil
+	}
+	slog.Info(run.Config.Action.Name, "text", "CHECKING LOCAL MODULE DEPENDENCIES", "module", params.ModuleName)
+
+	providerModuleDescriptors := helpers.GetAnySlice(baseApp, "moduleDescriptors")
+	existing, err := run.Config.ManagementSvc.GetLatestApplicationByName(ctx, appName)
+	if err != nil {
+		return err
+	}
+	for _, value := range helpers.GetAnySlice(existing, "moduleDescriptors") {
+		if entry, ok := value.(map[string]any); ok && helpers.GetModuleNameFromID(helpers.GetString(entry, "id")) == params.ModuleName {
+			continue
+		}
+		providerModuleDescriptors = append(providerModuleDescriptors, value)
+	}
+	parentModuleDescriptors, err := run.getParentModuleDescriptors(ctx)
+	if err != nil {
+		return err
+	}
+	providerModuleDescriptors = append(providerModuleDescriptors, parentModuleDescriptors...)
+
+	managementModuleDescriptors, err := run.getManagementModuleDescriptors(ctx)
+	if err != nil {
+		return err
+	}
+	providerModuleDescriptors = append(providerModuleDescriptors, managementModuleDescriptors...)
+
+	unsatisfied := run.Config.ManagementSvc.CheckModuleDependencies([]any{newModuleDescriptor}, providerModuleDescriptors)
+	return run.reportUnsatisfiedInterfaces(unsatisfied)
+}
+
+func (run *Run) reserveUsedHostPorts(ctx context.Context) error {
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
 
-	deployed, err := run.Config.ModuleSvc.GetDeployedModules(client, nil)
+	deployed, err := run.Config.ModuleSvc.GetDeployedModules(ctx, client, nil)
 	if err != nil {
 		return err
 	}
@@ -235,12 +278,12 @@ func (run *Run) reserveUsedHostPorts() error {
 	return nil
 }
 
-func (run *Run) deployLocalModuleAndSidecarPair(descriptorPath string) error {
-	if err := run.reserveUsedHostPorts(); err != nil {
+func (run *Run) deployLocalModuleAndSidecarPair(ctx context.Context, descriptorPath string) error {
+	if err := run.reserveUsedHostPorts(ctx); err != nil {
 		return err
 	}
 
-	return run.deployModuleAndSidecarPair(func(modules *models.ProxyModulesByRegistry, backendModules map[string]models.BackendModule) error {
+	return run.deployModuleAndSidecarPair(ctx, func(modules *models.ProxyModulesByRegistry, backendModules map[string]models.BackendModule) error {
 		modules.FolioModules = append(modules.FolioModules, &models.ProxyModule{ID: params.ID, Action: "enable"})
 
 		localBackendModule, err := run.newLocalBackendModule(descriptorPath)
@@ -274,13 +317,13 @@ func (run *Run) newLocalBackendModule(descriptorPath string) (*models.BackendMod
 		PrivatePort:         helpers.IntPtr(privatePort),
 		Env:                 map[string]any{},
 		SidecarEnv:          map[string]any{},
-		Resources:           map[string]any{},
+		Resources:           config.Resources{},
 		Volumes:             []string{},
 	})
 }
 
-func (run *Run) buildOrMergeLocalApp(applicationName, baseAppName, baseAppVersion string, shouldBuild bool, newModuleDescriptor map[string]any) (newAppID string, isNew bool, keepApplicationID string, discovery []map[string]string, err error) {
-	existing, err := run.Config.ManagementSvc.GetLatestApplicationByName(applicationName)
+func (run *Run) buildOrMergeLocalApp(ctx context.Context, applicationName, baseAppName, baseAppVersion string, shouldBuild bool, newModuleDescriptor map[string]any) (newAppID string, isNew bool, keepApplicationID string, discovery []map[string]string, err error) {
+	existing, err := run.Config.ManagementSvc.GetLatestApplicationByName(ctx, applicationName)
 	if err != nil {
 		return "", false, "", nil, err
 	}
@@ -336,7 +379,7 @@ func (run *Run) buildOrMergeLocalApp(applicationName, baseAppName, baseAppVersio
 		return newAppID, isNew, keepApplicationID, discovery, nil
 	}
 
-	if err := run.Config.ManagementSvc.CreateNewApplication(&models.ApplicationUpgradeRequest{
+	if err := run.Config.ManagementSvc.CreateNewApplication(ctx, &models.ApplicationUpgradeRequest{
 		ApplicationName:              applicationName,
 		NewApplicationID:             newAppID,
 		NewApplicationVersion:        newVersion,
@@ -409,20 +452,20 @@ func convertAnySliceToMapSlice(values []any) []map[string]any {
 	return result
 }
 
-func (run *Run) entitleTenantsToLocalApp(isNew bool, newAppID string) error {
+func (run *Run) entitleTenantsToLocalApp(ctx context.Context, isNew bool, newAppID string) error {
 	if isNew {
 		slog.Info(run.Config.Action.Name, "text", "ENTITLING TENANTS TO LOCAL APPLICATION", "application", newAppID)
-		return run.Config.ManagementSvc.CreateTenantEntitlementForApplication(constant.NoneConsortium, constant.All, newAppID)
+		return run.Config.ManagementSvc.CreateTenantEntitlementForApplication(ctx, constant.NoneConsortium, constant.All, newAppID)
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "UPGRADING TENANT ENTITLEMENT TO LOCAL APPLICATION", "application", newAppID)
-	return run.Config.ManagementSvc.UpgradeTenantEntitlement(constant.NoneConsortium, constant.All, newAppID)
+	return run.Config.ManagementSvc.UpgradeTenantEntitlement(ctx, constant.NoneConsortium, constant.All, newAppID)
 }
 
-func (run *Run) cleanupLocalAppOnFailure(applicationName, keepApplicationID string) error {
+func (run *Run) cleanupLocalAppOnFailure(ctx context.Context, applicationName, keepApplicationID string) error {
 	slog.Info(run.Config.Action.Name, "text", "REMOVING LOCAL APPLICATION ON FAILURE", "name", applicationName, "keep", keepApplicationID)
 
-	return run.Config.ManagementSvc.RemoveApplications(applicationName, keepApplicationID)
+	return run.Config.ManagementSvc.RemoveApplications(ctx, applicationName, keepApplicationID)
 }
 
 func init() {
@@ -439,6 +482,8 @@ func init() {
 	runLocalModuleCmd.PersistentFlags().BoolVarP(&params.SkipApplication, action.SkipApplication.Long, action.SkipApplication.Short, false, action.SkipApplication.Description)
 	runLocalModuleCmd.PersistentFlags().BoolVarP(&params.SkipModuleDiscovery, action.SkipModuleDiscovery.Long, action.SkipModuleDiscovery.Short, false, action.SkipModuleDiscovery.Description)
 	runLocalModuleCmd.PersistentFlags().BoolVarP(&params.SkipTenantEntitlement, action.SkipTenantEntitlement.Long, action.SkipTenantEntitlement.Short, false, action.SkipTenantEntitlement.Description)
+	runLocalModuleCmd.PersistentFlags().BoolVarP(&params.SkipDependencyCheck, action.SkipDependencyCheck.Long, action.SkipDependencyCheck.Short, false, action.SkipDependencyCheck.Description)
+	runLocalModuleCmd.PersistentFlags().BoolVarP(&params.StrictDependencyCheck, action.StrictDependencyCheck.Long, action.StrictDependencyCheck.Short, false, action.StrictDependencyCheck.Description)
 
 	if err := runLocalModuleCmd.MarkPersistentFlagRequired(action.ModulePath.Long); err != nil {
 		slog.Error(errors.MarkFlagRequiredFailed(action.ModulePath, err).Error())
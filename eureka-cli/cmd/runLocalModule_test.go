@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== RunLocalModule Rollback Tests ====================
+
+func setupRunLocalModuleRollbackTest(t *testing.T) (*Run, *MockManagementSvc, *MockUpgradeModuleSvc, func()) {
+	t.Helper()
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.RunLocalModule)
+	mockUpgrade := &MockUpgradeModuleSvc{}
+	run.Config.UpgradeModuleSvc = mockUpgrade
+
+	originalParams := params
+	params = action.Param{
+		ModuleName:           "mod-x",
+		ModuleVersion:        "1.0.0",
+		ModulePath:           "",
+		Namespace:            constant.SnapshotNamespace, // folioci -> shouldBuild=false
+		ApplicationName:      "app-local",
+		SkipModuleDeployment: true,
+	}
+
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+	mockUpgrade.On("SetDefaultNamespaceIntoContext").Return()
+	mockManagement.On("GetLatestApplication").Return(map[string]any{
+		"name":    "app-combined",
+		"version": "1.0.0",
+		"modules": []any{map[string]any{"name": "mod-users"}},
+	}, nil)
+
+	return run, mockManagement, mockUpgrade, func() { params = originalParams }
+}
+
+func existingLocalApp() map[string]any {
+	return map[string]any{
+		"id":                  "app-local-1.0.1",
+		"version":             "1.0.1",
+		"dependencies":        []any{map[string]any{"name": "app-combined", "version": "1.0.0"}},
+		"modules":             []any{map[string]any{"id": "mod-x-0.9.0", "name": "mod-x", "version": "0.9.0"}},
+		"moduleDescriptors":   []any{},
+		"uiModules":           []any{},
+		"uiModuleDescriptors": []any{},
+	}
+}
+
+func TestRunLocalModule_DiscoveryFailureKeepsPreviousVersion(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockUpgrade, cleanup := setupRunLocalModuleRollbackTest(t)
+	defer cleanup()
+
+	mockManagement.On("GetLatestApplicationByName", "app-local").Return(existingLocalApp(), nil)
+	mockManagement.On("CreateNewApplication", mock.Anything).Return(nil)
+	mockManagement.On("CreateNewModuleDiscovery", mock.Anything).Return(assert.AnError)
+	mockManagement.On("RemoveApplications", "app-local", "app-local-1.0.1").Return(nil)
+
+	// Act
+	err := run.RunLocalModule(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, assert.AnError, err)
+	mockManagement.AssertCalled(t, "RemoveApplications", "app-local", "app-local-1.0.1")
+	mockManagement.AssertNotCalled(t, "UpgradeTenantEntitlement", mock.Anything, mock.Anything, mock.Anything)
+	mockManagement.AssertNotCalled(t, "CreateTenantEntitlementForApplication", mock.Anything, mock.Anything, mock.Anything)
+	mockManagement.AssertExpectations(t)
+	mockUpgrade.AssertExpectations(t)
+}
+
+func TestRunLocalModule_DiscoveryFailureRemovesNewVersionWhenNoPrevious(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockUpgrade, cleanup := setupRunLocalModuleRollbackTest(t)
+	defer cleanup()
+
+	mockManagement.On("GetLatestApplicationByName", "app-local").Return(nil, nil)
+	mockManagement.On("CreateNewApplication", mock.Anything).Return(nil)
+	mockManagement.On("CreateNewModuleDiscovery", mock.Anything).Return(assert.AnError)
+	mockManagement.On("RemoveApplications", "app-local", "").Return(nil)
+
+	// Act
+	err := run.RunLocalModule(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, assert.AnError, err)
+	mockManagement.AssertCalled(t, "RemoveApplications", "app-local", "")
+	mockManagement.AssertExpectations(t)
+	mockUpgrade.AssertExpectations(t)
+}
+
+func TestRunLocalModule_EntitlementFailureKeepsPreviousVersion(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockUpgrade, cleanup := setupRunLocalModuleRollbackTest(t)
+	defer cleanup()
+
+	mockManagement.On("GetLatestApplicationByName", "app-local").Return(existingLocalApp(), nil)
+	mockManagement.On("CreateNewApplication", mock.Anything).Return(nil)
+	mockManagement.On("CreateNewModuleDiscovery", mock.Anything).Return(nil)
+	mockManagement.On("UpgradeTenantEntitlement", mock.Anything, mock.Anything, "app-local-1.0.2").Return(assert.AnError)
+	mockManagement.On("RemoveApplications", "app-local", "app-local-1.0.1").Return(nil)
+
+	// Act
+	err := run.RunLocalModule(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, assert.AnError, err)
+	mockManagement.AssertCalled(t, "RemoveApplications", "app-local", "app-local-1.0.1")
+	mockManagement.AssertExpectations(t)
+	mockUpgrade.AssertExpectations(t)
+}
+
+func TestReserveUsedHostPorts_SeedsReservedPortsFromRunningContainers(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, mockModule := newTestRun(action.RunLocalModule)
+
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return()
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Ports: []container.PortSummary{{PublicPort: 30112}, {PublicPort: 30113}, {PublicPort: 0}}},
+		{Ports: []container.PortSummary{{PublicPort: 30114}}},
+	}, nil)
+
+	// Act
+	err := run.reserveUsedHostPorts(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, run.Config.Action.ReservedPorts, 30112)
+	assert.Contains(t, run.Config.Action.ReservedPorts, 30113)
+	assert.Contains(t, run.Config.Action.ReservedPorts, 30114)
+	assert.NotContains(t, run.Config.Action.ReservedPorts, 0) // unpublished ports (PublicPort 0) are skipped
+	mockModule.AssertExpectations(t)
+	mockDocker.AssertExpectations(t)
+}
+
+func TestRunLocalModule_FirstRunCreatesInitialVersion(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockUpgrade, cleanup := setupRunLocalModuleRollbackTest(t)
+	defer cleanup()
+
+	mockManagement.On("GetLatestApplicationByName", "app-local").Return(nil, nil)
+	mockManagement.On("CreateNewApplication", mock.MatchedBy(func(r *models.ApplicationUpgradeRequest) bool {
+		return r.NewApplicationID == "app-local-1.0.0" && r.NewApplicationVersion == "1.0.0"
+	})).Return(nil)
+	mockManagement.On("CreateNewModuleDiscovery", mock.Anything).Return(nil)
+	mockManagement.On("CreateTenantEntitlementForApplication", mock.Anything, mock.Anything, "app-local-1.0.0").Return(nil)
+	mockManagement.On("RemoveApplications", "app-local", "app-local-1.0.0").Return(nil)
+
+	// Act
+	err := run.RunLocalModule(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertCalled(t, "CreateTenantEntitlementForApplication", mock.Anything, mock.Anything, "app-local-1.0.0")
+	mockManagement.AssertExpectations(t)
+	mockUpgrade.AssertExpectations(t)
+}
+
+func TestCleanupLocalAppOnFailure_OnlyRemovesAppVersions(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, mockDocker, mockModule := newTestRun(action.RunLocalModule)
+
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{ModuleName: "mod-x", ID: "mod-x-1.0.0"}
+
+	mockManagement.On("RemoveApplications", "app-local", "app-local-1.0.1").Return(nil)
+
+	// Act
+	err := run.cleanupLocalAppOnFailure(context.Background(), "app-local", "app-local-1.0.1")
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertCalled(t, "RemoveApplications", "app-local", "app-local-1.0.1")
+	mockManagement.AssertNotCalled(t, "RemoveModuleDiscovery", mock.Anything)
+	mockDocker.AssertNotCalled(t, "Create")
+	mockModule.AssertNotCalled(t, "UndeployModuleByNamePattern", mock.Anything, mock.Anything)
+	mockManagement.AssertExpectations(t)
+}
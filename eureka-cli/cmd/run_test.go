@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/checkpointsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/folio-org/eureka-setup/eureka-cli/timingsvc"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Checkpoint Tests ====================
+
+func TestCompleteCheckpoints_ClearsState(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	assert.NoError(t, run.Config.CheckpointSvc.MarkCompleted(action.DeploySystem))
+
+	// Act
+	err := run.CompleteCheckpoints()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, run.Config.CheckpointSvc.IsCompleted(action.DeploySystem))
+}
+
+func TestCheckpointHook_RunsAndMarksStep(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	run.Hooks = append(run.Hooks, run.CheckpointHook())
+	called := false
+
+	// Act
+	err := run.NewStepGraph().Add(runconfig.Step{Name: action.DeploySystem, Run: func(context.Context) error {
+		called = true
+		return nil
+	}}).Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, called)
+	assert.True(t, run.Config.CheckpointSvc.IsCompleted(action.DeploySystem))
+}
+
+func TestCheckpointHook_SkipsCompletedStepOnResume(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	assert.NoError(t, run.Config.CheckpointSvc.MarkCompleted(action.DeploySystem))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(true))
+	run.Hooks = append(run.Hooks, run.CheckpointHook())
+	var called []string
+
+	// Act
+	err := run.NewStepGraph().
+		Add(runconfig.Step{Name: action.DeploySystem, Run: func(context.Context) error {
+			called = append(called, action.DeploySystem)
+			return nil
+		}}).
+		Add(runconfig.Step{Name: action.DeployManagement, Needs: []string{action.DeploySystem}, Run: func(context.Context) error {
+			called = append(called, action.DeployManagement)
+			return nil
+		}}).
+		Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{action.DeployManagement}, called)
+	assert.Equal(t, []string{action.DeploySystem}, run.Config.CheckpointSvc.GetSkipped())
+}
+
+func TestCheckpointHook_RepeatableStepAlwaysRuns(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	assert.NoError(t, run.Config.CheckpointSvc.MarkCompleted("Ping Kong Status"))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(true))
+	run.Hooks = append(run.Hooks, run.CheckpointHook())
+	called := false
+
+	// Act
+	err := run.NewStepGraph().Add(runconfig.Step{Name: "Ping Kong Status", Repeatable: true, Run: func(context.Context) error {
+		called = true
+		return nil
+	}}).Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, called)
+	assert.Empty(t, run.Config.CheckpointSvc.GetSkipped())
+}
+
+func TestCheckpointHook_FailedStepIsNotMarked(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	run.Hooks = append(run.Hooks, run.CheckpointHook())
+
+	// Act
+	err := run.NewStepGraph().Add(runconfig.Step{Name: action.DeployModules, Run: func(context.Context) error {
+		return assert.AnError
+	}}).Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.False(t, run.Config.CheckpointSvc.IsCompleted(action.DeployModules))
+}
+
+func TestNewPartitionStep_MarksPartitionStep(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	run.Hooks = append(run.Hooks, run.CheckpointHook())
+	partition := Partition{ConsortiumName: "eureka", TenantType: constant.Member}
+	var calledWith string
+
+	// Act
+	err := run.NewStepGraph().Add(run.newPartitionStep(partition, action.CreateRoles, nil, func(_ context.Context, consortiumName string, tenantType constant.TenantType) error {
+		calledWith = consortiumName + ":" + string(tenantType)
+		return nil
+	})).Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "eureka:member", calledWith)
+	assert.True(t, run.Config.CheckpointSvc.IsCompleted(checkpointsvc.PartitionStep(action.CreateRoles, "eureka", constant.Member)))
+	assert.False(t, run.Config.CheckpointSvc.IsCompleted(checkpointsvc.PartitionStep(action.CreateRoles, "eureka", constant.Central)))
+}
+
+// ==================== GetPartitions Tests ====================
+
+func TestGetPartitions_WithoutConsortiums(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+
+	// Act
+	partitions := run.GetPartitions()
+
+	// Assert
+	assert.Equal(t, []Partition{{ConsortiumName: constant.NoneConsortium, TenantType: constant.Default}}, partitions)
+}
+
+// ==================== EventsHook Tests ====================
+
+func TestEventsHook_EmitsStepEvents(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication, withCheckpointFile(filepath.Join(t.TempDir(), "checkpoint.json")))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(false))
+	assert.NoError(t, run.Config.CheckpointSvc.MarkCompleted(action.DeploySystem))
+	assert.NoError(t, run.Config.CheckpointSvc.Load(true))
+	run.Hooks = append(run.Hooks, run.CheckpointHook())
+	var buf bytes.Buffer
+	run.Config.Action.Events = events.NewJSONSink(&buf)
+
+	// Act
+	err := run.NewStepGraph().
+		Add(runconfig.Step{Name: action.DeploySystem, Run: func(context.Context) error { return nil }}).
+		Add(runconfig.Step{Name: action.DeployManagement, Needs: []string{action.DeploySystem}, Run: func(context.Context) error { return nil }}).
+		Add(runconfig.Step{Name: action.DeployModules, Needs: []string{action.DeployManagement}, Run: func(context.Context) error { return assert.AnError }}).
+		Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	var emitted []events.Event
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var event events.Event
+		assert.NoError(t, decoder.Decode(&event))
+		assert.Equal(t, action.DeployApplication, event.Action)
+		emitted = append(emitted, event)
+	}
+	// The step skipped by the checkpoint hook is not reported
+	assert.Len(t, emitted, 4)
+	for i, expected := range []struct {
+		eventType events.Type
+		step      string
+	}{
+		{events.StepStarted, action.DeployManagement},
+		{events.StepFinished, action.DeployManagement},
+		{events.StepStarted, action.DeployModules},
+		{events.StepFailed, action.DeployModules},
+	} {
+		assert.Equal(t, expected.eventType, emitted[i].Type)
+		assert.Equal(t, expected.step, emitted[i].Step)
+	}
+	assert.Contains(t, emitted[3].Error, assert.AnError.Error())
+}
+
+// ==================== CompleteCommand Tests ====================
+
+func TestCompleteCommand_SavesTimingReport(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigProfileName = "ecs"
+	timingSvc := timingsvc.New(run.Config.Action)
+	timingSvc.LogDir = t.TempDir()
+	run.Config.TimingSvc = timingSvc
+	logFilePath = filepath.Join(timingSvc.LogDir, "ecs-20260102-030405.log")
+	t.Cleanup(func() { logFilePath = "" })
+	timingSvc.Emit(events.Event{Type: events.StepFinished, Step: action.DeploySystem, DurationMs: 1200})
+
+	// Act
+	err := run.CompleteCommand(time.Now().Add(-2 * time.Second))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(timingSvc.LogDir, "ecs-20260102-030405.timings.json"))
+	history, err := run.GetTimingHistory("ecs", constant.DefaultTimingRuns)
+	assert.NoError(t, err)
+	assert.Len(t, history.Runs, 1)
+	assert.Equal(t, action.DeployApplication, history.Runs[0].Action)
+	assert.GreaterOrEqual(t, history.Runs[0].DurationMs, int64(2000))
+	assert.Len(t, history.Timings, 2)
+	assert.Equal(t, action.DeploySystem, history.Timings[1].Name)
+}
+
+// ==================== ConsortiumPartition Tests ====================
+
+func TestConsortiumPartition_NoConsortiums(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigConsortiums = nil
+	called := false
+	fn := func(consortiumName string, tenantType constant.TenantType) error {
+		called = true
+		return nil
+	}
+
+	// Act
+	err := run.ConsortiumPartition(fn)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, called) // Should be called once for NoneConsortium
+}
+
+func TestConsortiumPartition_WithConsortiums(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigConsortiums = map[string]config.Consortium{
+		"consortium1": {},
+	}
+	// Note: action.IsSet(field.Consortiums) will return false in tests since viper isn't set up,
+	// so ConsortiumPartition will call fn once with NoneConsortium and Default
+	callCount := 0
+	var calledWith []string
+	fn := func(consortiumName string, tenantType constant.TenantType) error {
+		callCount++
+		calledWith = append(calledWith, string(consortiumName)+":"+string(tenantType))
+		return nil
+	}
+
+	// Act
+	err := run.ConsortiumPartition(fn)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, callCount)
+	assert.Contains(t, calledWith, constant.NoneConsortium+":"+string(constant.Default))
+}
+
+func TestConsortiumPartition_FunctionError(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigConsortiums = nil
+	expectedError := assert.AnError
+	fn := func(consortiumName string, tenantType constant.TenantType) error {
+		return expectedError
+	}
+
+	// Act
+	err := run.ConsortiumPartition(fn)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, expectedError, err)
+}
+
+// ==================== CheckDeployedModuleReadiness Tests ====================
+
+func TestCheckDeployedModuleReadiness_NoModules(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployModules)
+	modules := map[string]int{}
+
+	// Act
+	err := run.CheckDeployedModuleReadiness(context.Background(), "backend", modules)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestCheckDeployedModuleReadiness_WithModules(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, mockModule := newTestRun(action.DeployModules)
+	modules := map[string]int{
+		"mod-test-1": 8081,
+		"mod-test-2": 8082,
+	}
+
+	// CheckModuleReadiness is called once per module in a goroutine
+	mockModule.On("CheckModuleReadiness", "mod-test-1", 8081).Return()
+	mockModule.On("CheckModuleReadiness", "mod-test-2", 8082).Return()
+
+	// Act
+	err := run.CheckDeployedModuleReadiness(context.Background(), "backend", modules)
+
+	// Assert
+	assert.NoError(t, err)
+	mockModule.AssertExpectations(t)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// showConfigCmd represents the showConfig command
+var showConfigCmd = &cobra.Command{
+	Use:          "showConfig",
+	Short:        "Show config",
+	Long:         `Show the config of the profile merged with the profiles it extends and its local override file.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ShowConfig)
+		if err != nil {
+			return err
+		}
+
+		return run.ShowConfig(viper.ConfigFileUsed())
+	},
+}
+
+func (run *Run) ShowConfig(configFile string) error {
+	return run.Config.ConfigSvc.WriteMergedConfig(configFile, os.Stdout)
+}
+
+func init() {
+	rootCmd.AddCommand(showConfigCmd)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+)
+
+// clearScreen moves the cursor to the top left corner and clears the terminal before every refresh of the watch mode
+const clearScreen = "\033[H\033[2J"
+
+// statsCmd represents the stats command
+var statsCmd = &cobra.Command{
+	Use:          "stats",
+	Short:        "Show container resource usage",
+	Long:         `Show the CPU and memory usage of the module and sidecar containers of the profile against their configured limits.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Stats)
+		if err != nil {
+			return err
+		}
+
+		return run.Stats(cmd.Context())
+	},
+}
+
+func (run *Run) Stats(ctx context.Context) error {
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	ticker := time.NewTicker(constant.StatsWatchInterval)
+	defer ticker.Stop()
+	for {
+		stats, err := run.GetProfileStats(ctx, dockerClient)
+		if err != nil {
+			return err
+		}
+		if params.Watch && params.Output != constant.JSONOutput {
+			_, _ = fmt.Fprint(os.Stdout, clearScreen)
+		}
+		if err := writeProfileStats(os.Stdout, stats, params.Output); err != nil {
+			return err
+		}
+		if !params.Watch {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetProfileStats samples the resource usage of the running module and sidecar containers of the profile
+func (run *Run) GetProfileStats(ctx context.Context, dockerClient *client.Client) (*models.ProfileStats, error) {
+	profileName := run.Config.Action.ConfigProfileName
+	filters := make(client.Filters).Add("name", fmt.Sprintf(constant.ProfileContainerPattern, profileName), constant.ManagementContainerPattern).
+		Add("status", string(container.StateRunning))
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var containerNames []string
+	for _, c := range containers {
+		containerNames = append(containerNames, strings.TrimPrefix(c.Names[0], "/"))
+	}
+	slices.Sort(containerNames)
+
+	samples, err := run.Config.ModuleSvc.GetContainerStats(ctx, dockerClient, containerNames)
+	if err != nil {
+		return nil, err
+	}
+	oomKills, err := run.Config.ModuleSvc.CountOOMKills(ctx, dockerClient, time.Now().Add(-constant.StatsOOMKillWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	profileStats := &models.ProfileStats{Profile: profileName, Containers: make([]models.ContainerStats, 0, len(containerNames))}
+	for _, containerName := range containerNames {
+		sample, ok := samples[containerName]
+		if !ok {
+			continue
+		}
+
+		moduleName := strings.TrimPrefix(strings.TrimPrefix(containerName, "eureka-"), profileName+"-")
+		stats := models.ContainerStats{
+			Name:        containerName,
+			Module:      strings.TrimSuffix(moduleName, "-sc"),
+			Sidecar:     strings.HasSuffix(moduleName, "-sc"),
+			CPUPercent:  helpers.GetContainerCPUPercent(sample),
+			MemoryUsage: helpers.GetContainerMemoryUsage(sample),
+			OOMKills:    oomKills[containerName],
+		}
+		stats.MemoryLimit = run.getConfiguredMemoryLimit(stats.Module, stats.Sidecar, int64(sample.MemoryStats.Limit))
+		if stats.MemoryLimit > 0 {
+			stats.MemoryPercent = float64(stats.MemoryUsage) / float64(stats.MemoryLimit) * 100
+		}
+		if stats.MemoryPercent >= constant.StatsMemoryWarningThreshold {
+			stats.Warnings = append(stats.Warnings, fmt.Sprintf("memory at %.0f%% of the limit", stats.MemoryPercent))
+		}
+		if stats.OOMKills > 0 {
+			stats.Warnings = append(stats.Warnings, fmt.Sprintf("OOM killed %d time(s) in the last %.0fh", stats.OOMKills, constant.StatsOOMKillWindow.Hours()))
+		}
+
+		profileStats.Containers = append(profileStats.Containers, stats)
+		profileStats.TotalCPUPercent += stats.CPUPercent
+		profileStats.TotalMemoryUsage += stats.MemoryUsage
+		profileStats.TotalMemoryLimit += stats.MemoryLimit
+	}
+
+	return profileStats, nil
+}
+
+// getConfiguredMemoryLimit returns the memory limit that the config assigns to a module or sidecar container,
+// the limit reported by Docker is used for the containers that are not part of the config
+func (run *Run) getConfiguredMemoryLimit(moduleName string, sidecar bool, dockerLimit int64) int64 {
+	entry, ok := run.Config.Action.ConfigBackendModules[moduleName]
+	if !ok {
+		return dockerLimit
+	}
+	if sidecar {
+		return helpers.CreateResources(false, run.Config.Action.ConfigSidecarModule.Resources).Memory
+	}
+
+	return helpers.CreateResources(true, entry.Resources).Memory
+}
+
+func writeProfileStats(w io.Writer, stats *models.ProfileStats, output string) error {
+	switch output {
+	case constant.JSONOutput:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	case constant.TableOutput, "":
+		return writeProfileStatsTable(w, stats)
+	default:
+		return errors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writeProfileStatsTable(w io.Writer, stats *models.ProfileStats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "CONTAINER\tCPU %\tMEMORY\tLIMIT\tMEM %\tWARNINGS")
+	attention := 0
+	for _, c := range stats.Containers {
+		warnings := "-"
+		if len(c.Warnings) > 0 {
+			attention++
+			warnings = strings.Join(c.Warnings, ", ")
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%.1f%%\t%s\t%s\t%.0f%%\t%s\n", c.Name, c.CPUPercent, formatMemory(c.MemoryUsage), formatMemory(c.MemoryLimit), c.MemoryPercent, warnings)
+	}
+	_, _ = fmt.Fprintf(tw, "TOTAL\t%.1f%%\t%s\t%s\t\t\n", stats.TotalCPUPercent, formatMemory(stats.TotalMemoryUsage), formatMemory(stats.TotalMemoryLimit))
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if attention > 0 {
+		_, _ = fmt.Fprintf(w, "\n%d container(s) of %s need attention\n", attention, stats.Profile)
+	}
+
+	return nil
+}
+
+func formatMemory(bytes int64) string {
+	return fmt.Sprintf("%dMiB", helpers.ConvertMemory(helpers.BytesToMib, bytes))
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+	statsCmd.PersistentFlags().BoolVarP(&params.Watch, action.Watch.Long, action.Watch.Short, false, action.Watch.Description)
+	statsCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+	if err := statsCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
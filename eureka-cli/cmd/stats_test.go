@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Stats Tests ====================
+
+func newTestStatsSample(usageMib uint64, totalUsage uint64) container.StatsResponse {
+	return container.StatsResponse{
+		CPUStats:    container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: totalUsage}, SystemUsage: 2000, OnlineCPUs: 2},
+		PreCPUStats: container.CPUStats{SystemUsage: 1000},
+		MemoryStats: container.MemoryStats{Usage: usageMib << 20, Limit: 16 << 30},
+	}
+}
+
+func TestGetProfileStats(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, mockModule := newTestRun(action.Stats)
+	run.Config.Action.ConfigProfileName = "ecs"
+	run.Config.Action.ConfigBackendModules = map[string]config.BackendModule{
+		"mod-orders":  {Resources: config.Resources{Memory: helpers.Int64Ptr(1000)}},
+		"mgr-tenants": {},
+	}
+	run.Config.Action.ConfigSidecarModule.Resources = config.Resources{}
+	containerNames := []string{"eureka-ecs-mod-orders", "eureka-ecs-mod-orders-sc", "eureka-ecs-mod-unknown", "eureka-mgr-tenants"}
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-mgr-tenants"}},
+		{Names: []string{"/eureka-ecs-mod-orders-sc"}},
+		{Names: []string{"/eureka-ecs-mod-orders"}},
+		{Names: []string{"/eureka-ecs-mod-unknown"}},
+	}, nil)
+	mockModule.On("GetContainerStats", mock.Anything, containerNames).Return(map[string]container.StatsResponse{
+		"eureka-ecs-mod-orders":    newTestStatsSample(950, 500),
+		"eureka-ecs-mod-orders-sc": newTestStatsSample(100, 0),
+		"eureka-ecs-mod-unknown":   newTestStatsSample(1024, 0),
+		"eureka-mgr-tenants":       newTestStatsSample(300, 100),
+	}, nil)
+	mockModule.On("CountOOMKills", mock.Anything, mock.Anything).Return(map[string]int{"eureka-ecs-mod-orders": 3}, nil)
+
+	// Act
+	stats, err := run.GetProfileStats(context.Background(), nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, stats.Containers, 4)
+	orders, sidecar, unknown, mgr := stats.Containers[0], stats.Containers[1], stats.Containers[2], stats.Containers[3]
+	assert.Equal(t, "mod-orders", orders.Module)
+	assert.InDelta(t, 100.0, orders.CPUPercent, 0.001)
+	assert.Equal(t, int64(1000<<20), orders.MemoryLimit)
+	assert.InDelta(t, 95.0, orders.MemoryPercent, 0.001)
+	assert.Equal(t, []string{"memory at 95% of the limit", "OOM killed 3 time(s) in the last 24h"}, orders.Warnings)
+	assert.True(t, sidecar.Sidecar)
+	assert.Equal(t, int64(constant.SidecarMemory<<20), sidecar.MemoryLimit)
+	assert.Empty(t, sidecar.Warnings)
+	assert.Equal(t, int64(16<<30), unknown.MemoryLimit)
+	assert.Equal(t, int64(constant.ModuleMemory<<20), mgr.MemoryLimit)
+	assert.Equal(t, int64((950+100+1024+300)<<20), stats.TotalMemoryUsage)
+	assert.Equal(t, int64((1000+constant.SidecarMemory+constant.ModuleMemory)<<20+16<<30), stats.TotalMemoryLimit)
+	assert.InDelta(t, 120.0, stats.TotalCPUPercent, 0.001)
+}
+
+func TestGetProfileStats_StatsError(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, mockModule := newTestRun(action.Stats)
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{{Names: []string{"/eureka-mgr-tenants"}}}, nil)
+	mockModule.On("GetContainerStats", mock.Anything, mock.Anything).Return(nil, errors.New("stats unavailable"))
+
+	// Act
+	stats, err := run.GetProfileStats(context.Background(), nil)
+
+	// Assert
+	assert.Nil(t, stats)
+	assert.EqualError(t, err, "stats unavailable")
+	mockModule.AssertNotCalled(t, "CountOOMKills", mock.Anything, mock.Anything)
+}
+
+func TestWriteProfileStats_Table(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	stats := &models.ProfileStats{
+		Profile: "ecs",
+		Containers: []models.ContainerStats{
+			{Name: "eureka-ecs-mod-orders", CPUPercent: 12.34, MemoryUsage: 950 << 20, MemoryLimit: 1000 << 20, MemoryPercent: 95, Warnings: []string{"memory at 95% of the limit"}},
+			{Name: "eureka-ecs-mod-orders-sc", CPUPercent: 0.5, MemoryUsage: 100 << 20, MemoryLimit: 450 << 20, MemoryPercent: 22.2},
+		},
+		TotalCPUPercent:  12.84,
+		TotalMemoryUsage: 1050 << 20,
+		TotalMemoryLimit: 1450 << 20,
+	}
+
+	// Act
+	err := writeProfileStats(&buf, stats, constant.TableOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "CONTAINER                 CPU %  MEMORY   LIMIT    MEM %  WARNINGS\n"+
+		"eureka-ecs-mod-orders     12.3%  950MiB   1000MiB  95%    memory at 95% of the limit\n"+
+		"eureka-ecs-mod-orders-sc  0.5%   100MiB   450MiB   22%    -\n"+
+		"TOTAL                     12.8%  1050MiB  1450MiB         \n"+
+		"\n1 container(s) of ecs need attention\n", buf.String())
+}
+
+func TestWriteProfileStats_UnsupportedOutput(t *testing.T) {
+	// Act
+	err := writeProfileStats(io.Discard, &models.ProfileStats{}, "yaml")
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+}
@@ -0,0 +1,417 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:          "status",
+	Short:        "Show environment status",
+	Long:         `Show the system containers, modules, applications, tenants and Kong routes of the environment.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Status)
+		if err != nil {
+			return err
+		}
+
+		return run.Status(cmd.Context())
+	},
+}
+
+func (run *Run) Status(ctx context.Context) error {
+	status := run.GetEnvironmentStatus(ctx)
+	if err := writeEnvironmentStatus(os.Stdout, status, params.Output); err != nil {
+		return err
+	}
+	if !status.Healthy {
+		return errors.EnvironmentUnhealthy(len(status.Problems))
+	}
+
+	return nil
+}
+
+// GetEnvironmentStatus collects the state of the environment, a component that cannot be queried
+// is recorded as a problem so that the rest of the status is still reported
+func (run *Run) GetEnvironmentStatus(ctx context.Context) *models.EnvironmentStatus {
+	status := &models.EnvironmentStatus{Profile: run.Config.Action.ConfigProfileName}
+	addProblem := func(problem string, args ...any) {
+		status.Problems = append(status.Problems, fmt.Sprintf(problem, args...))
+	}
+
+	system, err := run.getSystemStatus()
+	if err != nil {
+		addProblem("system services cannot be listed: %v", err)
+	}
+	status.System = system
+
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		addProblem("docker client cannot be created: %v", err)
+		return finalizeEnvironmentStatus(status)
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	modules, err := run.getModuleStatus(ctx, dockerClient)
+	if err != nil {
+		addProblem("module containers cannot be listed: %v", err)
+	}
+	status.Modules = modules
+	if err := run.setModuleRouteCounts(ctx, status.Modules); err != nil {
+		addProblem("kong routes cannot be listed: %v", err)
+	}
+
+	ctx, err = run.setMasterAccessTokens(ctx, dockerClient)
+	if err != nil {
+		addProblem("access token cannot be obtained: %v", err)
+		return finalizeEnvironmentStatus(status)
+	}
+	applications, err := run.getApplicationStatus(ctx)
+	if err != nil {
+		addProblem("applications cannot be listed: %v", err)
+	}
+	status.Applications = applications
+
+	tenants, err := run.getTenantStatus(ctx)
+	if err != nil {
+		addProblem("tenants cannot be listed: %v", err)
+	}
+	status.Tenants = tenants
+
+	return finalizeEnvironmentStatus(status)
+}
+
+func finalizeEnvironmentStatus(status *models.EnvironmentStatus) *models.EnvironmentStatus {
+	for _, c := range status.System {
+		if !c.Healthy {
+			status.Problems = append(status.Problems, fmt.Sprintf("system service %s is %s", c.Service, describeContainerState(c)))
+		}
+	}
+	for _, c := range status.Modules {
+		if !c.Healthy {
+			status.Problems = append(status.Problems, fmt.Sprintf("container %s is %s", c.Name, describeContainerState(c)))
+		}
+	}
+	status.Healthy = len(status.Problems) == 0
+
+	return status
+}
+
+func describeContainerState(c models.ContainerStatus) string {
+	if c.State == string(container.StateRunning) && c.Health != "" {
+		return c.Health
+	}
+
+	return c.State
+}
+
+func (run *Run) getSystemStatus() ([]models.ContainerStatus, error) {
+	stdout, stderr, err := run.Config.ExecSvc.ExecReturnOutput(exec.Command("docker", "compose", "--project-name", "eureka", "ps", "--all", "--format", "json"))
+	if err != nil {
+		if stderr.Len() > 0 {
+			slog.Warn(run.Config.Action.Name, "text", "Listing system services was unsuccessful", "stderr", strings.TrimSpace(stderr.String()))
+		}
+		return nil, err
+	}
+	services, err := parseComposeServices(stdout.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]models.ContainerStatus, 0, len(services))
+	for _, service := range services {
+		var ports []string
+		for _, publisher := range service.Publishers {
+			if publisher.PublishedPort == 0 {
+				continue
+			}
+			ports = append(ports, fmt.Sprintf("%d->%d/%s", publisher.PublishedPort, publisher.TargetPort, publisher.Protocol))
+		}
+		statuses = append(statuses, models.ContainerStatus{
+			Name:    service.Name,
+			Service: service.Service,
+			State:   service.State,
+			Health:  service.Health,
+			// One-off services such as the Vault initializer exit once they are done
+			Healthy: isContainerHealthy(service.State, service.Health) || (service.State == string(container.StateExited) && service.ExitCode == 0),
+			Ports:   slices.Compact(ports),
+		})
+	}
+	slices.SortFunc(statuses, func(a, b models.ContainerStatus) int { return strings.Compare(a.Service, b.Service) })
+
+	return statuses, nil
+}
+
+// parseComposeServices reads both the JSON lines written by recent Docker Compose versions and the JSON array of older ones
+func parseComposeServices(stdout []byte) ([]models.ComposeService, error) {
+	stdout = bytes.TrimSpace(stdout)
+	if len(stdout) == 0 {
+		return nil, nil
+	}
+
+	var services []models.ComposeService
+	if stdout[0] == '[' {
+		if err := json.Unmarshal(stdout, &services); err != nil {
+			return nil, err
+		}
+		return services, nil
+	}
+	for line := range bytes.Lines(stdout) {
+		var service models.ComposeService
+		if err := json.Unmarshal(line, &service); err != nil {
+			return nil, err
+		}
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+func (run *Run) getModuleStatus(ctx context.Context, dockerClient *client.Client) ([]models.ContainerStatus, error) {
+	profileName := run.Config.Action.ConfigProfileName
+	filters := make(client.Filters).Add("name", fmt.Sprintf(constant.ProfileContainerPattern, profileName), constant.ManagementContainerPattern)
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]models.ContainerStatus, 0, len(containers))
+	for _, c := range containers {
+		name := strings.TrimPrefix(c.Names[0], "/")
+		moduleName := strings.TrimPrefix(strings.TrimPrefix(name, "eureka-"), profileName+"-")
+		health := getContainerHealth(c)
+		status := models.ContainerStatus{
+			Name:    name,
+			Module:  strings.TrimSuffix(moduleName, "-sc"),
+			Sidecar: strings.HasSuffix(moduleName, "-sc"),
+			State:   string(c.State),
+			Health:  health,
+			Healthy: isContainerHealthy(string(c.State), health),
+		}
+		for _, port := range c.Ports {
+			if port.PublicPort == 0 {
+				continue
+			}
+			status.Ports = append(status.Ports, fmt.Sprintf("%d->%d/%s", port.PublicPort, port.PrivatePort, port.Type))
+		}
+		slices.Sort(status.Ports)
+		status.Ports = slices.Compact(status.Ports)
+		statuses = append(statuses, status)
+	}
+	slices.SortFunc(statuses, func(a, b models.ContainerStatus) int { return strings.Compare(a.Name, b.Name) })
+
+	return statuses, nil
+}
+
+// getContainerHealth falls back to the status text for Docker Engine versions that do not report the health summary
+func getContainerHealth(c container.Summary) string {
+	if c.Health != nil {
+		if c.Health.Status == container.NoHealthcheck {
+			return ""
+		}
+		return string(c.Health.Status)
+	}
+
+	switch {
+	case strings.Contains(c.Status, "(unhealthy)"):
+		return string(container.Unhealthy)
+	case strings.Contains(c.Status, "(healthy)"):
+		return string(container.Healthy)
+	case strings.Contains(c.Status, "(health: starting)"):
+		return string(container.Starting)
+	default:
+		return ""
+	}
+}
+
+func isContainerHealthy(state, health string) bool {
+	return state == string(container.StateRunning) && health != string(container.Unhealthy) && health != string(container.Starting)
+}
+
+// setModuleRouteCounts counts the Kong routes of every module, the routes are tagged with the module ID
+func (run *Run) setModuleRouteCounts(ctx context.Context, modules []models.ContainerStatus) error {
+	routes, err := run.Config.KongSvc.ListAllRoutes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, module := range modules {
+		if module.Sidecar {
+			continue
+		}
+		count := 0
+		for _, route := range routes {
+			if slices.ContainsFunc(route.Tags, func(tag string) bool { return helpers.MatchesModuleName(tag, module.Module) }) {
+				count++
+			}
+		}
+		modules[i].Routes = &count
+	}
+
+	return nil
+}
+
+func (run *Run) getApplicationStatus(ctx context.Context) ([]models.ApplicationStatus, error) {
+	response, err := run.Config.ManagementSvc.GetApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applications := make([]models.ApplicationStatus, 0, len(response.ApplicationDescriptors))
+	for _, descriptor := range response.ApplicationDescriptors {
+		applications = append(applications, models.ApplicationStatus{
+			ID:      helpers.GetString(descriptor, "id"),
+			Name:    helpers.GetString(descriptor, "name"),
+			Version: helpers.GetString(descriptor, "version"),
+		})
+	}
+	slices.SortFunc(applications, func(a, b models.ApplicationStatus) int { return strings.Compare(a.ID, b.ID) })
+
+	return applications, nil
+}
+
+func (run *Run) getTenantStatus(ctx context.Context) ([]models.TenantStatus, error) {
+	tenants, err := run.Config.ManagementSvc.GetTenants(ctx, constant.NoneConsortium, constant.All)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]models.TenantStatus, 0, len(tenants))
+	for _, value := range tenants {
+		entry, ok := value.(map[string]any)
+		if !ok {
+			slog.Warn(run.Config.Action.Name, "text", "Skipping tenant entry of unexpected type", "entry", value)
+			continue
+		}
+		tenantName := helpers.GetString(entry, "name")
+		response, err := run.Config.ManagementSvc.GetTenantEntitlements(ctx, tenantName, false)
+		if err != nil {
+			return statuses, err
+		}
+
+		status := models.TenantStatus{Name: tenantName, Applications: []string{}}
+		for _, entitlement := range response.Entitlements {
+			status.Applications = append(status.Applications, entitlement.ApplicationID)
+		}
+		slices.Sort(status.Applications)
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func writeEnvironmentStatus(w io.Writer, status *models.EnvironmentStatus, output string) error {
+	switch output {
+	case constant.JSONOutput:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(status)
+	case constant.TableOutput, "":
+		return writeEnvironmentStatusTable(w, status)
+	default:
+		return errors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writeEnvironmentStatusTable(w io.Writer, status *models.EnvironmentStatus) error {
+	systemRows := [][]string{{"SERVICE", "STATE", "HEALTH", "PORTS"}}
+	for _, c := range status.System {
+		systemRows = append(systemRows, []string{c.Service, c.State, formatStatusValue(c.Health), formatStatusValue(strings.Join(c.Ports, ", "))})
+	}
+	moduleRows := [][]string{{"CONTAINER", "STATE", "HEALTH", "ROUTES", "PORTS"}}
+	for _, c := range status.Modules {
+		routes := "-"
+		if c.Routes != nil {
+			routes = strconv.Itoa(*c.Routes)
+		}
+		moduleRows = append(moduleRows, []string{c.Name, c.State, formatStatusValue(c.Health), routes, formatStatusValue(strings.Join(c.Ports, ", "))})
+	}
+	applicationRows := [][]string{{"ID", "NAME", "VERSION"}}
+	for _, a := range status.Applications {
+		applicationRows = append(applicationRows, []string{a.ID, a.Name, a.Version})
+	}
+	tenantRows := [][]string{{"TENANT", "APPLICATIONS"}}
+	for _, t := range status.Tenants {
+		tenantRows = append(tenantRows, []string{t.Name, formatStatusValue(strings.Join(t.Applications, ", "))})
+	}
+
+	for i, section := range [][][]string{systemRows, moduleRows, applicationRows, tenantRows} {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, row := range section {
+			_, _ = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintln(w)
+	if status.Healthy {
+		_, _ = fmt.Fprintf(w, "Environment %s is healthy\n", status.Profile)
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "Environment %s has %d problem(s):\n", status.Profile, len(status.Problems))
+	for _, problem := range status.Problems {
+		_, _ = fmt.Fprintf(w, "  - %s\n", problem)
+	}
+
+	return nil
+}
+
+func formatStatusValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	statusCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+	if err := statusCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Status Tests ====================
+
+func TestGetEnvironmentStatus_Healthy(t *testing.T) {
+	// Arrange
+	composeOutput := `{"Name":"postgres","Service":"postgres","State":"running","Health":"healthy","Publishers":[{"URL":"0.0.0.0","TargetPort":5432,"PublishedPort":5432,"Protocol":"tcp"},{"URL":"::","TargetPort":5432,"PublishedPort":5432,"Protocol":"tcp"}]}
+{"Name":"vault-init","Service":"vault-init","State":"exited","ExitCode":0}`
+	mockExec := &MockExecSvc{}
+	mockExec.On("ExecReturnOutput", mock.Anything).Return(*bytes.NewBufferString(composeOutput), bytes.Buffer{}, nil)
+	mockKong := &MockKongSvc{}
+	run, mockManagement, mockKeycloak, _, _, mockModule := newTestRun(action.Status, withProfileName("combined"), withExecSvc(mockExec), withKongSvc(mockKong), withDockerClient())
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders-sc"}, State: container.StateRunning, Status: "Up 2 minutes"},
+		{Names: []string{"/eureka-combined-mod-orders"}, State: container.StateRunning, Status: "Up 2 minutes (healthy)", Ports: []container.PortSummary{{PrivatePort: 8081, PublicPort: 9131, Type: "tcp"}}},
+		{Names: []string{"/eureka-mgr-tenants"}, State: container.StateRunning, Health: &container.HealthSummary{Status: container.NoHealthcheck}},
+	}, nil)
+	mockKong.On("ListAllRoutes").Return([]models.KongRoute{
+		{Tags: []string{"mod-orders-13.0.0"}},
+		{Tags: []string{"mod-orders-13.0.0"}},
+		{Tags: []string{"mod-orders-storage-13.0.0"}},
+		{Tags: []string{"mgr-tenants-3.0.0"}},
+	}, nil)
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("vault-token", nil)
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("master-token", nil)
+	mockManagement.On("GetApplications").Return(models.ApplicationsResponse{ApplicationDescriptors: []map[string]any{
+		{"id": "app-combined-1.0.0", "name": "app-combined", "version": "1.0.0"},
+	}}, nil)
+	mockManagement.On("GetTenants", constant.NoneConsortium, constant.TenantType(constant.All)).Return([]any{map[string]any{"name": "diku"}}, nil)
+	mockManagement.On("GetTenantEntitlements", "diku", false).Return(models.TenantEntitlementResponse{
+		Entitlements: []models.TenantEntitlementDTO{{ApplicationID: "app-combined-1.0.0"}},
+	}, nil)
+
+	// Act
+	status := run.GetEnvironmentStatus(context.Background())
+
+	// Assert
+	assert.True(t, status.Healthy)
+	assert.Empty(t, status.Problems)
+	assert.Equal(t, "combined", status.Profile)
+	assert.Len(t, status.System, 2)
+	assert.Equal(t, []string{"5432->5432/tcp"}, status.System[0].Ports)
+	assert.True(t, status.System[1].Healthy)
+
+	assert.Len(t, status.Modules, 3)
+	orders, sidecar, mgr := status.Modules[0], status.Modules[1], status.Modules[2]
+	assert.Equal(t, "eureka-combined-mod-orders", orders.Name)
+	assert.Equal(t, "mod-orders", orders.Module)
+	assert.Equal(t, "healthy", orders.Health)
+	assert.Equal(t, []string{"9131->8081/tcp"}, orders.Ports)
+	assert.Equal(t, 2, *orders.Routes)
+	assert.True(t, sidecar.Sidecar)
+	assert.Nil(t, sidecar.Routes)
+	assert.Equal(t, "mgr-tenants", mgr.Module)
+	assert.Empty(t, mgr.Health)
+	assert.Equal(t, 1, *mgr.Routes)
+
+	assert.Equal(t, []models.ApplicationStatus{{ID: "app-combined-1.0.0", Name: "app-combined", Version: "1.0.0"}}, status.Applications)
+	assert.Equal(t, []models.TenantStatus{{Name: "diku", Applications: []string{"app-combined-1.0.0"}}}, status.Tenants)
+	mockManagement.AssertExpectations(t)
+}
+
+func TestGetEnvironmentStatus_ReportsProblems(t *testing.T) {
+	// Arrange
+	composeOutput := `[{"Name":"kafka","Service":"kafka","State":"exited","ExitCode":137}]`
+	mockExec := &MockExecSvc{}
+	mockExec.On("ExecReturnOutput", mock.Anything).Return(*bytes.NewBufferString(composeOutput), bytes.Buffer{}, nil)
+	mockKong := &MockKongSvc{}
+	run, mockManagement, _, _, _, mockModule := newTestRun(action.Status, withProfileName("combined"), withExecSvc(mockExec), withKongSvc(mockKong), withDockerClient())
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}, State: container.StateRunning, Status: "Up 2 minutes (unhealthy)"},
+		{Names: []string{"/eureka-combined-mod-users"}, State: container.StateRunning, Status: "Up 5 seconds (health: starting)"},
+	}, nil)
+	mockKong.On("ListAllRoutes").Return(nil, assert.AnError)
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("", assert.AnError)
+
+	// Act
+	status := run.GetEnvironmentStatus(context.Background())
+
+	// Assert
+	assert.False(t, status.Healthy)
+	assert.Equal(t, []string{
+		"kong routes cannot be listed: " + assert.AnError.Error(),
+		"access token cannot be obtained: " + assert.AnError.Error(),
+		"system service kafka is exited",
+		"container eureka-combined-mod-orders is unhealthy",
+		"container eureka-combined-mod-users is starting",
+	}, status.Problems)
+	assert.Nil(t, status.Modules[0].Routes)
+	mockManagement.AssertNotCalled(t, "GetApplications")
+}
+
+func TestGetTenantStatus_SkipsMalformedEntries(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, _, _ := newTestRun(action.Status)
+	mockManagement.On("GetTenants", constant.NoneConsortium, constant.TenantType(constant.All)).Return([]any{"diku", map[string]any{"name": "diku"}}, nil)
+	mockManagement.On("GetTenantEntitlements", "diku", false).Return(models.TenantEntitlementResponse{}, nil)
+
+	// Act
+	tenants, err := run.getTenantStatus(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.TenantStatus{{Name: "diku", Applications: []string{}}}, tenants)
+	mockManagement.AssertNumberOfCalls(t, "GetTenantEntitlements", 1)
+}
+
+func TestParseComposeServices(t *testing.T) {
+	tests := []struct {
+		name   string
+		stdout string
+	}{
+		{name: "JSON lines", stdout: "{\"Service\":\"kafka\",\"State\":\"running\"}\n{\"Service\":\"kong\",\"State\":\"running\"}\n"},
+		{name: "JSON array", stdout: `[{"Service":"kafka","State":"running"},{"Service":"kong","State":"running"}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			services, err := parseComposeServices([]byte(tt.stdout))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Len(t, services, 2)
+			assert.Equal(t, "kong", services[1].Service)
+		})
+	}
+}
+
+func TestParseComposeServices_Empty(t *testing.T) {
+	// Act
+	services, err := parseComposeServices([]byte("\n"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, services)
+}
+
+func TestWriteEnvironmentStatus(t *testing.T) {
+	routes := 2
+	status := &models.EnvironmentStatus{
+		Profile:  "combined",
+		System:   []models.ContainerStatus{{Name: "kong", Service: "kong", State: "running", Healthy: true, Ports: []string{"8000->8000/tcp"}}},
+		Modules:  []models.ContainerStatus{{Name: "eureka-combined-mod-orders", Module: "mod-orders", State: "running", Health: "unhealthy", Routes: &routes}},
+		Tenants:  []models.TenantStatus{{Name: "diku", Applications: []string{"app-combined-1.0.0"}}},
+		Problems: []string{"container eureka-combined-mod-orders is unhealthy"},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writeEnvironmentStatus(&buf, status, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "8000->8000/tcp")
+		assert.Equal(t, []string{"eureka-combined-mod-orders", "running", "unhealthy", "2", "-"}, strings.Fields(strings.Split(buf.String(), "\n")[4]))
+		assert.Contains(t, buf.String(), "Environment combined has 1 problem(s):\n  - container eureka-combined-mod-orders is unhealthy")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := writeEnvironmentStatus(&buf, status, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		var decoded models.EnvironmentStatus
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, *status, decoded)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		// Act
+		err := writeEnvironmentStatus(&bytes.Buffer{}, status, "yaml")
+
+		// Assert
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// timingsCmd represents the timings command
+var timingsCmd = &cobra.Command{
+	Use:   "timings",
+	Short: "Compare run timings",
+	Long:  `Compare the per-step timings of the last runs of a profile.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Timings)
+		if err != nil {
+			return err
+		}
+
+		return run.Timings()
+	},
+}
+
+func (run *Run) Timings() error {
+	history, err := run.GetTimingHistory(params.Profile, params.Runs)
+	if err != nil {
+		return err
+	}
+	if len(history.Runs) == 0 {
+		slog.Info(run.Config.Action.Name, "text", "No timing reports found", "profile", params.Profile)
+		return nil
+	}
+
+	return writeTimingHistory(os.Stdout, history, params.Output)
+}
+
+func (run *Run) GetTimingHistory(profile string, runs int) (*models.TimingHistory, error) {
+	reports, err := run.Config.TimingSvc.ListReports(profile, runs)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &models.TimingHistory{Profile: profile}
+	for _, report := range reports {
+		history.Runs = append(history.Runs, models.TimingRun{Action: report.Action, StartedAt: report.StartedAt, DurationMs: report.DurationMs})
+	}
+	if len(reports) > 0 {
+		history.Timings = run.Config.TimingSvc.CompareReports(reports)
+	}
+
+	return history, nil
+}
+
+func writeTimingHistory(w io.Writer, history *models.TimingHistory, output string) error {
+	switch output {
+	case constant.JSONOutput:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(history)
+	case constant.TableOutput, "":
+		return writeTimingTable(w, history)
+	default:
+		return errors.UnsupportedOutputFormat(output)
+	}
+}
+
+func writeTimingTable(w io.Writer, history *models.TimingHistory) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := []string{"KIND", "NAME"}
+	for _, r := range history.Runs {
+		header = append(header, r.StartedAt.Local().Format(time.DateTime))
+	}
+	_, _ = fmt.Fprintln(tw, strings.Join(append(header, "CHANGE"), "\t"))
+
+	for _, t := range history.Timings {
+		row := []string{t.Kind, t.Name}
+		if t.Name == "" {
+			row[1] = "-"
+		}
+		for _, durationMs := range t.DurationsMs {
+			row = append(row, formatTiming(durationMs))
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(append(row, formatTimingChange(t.DurationsMs)), "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func formatTiming(durationMs *int64) string {
+	if durationMs == nil {
+		return "-"
+	}
+
+	return (time.Duration(*durationMs) * time.Millisecond).Round(100 * time.Millisecond).String()
+}
+
+// formatTimingChange returns the difference between the last run and the run before it
+func formatTimingChange(durationsMs []*int64) string {
+	if len(durationsMs) < 2 {
+		return "-"
+	}
+	previous, last := durationsMs[len(durationsMs)-2], durationsMs[len(durationsMs)-1]
+	if previous == nil || last == nil {
+		return "-"
+	}
+
+	change := (time.Duration(*last-*previous) * time.Millisecond).Round(100 * time.Millisecond)
+	if change >= 0 {
+		return "+" + change.String()
+	}
+
+	return change.String()
+}
+
+func init() {
+	rootCmd.AddCommand(timingsCmd)
+	timingsCmd.PersistentFlags().IntVarP(&params.Runs, action.Runs.Long, action.Runs.Short, constant.DefaultTimingRuns, action.Runs.Description)
+	timingsCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, action.Output.Description)
+	if err := timingsCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/timingsvc"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Timings Tests ====================
+
+func TestWriteTimingHistory(t *testing.T) {
+	startedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	first, second, third := int64(61000), int64(58400), int64(1200)
+	history := &models.TimingHistory{
+		Profile: "ecs",
+		Runs:    []models.TimingRun{{StartedAt: startedAt}, {StartedAt: startedAt.Add(time.Hour)}},
+		Timings: []models.TimingComparison{
+			{Kind: timingsvc.TotalKind, DurationsMs: []*int64{&first, &second}},
+			{Kind: timingsvc.StepKind, Name: action.DeploySystem, DurationsMs: []*int64{nil, &third}},
+		},
+	}
+
+	t.Run("TestWriteTimingHistory_Table", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := writeTimingHistory(&buf, history, constant.TableOutput)
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.Contains(t, lines[0], "CHANGE")
+		assert.Equal(t, []string{"total", "-", "1m1s", "58.4s", "-2.6s"}, strings.Fields(lines[1]))
+		assert.Equal(t, []string{"step", "-", "1.2s", "-"}, strings.Fields(strings.Replace(lines[2], action.DeploySystem, "", 1)))
+	})
+
+	t.Run("TestWriteTimingHistory_JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := writeTimingHistory(&buf, history, constant.JSONOutput)
+
+		assert.NoError(t, err)
+		var decoded models.TimingHistory
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, "ecs", decoded.Profile)
+		assert.Nil(t, decoded.Timings[1].DurationsMs[0])
+		assert.Equal(t, int64(1200), *decoded.Timings[1].DurationsMs[1])
+	})
+
+	t.Run("TestWriteTimingHistory_UnsupportedFormat", func(t *testing.T) {
+		err := writeTimingHistory(&bytes.Buffer{}, history, "yaml")
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	})
+}
+
+func TestFormatTimingChange(t *testing.T) {
+	previous, last := int64(1500), int64(1000)
+
+	assert.Equal(t, "-", formatTimingChange([]*int64{&last}))
+	assert.Equal(t, "-", formatTimingChange([]*int64{nil, &last}))
+	assert.Equal(t, "-500ms", formatTimingChange([]*int64{&previous, &last}))
+	assert.Equal(t, "+500ms", formatTimingChange([]*int64{&last, &previous}))
+}
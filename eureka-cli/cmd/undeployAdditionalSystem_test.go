@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== UndeployAdditionalSystem Tests ====================
+
+func TestUndeployAdditionalSystem_NoContainers(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.UndeployAdditionalSystem)
+	run.Config.Action.ConfigBackendModules = nil // No additional containers
+
+	// Act
+	err := run.UndeployAdditionalSystem()
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
 	"github.com/spf13/cobra"
 )
 
@@ -43,43 +45,47 @@ var undeployApplicationCmd = &cobra.Command{
 
 		switch {
 		case cmd.Flags().Changed(action.ApplicationName.Long):
-			err = run.UndeployLocalApplication()
+			err = run.UndeployLocalApplication(cmd.Context())
 		case run.Config.Action.IsChildApp():
-			err = run.UndeployChildApplication()
+			err = run.UndeployChildApplication(cmd.Context())
 		default:
-			err = run.UndeployApplication()
+			err = run.UndeployApplication(cmd.Context())
 		}
 		if err != nil {
 			return err
 		}
-		slog.Info(run.Config.Action.Name, "text", "Command completed", "duration", time.Since(start))
-
-		return nil
+		return run.CompleteCommand(start)
 	},
 }
 
-func (run *Run) UndeployApplication() error {
-	if err := run.UndeployUI(); err != nil {
-		slog.Warn(run.Config.Action.Name, "text", "UI undeploy was unsuccessful", "error", err)
-	}
-	if err := run.UndeployModules(false); err != nil {
-		return err
-	}
-	if err := run.UndeployManagement(); err != nil {
-		return err
-	}
-
-	return run.UndeploySystem()
+func (run *Run) UndeployApplication(ctx context.Context) error {
+	return run.NewStepGraph().
+		Add(runconfig.Step{Name: action.UndeployUi, Repeatable: true, Run: func(ctx context.Context) error {
+			if err := run.UndeployUI(ctx); err != nil {
+				slog.Warn(run.Config.Action.Name, "text", "UI undeploy was unsuccessful", "error", err)
+			}
+
+			return nil
+		}}).
+		Add(runconfig.Step{Name: action.UndeployModules, Repeatable: true, Run: func(ctx context.Context) error {
+			return run.UndeployModules(ctx, false)
+		}}).
+		Add(runconfig.Step{Name: action.UndeployManagement, Needs: []string{action.UndeployModules}, Repeatable: true, Run: run.UndeployManagement}).
+		Add(runconfig.Step{Name: action.UndeploySystem, Needs: []string{action.UndeployUi, action.UndeployManagement}, Repeatable: true, Run: func(context.Context) error {
+			return run.UndeploySystem()
+		}}).
+		Run(ctx)
 }
 
 // UndeployLocalApplication tears down a local application created by runLocalModule
-func (run *Run) UndeployLocalApplication() error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+func (run *Run) UndeployLocalApplication(ctx context.Context) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 	appName := params.ApplicationName
 
-	app, err := run.Config.ManagementSvc.GetLatestApplicationByName(appName)
+	app, err := run.Config.ManagementSvc.GetLatestApplicationByName(ctx, appName)
 	if err != nil {
 		return err
 	}
@@ -90,7 +96,7 @@ func (run *Run) UndeployLocalApplication() error {
 	appID := helpers.GetString(app, "id")
 
 	slog.Info(run.Config.Action.Name, "text", "UNDEPLOYING LOCAL APPLICATION", "name", appName, "id", appID)
-	if err := run.Config.ManagementSvc.RemoveTenantEntitlementsForApplication(constant.NoneConsortium, constant.All, appID, params.PurgeSchemas); err != nil {
+	if err := run.Config.ManagementSvc.RemoveTenantEntitlementsForApplication(ctx, constant.NoneConsortium, constant.All, appID, params.PurgeSchemas); err != nil {
 		slog.Warn(run.Config.Action.Name, "text", "Remove local application tenant entitlements was unsuccessful", "error", err)
 	}
 
@@ -108,23 +114,23 @@ func (run *Run) UndeployLocalApplication() error {
 		moduleName := helpers.GetString(entry, "name")
 		moduleID := helpers.GetString(entry, "id")
 
-		if err := run.Config.ManagementSvc.RemoveModuleDiscovery(moduleID); err != nil {
+		if err := run.Config.ManagementSvc.RemoveModuleDiscovery(ctx, moduleID); err != nil {
 			slog.Warn(run.Config.Action.Name, "text", "Remove module discovery was unsuccessful", "module", moduleName, "error", err)
 		}
 
 		pattern := fmt.Sprintf(constant.SingleModuleOrSidecarContainerPattern, run.Config.Action.ConfigProfileName, moduleName)
-		if err := run.Config.ModuleSvc.UndeployModuleByNamePattern(client, pattern); err != nil {
+		if err := run.Config.ModuleSvc.UndeployModuleByNamePattern(ctx, client, pattern); err != nil {
 			slog.Warn(run.Config.Action.Name, "text", "Undeploy module containers was unsuccessful", "module", moduleName, "error", err)
 		}
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "REMOVING LOCAL APPLICATIONS", "name", appName)
-	return run.Config.ManagementSvc.RemoveApplications(appName, "")
+	return run.Config.ManagementSvc.RemoveApplications(ctx, appName, "")
 }
 
-func (run *Run) UndeployChildApplication() error {
+func (run *Run) UndeployChildApplication(ctx context.Context) error {
 	if err := run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.RemoveTenantEntitlements(consortiumName, tenantType); err != nil {
+		if err := run.RemoveTenantEntitlements(ctx, consortiumName, tenantType); err != nil {
 			slog.Warn(run.Config.Action.Name, "text", "Remove tenant entitlement was unsuccessful", "error", err)
 		}
 
@@ -132,7 +138,7 @@ func (run *Run) UndeployChildApplication() error {
 	}); err != nil {
 		return err
 	}
-	if err := run.UndeployModules(true); err != nil {
+	if err := run.UndeployModules(ctx, true); err != nil {
 		return err
 	}
 	if err := run.UndeployAdditionalSystem(); err != nil {
@@ -142,11 +148,11 @@ func (run *Run) UndeployChildApplication() error {
 		return nil
 	}
 	return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.DetachCapabilitySets(consortiumName, tenantType); err != nil {
+		if err := run.DetachCapabilitySets(ctx, consortiumName, tenantType); err != nil {
 			return err
 		}
 
-		return run.AttachCapabilitySets(consortiumName, tenantType, 0*time.Second, false)
+		return run.AttachCapabilitySets(ctx, consortiumName, tenantType, 0*time.Second, false)
 	})
 }
 
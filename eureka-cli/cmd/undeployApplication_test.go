@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== UndeployApplication Tests ====================
+
+func TestUndeployApplication_UIFailureDoesNotStopTeardown(t *testing.T) {
+	// Arrange
+	run, _, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.UndeployApplication)
+	mockExec := &MockExecSvc{}
+	run.Config.ExecSvc = mockExec
+
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return()
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("", assert.AnError)
+	mockModule.On("UndeployModuleByNamePattern", mock.Anything, mock.Anything).Return(nil)
+	mockExec.On("Exec", mock.Anything).Return(nil)
+
+	// Act
+	err := run.UndeployApplication(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockModule.AssertNumberOfCalls(t, "UndeployModuleByNamePattern", 2)
+	mockExec.AssertNumberOfCalls(t, "Exec", 1)
+}
+
+func TestUndeployApplication_ModulesFailureSkipsSystem(t *testing.T) {
+	// Arrange
+	run, _, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.UndeployApplication)
+	mockExec := &MockExecSvc{}
+	run.Config.ExecSvc = mockExec
+
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return()
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("", assert.AnError)
+	mockModule.On("UndeployModuleByNamePattern", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	// Act
+	err := run.UndeployApplication(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "step Undeploy Modules failed")
+	mockExec.AssertNotCalled(t, "Exec", mock.Anything)
+}
+
+func TestUndeployApplication_Success(t *testing.T) {
+	t.Skip("UndeployApplication requires extensive mocking of multiple sub-commands; tested via integration tests")
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -34,11 +35,11 @@ var undeployManagementCmd = &cobra.Command{
 			return err
 		}
 
-		return run.UndeployManagement()
+		return run.UndeployManagement(cmd.Context())
 	},
 }
 
-func (run *Run) UndeployManagement() error {
+func (run *Run) UndeployManagement(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "UNDEPLOYING MANAGEMENT MODULES")
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
@@ -46,7 +47,7 @@ func (run *Run) UndeployManagement() error {
 	}
 	defer run.Config.DockerClient.Close(client)
 
-	return run.Config.ModuleSvc.UndeployModuleByNamePattern(client, constant.ManagementContainerPattern)
+	return run.Config.ModuleSvc.UndeployModuleByNamePattern(ctx, client, constant.ManagementContainerPattern)
 }
 
 func init() {
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== UndeployManagement Tests ====================
+
+func TestUndeployManagement_Success(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, mockModule := newTestRun(action.UndeployManagement)
+
+	mockDocker.On("Create").Return(nil, nil)
+	mockModule.On("UndeployModuleByNamePattern", mock.Anything, constant.ManagementContainerPattern).Return(nil)
+	mockDocker.On("Close", mock.Anything).Return(nil)
+
+	// Act
+	err := run.UndeployManagement(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockModule.AssertExpectations(t)
+}
+
+func TestUndeployManagement_UndeployError(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, mockModule := newTestRun(action.UndeployManagement)
+
+	expectedError := assert.AnError
+	mockDocker.On("Create").Return(nil, nil)
+	mockModule.On("UndeployModuleByNamePattern", mock.Anything, constant.ManagementContainerPattern).Return(expectedError)
+	mockDocker.On("Close", mock.Anything).Return(nil)
+
+	// Act
+	err := run.UndeployManagement(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, expectedError, err)
+}
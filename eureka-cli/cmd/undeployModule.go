@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -40,11 +41,11 @@ var undeployModuleCmd = &cobra.Command{
 			return err
 		}
 
-		return run.UndeployModule()
+		return run.UndeployModule(cmd.Context())
 	},
 }
 
-func (run *Run) UndeployModule() error {
+func (run *Run) UndeployModule(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "UNDEPLOYING MODULE")
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
@@ -53,7 +54,7 @@ func (run *Run) UndeployModule() error {
 	defer run.Config.DockerClient.Close(client)
 
 	pattern := fmt.Sprintf(constant.SingleModuleOrSidecarContainerPattern, run.Config.Action.ConfigProfileName, params.ModuleName)
-	return run.Config.ModuleSvc.UndeployModuleByNamePattern(client, pattern)
+	return run.Config.ModuleSvc.UndeployModuleByNamePattern(ctx, client, pattern)
 }
 
 func init() {
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -35,17 +36,18 @@ var undeployModulesCmd = &cobra.Command{
 			return err
 		}
 
-		return run.UndeployModules(params.RemoveApplication)
+		return run.UndeployModules(cmd.Context(), params.RemoveApplication)
 	},
 }
 
-func (run *Run) UndeployModules(removeApplication bool) error {
+func (run *Run) UndeployModules(ctx context.Context, removeApplication bool) error {
 	if removeApplication {
 		slog.Info(run.Config.Action.Name, "text", "REMOVING APPLICATION")
-		if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+		if err != nil {
 			return err
 		}
-		if err := run.Config.ManagementSvc.RemoveApplication(run.Config.Action.ConfigApplicationID); err != nil {
+		if err := run.Config.ManagementSvc.RemoveApplication(ctx, run.Config.Action.ConfigApplicationID); err != nil {
 			slog.Warn(run.Config.Action.Name, "text", "Application removal was unsuccessful", "error", err)
 		}
 	}
@@ -58,7 +60,7 @@ func (run *Run) UndeployModules(removeApplication bool) error {
 	defer run.Config.DockerClient.Close(client)
 
 	pattern := fmt.Sprintf(constant.ProfileContainerPattern, run.Config.Action.ConfigProfileName)
-	return run.Config.ModuleSvc.UndeployModuleByNamePattern(client, pattern)
+	return run.Config.ModuleSvc.UndeployModuleByNamePattern(ctx, client, pattern)
 }
 
 func init() {
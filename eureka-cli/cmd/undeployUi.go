@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -36,22 +37,23 @@ var undeployUiCmd = &cobra.Command{
 			return err
 		}
 
-		return run.UndeployUI()
+		return run.UndeployUI(cmd.Context())
 	},
 }
 
-func (run *Run) UndeployUI() error {
+func (run *Run) UndeployUI(ctx context.Context) error {
 	slog.Info(run.Config.Action.Name, "text", "UNDEPLOYING UI CONTAINERS")
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+	ctx, err = run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
 
-	tenants, err := run.Config.ManagementSvc.GetTenants(constant.NoneConsortium, constant.All)
+	tenants, err := run.Config.ManagementSvc.GetTenants(ctx, constant.NoneConsortium, constant.All)
 	if err != nil {
 		return err
 	}
@@ -59,7 +61,7 @@ func (run *Run) UndeployUI() error {
 	for _, value := range tenants {
 		entry := value.(map[string]any)
 		pattern := fmt.Sprintf(constant.SingleUiContainerPattern, helpers.GetString(entry, "name"))
-		if err := run.Config.ModuleSvc.UndeployModuleByNamePattern(client, pattern); err != nil {
+		if err := run.Config.ModuleSvc.UndeployModuleByNamePattern(ctx, client, pattern); err != nil {
 			return err
 		}
 	}
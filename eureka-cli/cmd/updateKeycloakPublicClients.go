@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -36,18 +37,19 @@ var updateKeycloakPublicClientsCmd = &cobra.Command{
 		}
 
 		return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-			return run.UpdateKeycloakPublicClients(consortiumName, tenantType)
+			return run.UpdateKeycloakPublicClients(cmd.Context(), consortiumName, tenantType)
 		})
 	},
 }
 
-func (run *Run) UpdateKeycloakPublicClients(consortiumName string, tenantType constant.TenantType) error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+func (run *Run) UpdateKeycloakPublicClients(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.Password)
+	if err != nil {
 		return err
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "UPDATING KEYCLOAK PUBLIC CLIENTS")
-	return run.TenantPartition(consortiumName, tenantType, func(configTenant, tenantType string) error {
+	return run.TenantPartition(ctx, consortiumName, tenantType, func(ctx context.Context, configTenant, tenantType string) error {
 		if helpers.IsUIEnabled(configTenant, run.Config.Action.ConfigTenants) {
 			slog.Info(run.Config.Action.Name, "text", "Setting config tenant params")
 			if err := run.Config.TenantSvc.SetConfigTenantParams(configTenant); err != nil {
@@ -55,7 +57,7 @@ func (run *Run) UpdateKeycloakPublicClients(consortiumName string, tenantType co
 			}
 
 			slog.Info(run.Config.Action.Name, "text", "Updating keycloak public client")
-			if err := run.Config.KeycloakSvc.UpdatePublicClientSettings(configTenant, params.PlatformLspURL); err != nil {
+			if err := run.Config.KeycloakSvc.UpdatePublicClientSettings(ctx, configTenant, params.PlatformLspURL); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,322 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+)
+
+// updateLockCmd represents the updateLock command
+var updateLockCmd = &cobra.Command{
+	Use:   "updateLock",
+	Short: "Update lockfile",
+	Long: `Resolve the module versions of the profile from the registry again, print how they differ from the versions
+pinned in eureka.lock.yaml and refresh the lockfile with them once confirmed, or right away with --yes.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.UpdateLock)
+		if err != nil {
+			return err
+		}
+
+		return run.UpdateLock(cmd.Context(), cmd.InOrStdin())
+	},
+}
+
+// UpdateLock refreshes the lockfile with the versions resolved from the registry, the changed pins are printed and
+// written only when the user confirms them on in or passed --yes
+func (run *Run) UpdateLock(ctx context.Context, in io.Reader) error {
+	locked, err := run.Config.LockSvc.Read()
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			return err
+		}
+		locked = &models.Lockfile{}
+	}
+
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, true, true)
+	if err != nil {
+		return err
+	}
+	run.Config.RegistrySvc.ResolveModuleMetadata(modules)
+
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	resolved, err := run.BuildLockfile(ctx, client, modules, locked)
+	if err != nil {
+		return err
+	}
+	changes := run.Config.LockSvc.Diff(locked, resolved)
+	if err := writeLockChanges(os.Stdout, changes); err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	if !params.Yes {
+		confirmed, err := confirm(in, os.Stdout, fmt.Sprintf("Update lockfile %s?", run.Config.LockSvc.GetFilePath()))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("Lockfile was not updated")
+			return nil
+		}
+	}
+	if err := run.Config.LockSvc.Write(resolved); err != nil {
+		return err
+	}
+	fmt.Printf("Updated lockfile %s\n", run.Config.LockSvc.GetFilePath())
+
+	return nil
+}
+
+// UseLockfile makes the module versions and the sidecar version pinned in the lockfile the only versions known to
+// the registry, every module of the profile must be pinned
+func (run *Run) UseLockfile() (*models.Lockfile, error) {
+	lockfile, err := run.Config.LockSvc.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := make(map[string]bool)
+	for _, module := range lockfile.Modules {
+		pinned[module.Name] = true
+	}
+	var missing []string
+	for _, moduleName := range slices.Concat(slices.Sorted(maps.Keys(run.Config.Action.ConfigBackendModules)), slices.Sorted(maps.Keys(run.Config.Action.ConfigFrontendModules))) {
+		if !pinned[moduleName] {
+			missing = append(missing, moduleName)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, apperrors.LockfileModulesMissing(run.Config.LockSvc.GetFilePath(), missing)
+	}
+
+	var modules []models.ApplicationModule
+	for _, module := range append([]models.LockedModule{lockfile.Sidecar}, lockfile.Modules...) {
+		if module.ID != "" {
+			modules = append(modules, models.ApplicationModule{ID: module.ID, Name: module.Name, Version: module.Version})
+		}
+	}
+	run.Config.RegistrySvc.PinModuleVersions(modules)
+	slog.Info(run.Config.Action.Name, "text", "Using lockfile", "file", run.Config.LockSvc.GetFilePath(), "platform", lockfile.Platform.Version, "modules", len(lockfile.Modules))
+
+	return lockfile, nil
+}
+
+// PinLockedImages points the tags of the locked images to their locked digests before they are deployed
+func (run *Run) PinLockedImages(ctx context.Context, lockfile *models.Lockfile) error {
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	for _, module := range append([]models.LockedModule{lockfile.Sidecar}, lockfile.Modules...) {
+		if module.Image == "" || module.Digest == "" {
+			continue
+		}
+		if err := run.Config.ModuleSvc.PinImage(ctx, client, module.Image, module.Digest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteLockfile pins the module versions, the sidecar version and the image digests of a completed deployment
+func (run *Run) WriteLockfile(ctx context.Context) error {
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, false, false)
+	if err != nil {
+		return err
+	}
+	run.Config.RegistrySvc.ResolveModuleMetadata(modules)
+
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	lockfile, err := run.BuildLockfile(ctx, client, modules, nil)
+	if err != nil {
+		return err
+	}
+	if err := run.Config.LockSvc.Write(lockfile); err != nil {
+		return err
+	}
+	slog.Info(run.Config.Action.Name, "text", "Wrote lockfile", "file", run.Config.LockSvc.GetFilePath(), "modules", len(lockfile.Modules))
+
+	return nil
+}
+
+// BuildLockfile pins the modules of the profile found in the registry, a backend module pulled from the registry is
+// pinned with the digest of its image, the digest of a previous lockfile is kept while the image is unchanged and an
+// image missing locally is pulled to get its digest when a previous lockfile is refreshed
+func (run *Run) BuildLockfile(ctx context.Context, client *client.Client, modules *models.ProxyModulesByRegistry, previous *models.Lockfile) (*models.Lockfile, error) {
+	backendModules, err := run.Config.ModuleProps.ReadBackendModules(true, false)
+	if err != nil {
+		return nil, err
+	}
+	moduleBackendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(backendModules, moduleBackendModules)
+
+	previousDigests := make(map[string]string)
+	if previous != nil {
+		for _, module := range append([]models.LockedModule{previous.Sidecar}, previous.Modules...) {
+			if module.Image != "" && module.Digest != "" {
+				previousDigests[module.Image] = module.Digest
+			}
+		}
+	}
+
+	lockfile := &models.Lockfile{Profile: run.Config.Action.ConfigProfileName}
+	for _, module := range slices.Concat(modules.FolioModules, modules.EurekaModules) {
+		if module.Metadata.Version == nil {
+			continue
+		}
+		moduleName := module.Metadata.Name
+		if backendModule, ok := backendModules[moduleName]; ok {
+			version := run.Config.ModuleSvc.GetModuleImageVersion(backendModule, module)
+			lockedModule := models.LockedModule{ID: fmt.Sprintf("%s-%s", moduleName, version), Name: moduleName, Version: version}
+			// A module deployed from a local descriptor runs a locally built image that has no digest
+			if backendModule.DeployModule && backendModule.LocalDescriptorPath == "" {
+				lockedModule.Image = run.Config.ModuleSvc.GetModuleImage(&models.ProxyModule{
+					ID:       lockedModule.ID,
+					Metadata: models.ProxyModuleMetadata{Name: moduleName, Version: &version},
+				})
+				if lockedModule.Digest, err = run.getLockedImageDigest(ctx, client, lockedModule.Image, previousDigests, previous != nil); err != nil {
+					return nil, err
+				}
+			}
+			lockfile.Modules = append(lockfile.Modules, lockedModule)
+		} else if _, ok := run.Config.Action.ConfigFrontendModules[moduleName]; ok {
+			lockfile.Modules = append(lockfile.Modules, models.LockedModule{ID: module.ID, Name: moduleName, Version: *module.Metadata.Version})
+		}
+	}
+	slices.SortFunc(lockfile.Modules, func(a, b models.LockedModule) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	sidecarImage, _, err := run.Config.ModuleSvc.GetSidecarImage(modules.EurekaModules)
+	if err != nil {
+		return nil, err
+	}
+	_, sidecarVersion := helpers.SplitImageTag(sidecarImage)
+	lockfile.Sidecar = models.LockedModule{
+		ID:      fmt.Sprintf("%s-%s", constant.SidecarProjectName, sidecarVersion),
+		Name:    constant.SidecarProjectName,
+		Version: sidecarVersion,
+		Image:   sidecarImage,
+	}
+	if lockfile.Sidecar.Digest, err = run.getLockedImageDigest(ctx, client, sidecarImage, previousDigests, previous != nil); err != nil {
+		return nil, err
+	}
+
+	platform, err := run.Config.RegistrySvc.GetPlatformDescriptor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockfile.Platform = models.LockedPlatform{URL: run.Config.Action.ConfigLspURL, Name: platform.Name, Version: platform.Version}
+
+	return lockfile, nil
+}
+
+func (run *Run) getLockedImageDigest(ctx context.Context, client *client.Client, imageName string, previousDigests map[string]string, pullImage bool) (string, error) {
+	if digest, ok := previousDigests[imageName]; ok {
+		return digest, nil
+	}
+	digest, err := run.Config.ModuleSvc.GetImageDigest(ctx, client, imageName)
+	if err != nil || digest != "" || !pullImage {
+		return digest, err
+	}
+	if err := run.Config.ModuleSvc.PullModule(ctx, client, imageName); err != nil {
+		return "", err
+	}
+
+	return run.Config.ModuleSvc.GetImageDigest(ctx, client, imageName)
+}
+
+func writeLockChanges(w io.Writer, changes []models.LockChange) error {
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(w, "Lockfile is up to date")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MODULE\tLOCKED\tRESOLVED")
+	for _, c := range changes {
+		locked, resolved := c.Locked, c.Resolved
+		if locked == "" {
+			locked = "-"
+		}
+		if resolved == "" {
+			resolved = "-"
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join([]string{c.Name, locked, resolved}, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintf(w, "%d pin(s) of the lockfile changed\n", len(changes))
+
+	return nil
+}
+
+// confirm asks a yes or no question, only an explicit yes confirms, an empty answer or the end of the input declines
+func confirm(in io.Reader, out io.Writer, question string) (bool, error) {
+	_, _ = fmt.Fprintf(out, "%s [y/N]: ", question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if errors.Is(err, io.EOF) {
+		_, _ = fmt.Fprintln(out)
+	} else if err != nil {
+		return false, err
+	}
+
+	return slices.Contains([]string{"y", "yes"}, strings.ToLower(strings.TrimSpace(answer))), nil
+}
+
+func init() {
+	rootCmd.AddCommand(updateLockCmd)
+	updateLockCmd.PersistentFlags().BoolVarP(&params.Yes, action.Yes.Long, action.Yes.Short, false, action.Yes.Description)
+}
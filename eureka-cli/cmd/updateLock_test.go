@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ==================== Lockfile Tests ====================
+
+const testLockDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+// withLockedProfile sets up the combined profile whose modules the lockfile tests pin
+func withLockedProfile() testRunOption {
+	return func(run *Run) {
+		run.Config.Action.ConfigProfileName = "combined"
+		run.Config.Action.ConfigLspURL = "https://example.org/platform-lsp"
+		run.Config.Action.ConfigBackendModules = map[string]config.BackendModule{"mgr-tenants": {}, "mod-orders": {}}
+		run.Config.Action.ConfigFrontendModules = map[string]config.FrontendModule{"folio_orders": {}}
+	}
+}
+
+func newTestLockModules() *models.ProxyModulesByRegistry {
+	newModule := func(id string) *models.ProxyModule {
+		return &models.ProxyModule{ID: id, Metadata: models.ProxyModuleMetadata{
+			Name:    helpers.GetModuleNameFromID(id),
+			Version: helpers.GetOptionalModuleVersion(id),
+		}}
+	}
+
+	return &models.ProxyModulesByRegistry{
+		FolioModules:  []*models.ProxyModule{newModule("mod-orders-13.2.0"), newModule("mod-finance-5.0.0"), newModule("folio_orders-8.0.0")},
+		EurekaModules: []*models.ProxyModule{newModule("mgr-tenants-3.0.0"), newModule("folio-module-sidecar-3.0.0")},
+	}
+}
+
+func stubLockModules(mockRegistrySvc *MockRegistrySvc, mockModuleProps *MockModuleProps, mockModule *MockModuleSvc) {
+	mockRegistrySvc.On("ResolveModuleMetadata", mock.Anything).Return()
+	mockRegistrySvc.On("GetPlatformDescriptor").Return(&models.PlatformDescriptor{Name: "platform-lsp", Version: "R1-2025"}, nil)
+	mockModuleProps.On("ReadBackendModules", true, false).Return(map[string]models.BackendModule{"mgr-tenants": {DeployModule: true}}, nil)
+	mockModuleProps.On("ReadBackendModules", false, false).Return(map[string]models.BackendModule{"mod-orders": {DeployModule: true}}, nil)
+	mockModule.On("GetModuleImageVersion", mock.Anything, mock.MatchedBy(func(m *models.ProxyModule) bool { return m.Metadata.Name == "mod-orders" })).Return("13.2.0")
+	mockModule.On("GetModuleImageVersion", mock.Anything, mock.MatchedBy(func(m *models.ProxyModule) bool { return m.Metadata.Name == "mgr-tenants" })).Return("3.0.0")
+	mockModule.On("GetModuleImage", mock.MatchedBy(func(m *models.ProxyModule) bool { return m.Metadata.Name == "mod-orders" })).Return("folioorg/mod-orders:13.2.0")
+	mockModule.On("GetModuleImage", mock.MatchedBy(func(m *models.ProxyModule) bool { return m.Metadata.Name == "mgr-tenants" })).Return("folioorg/mgr-tenants:3.0.0")
+	mockModule.On("GetSidecarImage", mock.Anything).Return("folioorg/folio-module-sidecar:3.0.0", true, nil)
+}
+
+func TestBuildLockfile_PinsModulesAndDigests(t *testing.T) {
+	// Arrange
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockModuleProps := &MockModuleProps{}
+	run, _, _, _, _, mockModule := newTestRun(action.UpdateLock, withLockedProfile(), withLockFile(filepath.Join(t.TempDir(), constant.LockFile)), withRegistrySvc(mockRegistrySvc), withModuleProps(mockModuleProps), withDockerClient())
+	stubLockModules(mockRegistrySvc, mockModuleProps, mockModule)
+	mockModule.On("GetImageDigest", mock.Anything, "folioorg/mod-orders:13.2.0").Return(testLockDigest, nil)
+	mockModule.On("GetImageDigest", mock.Anything, "folioorg/mgr-tenants:3.0.0").Return("", nil)
+	mockModule.On("GetImageDigest", mock.Anything, "folioorg/folio-module-sidecar:3.0.0").Return(testLockDigest, nil)
+
+	// Act
+	lockfile, err := run.BuildLockfile(context.Background(), nil, newTestLockModules(), nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &models.Lockfile{
+		Profile:  "combined",
+		Platform: models.LockedPlatform{URL: "https://example.org/platform-lsp", Name: "platform-lsp", Version: "R1-2025"},
+		Sidecar: models.LockedModule{ID: "folio-module-sidecar-3.0.0", Name: "folio-module-sidecar", Version: "3.0.0",
+			Image: "folioorg/folio-module-sidecar:3.0.0", Digest: testLockDigest},
+		Modules: []models.LockedModule{
+			{ID: "folio_orders-8.0.0", Name: "folio_orders", Version: "8.0.0"},
+			{ID: "mgr-tenants-3.0.0", Name: "mgr-tenants", Version: "3.0.0", Image: "folioorg/mgr-tenants:3.0.0"},
+			{ID: "mod-orders-13.2.0", Name: "mod-orders", Version: "13.2.0", Image: "folioorg/mod-orders:13.2.0", Digest: testLockDigest},
+		},
+	}, lockfile)
+	mockModule.AssertNotCalled(t, "PullModule", mock.Anything, mock.Anything)
+}
+
+// arrangeLockChanges writes a lockfile pinning mod-orders 13.1.0 while the registry resolves 13.2.0
+func arrangeLockChanges(t *testing.T, run *Run, mockRegistrySvc *MockRegistrySvc, mockModuleProps *MockModuleProps, mockModule *MockModuleSvc) {
+	t.Helper()
+	stubLockModules(mockRegistrySvc, mockModuleProps, mockModule)
+	require.NoError(t, run.Config.LockSvc.Write(&models.Lockfile{
+		Profile:  "combined",
+		Platform: models.LockedPlatform{URL: "https://example.org/platform-lsp", Name: "platform-lsp", Version: "R1-2025"},
+		Sidecar: models.LockedModule{ID: "folio-module-sidecar-3.0.0", Name: "folio-module-sidecar", Version: "3.0.0",
+			Image: "folioorg/folio-module-sidecar:3.0.0", Digest: testLockDigest},
+		Modules: []models.LockedModule{
+			{ID: "folio_orders-8.0.0", Name: "folio_orders", Version: "8.0.0"},
+			{ID: "mgr-tenants-3.0.0", Name: "mgr-tenants", Version: "3.0.0", Image: "folioorg/mgr-tenants:3.0.0"},
+			{ID: "mod-orders-13.1.0", Name: "mod-orders", Version: "13.1.0", Image: "folioorg/mod-orders:13.1.0", Digest: testLockDigest},
+		},
+	}))
+	mockRegistrySvc.On("GetModules", true, true).Return(newTestLockModules(), nil)
+	mockModule.On("GetImageDigest", mock.Anything, "folioorg/mod-orders:13.2.0").Return("", nil).Once()
+	mockModule.On("PullModule", mock.Anything, "folioorg/mod-orders:13.2.0").Return(nil)
+	mockModule.On("GetImageDigest", mock.Anything, "folioorg/mod-orders:13.2.0").Return(testLockDigest, nil).Once()
+	mockModule.On("GetImageDigest", mock.Anything, "folioorg/mgr-tenants:3.0.0").Return("", nil)
+	mockModule.On("PullModule", mock.Anything, "folioorg/mgr-tenants:3.0.0").Return(nil)
+}
+
+func TestUpdateLock_WritesChangedPins(t *testing.T) {
+	// Arrange
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockModuleProps := &MockModuleProps{}
+	run, _, _, _, _, mockModule := newTestRun(action.UpdateLock, withLockedProfile(), withLockFile(filepath.Join(t.TempDir(), constant.LockFile)), withRegistrySvc(mockRegistrySvc), withModuleProps(mockModuleProps), withDockerClient())
+	arrangeLockChanges(t, run, mockRegistrySvc, mockModuleProps, mockModule)
+	originalParams := params
+	defer func() { params = originalParams }()
+	params = action.Param{Yes: true}
+
+	// Act
+	err := run.UpdateLock(context.Background(), strings.NewReader(""))
+
+	// Assert
+	require.NoError(t, err)
+	lockfile, err := run.Config.LockSvc.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "13.2.0", lockfile.Modules[2].Version)
+	assert.Equal(t, testLockDigest, lockfile.Modules[2].Digest)
+	mockModule.AssertCalled(t, "PullModule", mock.Anything, "folioorg/mod-orders:13.2.0")
+	mockModule.AssertNotCalled(t, "GetImageDigest", mock.Anything, "folioorg/folio-module-sidecar:3.0.0")
+}
+
+func TestUpdateLock_Confirmation(t *testing.T) {
+	tests := []struct {
+		name     string
+		answer   string
+		expected string
+	}{
+		{"yes", "y\n", "13.2.0"},
+		{"no", "n\n", "13.1.0"},
+		{"empty answer", "\n", "13.1.0"},
+		{"end of input", "", "13.1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockRegistrySvc := &MockRegistrySvc{}
+			mockModuleProps := &MockModuleProps{}
+			run, _, _, _, _, mockModule := newTestRun(action.UpdateLock, withLockedProfile(), withLockFile(filepath.Join(t.TempDir(), constant.LockFile)), withRegistrySvc(mockRegistrySvc), withModuleProps(mockModuleProps), withDockerClient())
+			arrangeLockChanges(t, run, mockRegistrySvc, mockModuleProps, mockModule)
+			originalParams := params
+			defer func() { params = originalParams }()
+			params = action.Param{}
+
+			// Act
+			err := run.UpdateLock(context.Background(), strings.NewReader(tt.answer))
+
+			// Assert
+			require.NoError(t, err)
+			lockfile, err := run.Config.LockSvc.Read()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lockfile.Modules[2].Version)
+		})
+	}
+}
+
+func TestUpdateLock_ProfileMismatch(t *testing.T) {
+	// Arrange
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, _, _, _, _, _ := newTestRun(action.UpdateLock, withLockedProfile(), withLockFile(filepath.Join(t.TempDir(), constant.LockFile)), withRegistrySvc(mockRegistrySvc), withDockerClient())
+	require.NoError(t, run.Config.LockSvc.Write(&models.Lockfile{Profile: "export"}))
+
+	// Act
+	err := run.UpdateLock(context.Background(), strings.NewReader(""))
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	mockRegistrySvc.AssertNotCalled(t, "GetModules", mock.Anything, mock.Anything)
+}
+
+func TestUseLockfile_PinsModuleVersions(t *testing.T) {
+	// Arrange
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, _, _, _, _, _ := newTestRun(action.UpdateLock, withLockedProfile(), withLockFile(filepath.Join(t.TempDir(), constant.LockFile)), withRegistrySvc(mockRegistrySvc), withDockerClient())
+	require.NoError(t, run.Config.LockSvc.Write(&models.Lockfile{
+		Profile: "combined",
+		Sidecar: models.LockedModule{ID: "folio-module-sidecar-3.0.0", Name: "folio-module-sidecar", Version: "3.0.0"},
+		Modules: []models.LockedModule{
+			{ID: "folio_orders-8.0.0", Name: "folio_orders", Version: "8.0.0"},
+			{ID: "mgr-tenants-3.0.0", Name: "mgr-tenants", Version: "3.0.0"},
+			{ID: "mod-orders-13.1.0", Name: "mod-orders", Version: "13.1.0"},
+		},
+	}))
+	mockRegistrySvc.On("PinModuleVersions", []models.ApplicationModule{
+		{ID: "folio-module-sidecar-3.0.0", Name: "folio-module-sidecar", Version: "3.0.0"},
+		{ID: "folio_orders-8.0.0", Name: "folio_orders", Version: "8.0.0"},
+		{ID: "mgr-tenants-3.0.0", Name: "mgr-tenants", Version: "3.0.0"},
+		{ID: "mod-orders-13.1.0", Name: "mod-orders", Version: "13.1.0"},
+	}).Return()
+
+	// Act
+	lockfile, err := run.UseLockfile()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, lockfile.Modules, 3)
+	mockRegistrySvc.AssertExpectations(t)
+}
+
+func TestUseLockfile_ModulesMissing(t *testing.T) {
+	// Arrange
+	mockRegistrySvc := &MockRegistrySvc{}
+	run, _, _, _, _, _ := newTestRun(action.UpdateLock, withLockedProfile(), withLockFile(filepath.Join(t.TempDir(), constant.LockFile)), withRegistrySvc(mockRegistrySvc), withDockerClient())
+	require.NoError(t, run.Config.LockSvc.Write(&models.Lockfile{
+		Profile: "combined",
+		Modules: []models.LockedModule{{ID: "mod-orders-13.1.0", Name: "mod-orders", Version: "13.1.0"}},
+	}))
+
+	// Act
+	lockfile, err := run.UseLockfile()
+
+	// Assert
+	assert.Nil(t, lockfile)
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "mgr-tenants, folio_orders")
+	mockRegistrySvc.AssertNotCalled(t, "PinModuleVersions", mock.Anything)
+}
+
+func TestPinLockedImages_PinsImagesWithDigest(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, mockModule := newTestRun(action.UpdateLock, withDockerClient())
+	mockModule.On("PinImage", mock.Anything, "folioorg/folio-module-sidecar:3.0.0", testLockDigest).Return(nil)
+	mockModule.On("PinImage", mock.Anything, "folioorg/mod-orders:13.1.0", testLockDigest).Return(nil)
+
+	// Act
+	err := run.PinLockedImages(context.Background(), &models.Lockfile{
+		Sidecar: models.LockedModule{Name: "folio-module-sidecar", Image: "folioorg/folio-module-sidecar:3.0.0", Digest: testLockDigest},
+		Modules: []models.LockedModule{
+			{Name: "folio_orders", Version: "8.0.0"},
+			{Name: "mgr-tenants", Image: "folioorg/mgr-tenants:3.0.0"},
+			{Name: "mod-orders", Image: "folioorg/mod-orders:13.1.0", Digest: testLockDigest},
+		},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	mockModule.AssertExpectations(t)
+	mockModule.AssertNumberOfCalls(t, "PinImage", 2)
+}
+
+func TestWriteLockChanges_Table(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+
+	// Act
+	err := writeLockChanges(&buffer, []models.LockChange{
+		{Name: "mod-finance", Resolved: "5.0.0"},
+		{Name: "mod-orders", Locked: "13.1.0", Resolved: "13.2.0"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, buffer.String(), "MODULE       LOCKED  RESOLVED")
+	assert.Contains(t, buffer.String(), "mod-finance  -       5.0.0")
+	assert.Contains(t, buffer.String(), "2 pin(s) of the lockfile changed")
+}
+
+func TestWriteLockChanges_UpToDate(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+
+	// Act
+	err := writeLockChanges(&buffer, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Lockfile is up to date\n", buffer.String())
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"log/slog"
 	"os"
 
@@ -39,24 +40,25 @@ var updateModuleDiscoveryCmd = &cobra.Command{
 			return err
 		}
 
-		return run.UpdateModuleDiscovery()
+		return run.UpdateModuleDiscovery(cmd.Context())
 	},
 }
 
-func (run *Run) UpdateModuleDiscovery() error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+func (run *Run) UpdateModuleDiscovery(ctx context.Context) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
-	if err := run.setModuleDiscoveryDataIntoContext(); err != nil {
+	if err := run.setModuleDiscoveryDataIntoContext(ctx); err != nil {
 		return err
 	}
 	slog.Info(run.Config.Action.Name, "text", "UPDATING MODULE DISCOVERY", "module", params.ModuleName, "id", params.ID)
 
-	return run.Config.ManagementSvc.UpdateModuleDiscovery(params.ID, params.Restore, params.PrivatePort, params.SidecarURL)
+	return run.Config.ManagementSvc.UpdateModuleDiscovery(ctx, params.ID, params.Restore, params.PrivatePort, params.SidecarURL)
 }
 
-func (run *Run) setModuleDiscoveryDataIntoContext() error {
-	moduleDiscovery, err := run.Config.ManagementSvc.GetModuleDiscovery(params.ModuleName)
+func (run *Run) setModuleDiscoveryDataIntoContext(ctx context.Context) error {
+	moduleDiscovery, err := run.Config.ManagementSvc.GetModuleDiscovery(ctx, params.ModuleName)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -28,10 +29,24 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/modulesvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+const (
+	buildModuleArtifactStep      = "Build Module Artifact"
+	buildModuleImageStep         = "Build Module Image"
+	readModuleDescriptorStep     = "Read Module Descriptor"
+	resolveApplicationStep       = "Resolve Application"
+	updateApplicationModulesStep = "Update Application Modules"
+	createApplicationStep        = "Create Application"
+	createModuleDiscoveryStep    = "Create Module Discovery"
+	upgradeTenantEntitlementStep = "Upgrade Tenant Entitlement"
+	removeApplicationsStep       = "Remove Applications"
+	cleanModuleArtifactStep      = "Clean Module Artifact"
+)
+
 // upgradeModuleCmd represents the upgradeModule command
 var upgradeModuleCmd = &cobra.Command{
 	Use:   "upgradeModule",
@@ -43,15 +58,16 @@ var upgradeModuleCmd = &cobra.Command{
 			return err
 		}
 
-		return run.UpgradeModule()
+		return run.UpgradeModule(cmd.Context())
 	},
 }
 
-func (run *Run) UpgradeModule() error {
-	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+func (run *Run) UpgradeModule(ctx context.Context) error {
+	ctx, err := run.setKeycloakMasterAccessTokenIntoContext(ctx, constant.ClientCredentials)
+	if err != nil {
 		return err
 	}
-	if err := run.setModuleDiscoveryDataIntoContext(); err != nil {
+	if err := run.setModuleDiscoveryDataIntoContext(ctx); err != nil {
 		return err
 	}
 	if err := run.Config.UpgradeModuleSvc.SetNewModuleVersionAndIDIntoContext(); err != nil {
@@ -71,119 +87,156 @@ func (run *Run) UpgradeModule() error {
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "UPGRADING MODULE", "module", moduleName, "version", newModuleVersion, "build", shouldBuild)
-	if shouldBuild {
-		if !params.SkipModuleArtifact {
-			if err := run.Config.UpgradeModuleSvc.BuildModuleArtifact(moduleName, newModuleVersion, modulePath); err != nil {
-				return err
+	var (
+		newModuleDescriptor         map[string]any
+		app                         map[string]any
+		appName                     string
+		oldAppVersion               string
+		newAppVersion               string
+		newAppID                    string
+		newBackendModules           []map[string]any
+		newFrontendModules          []map[string]any
+		newDiscoveryModules         []map[string]string
+		newBackendModuleDescriptors []any
+	)
+
+	return run.NewStepGraph().
+		Add(runconfig.Step{Name: buildModuleArtifactStep, Run: func(ctx context.Context) error {
+			if !shouldBuild || params.SkipModuleArtifact {
+				return nil
 			}
-		}
-		if !params.SkipModuleImage {
-			if err := run.Config.UpgradeModuleSvc.BuildModuleImage(namespace, moduleName, newModuleVersion, modulePath); err != nil {
+			return run.Config.UpgradeModuleSvc.BuildModuleArtifact(moduleName, newModuleVersion, modulePath)
+		}}).
+		Add(runconfig.Step{Name: buildModuleImageStep, Needs: []string{buildModuleArtifactStep}, Run: func(ctx context.Context) error {
+			if !shouldBuild || params.SkipModuleImage {
+				return nil
+			}
+			return run.Config.UpgradeModuleSvc.BuildModuleImage(namespace, moduleName, newModuleVersion, modulePath)
+		}}).
+		Add(runconfig.Step{Name: readModuleDescriptorStep, Needs: []string{buildModuleArtifactStep}, Run: func(ctx context.Context) error {
+			if !shouldBuild {
+				return nil
+			}
+			readModuleDescriptor, err := run.Config.UpgradeModuleSvc.ReadModuleDescriptor(moduleName, newModuleVersion, modulePath)
+			if err != nil {
 				return err
 			}
-		}
-	}
+			newModuleDescriptor = readModuleDescriptor
 
-	var newModuleDescriptor map[string]any
-	if shouldBuild {
-		readModuleDescriptor, err := run.Config.UpgradeModuleSvc.ReadModuleDescriptor(moduleName, newModuleVersion, modulePath)
-		if err != nil {
-			return err
-		}
-		newModuleDescriptor = readModuleDescriptor
-	}
-	if !params.SkipModuleDeployment {
-		if err := run.deployModuleAndSidecarPair(nil); err != nil {
-			return err
-		}
-	}
-
-	app, err := run.Config.ManagementSvc.GetLatestApplication()
-	if err != nil {
-		return err
-	}
-	oldBackendModules := helpers.GetAnySlice(app, "modules")
-	newBackendModules, newDiscoveryModules, oldModuleID, err := run.Config.UpgradeModuleSvc.UpdateBackendModules(moduleName, newModuleVersion, shouldBuild, oldBackendModules)
-	if err != nil {
-		return err
-	}
-	oldFrontendModules := helpers.GetAnySlice(app, "uiModules")
-	newFrontendModules := run.Config.UpgradeModuleSvc.UpdateFrontendModules(shouldBuild, oldFrontendModules)
+			return nil
+		}}).
+		Add(runconfig.Step{Name: action.DeployModule, Needs: []string{buildModuleImageStep}, Run: func(ctx context.Context) error {
+			if params.SkipModuleDeployment {
+				return nil
+			}
+			return run.deployModuleAndSidecarPair(ctx, nil)
+		}}).
+		Add(runconfig.Step{Name: resolveApplicationStep, Run: func(ctx context.Context) error {
+			latestApp, err := run.Config.ManagementSvc.GetLatestApplication(ctx)
+			if err != nil {
+				return err
+			}
+			app = latestApp
+			appName = helpers.GetString(app, "name")
+			oldAppVersion = helpers.GetString(app, "version")
 
-	var newBackendModuleDescriptors []any
-	if shouldBuild {
-		oldBackendModuleDescriptors := helpers.GetAnySlice(app, "moduleDescriptors")
-		newBackendModuleDescriptors = run.Config.UpgradeModuleSvc.UpdateBackendModuleDescriptors(moduleName, oldModuleID, newModuleDescriptor, oldBackendModuleDescriptors)
-	}
+			appVersion, err := semver.NewVersion(oldAppVersion)
+			if err != nil {
+				return err
+			}
+			newAppVersion = appVersion.IncPatch().String()
+			newAppID = fmt.Sprintf("%s-%s", appName, newAppVersion)
+
+			return nil
+		}}).
+		Add(runconfig.Step{Name: updateApplicationModulesStep, Needs: []string{readModuleDescriptorStep, resolveApplicationStep}, Run: func(ctx context.Context) error {
+			oldBackendModules := helpers.GetAnySlice(app, "modules")
+			var (
+				oldModuleID string
+				err         error
+			)
+			newBackendModules, newDiscoveryModules, oldModuleID, err = run.Config.UpgradeModuleSvc.UpdateBackendModules(moduleName, newModuleVersion, shouldBuild, oldBackendModules)
+			if err != nil {
+				return err
+			}
+			oldFrontendModules := helpers.GetAnySlice(app, "uiModules")
+			newFrontendModules = run.Config.UpgradeModuleSvc.UpdateFrontendModules(shouldBuild, oldFrontendModules)
+			if shouldBuild {
+				oldBackendModuleDescriptors := helpers.GetAnySlice(app, "moduleDescriptors")
+				newBackendModuleDescriptors = run.Config.UpgradeModuleSvc.UpdateBackendModuleDescriptors(moduleName, oldModuleID, newModuleDescriptor, oldBackendModuleDescriptors)
+			}
 
-	oldAppVersion := helpers.GetString(app, "version")
-	appVersion, err := semver.NewVersion(oldAppVersion)
-	if err != nil {
-		return err
-	}
-	newAppVersion := appVersion.IncPatch().String()
+			return nil
+		}}).
+		Add(runconfig.Step{Name: createApplicationStep, Needs: []string{updateApplicationModulesStep, action.DeployModule}, Run: func(ctx context.Context) error {
+			if params.SkipApplication {
+				return nil
+			}
+			newDependencies := helpers.GetMapOrDefault(app, "dependencies", nil)
+			newFrontendModuleDescriptors := helpers.GetAnySlice(app, "uiModuleDescriptors")
+
+			return run.Config.ManagementSvc.CreateNewApplication(ctx, &models.ApplicationUpgradeRequest{
+				ApplicationName:              appName,
+				NewApplicationID:             newAppID,
+				NewApplicationVersion:        newAppVersion,
+				NewDependencies:              newDependencies,
+				NewBackendModules:            newBackendModules,
+				NewFrontendModules:           newFrontendModules,
+				NewBackendModuleDescriptors:  newBackendModuleDescriptors,
+				NewFrontendModuleDescriptors: newFrontendModuleDescriptors,
+				ShouldBuild:                  shouldBuild,
+			})
+		}}).
+		Add(runconfig.Step{Name: createModuleDiscoveryStep, Needs: []string{createApplicationStep}, Run: func(ctx context.Context) error {
+			if params.SkipModuleDiscovery {
+				return nil
+			}
+			if err := run.Config.ManagementSvc.CreateNewModuleDiscovery(ctx, newDiscoveryModules); err != nil {
+				if downstreamErr := run.cleanupApplicationsOnFailure(ctx, constant.NoneConsortium, constant.All, appName, err); downstreamErr != nil {
+					return downstreamErr
+				}
 
-	var (
-		appName  = helpers.GetString(app, "name")
-		newAppID = fmt.Sprintf("%s-%s", appName, newAppVersion)
-	)
-	if !params.SkipApplication {
-		newDependencies := helpers.GetMapOrDefault(app, "dependencies", nil)
-		newFrontendModuleDescriptors := helpers.GetAnySlice(app, "uiModuleDescriptors")
-		if err := run.Config.ManagementSvc.CreateNewApplication(&models.ApplicationUpgradeRequest{
-			ApplicationName:              appName,
-			NewApplicationID:             newAppID,
-			NewApplicationVersion:        newAppVersion,
-			NewDependencies:              newDependencies,
-			NewBackendModules:            newBackendModules,
-			NewFrontendModules:           newFrontendModules,
-			NewBackendModuleDescriptors:  newBackendModuleDescriptors,
-			NewFrontendModuleDescriptors: newFrontendModuleDescriptors,
-			ShouldBuild:                  shouldBuild,
-		}); err != nil {
-			return err
-		}
-	}
-	if !params.SkipModuleDiscovery {
-		if err := run.Config.ManagementSvc.CreateNewModuleDiscovery(newDiscoveryModules); err != nil {
-			if downstreamErr := run.cleanupApplicationsOnFailure(constant.NoneConsortium, constant.All, appName, err); downstreamErr != nil {
-				return downstreamErr
+				return err
 			}
 
-			return err
-		}
-	}
-	if !params.SkipTenantEntitlement {
-		slog.Info(run.Config.Action.Name, "text", "UPGRADING TENANT ENTITLEMENT", "from", oldAppVersion, "to", newAppVersion)
-		if err := run.Config.ManagementSvc.UpgradeTenantEntitlement(constant.NoneConsortium, constant.All, newAppID); err != nil {
-			if downstreamErr := run.cleanupApplicationsOnFailure(constant.NoneConsortium, constant.All, appName, err); downstreamErr != nil {
-				return downstreamErr
+			return nil
+		}}).
+		Add(runconfig.Step{Name: upgradeTenantEntitlementStep, Needs: []string{createModuleDiscoveryStep}, Run: func(ctx context.Context) error {
+			if params.SkipTenantEntitlement {
+				return nil
 			}
+			slog.Info(run.Config.Action.Name, "text", "UPGRADING TENANT ENTITLEMENT", "from", oldAppVersion, "to", newAppVersion)
+			if err := run.Config.ManagementSvc.UpgradeTenantEntitlement(ctx, constant.NoneConsortium, constant.All, newAppID); err != nil {
+				if downstreamErr := run.cleanupApplicationsOnFailure(ctx, constant.NoneConsortium, constant.All, appName, err); downstreamErr != nil {
+					return downstreamErr
+				}
 
-			return err
-		}
-	}
-	slog.Info(run.Config.Action.Name, "text", "REMOVING APPLICATIONS", "name", appName)
-	if err := run.Config.ManagementSvc.RemoveApplications(appName, newAppID); err != nil {
-		return err
-	}
-	if params.Cleanup {
-		if err := run.Config.UpgradeModuleSvc.CleanModuleArtifact(moduleName, modulePath); err != nil {
-			return err
-		}
-	}
+				return err
+			}
 
-	return nil
+			return nil
+		}}).
+		Add(runconfig.Step{Name: removeApplicationsStep, Needs: []string{upgradeTenantEntitlementStep}, Run: func(ctx context.Context) error {
+			slog.Info(run.Config.Action.Name, "text", "REMOVING APPLICATIONS", "name", appName)
+			return run.Config.ManagementSvc.RemoveApplications(ctx, appName, newAppID)
+		}}).
+		Add(runconfig.Step{Name: cleanModuleArtifactStep, Needs: []string{removeApplicationsStep}, Run: func(ctx context.Context) error {
+			if !params.Cleanup {
+				return nil
+			}
+			return run.Config.UpgradeModuleSvc.CleanModuleArtifact(moduleName, modulePath)
+		}}).
+		Run(ctx)
 }
 
-func (run *Run) deployModuleAndSidecarPair(prepare func(*models.ProxyModulesByRegistry, map[string]models.BackendModule) error) error {
+func (run *Run) deployModuleAndSidecarPair(ctx context.Context, prepare func(*models.ProxyModulesByRegistry, map[string]models.BackendModule) error) error {
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING MODULE AND SIDECAR PAIR", "module", params.ModuleName, "id", params.ID)
 	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
 	if err != nil {
 		return err
 	}
 
-	modules, err := run.Config.RegistrySvc.GetModules(false, false)
+	modules, err := run.Config.RegistrySvc.GetModules(ctx, false, false)
 	if err != nil {
 		return err
 	}
@@ -199,7 +252,7 @@ func (run *Run) deployModuleAndSidecarPair(prepare func(*models.ProxyModulesByRe
 		return err
 	}
 	defer run.Config.DockerClient.Close(client)
-	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+	if err := run.setVaultRootTokenIntoContext(ctx, client); err != nil {
 		return err
 	}
 
@@ -213,7 +266,7 @@ func (run *Run) deployModuleAndSidecarPair(prepare func(*models.ProxyModulesByRe
 		IsManagement:   false,
 	}
 
-	return run.Config.UpgradeModuleSvc.DeployModuleAndSidecarPair(client, pair)
+	return run.Config.UpgradeModuleSvc.DeployModuleAndSidecarPair(ctx, client, pair)
 }
 
 func (run *Run) validateModulePath(modulePath string) error {
@@ -235,8 +288,8 @@ func (run *Run) validateModulePath(modulePath string) error {
 	return nil
 }
 
-func (run *Run) cleanupApplicationsOnFailure(consortiumName string, tenantType constant.TenantType, appName string, upstreamErr error) error {
-	tenants, err := run.Config.ManagementSvc.GetTenants(consortiumName, tenantType)
+func (run *Run) cleanupApplicationsOnFailure(ctx context.Context, consortiumName string, tenantType constant.TenantType, appName string, upstreamErr error) error {
+	tenants, err := run.Config.ManagementSvc.GetTenants(ctx, consortiumName, tenantType)
 	if err != nil {
 		return errors.Wrap(upstreamErr, "failed to cleanup apps on failure - cannot retrieve tenants")
 	}
@@ -248,14 +301,14 @@ func (run *Run) cleanupApplicationsOnFailure(consortiumName string, tenantType c
 			continue
 		}
 
-		entitlements, err := run.Config.ManagementSvc.GetTenantEntitlements(tenantName, false)
+		entitlements, err := run.Config.ManagementSvc.GetTenantEntitlements(ctx, tenantName, false)
 		if err != nil {
 			return errors.Wrap(upstreamErr, "failed to cleanup apps on failure - cannot retrieve tenant entitlements")
 		}
 		entitlement := entitlements.Entitlements[0]
 
 		slog.Info(run.Config.Action.Name, "text", "REMOVING APPLICATIONS ON FAILURE", "name", appName)
-		if err := run.Config.ManagementSvc.RemoveApplications(appName, entitlement.ApplicationID); err != nil {
+		if err := run.Config.ManagementSvc.RemoveApplications(ctx, appName, entitlement.ApplicationID); err != nil {
 			return errors.Wrapf(err, "failed to cleanup apps on failure - cannot remove apps (%s app id is ignored)", entitlement.ApplicationID)
 		}
 	}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== UpgradeModule Tests ====================
+
+func TestValidateModulePath_EmptyPath(t *testing.T) {
+	// Arrange
+	run := &Run{
+		Config: &runconfig.RunConfig{
+			Infrastructure: &runconfig.Infrastructure{
+				Action: &action.Action{},
+			},
+		},
+	}
+
+	// Act
+	err := run.validateModulePath("")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidateModulePath_ValidDirectory(t *testing.T) {
+	// Arrange
+	run := &Run{
+		Config: &runconfig.RunConfig{
+			Infrastructure: &runconfig.Infrastructure{
+				Action: &action.Action{},
+			},
+		},
+	}
+	tempDir := t.TempDir()
+
+	// Act
+	err := run.validateModulePath(tempDir)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidateModulePath_PathDoesNotExist(t *testing.T) {
+	// Arrange
+	run := &Run{
+		Config: &runconfig.RunConfig{
+			Infrastructure: &runconfig.Infrastructure{
+				Action: &action.Action{},
+			},
+		},
+	}
+	nonExistentPath := "/path/that/does/not/exist/at/all"
+
+	// Act
+	err := run.validateModulePath(nonExistentPath)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "module path does not exist")
+	assert.Contains(t, err.Error(), nonExistentPath)
+}
+
+func TestValidateModulePath_PathIsFile(t *testing.T) {
+	// Arrange
+	run := &Run{
+		Config: &runconfig.RunConfig{
+			Infrastructure: &runconfig.Infrastructure{
+				Action: &action.Action{},
+			},
+		},
+	}
+	tempDir := t.TempDir()
+	tempFile := tempDir + "/testfile.txt"
+
+	// Create a temporary file
+	file, err := os.Create(tempFile)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	// Act
+	err = run.validateModulePath(tempFile)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "module path is not a directory")
+	assert.Contains(t, err.Error(), tempFile)
+}
+
+func TestUpgradeModule_Success(t *testing.T) {
+	t.Skip("UpgradeModule requires extensive mocking of build, deployment, and application management flow; tested via integration tests")
+}
+
+func TestUpgradeModule_GetLatestApplicationError(t *testing.T) {
+	t.Skip("UpgradeModule requires extensive mocking; tested via integration tests")
+}
@@ -0,0 +1,100 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// validateConfigCmd represents the validateConfig command
+var validateConfigCmd = &cobra.Command{
+	Use:          "validateConfig",
+	Short:        "Validate config",
+	Long:         `Validate the config file of the profile against its schema and the references between its sections.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ValidateConfig)
+		if err != nil {
+			return err
+		}
+
+		return run.ValidateConfig(viper.ConfigFileUsed())
+	},
+}
+
+func (run *Run) ValidateConfig(configFile string) error {
+	problems, err := run.Config.ConfigSvc.ValidateConfig(configFile)
+	if err != nil {
+		return err
+	}
+	writeConfigProblems(os.Stdout, problems)
+	if errorCount := countConfigErrors(problems); errorCount > 0 {
+		return errors.ConfigInvalid(configFile, errorCount)
+	}
+	fmt.Printf("Config %s is valid\n", configFile)
+
+	return nil
+}
+
+// checkConfig validates the config file before a command is run, the problems are written to stderr
+// so that the output of the command stays machine-readable
+func (run *Run) checkConfig(configFile string) error {
+	if configFile == "" {
+		return nil
+	}
+
+	problems, err := run.Config.ConfigSvc.ValidateConfig(configFile)
+	if err != nil {
+		return err
+	}
+	writeConfigProblems(os.Stderr, problems)
+	if errorCount := countConfigErrors(problems); errorCount > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "Fix the config or use --%s to run the command anyway\n", action.SkipConfigValidation.Long)
+		return errors.ConfigInvalid(configFile, errorCount)
+	}
+
+	return nil
+}
+
+func writeConfigProblems(w io.Writer, problems []models.ConfigProblem) {
+	for _, problem := range problems {
+		_, _ = fmt.Fprintln(w, problem.String())
+	}
+}
+
+// countConfigErrors counts the problems that fail the validation, warnings are only reported
+func countConfigErrors(problems []models.ConfigProblem) int {
+	count := 0
+	for _, problem := range problems {
+		if !problem.Warning {
+			count++
+		}
+	}
+
+	return count
+}
+
+func init() {
+	rootCmd.AddCommand(validateConfigCmd)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ==================== ValidateConfig Tests ====================
+
+func TestValidateConfig_Valid(t *testing.T) {
+	// Arrange
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("tenants:\n  diku:\nusers:\n  diku_admin:\n    tenant: diku\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig, withConfigSvc())
+
+	// Act
+	err := run.ValidateConfig(configFile)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidateConfig_Invalid(t *testing.T) {
+	// Arrange
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("tenants:\n  diku:\nusers:\n  diku_admin:\n    tenant: dikuu\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig, withConfigSvc())
+
+	// Act
+	err := run.ValidateConfig(configFile)
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "has 1 problem(s)")
+}
+
+func TestValidateConfig_DeprecatedKeyIsValid(t *testing.T) {
+	// Arrange
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("backend-modules:\n  mod-orders:\n    resources:\n      cpus: 2\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig, withConfigSvc())
+
+	// Act
+	err := run.ValidateConfig(configFile)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestCheckConfig_SkipsWithoutConfigFile(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig)
+
+	// Act
+	err := run.checkConfig("")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestShowConfig_Success(t *testing.T) {
+	// Arrange
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("application:\n  name: app-test\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig, withConfigSvc())
+
+	// Act
+	err := run.ShowConfig(configFile)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestShowConfig_ExtendsCycle(t *testing.T) {
+	// Arrange
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("extends: test\n"), 0600))
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig, withConfigSvc())
+
+	// Act
+	err := run.ShowConfig(configFile)
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "config extends cycle")
+}
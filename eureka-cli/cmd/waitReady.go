@@ -0,0 +1,199 @@
+/*
+Copyright © 2026 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"github.com/spf13/cobra"
+)
+
+// waitReadyCmd represents the waitReady command
+var waitReadyCmd = &cobra.Command{
+	Use:          "waitReady",
+	Short:        "Wait until the environment is ready",
+	Long:         `Wait until the module containers are healthy, the Kong routes exist and every tenant can obtain an access token.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.WaitReady)
+		if err != nil {
+			return err
+		}
+
+		return run.WaitReady(cmd.Context())
+	},
+}
+
+// readinessCheck is a named check of the environment that is repeated until it passes
+type readinessCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// WaitReady blocks until the environment is usable, a check that cannot succeed such as a stopped container fails
+// immediately while a check that is still not ready when the timeout expires fails with a distinct exit code
+func (run *Run) WaitReady(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, params.Timeout)
+	defer cancel()
+
+	slog.Info(run.Config.Action.Name, "text", "WAITING FOR ENVIRONMENT READINESS", "timeout", params.Timeout)
+	dockerClient, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(dockerClient)
+
+	checks := []readinessCheck{
+		{"module containers", func(ctx context.Context) error { return run.checkModuleContainersReady(ctx, dockerClient) }},
+		{"kong routes", run.checkKongRoutesReady},
+		{"tenant access tokens", func(ctx context.Context) error { return run.checkTenantAccessTokensReady(ctx, dockerClient) }},
+	}
+	if params.CapabilitySets {
+		checks = append(checks, readinessCheck{"capability sets", run.checkCapabilitySetsReady})
+	}
+	for _, c := range checks {
+		if err := run.waitUntilReady(ctx, timeoutCtx, c.name, c.check); err != nil {
+			return err
+		}
+	}
+	slog.Info(run.Config.Action.Name, "text", "Environment is ready")
+
+	return nil
+}
+
+// waitUntilReady repeats a check until it passes, fails with a hard failure or the timeout of the command expires
+func (run *Run) waitUntilReady(parentCtx context.Context, ctx context.Context, name string, check func(context.Context) error) error {
+	for {
+		err := check(ctx)
+		if err == nil {
+			slog.Info(run.Config.Action.Name, "text", "Check passed", "check", name)
+			return nil
+		}
+		if parentCtx.Err() != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return apperrors.WithExitCode(constant.WaitReadyExitCode, apperrors.WaitReadyTimeout(name, params.Timeout, err))
+		}
+		if isHardFailure(err) {
+			return err
+		}
+
+		slog.Warn(run.Config.Action.Name, "text", "Check is not ready, retrying", "check", name, "error", err)
+		if sleepErr := helpers.Sleep(ctx, constant.WaitReadyInterval); sleepErr != nil {
+			if parentCtx.Err() != nil {
+				return sleepErr
+			}
+			return apperrors.WithExitCode(constant.WaitReadyExitCode, apperrors.WaitReadyTimeout(name, params.Timeout, err))
+		}
+	}
+}
+
+func isHardFailure(err error) bool {
+	return errors.Is(err, apperrors.ErrDeploymentFailed) || errors.Is(err, apperrors.ErrInvalidInput) || errors.Is(err, apperrors.ErrConfigMissing)
+}
+
+// checkModuleContainersReady checks the health endpoint of every module and sidecar container through its published
+// server port, a container that has exited cannot become ready and is reported as a hard failure
+func (run *Run) checkModuleContainersReady(ctx context.Context, dockerClient *client.Client) error {
+	profileName := run.Config.Action.ConfigProfileName
+	filters := make(client.Filters).Add("name", fmt.Sprintf(constant.ProfileContainerPattern, profileName), constant.ManagementContainerPattern)
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(ctx, dockerClient, filters)
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return apperrors.WaitReadyContainersNotFound(profileName)
+	}
+
+	serverPort, _ := strconv.Atoi(constant.PrivateServerPort)
+	modules := make(map[string]int, len(containers))
+	for _, c := range containers {
+		name := strings.TrimPrefix(c.Names[0], "/")
+		if c.State == container.StateExited || c.State == container.StateDead {
+			return apperrors.WaitReadyContainerFailed(name, string(c.State))
+		}
+		for _, port := range c.Ports {
+			if int(port.PrivatePort) == serverPort && port.PublicPort != 0 {
+				modules[name] = int(port.PublicPort)
+				break
+			}
+		}
+	}
+
+	return run.CheckDeployedModuleReadiness(ctx, constant.Module, modules)
+}
+
+// checkKongRoutesReady checks that the Kong routes of every module of the application exist, extra routes
+// do not make the environment unusable and are left to checkRoutes
+func (run *Run) checkKongRoutesReady(ctx context.Context) error {
+	coverages, err := run.GetRouteCoverage(ctx, "", "")
+	if err != nil {
+		return err
+	}
+
+	var missing int
+	for _, coverage := range coverages {
+		missing += len(coverage.Missing)
+	}
+	if missing > 0 {
+		return apperrors.WaitReadyRoutesMissing(missing)
+	}
+
+	return nil
+}
+
+func (run *Run) checkTenantAccessTokensReady(ctx context.Context, dockerClient *client.Client) error {
+	if err := run.setVaultRootTokenIntoContext(ctx, dockerClient); err != nil {
+		return err
+	}
+	for _, tenantName := range slices.Sorted(maps.Keys(run.Config.Action.ConfigTenants)) {
+		if _, err := run.Config.KeycloakSvc.GetAccessToken(ctx, tenantName); err != nil {
+			return apperrors.Wrapf(err, "tenant %s", tenantName)
+		}
+	}
+
+	return nil
+}
+
+func (run *Run) checkCapabilitySetsReady(ctx context.Context) error {
+	for _, tenantName := range slices.Sorted(maps.Keys(run.Config.Action.ConfigTenants)) {
+		if err := run.Config.KafkaSvc.PollConsumerGroup(ctx, run.Config.Action.GetKafkaTopicConfigTenant(tenantName)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(waitReadyCmd)
+	waitReadyCmd.PersistentFlags().DurationVarP(&params.Timeout, action.Timeout.Long, action.Timeout.Short, constant.DefaultWaitReadyTimeout, action.Timeout.Description)
+	waitReadyCmd.PersistentFlags().BoolVarP(&params.CapabilitySets, action.CapabilitySets.Long, action.CapabilitySets.Short, false, action.CapabilitySets.Description)
+}
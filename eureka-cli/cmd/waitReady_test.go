@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== WaitReady Tests ====================
+
+func TestWaitReady_Ready(t *testing.T) {
+	// Arrange
+	params.Timeout = time.Minute
+	t.Cleanup(func() { params.Timeout = 0 })
+	mockKong := &MockKongSvc{}
+	run, mockManagement, mockKeycloak, _, _, mockModule := newTestRun(action.WaitReady, withProfileName("combined"), withApplicationName("app-combined"), withKongSvc(mockKong), withDockerClient())
+	run.Config.Action.ConfigTenants = map[string]config.Tenant{"diku": {}}
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}, State: container.StateRunning, Ports: []container.PortSummary{{PrivatePort: 5005, PublicPort: 9132}, {PrivatePort: 8081, PublicPort: 9131}}},
+		{Names: []string{"/eureka-combined-mod-orders-sc"}, State: container.StateRunning, Ports: []container.PortSummary{{PrivatePort: 8081, PublicPort: 19131}}},
+	}, nil)
+	mockModule.On("CheckModuleReadiness", "eureka-combined-mod-orders", 9131).Return()
+	mockModule.On("CheckModuleReadiness", "eureka-combined-mod-orders-sc", 19131).Return()
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
+	mockManagement.On("GetLatestApplicationByName", "app-combined").Return(map[string]any{"moduleDescriptors": []any{}}, nil)
+	mockKong.On("CheckRouteCoverage", mock.Anything).Return([]models.ModuleRouteCoverage{{ModuleID: "mod-orders-13.1.0", Missing: []string{}, Extra: []string{"extra"}}}, nil)
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("vault-token", nil)
+	mockKeycloak.On("GetAccessToken", "diku").Return("diku-token", nil)
+
+	// Act
+	err := run.WaitReady(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "vault-token", run.Config.Action.VaultRootToken)
+	mockModule.AssertExpectations(t)
+	mockKeycloak.AssertExpectations(t)
+	mockKong.AssertExpectations(t)
+}
+
+func TestWaitReady_ExitedContainerIsHardFailure(t *testing.T) {
+	// Arrange
+	params.Timeout = time.Minute
+	t.Cleanup(func() { params.Timeout = 0 })
+	mockKong := &MockKongSvc{}
+	run, _, _, _, _, mockModule := newTestRun(action.WaitReady, withProfileName("combined"), withKongSvc(mockKong), withDockerClient())
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}, State: container.StateExited},
+	}, nil)
+
+	// Act
+	err := run.WaitReady(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrDeploymentFailed)
+	assert.Contains(t, err.Error(), "container eureka-combined-mod-orders is exited")
+	assert.Equal(t, 1, apperrors.GetExitCode(err))
+	mockKong.AssertNotCalled(t, "CheckRouteCoverage", mock.Anything)
+}
+
+func TestWaitReady_Timeout(t *testing.T) {
+	// Arrange
+	params.Timeout = 50 * time.Millisecond
+	t.Cleanup(func() { params.Timeout = 0 })
+	run, _, _, _, _, mockModule := newTestRun(action.WaitReady, withProfileName("combined"), withDockerClient())
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{}, nil)
+
+	// Act
+	err := run.WaitReady(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrTimeout)
+	assert.ErrorIs(t, err, apperrors.ErrNotReady)
+	assert.Contains(t, err.Error(), "module containers not ready within 50ms")
+	assert.Equal(t, constant.WaitReadyExitCode, apperrors.GetExitCode(err))
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// Config is the typed model of the config sections that describe the modules, tenants, roles, users and consortiums
+// of a profile, it is decoded once from the config read by viper so that the services do not parse raw maps
+type Config struct {
+	BackendModules        map[string]BackendModule  `mapstructure:"backend-modules"`
+	FrontendModules       map[string]FrontendModule `mapstructure:"frontend-modules"`
+	CustomFrontendModules map[string]FrontendModule `mapstructure:"custom-frontend-modules"`
+	SidecarModule         SidecarModule             `mapstructure:"sidecar-module"`
+	Tenants               map[string]Tenant         `mapstructure:"tenants"`
+	Roles                 map[string]Role           `mapstructure:"roles"`
+	Users                 map[string]User           `mapstructure:"users"`
+	Consortiums           map[string]Consortium     `mapstructure:"consortiums"`
+}
+
+// sections are the keys of the config that are decoded, they are read one by one because the settings of viper
+// omit the entries without a value, e.g. a backend module that is deployed with the defaults
+var sections = []string{
+	field.BackendModules,
+	field.FrontendModules,
+	field.CustomFrontendModules,
+	field.SidecarModule,
+	field.Tenants,
+	field.Roles,
+	field.Users,
+	field.Consortiums,
+}
+
+// Load decodes the config read by viper
+func Load() (*Config, error) {
+	settings := make(map[string]any, len(sections))
+	for _, section := range sections {
+		settings[section] = viper.Get(section)
+	}
+
+	return Decode(settings)
+}
+
+// Decode decodes the settings of a config, e.g. the settings read by viper, a value of the wrong type
+// fails the decoding with the key path of the value instead of being ignored by the services
+func Decode(settings map[string]any) (*Config, error) {
+	var config Config
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           &config,
+		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.StringToSliceHookFunc(","),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(settings); err != nil {
+		return nil, errors.ConfigDecodeFailed(err)
+	}
+	if backendModules, ok := settings[field.BackendModules].(map[string]any); ok {
+		for name, value := range backendModules {
+			if backendModule, exists := config.BackendModules[name]; exists && value == nil {
+				backendModule.Bare = true
+				config.BackendModules[name] = backendModule
+			}
+		}
+	}
+
+	return &config, nil
+}
+
+// Validate checks the invariants between the sections that the services rely on, the references between
+// the sections are checked by the validation of the config file
+func (c *Config) Validate() error {
+	var problems []string
+	for _, consortiumName := range slices.Sorted(maps.Keys(c.Consortiums)) {
+		if !c.Consortiums[consortiumName].CreateConsortium {
+			continue
+		}
+		if centralTenants := c.GetCentralTenants(consortiumName); len(centralTenants) != 1 {
+			problems = append(problems, fmt.Sprintf("consortium %s must have exactly one central tenant, found %d", consortiumName, len(centralTenants)))
+		}
+	}
+	for _, tenantName := range slices.Sorted(maps.Keys(c.Tenants)) {
+		if tenant := c.Tenants[tenantName]; tenant.CentralTenant && tenant.Consortium == "" {
+			problems = append(problems, fmt.Sprintf("tenant %s is a central tenant without a consortium", tenantName))
+		}
+	}
+	for _, roleName := range slices.Sorted(maps.Keys(c.Roles)) {
+		if c.Roles[roleName].Tenant == "" {
+			problems = append(problems, fmt.Sprintf("role %s has no tenant", roleName))
+		}
+	}
+	for _, username := range slices.Sorted(maps.Keys(c.Users)) {
+		if c.Users[username].Tenant == "" {
+			problems = append(problems, fmt.Sprintf("user %s has no tenant", username))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.ConfigInconsistent(problems)
+	}
+
+	return nil
+}
+
+// GetCentralTenants returns the sorted names of the central tenants of a consortium
+func (c *Config) GetCentralTenants(consortiumName string) []string {
+	var centralTenants []string
+	for _, tenantName := range slices.Sorted(maps.Keys(c.Tenants)) {
+		if tenant := c.Tenants[tenantName]; tenant.Consortium == consortiumName && tenant.CentralTenant {
+			centralTenants = append(centralTenants, tenantName)
+		}
+	}
+
+	return centralTenants
+}
@@ -0,0 +1,76 @@
+package config
+
+// BackendModule is an entry of the backend-modules section, an entry without settings deploys the module
+// with its sidecar on the default ports
+type BackendModule struct {
+	DeployModule        *bool          `mapstructure:"deploy-module"`
+	DeploySidecar       *bool          `mapstructure:"deploy-sidecar"`
+	Version             *string        `mapstructure:"version"`
+	Port                *int           `mapstructure:"port"`
+	PrivatePort         *int           `mapstructure:"private-port"`
+	PortServer          *int           `mapstructure:"port-server"` // Alias of "private-port" for compatibility
+	UseVault            bool           `mapstructure:"use-vault"`
+	UseOkapiURL         bool           `mapstructure:"use-okapi-url"`
+	DisableSystemUser   bool           `mapstructure:"disable-system-user"`
+	LocalDescriptorPath string         `mapstructure:"local-descriptor-path"`
+	Environment         map[string]any `mapstructure:"environment"`
+	SidecarEnvironment  map[string]any `mapstructure:"sidecar-environment"`
+	Volumes             []string       `mapstructure:"volumes"`
+	Resources           Resources      `mapstructure:"resources"`
+	// Bare is set by the decoding for an entry without settings, e.g. "mod-data-export-worker:"
+	Bare bool `mapstructure:"-"`
+}
+
+// IsDeployModule reports whether the module is deployed, it is deployed unless deploy-module is false
+func (m BackendModule) IsDeployModule() bool {
+	return m.DeployModule == nil || *m.DeployModule
+}
+
+// IsDeploySidecar reports whether the sidecar of the module is deployed, it is deployed unless deploy-sidecar is false
+func (m BackendModule) IsDeploySidecar() bool {
+	return m.DeploySidecar == nil || *m.DeploySidecar
+}
+
+// GetPrivatePort returns the configured private port of the module, preferring the port-server compatibility alias
+// over private-port; returns nil when no private port is configured
+func (m BackendModule) GetPrivatePort() *int {
+	if m.PortServer != nil {
+		return m.PortServer
+	}
+
+	return m.PrivatePort
+}
+
+// FrontendModule is an entry of the frontend-modules or custom-frontend-modules sections
+type FrontendModule struct {
+	DeployModule        *bool  `mapstructure:"deploy-module"`
+	Version             string `mapstructure:"version"`
+	LocalDescriptorPath string `mapstructure:"local-descriptor-path"`
+}
+
+// IsDeployModule reports whether the module is deployed, it is deployed unless deploy-module is false
+func (m FrontendModule) IsDeployModule() bool {
+	return m.DeployModule == nil || *m.DeployModule
+}
+
+// SidecarModule is the sidecar-module section shared by the sidecars of all backend modules
+type SidecarModule struct {
+	Image           string    `mapstructure:"image"`
+	Version         string    `mapstructure:"version"`
+	CustomNamespace bool      `mapstructure:"custom-namespace"`
+	Resources       Resources `mapstructure:"resources"`
+}
+
+// Resources are the container resources of a module or sidecar, the sizes are in MiB
+type Resources struct {
+	CPUCount          *int64 `mapstructure:"cpu-count"`
+	MemoryReservation *int64 `mapstructure:"memory-reservation"`
+	Memory            *int64 `mapstructure:"memory"`
+	MemorySwap        *int64 `mapstructure:"memory-swap"`
+	OomKillDisable    *bool  `mapstructure:"oom-kill-disable"`
+}
+
+// IsEmpty reports whether none of the resources are configured
+func (r Resources) IsEmpty() bool {
+	return r == Resources{}
+}
@@ -0,0 +1,82 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== BackendModule Tests ====================
+
+func TestBackendModule_IsDeployModule(t *testing.T) {
+	assert.True(t, config.BackendModule{}.IsDeployModule())
+	assert.True(t, config.BackendModule{DeployModule: helpers.BoolPtr(true)}.IsDeployModule())
+	assert.False(t, config.BackendModule{DeployModule: helpers.BoolPtr(false)}.IsDeployModule())
+}
+
+func TestBackendModule_IsDeploySidecar(t *testing.T) {
+	assert.True(t, config.BackendModule{}.IsDeploySidecar())
+	assert.True(t, config.BackendModule{DeploySidecar: helpers.BoolPtr(true)}.IsDeploySidecar())
+	assert.False(t, config.BackendModule{DeploySidecar: helpers.BoolPtr(false)}.IsDeploySidecar())
+}
+
+func TestBackendModule_GetPrivatePort_PrivatePort(t *testing.T) {
+	// Arrange
+	module := config.BackendModule{PrivatePort: helpers.IntPtr(8080)}
+
+	// Act
+	result := module.GetPrivatePort()
+
+	// Assert
+	assert.Equal(t, helpers.IntPtr(8080), result)
+}
+
+func TestBackendModule_GetPrivatePort_PortServerAliasTakesPrecedence(t *testing.T) {
+	// Arrange
+	module := config.BackendModule{
+		PrivatePort: helpers.IntPtr(8080),
+		PortServer:  helpers.IntPtr(9090),
+	}
+
+	// Act
+	result := module.GetPrivatePort()
+
+	// Assert
+	assert.Equal(t, helpers.IntPtr(9090), result)
+}
+
+func TestBackendModule_GetPrivatePort_PortServerAliasWithoutPrivatePort(t *testing.T) {
+	// Arrange
+	module := config.BackendModule{PortServer: helpers.IntPtr(9090)}
+
+	// Act
+	result := module.GetPrivatePort()
+
+	// Assert
+	assert.Equal(t, helpers.IntPtr(9090), result)
+}
+
+func TestBackendModule_GetPrivatePort_NotConfigured(t *testing.T) {
+	// Act
+	result := config.BackendModule{}.GetPrivatePort()
+
+	// Assert
+	assert.Nil(t, result)
+}
+
+// ==================== FrontendModule Tests ====================
+
+func TestFrontendModule_IsDeployModule(t *testing.T) {
+	assert.True(t, config.FrontendModule{}.IsDeployModule())
+	assert.False(t, config.FrontendModule{DeployModule: helpers.BoolPtr(false)}.IsDeployModule())
+}
+
+// ==================== Resources Tests ====================
+
+func TestResources_IsEmpty(t *testing.T) {
+	assert.True(t, config.Resources{}.IsEmpty())
+	assert.False(t, config.Resources{Memory: helpers.Int64Ptr(512)}.IsEmpty())
+	assert.False(t, config.Resources{OomKillDisable: helpers.BoolPtr(false)}.IsEmpty())
+}
@@ -0,0 +1,34 @@
+package config
+
+// Tenant is an entry of the tenants section, the optional settings override the command flags of the same name
+type Tenant struct {
+	Consortium       string  `mapstructure:"consortium"`
+	CentralTenant    bool    `mapstructure:"central-tenant"`
+	DeployUI         bool    `mapstructure:"deploy-ui"`
+	SingleTenant     *bool   `mapstructure:"single-tenant"`
+	EnableEcsRequest *bool   `mapstructure:"enable-ecs-request"`
+	PlatformLspURL   *string `mapstructure:"platform-lsp-url"`
+}
+
+// Role is an entry of the roles section
+type Role struct {
+	Tenant         string   `mapstructure:"tenant"`
+	Consortium     string   `mapstructure:"consortium"`
+	CapabilitySets []string `mapstructure:"capability-sets"`
+}
+
+// User is an entry of the users section
+type User struct {
+	Tenant     string   `mapstructure:"tenant"`
+	Consortium string   `mapstructure:"consortium"`
+	Password   string   `mapstructure:"password"`
+	FirstName  string   `mapstructure:"first-name"`
+	LastName   string   `mapstructure:"last-name"`
+	Roles      []string `mapstructure:"roles"`
+}
+
+// Consortium is an entry of the consortiums section
+type Consortium struct {
+	CreateConsortium      bool `mapstructure:"create-consortium"`
+	EnableCentralOrdering bool `mapstructure:"enable-central-ordering"`
+}
@@ -0,0 +1,227 @@
+package config_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ==================== Load Tests ====================
+
+func TestLoad_ShippedConfigs(t *testing.T) {
+	configFiles, err := filepath.Glob(filepath.Join("..", "config.*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, configFiles)
+
+	for _, configFile := range configFiles {
+		t.Run(filepath.Base(configFile), func(t *testing.T) {
+			// Arrange
+			content, err := os.ReadFile(configFile)
+			require.NoError(t, err)
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			viper.SetConfigType("yaml")
+			require.NoError(t, viper.ReadConfig(bytes.NewReader(content)))
+
+			// Act
+			cfg, err := config.Load()
+
+			// Assert
+			require.NoError(t, err)
+			assert.NoError(t, cfg.Validate())
+			assert.Len(t, cfg.BackendModules, len(viper.GetStringMap(field.BackendModules)))
+			assert.Len(t, cfg.Tenants, len(viper.GetStringMap(field.Tenants)))
+		})
+	}
+}
+
+func TestLoad_KeepsEntriesWithoutSettings(t *testing.T) {
+	// Arrange
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.SetConfigType("yaml")
+	require.NoError(t, viper.ReadConfig(bytes.NewBufferString("backend-modules:\n  mod-users:\n  mod-orders:\n    port: 9000\n")))
+
+	// Act
+	cfg, err := config.Load()
+
+	// Assert
+	require.NoError(t, err)
+	require.Contains(t, cfg.BackendModules, "mod-users")
+	assert.True(t, cfg.BackendModules["mod-users"].IsDeployModule())
+	assert.True(t, cfg.BackendModules["mod-users"].Bare)
+	assert.False(t, cfg.BackendModules["mod-orders"].Bare)
+	assert.Equal(t, 9000, *cfg.BackendModules["mod-orders"].Port)
+}
+
+// ==================== Decode Tests ====================
+
+func TestDecode_Success(t *testing.T) {
+	// Arrange
+	settings := map[string]any{
+		field.BackendModules: map[string]any{
+			"mod-orders": map[string]any{
+				field.ModuleVersionEntry:     2.5,
+				field.ModulePrivatePortEntry: "8082",
+				field.ModuleEnvEntry:         map[string]any{"DB_HOST": "postgres"},
+				field.ModuleResourceEntry:    map[string]any{field.ModuleResourceMemoryEntry: 1024},
+			},
+		},
+		field.SidecarModule: map[string]any{
+			field.SidecarModuleImageEntry:           "folio-module-sidecar",
+			field.SidecarModuleCustomNamespaceEntry: true,
+		},
+		field.Tenants: map[string]any{
+			"diku": map[string]any{field.TenantsDeployUIEntry: true},
+		},
+		field.Roles: map[string]any{
+			"admin": map[string]any{
+				field.RolesTenantEntry:         "diku",
+				field.RolesCapabilitySetsEntry: []any{"all"},
+			},
+		},
+		field.Users: map[string]any{
+			"diku_admin": map[string]any{
+				field.UsersTenantEntry: "diku",
+				field.UsersRolesEntry:  "admin,user",
+			},
+		},
+	}
+
+	// Act
+	cfg, err := config.Decode(settings)
+
+	// Assert
+	require.NoError(t, err)
+	module := cfg.BackendModules["mod-orders"]
+	assert.Equal(t, "2.5", *module.Version)
+	assert.Equal(t, 8082, *module.PrivatePort)
+	assert.Equal(t, "postgres", module.Environment["DB_HOST"])
+	assert.Equal(t, int64(1024), *module.Resources.Memory)
+	assert.Equal(t, "folio-module-sidecar", cfg.SidecarModule.Image)
+	assert.True(t, cfg.SidecarModule.CustomNamespace)
+	assert.True(t, cfg.Tenants["diku"].DeployUI)
+	assert.Equal(t, []string{"all"}, cfg.Roles["admin"].CapabilitySets)
+	assert.Equal(t, []string{"admin", "user"}, cfg.Users["diku_admin"].Roles)
+}
+
+func TestDecode_EmptySettings(t *testing.T) {
+	// Act
+	cfg, err := config.Decode(map[string]any{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, cfg.BackendModules)
+	assert.Empty(t, cfg.Tenants)
+	assert.True(t, cfg.SidecarModule.Resources.IsEmpty())
+}
+
+func TestDecode_InvalidValue(t *testing.T) {
+	// Arrange
+	settings := map[string]any{
+		field.Tenants: map[string]any{
+			"diku": map[string]any{field.TenantsDeployUIEntry: "sometimes"},
+		},
+	}
+
+	// Act
+	cfg, err := config.Decode(settings)
+
+	// Assert
+	assert.Nil(t, cfg)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "deploy-ui")
+}
+
+// ==================== Validate Tests ====================
+
+func TestValidate_Success(t *testing.T) {
+	// Arrange
+	cfg := &config.Config{
+		Tenants: map[string]config.Tenant{
+			"consortium": {Consortium: "eureka", CentralTenant: true},
+			"university": {Consortium: "eureka"},
+		},
+		Roles:       map[string]config.Role{"admin": {Tenant: "consortium"}},
+		Users:       map[string]config.User{"consortium_admin": {Tenant: "consortium"}},
+		Consortiums: map[string]config.Consortium{"eureka": {CreateConsortium: true}},
+	}
+
+	// Act
+	err := cfg.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidate_ReportsAllProblems(t *testing.T) {
+	// Arrange
+	cfg := &config.Config{
+		Tenants: map[string]config.Tenant{
+			"central1": {Consortium: "eureka", CentralTenant: true},
+			"central2": {Consortium: "eureka", CentralTenant: true},
+			"orphan":   {CentralTenant: true},
+		},
+		Roles: map[string]config.Role{"admin": {}},
+		Users: map[string]config.User{"diku_admin": {}},
+		Consortiums: map[string]config.Consortium{
+			"eureka":  {CreateConsortium: true},
+			"ignored": {CreateConsortium: false},
+		},
+	}
+
+	// Act
+	err := cfg.Validate()
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "consortium eureka must have exactly one central tenant, found 2; "+
+		"tenant orphan is a central tenant without a consortium; role admin has no tenant; user diku_admin has no tenant")
+	assert.NotContains(t, err.Error(), "ignored")
+}
+
+func TestValidate_ConsortiumWithoutCentralTenant(t *testing.T) {
+	// Arrange
+	cfg := &config.Config{
+		Tenants:     map[string]config.Tenant{"university": {Consortium: "eureka"}},
+		Consortiums: map[string]config.Consortium{"eureka": {CreateConsortium: true}},
+	}
+
+	// Act
+	err := cfg.Validate()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "consortium eureka must have exactly one central tenant, found 0")
+}
+
+// ==================== GetCentralTenants Tests ====================
+
+func TestGetCentralTenants(t *testing.T) {
+	// Arrange
+	cfg := &config.Config{
+		Tenants: map[string]config.Tenant{
+			"consortium":  {Consortium: "eureka", CentralTenant: true},
+			"university":  {Consortium: "eureka"},
+			"consortium2": {Consortium: "other", CentralTenant: true},
+		},
+	}
+
+	// Act
+	result := cfg.GetCentralTenants("eureka")
+
+	// Assert
+	assert.Equal(t, []string{"consortium"}, result)
+	assert.Empty(t, cfg.GetCentralTenants("missing"))
+}
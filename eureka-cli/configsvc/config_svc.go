@@ -0,0 +1,22 @@
+package configsvc
+
+import (
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+)
+
+// ConfigProcessor defines the interface for config file operations
+type ConfigProcessor interface {
+	ConfigValidator
+	ConfigPrinter
+	ConfigScaffolder
+}
+
+// ConfigSvc provides functionality for validating the config files of the profiles
+type ConfigSvc struct {
+	Action *action.Action
+}
+
+// New creates a new ConfigSvc instance
+func New(action *action.Action) *ConfigSvc {
+	return &ConfigSvc{Action: action}
+}
@@ -0,0 +1,138 @@
+package configsvc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	referencePrefix     = "${"
+	escapedReference    = "$${"
+	fileReferencePrefix = "file:"
+	defaultSeparator    = ":-"
+)
+
+// Interpolate resolves the ${VAR}, ${VAR:-default} and ${file:/path} references of every string value of the merged
+// config, $${ is kept as a literal ${; it reports whether any value was changed and fails with the key paths of all
+// the references that cannot be resolved
+func (mc *MergedConfig) Interpolate(lookupEnv func(string) (string, bool), readFile func(string) ([]byte, error)) (bool, error) {
+	interpolator := &configInterpolator{lookupEnv: lookupEnv, readFile: readFile}
+	interpolator.interpolate(mc.Root, "")
+	if len(interpolator.problems) > 0 {
+		return false, errors.ConfigReferencesUnresolved(interpolator.problems)
+	}
+
+	return interpolator.changed, nil
+}
+
+type configInterpolator struct {
+	lookupEnv func(string) (string, bool)
+	readFile  func(string) ([]byte, error)
+	changed   bool
+	problems  []string
+}
+
+func (ci *configInterpolator) interpolate(node *yaml.Node, path string) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			ci.interpolate(node.Content[i+1], joinPath(path, node.Content[i].Value))
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			ci.interpolate(item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case yaml.ScalarNode:
+		if !hasReference(node) {
+			return
+		}
+		value, err := ci.resolve(node.Value)
+		if err != nil {
+			ci.problems = append(ci.problems, fmt.Sprintf("%s: %s", path, err))
+			return
+		}
+		node.Value = value
+		// A plain value is resolved as if the result was written in the config, e.g. ${PORT} becomes an integer
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+		ci.changed = true
+	}
+}
+
+// resolve replaces the references of a string value with the values of the environment variables or the files
+func (ci *configInterpolator) resolve(value string) (string, error) {
+	var builder strings.Builder
+	for {
+		index := strings.Index(value, referencePrefix)
+		if index < 0 {
+			builder.WriteString(value)
+			return builder.String(), nil
+		}
+		if index > 0 && value[index-1] == '$' {
+			builder.WriteString(value[:index-1] + referencePrefix)
+			value = value[index+len(referencePrefix):]
+			continue
+		}
+
+		end := strings.Index(value[index:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("reference %s is not closed", value[index:])
+		}
+		expression := value[index+len(referencePrefix) : index+end]
+		resolved, err := ci.resolveExpression(expression)
+		if err != nil {
+			return "", err
+		}
+		builder.WriteString(value[:index] + resolved)
+		value = value[index+end+1:]
+	}
+}
+
+func (ci *configInterpolator) resolveExpression(expression string) (string, error) {
+	if path, ok := strings.CutPrefix(expression, fileReferencePrefix); ok {
+		return ci.readFileReference(path)
+	}
+
+	name, defaultValue, hasDefault := strings.Cut(expression, defaultSeparator)
+	if name == "" {
+		return "", fmt.Errorf("reference ${%s} has no variable name", expression)
+	}
+	value, ok := ci.lookupEnv(name)
+	if hasDefault && value == "" {
+		return defaultValue, nil
+	}
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+
+	return value, nil
+}
+
+// readFileReference returns the content of a file without its trailing line breaks, a leading ~ is the user home
+func (ci *configInterpolator) readFileReference(path string) (string, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		userHome, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(userHome, rest)
+	}
+
+	content, err := ci.readFile(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read file reference: %w", err)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// hasReference reports whether a value is a string with references that are only resolved when the config is loaded
+func hasReference(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!str" && strings.Contains(node.Value, referencePrefix)
+}
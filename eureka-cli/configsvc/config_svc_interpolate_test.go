@@ -0,0 +1,98 @@
+package configsvc_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupEnv(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+func TestInterpolate_ResolvesReferences(t *testing.T) {
+	// Arrange
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("s3cret\n"), 0600))
+	dir := writeConfigFiles(t, map[string]string{
+		"config.test.yaml": "application:\n  port-start: ${PORT_START}\n" +
+			"environment:\n  DB_HOST: ${DB_HOST:-postgres.eureka}\n  KAFKA_HOST: \"${KAFKA_HOST}\"\n  TEMPLATE: $${NOT_RESOLVED}\n" +
+			"sidecar-module:\n  environment:\n    JAVA_OPTIONS: -Xmx${HEAP:-256}m\n" +
+			"namespaces:\n  platform-complete-ui: ${USER_NAMESPACE}\n" +
+			"users:\n  diku_admin:\n    password: ${file:" + passwordFile + "}\n",
+	})
+	mc, err := configsvc.LoadConfig(filepath.Join(dir, "config.test.yaml"))
+	require.NoError(t, err)
+
+	// Act
+	interpolated, err := mc.Interpolate(lookupEnv(map[string]string{"PORT_START": "31000", "KAFKA_HOST": "kafka.aws", "USER_NAMESPACE": "jdoe"}), os.ReadFile)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, interpolated)
+	config := decodeMergedConfig(t, mc)
+	assert.Equal(t, map[string]any{"port-start": 31000}, config["application"])
+	assert.Equal(t, map[string]any{"DB_HOST": "postgres.eureka", "KAFKA_HOST": "kafka.aws", "TEMPLATE": "${NOT_RESOLVED}"}, config["environment"])
+	assert.Equal(t, map[string]any{"environment": map[string]any{"JAVA_OPTIONS": "-Xmx256m"}}, config["sidecar-module"])
+	assert.Equal(t, map[string]any{"platform-complete-ui": "jdoe"}, config["namespaces"])
+	assert.Equal(t, map[string]any{"diku_admin": map[string]any{"password": "s3cret"}}, config["users"])
+}
+
+func TestInterpolate_NoReferences(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{"config.test.yaml": "environment:\n  ENV: folio\n"})
+	mc, err := configsvc.LoadConfig(filepath.Join(dir, "config.test.yaml"))
+	require.NoError(t, err)
+
+	// Act
+	interpolated, err := mc.Interpolate(lookupEnv(nil), os.ReadFile)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, interpolated)
+}
+
+func TestInterpolate_UnresolvedReferences(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.test.yaml": "environment:\n  DB_PASSWORD: ${DB_PASSWORD}\n" +
+			"users:\n  diku_admin:\n    password: ${file:/does/not/exist}\n" +
+			"namespaces:\n  mod-orders: ${NAMESPACE\n",
+	})
+	mc, err := configsvc.LoadConfig(filepath.Join(dir, "config.test.yaml"))
+	require.NoError(t, err)
+
+	// Act
+	interpolated, err := mc.Interpolate(lookupEnv(nil), os.ReadFile)
+
+	// Assert
+	assert.False(t, interpolated)
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "environment.DB_PASSWORD: environment variable DB_PASSWORD is not set")
+	assert.Contains(t, err.Error(), "users.diku_admin.password: cannot read file reference")
+	assert.Contains(t, err.Error(), "namespaces.mod-orders: reference ${NAMESPACE is not closed")
+}
+
+func TestValidateConfig_AcceptsReferences(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.test.yaml": "application:\n  port-start: ${PORT_START:-30000}\n  fetch-descriptors: ${FETCH_DESCRIPTORS}\n" +
+			"tenants:\n  diku:\nusers:\n  diku_admin:\n    tenant: ${TENANT}\n",
+	})
+
+	// Act
+	problems, err := configsvc.New(testhelpers.NewMockAction()).ValidateConfig(filepath.Join(dir, "config.test.yaml"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, problems)
+}
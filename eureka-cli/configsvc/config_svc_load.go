@@ -0,0 +1,271 @@
+package configsvc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"go.yaml.in/yaml/v3"
+)
+
+// ConfigPrinter defines the interface for printing the merged config
+type ConfigPrinter interface {
+	WriteMergedConfig(configFile string, w io.Writer) error
+}
+
+// ConfigLayer is a single config file of a merged config
+type ConfigLayer struct {
+	File string
+	Root *yaml.Node
+}
+
+// MergedConfig is a config file deep-merged on top of the profiles it extends and with its local override file
+// merged on top of it, every node of the merged document remembers the file it was read from
+type MergedConfig struct {
+	Layers  []ConfigLayer
+	Root    *yaml.Node
+	sources map[*yaml.Node]string
+}
+
+// GetLocalConfigFile returns the path of the local override file of a config file, e.g. config.combined.local.yaml
+func GetLocalConfigFile(configFile string) string {
+	extension := filepath.Ext(configFile)
+	return strings.TrimSuffix(configFile, extension) + constant.LocalConfigSuffix + extension
+}
+
+// LoadConfig reads a config file together with the chain of profiles it extends and its optional local override
+// file, the layers are ordered from the base profile to the local override file
+func LoadConfig(configFile string) (*MergedConfig, error) {
+	return loadConfig(configFile, true)
+}
+
+func loadConfig(configFile string, withLocalConfig bool) (*MergedConfig, error) {
+	mc := &MergedConfig{sources: make(map[*yaml.Node]string)}
+	if err := mc.addProfileLayers(configFile, nil); err != nil {
+		return nil, err
+	}
+
+	localConfigFile := GetLocalConfigFile(configFile)
+	if _, err := os.Stat(localConfigFile); err == nil && withLocalConfig {
+		layer, err := mc.readLayer(localConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		mc.Layers = append(mc.Layers, layer)
+	}
+
+	mc.Root = mc.copyNode(mc.Layers[0].Root, "")
+	for _, layer := range mc.Layers[1:] {
+		mergeNode(mc.Root, mc.copyNode(layer.Root, ""))
+	}
+
+	return mc, nil
+}
+
+func (mc *MergedConfig) addProfileLayers(configFile string, chain []string) error {
+	chain = append(chain, configFile)
+	layer, err := mc.readLayer(configFile)
+	if err != nil {
+		return err
+	}
+
+	parent := removeMappingValue(layer.Root, field.Extends)
+	if parent != nil && !isNull(parent) {
+		if parent.Kind != yaml.ScalarNode || parent.Value == "" {
+			return errors.ConfigExtendsInvalid(configFile)
+		}
+		parentConfigFile := findParentConfigFile(configFile, parent.Value)
+		if slices.Contains(chain, parentConfigFile) {
+			return errors.ConfigExtendsCycle(append(chain, parentConfigFile))
+		}
+		if err := mc.addProfileLayers(parentConfigFile, chain); err != nil {
+			return err
+		}
+	}
+	mc.Layers = append(mc.Layers, layer)
+
+	return nil
+}
+
+// findParentConfigFile looks up the config of an extended profile next to the extending config first
+// and then in the profile directories
+func findParentConfigFile(configFile, profile string) string {
+	parentConfigFile := helpers.GetProfileConfigFile(filepath.Dir(configFile), profile)
+	if _, err := os.Stat(parentConfigFile); err == nil {
+		return parentConfigFile
+	}
+	if dirs, err := helpers.GetProfileDirs(); err == nil {
+		if foundConfigFile, err := helpers.FindProfileConfigFile(profile, dirs); err == nil {
+			return foundConfigFile
+		}
+	}
+
+	return parentConfigFile
+}
+
+func (mc *MergedConfig) readLayer(configFile string) (ConfigLayer, error) {
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return ConfigLayer{}, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return ConfigLayer{}, fmt.Errorf("%s: %w", configFile, err)
+	}
+	if len(document.Content) == 0 {
+		return ConfigLayer{File: configFile, Root: &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: 1, Column: 1}}, nil
+	}
+
+	root := resolveAlias(document.Content[0])
+	if root.Kind != yaml.MappingNode {
+		return ConfigLayer{}, errors.ConfigNotMapping(configFile)
+	}
+
+	return ConfigLayer{File: configFile, Root: mc.copyNode(root, configFile)}, nil
+}
+
+// Source returns the file a node of the merged config or of one of its layers was read from
+func (mc *MergedConfig) Source(node *yaml.Node) string {
+	return mc.sources[node]
+}
+
+// Marshal encodes the merged config, the result can be read by viper in place of the config file
+func (mc *MergedConfig) Marshal() ([]byte, error) {
+	return yaml.Marshal(mc.Root)
+}
+
+// copyNode deep copies a node with its aliases and merge keys expanded so that the layers can be merged
+// without sharing nodes, the copies are attributed to the file or, when it is empty, to the source of the original
+func (mc *MergedConfig) copyNode(node *yaml.Node, file string) *yaml.Node {
+	node = resolveAlias(node)
+	result := *node
+	result.Anchor = ""
+	result.Content = nil
+	if file != "" {
+		mc.sources[&result] = file
+	} else {
+		mc.sources[&result] = mc.sources[node]
+	}
+
+	if node.Kind != yaml.MappingNode {
+		for _, child := range node.Content {
+			result.Content = append(result.Content, mc.copyNode(child, file))
+		}
+		return &result
+	}
+
+	var merged []*yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != "<<" {
+			result.Content = append(result.Content, mc.copyNode(node.Content[i], file), mc.copyNode(node.Content[i+1], file))
+			continue
+		}
+		sources := []*yaml.Node{resolveAlias(node.Content[i+1])}
+		if sources[0].Kind == yaml.SequenceNode {
+			sources = sources[0].Content
+		}
+		for _, source := range sources {
+			if source = mc.copyNode(source, file); source.Kind == yaml.MappingNode {
+				merged = append(merged, source.Content...)
+			}
+		}
+	}
+	for i := 0; i+1 < len(merged); i += 2 {
+		if mappingValue(&result, merged[i].Value) == nil {
+			result.Content = append(result.Content, merged[i], merged[i+1])
+		}
+	}
+
+	return &result
+}
+
+// mergeNode deep merges the override mapping into the base mapping, other values of the override replace the base
+// values while a null override keeps the base value so that e.g. a module can be listed without repeating its settings
+func mergeNode(base, override *yaml.Node) {
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		keyNode, valueNode := override.Content[i], override.Content[i+1]
+		index := indexOfKey(base, keyNode.Value)
+
+		switch {
+		case index < 0:
+			base.Content = append(base.Content, keyNode, valueNode)
+		case isNull(valueNode):
+			continue
+		case base.Content[index+1].Kind == yaml.MappingNode && valueNode.Kind == yaml.MappingNode:
+			mergeNode(base.Content[index+1], valueNode)
+		default:
+			base.Content[index+1] = valueNode
+		}
+	}
+}
+
+func indexOfKey(node *yaml.Node, name string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func removeMappingValue(node *yaml.Node, name string) *yaml.Node {
+	index := indexOfKey(node, name)
+	if index < 0 {
+		return nil
+	}
+	value := node.Content[index+1]
+	node.Content = slices.Delete(node.Content, index, index+2)
+
+	return value
+}
+
+// WriteMergedConfig writes the merged config of a config file, every value is annotated with the file it was read from
+func (cs *ConfigSvc) WriteMergedConfig(configFile string, w io.Writer) error {
+	mc, err := LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	root := mc.copyNode(mc.Root, "")
+	annotateSources(mc, root)
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	_, err = w.Write(buffer.Bytes())
+
+	return err
+}
+
+// annotateSources replaces the comments of the config with the base name of the file every value was read from
+func annotateSources(mc *MergedConfig, node *yaml.Node) {
+	node.HeadComment, node.LineComment, node.FootComment = "", "", ""
+	for _, child := range node.Content {
+		annotateSources(mc, child)
+	}
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if valueNode := node.Content[i+1]; valueNode.Kind != yaml.MappingNode || len(valueNode.Content) == 0 {
+			valueNode.LineComment = filepath.Base(mc.Source(valueNode))
+		}
+	}
+}
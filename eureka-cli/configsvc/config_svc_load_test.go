@@ -0,0 +1,184 @@
+package configsvc_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+func writeConfigFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	return dir
+}
+
+func decodeMergedConfig(t *testing.T, mc *configsvc.MergedConfig) map[string]any {
+	t.Helper()
+	content, err := mc.Marshal()
+	require.NoError(t, err)
+
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+
+	return config
+}
+
+func TestGetLocalConfigFile(t *testing.T) {
+	assert.Equal(t, filepath.Join("home", "config.combined.local.yaml"), configsvc.GetLocalConfigFile(filepath.Join("home", "config.combined.yaml")))
+}
+
+func TestLoadConfig_ExtendsAndLocalOverride(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml": "profile:\n  name: base\napplication:\n  port-start: 30000\n  port-end: 30200\n" +
+			"environment:\n  ENV: folio\n  DB_HOST: postgres.eureka\n" +
+			"backend-modules:\n  mod-orders:\n    version: 1.0.0\n    deploy-sidecar: true\n  mod-users:\n    disable-system-user: true\n",
+		"config.child.yaml": "extends: base\nprofile:\n  name: child\n" +
+			"backend-modules:\n  mod-orders:\n    version: 2.0.0\n  mod-users:\n  mod-finance:\n",
+		"config.child.local.yaml": "environment:\n  ENV: local\n",
+	})
+	configFile := filepath.Join(dir, "config.child.yaml")
+
+	// Act
+	mc, err := configsvc.LoadConfig(configFile)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, mc.Layers, 3)
+	assert.Equal(t, filepath.Join(dir, "config.base.yaml"), mc.Layers[0].File)
+	assert.Equal(t, configFile, mc.Layers[1].File)
+	assert.Equal(t, filepath.Join(dir, "config.child.local.yaml"), mc.Layers[2].File)
+
+	config := decodeMergedConfig(t, mc)
+	assert.NotContains(t, config, "extends")
+	assert.Equal(t, map[string]any{"name": "child"}, config["profile"])
+	assert.Equal(t, map[string]any{"ENV": "local", "DB_HOST": "postgres.eureka"}, config["environment"])
+	assert.Equal(t, map[string]any{
+		"mod-orders":  map[string]any{"version": "2.0.0", "deploy-sidecar": true},
+		"mod-users":   map[string]any{"disable-system-user": true},
+		"mod-finance": nil,
+	}, config["backend-modules"])
+}
+
+func TestLoadConfig_WithoutLayers(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{"config.combined.yaml": "profile:\n  name: combined\n"})
+
+	// Act
+	mc, err := configsvc.LoadConfig(filepath.Join(dir, "config.combined.yaml"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, mc.Layers, 1)
+	assert.Equal(t, map[string]any{"profile": map[string]any{"name": "combined"}}, decodeMergedConfig(t, mc))
+}
+
+func TestLoadConfig_ExpandsAnchorsAcrossLayers(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml":  "x-java: &java\n  JAVA_OPTIONS: -Xmx400m\nenvironment:\n  <<: *java\n  ENV: folio\n",
+		"config.child.yaml": "extends: base\nenvironment:\n  JAVA_OPTIONS: -Xmx800m\n",
+	})
+
+	// Act
+	mc, err := configsvc.LoadConfig(filepath.Join(dir, "config.child.yaml"))
+
+	// Assert
+	require.NoError(t, err)
+	config := decodeMergedConfig(t, mc)
+	assert.Equal(t, map[string]any{"JAVA_OPTIONS": "-Xmx800m", "ENV": "folio"}, config["environment"])
+	assert.Equal(t, map[string]any{"JAVA_OPTIONS": "-Xmx400m"}, config["x-java"])
+}
+
+func TestLoadConfig_ExtendsCycle(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.a.yaml": "extends: b\n",
+		"config.b.yaml": "extends: a\n",
+	})
+
+	// Act
+	mc, err := configsvc.LoadConfig(filepath.Join(dir, "config.a.yaml"))
+
+	// Assert
+	assert.Nil(t, mc)
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "config extends cycle")
+}
+
+func TestLoadConfig_ExtendsInvalid(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{"config.a.yaml": "extends: [b, c]\n"})
+
+	// Act
+	_, err := configsvc.LoadConfig(filepath.Join(dir, "config.a.yaml"))
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "must be a profile name")
+}
+
+func TestLoadConfig_ParentNotFound(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{"config.a.yaml": "extends: missing\n"})
+
+	// Act
+	_, err := configsvc.LoadConfig(filepath.Join(dir, "config.a.yaml"))
+
+	// Assert
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestValidateConfig_ReportsProblemsOfEveryLayer(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml":        "roles:\n  admin-role:\n",
+		"config.child.yaml":       "extends: base\nusers:\n  admin:\n    roles: [admin-role, user-role]\n",
+		"config.child.local.yaml": "extends: base\n",
+	})
+
+	// Act
+	problems, err := configsvc.New(testhelpers.NewMockAction()).ValidateConfig(filepath.Join(dir, "config.child.yaml"))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, problems, 2)
+	assert.Equal(t, filepath.Join(dir, "config.child.yaml"), problems[0].File)
+	assert.Equal(t, "user-role is not defined in roles", problems[0].Message)
+	assert.Equal(t, filepath.Join(dir, "config.child.local.yaml"), problems[1].File)
+	assert.Equal(t, "unknown key extends", problems[1].Message)
+}
+
+func TestWriteMergedConfig_AnnotatesSources(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml":        "# Base profile\napplication:\n  name: app-base\n  port-start: 30000\n",
+		"config.child.yaml":       "extends: base\napplication:\n  port-start: 31000 # moved\n",
+		"config.child.local.yaml": "roles:\n  admin-role:\n    capability-sets: [all]\n",
+	})
+	var out bytes.Buffer
+
+	// Act
+	err := configsvc.New(testhelpers.NewMockAction()).WriteMergedConfig(filepath.Join(dir, "config.child.yaml"), &out)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "application:\n"+
+		"  name: app-base # config.base.yaml\n"+
+		"  port-start: 31000 # config.child.yaml\n"+
+		"roles:\n"+
+		"  admin-role:\n"+
+		"    capability-sets: [all] # config.child.local.yaml\n", out.String())
+}
@@ -0,0 +1,254 @@
+package configsvc
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"go.yaml.in/yaml/v3"
+)
+
+// ConfigScaffolder defines the interface for scaffolding new profile configs
+type ConfigScaffolder interface {
+	ScaffoldProfile(sourceConfigFile string, templateConfigFiles []string, profileName string, moduleNames []string) ([]byte, error)
+	GenerateProfile(sourceConfigFile string, templateConfigFiles []string, profileName string, backendModuleNames, frontendModuleNames []string) ([]byte, error)
+}
+
+// ScaffoldProfile returns the config of a new profile copied from the merged config of an existing profile without its
+// local override file, the backend modules are narrowed down to the module names while the management modules are always kept,
+// and the application gets the port range following the highest port range of the source and template profiles
+func (cs *ConfigSvc) ScaffoldProfile(sourceConfigFile string, templateConfigFiles []string, profileName string, moduleNames []string) ([]byte, error) {
+	mc, err := loadConfig(sourceConfigFile, false)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := loadTemplateRoots(mc.Root, sourceConfigFile, templateConfigFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(moduleNames) > 0 {
+		sourceProfileName := mappingValue(mappingValue(mc.Root, field.Profile), key(field.ProfileName))
+		if err := filterBackendModules(mc.Root, sourceProfileName, moduleNames); err != nil {
+			return nil, err
+		}
+	}
+	setPortRange(mc.Root, roots)
+	setScalar(mc.Root, field.Profile, key(field.ProfileName), profileName)
+	setScalar(mc.Root, field.Application, key(field.ApplicationName), "app-"+profileName)
+
+	return encodeProfile(mc.Root)
+}
+
+// GenerateProfile returns the config of a new profile copied from the merged config of an existing profile without its
+// local override file, the backend and frontend modules are replaced by the module names while the management modules
+// are always kept, the settings of a module are taken from the source profile or else from the first template profile
+// that has the module, and the application gets the port range following the highest port range of these profiles
+func (cs *ConfigSvc) GenerateProfile(sourceConfigFile string, templateConfigFiles []string, profileName string, backendModuleNames, frontendModuleNames []string) ([]byte, error) {
+	mc, err := loadConfig(sourceConfigFile, false)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := loadTemplateRoots(mc.Root, sourceConfigFile, templateConfigFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	setModules(mc.Root, field.BackendModules, backendModuleNames, roots, func(moduleName string) bool {
+		return strings.HasPrefix(moduleName, constant.ManagementModulePattern)
+	})
+	enableModules(mc.Root, field.BackendModules, constant.GetSystemContainerModules())
+	setModules(mc.Root, field.FrontendModules, frontendModuleNames, roots, func(string) bool {
+		return false
+	})
+	setPortRange(mc.Root, roots)
+	setScalar(mc.Root, field.Profile, key(field.ProfileName), profileName)
+	setScalar(mc.Root, field.Application, key(field.ApplicationName), "app-"+profileName)
+
+	return encodeProfile(mc.Root)
+}
+
+// loadTemplateRoots returns the root of the source profile followed by the roots of the merged configs of the template profiles
+func loadTemplateRoots(root *yaml.Node, sourceConfigFile string, templateConfigFiles []string) ([]*yaml.Node, error) {
+	roots := []*yaml.Node{root}
+	for _, templateConfigFile := range templateConfigFiles {
+		if templateConfigFile == sourceConfigFile {
+			continue
+		}
+		template, err := loadConfig(templateConfigFile, false)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, template.Root)
+	}
+
+	return roots, nil
+}
+
+func encodeProfile(root *yaml.Node) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// setModules replaces the entries of a modules section, the kept entries of the source profile stay in their order
+// and are followed by the other modules with the settings of the first profile that has them
+func setModules(root *yaml.Node, section string, moduleNames []string, roots []*yaml.Node, keep func(moduleName string) bool) {
+	modules := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if len(moduleNames) == 0 {
+		modules.Style = yaml.FlowStyle
+	}
+
+	var added []string
+	if sourceModules := mappingValue(root, section); sourceModules != nil && sourceModules.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(sourceModules.Content); i += 2 {
+			moduleName := sourceModules.Content[i].Value
+			if keep(moduleName) || slices.Contains(moduleNames, moduleName) {
+				modules.Content = append(modules.Content, sourceModules.Content[i], sourceModules.Content[i+1])
+				added = append(added, moduleName)
+			}
+		}
+	}
+	for _, moduleName := range moduleNames {
+		if slices.Contains(added, moduleName) {
+			continue
+		}
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}
+		for _, templateRoot := range roots[1:] {
+			if templateModules := mappingValue(templateRoot, section); templateModules != nil && templateModules.Kind == yaml.MappingNode {
+				if index := indexOfKey(templateModules, moduleName); index >= 0 {
+					value = templateModules.Content[index+1]
+					break
+				}
+			}
+		}
+		modules.Content = append(modules.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: moduleName}, value)
+		added = append(added, moduleName)
+	}
+
+	setNode(root, section, modules)
+}
+
+// enableModules sets deploy-module on the modules of a section that are listed without settings, a module without
+// settings does not enable the system containers it requires, e.g. OpenSearch for mod-search
+func enableModules(root *yaml.Node, section string, moduleNames []string) {
+	modules := mappingValue(root, section)
+	if modules == nil || modules.Kind != yaml.MappingNode {
+		return
+	}
+	for _, moduleName := range moduleNames {
+		index := indexOfKey(modules, moduleName)
+		if index < 0 || modules.Content[index+1].Tag != "!!null" {
+			continue
+		}
+		modules.Content[index+1] = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: field.ModuleDeployModuleEntry},
+			{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"},
+		}}
+	}
+}
+
+// setPortRange gives the application a port range of the size of the source range right after the highest port range
+// of the profiles, so that the containers of the new profile can run next to the containers of the others
+func setPortRange(root *yaml.Node, roots []*yaml.Node) {
+	start, end, ok := getPortRange(root)
+	if !ok {
+		return
+	}
+
+	highestEnd := end
+	for _, templateRoot := range roots[1:] {
+		if _, templateEnd, ok := getPortRange(templateRoot); ok && templateEnd > highestEnd {
+			highestEnd = templateEnd
+		}
+	}
+	newStart := highestEnd + 1
+	newEnd := newStart + end - start
+	setSectionNode(root, field.Application, key(field.ApplicationPortStart), &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(newStart)})
+	setSectionNode(root, field.Application, key(field.ApplicationPortEnd), &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(newEnd)})
+}
+
+func getPortRange(root *yaml.Node) (int, int, bool) {
+	application := mappingValue(root, field.Application)
+	portStart, portEnd := mappingValue(application, key(field.ApplicationPortStart)), mappingValue(application, key(field.ApplicationPortEnd))
+	if portStart == nil || portEnd == nil {
+		return 0, 0, false
+	}
+	start, startErr := strconv.Atoi(portStart.Value)
+	end, endErr := strconv.Atoi(portEnd.Value)
+	if startErr != nil || endErr != nil || start > end {
+		return 0, 0, false
+	}
+
+	return start, end, true
+}
+
+func filterBackendModules(root, sourceProfileName *yaml.Node, moduleNames []string) error {
+	backendModules := mappingValue(root, field.BackendModules)
+	var configured []string
+	if backendModules != nil {
+		configured = mappingKeys(backendModules)
+	}
+
+	var missing []string
+	for _, moduleName := range moduleNames {
+		if !slices.Contains(configured, moduleName) {
+			missing = append(missing, moduleName)
+		}
+	}
+	if len(missing) > 0 {
+		profileName := ""
+		if sourceProfileName != nil {
+			profileName = sourceProfileName.Value
+		}
+		return errors.ModulesNotInProfile(profileName, missing)
+	}
+
+	var content []*yaml.Node
+	for i := 0; i+1 < len(backendModules.Content); i += 2 {
+		moduleName := backendModules.Content[i].Value
+		if strings.HasPrefix(moduleName, constant.ManagementModulePattern) || slices.Contains(moduleNames, moduleName) {
+			content = append(content, backendModules.Content[i], backendModules.Content[i+1])
+		}
+	}
+	backendModules.Content = content
+
+	return nil
+}
+
+// setScalar sets a string value of a section, the section and the key are added when they are missing
+func setScalar(root *yaml.Node, section, name, value string) {
+	setSectionNode(root, section, name, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
+
+// setSectionNode sets a value of a section, the section and the key are added when they are missing
+func setSectionNode(root *yaml.Node, section, name string, valueNode *yaml.Node) {
+	sectionNode := mappingValue(root, section)
+	if sectionNode == nil || sectionNode.Kind != yaml.MappingNode {
+		sectionNode = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		setNode(root, section, sectionNode)
+	}
+	setNode(sectionNode, name, valueNode)
+}
+
+// setNode sets the value of a key of a mapping, the key is added when it is missing
+func setNode(node *yaml.Node, name string, valueNode *yaml.Node) {
+	if index := indexOfKey(node, name); index >= 0 {
+		node.Content[index+1] = valueNode
+		return
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}, valueNode)
+}
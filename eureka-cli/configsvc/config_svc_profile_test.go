@@ -0,0 +1,156 @@
+package configsvc_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+func TestScaffoldProfile_KeepsSelectedModules(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml": "profile:\n  name: base\napplication:\n  name: app-base\n  version: 1.0.0\n" +
+			"backend-modules:\n  mgr-tenants:\n  mod-orders:\n    version: 1.0.0\n  mod-finance:\n  mod-users:\n",
+		"config.base.local.yaml": "application:\n  version: 9.9.9\n",
+	})
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).ScaffoldProfile(filepath.Join(dir, "config.base.yaml"), nil, "acquisitions", []string{"mod-orders", "mod-finance"})
+
+	// Assert
+	require.NoError(t, err)
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	assert.Equal(t, map[string]any{"name": "acquisitions"}, config["profile"])
+	assert.Equal(t, map[string]any{"name": "app-acquisitions", "version": "1.0.0"}, config["application"])
+	assert.Equal(t, map[string]any{"mgr-tenants": nil, "mod-orders": map[string]any{"version": "1.0.0"}, "mod-finance": nil}, config["backend-modules"])
+}
+
+func TestScaffoldProfile_AllModules(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml":  "profile:\n  name: base\nbackend-modules:\n  mod-orders:\n",
+		"config.child.yaml": "extends: base\nbackend-modules:\n  mod-users:\n",
+	})
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).ScaffoldProfile(filepath.Join(dir, "config.child.yaml"), nil, "copy", nil)
+
+	// Assert
+	require.NoError(t, err)
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	assert.NotContains(t, config, "extends")
+	assert.Equal(t, map[string]any{"name": "copy"}, config["profile"])
+	assert.Equal(t, map[string]any{"mod-orders": nil, "mod-users": nil}, config["backend-modules"])
+}
+
+func TestScaffoldProfile_MovesPortRange(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml":   "profile:\n  name: base\napplication:\n  port-start: 30000\n  port-end: 30999\nbackend-modules:\n  mod-orders:\n",
+		"config.search.yaml": "profile:\n  name: search\napplication:\n  port-start: 32000\n  port-end: 32999\n",
+	})
+	templateConfigFiles := []string{filepath.Join(dir, "config.base.yaml"), filepath.Join(dir, "config.search.yaml")}
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).ScaffoldProfile(filepath.Join(dir, "config.base.yaml"), templateConfigFiles, "copy", nil)
+
+	// Assert
+	require.NoError(t, err)
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	assert.Equal(t, map[string]any{"name": "app-copy", "port-start": 33000, "port-end": 33999}, config["application"])
+}
+
+func TestScaffoldProfile_ModuleNotInProfile(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml": "profile:\n  name: base\nbackend-modules:\n  mod-orders:\n",
+	})
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).ScaffoldProfile(filepath.Join(dir, "config.base.yaml"), nil, "acquisitions", []string{"mod-orders", "mod-invoice"})
+
+	// Assert
+	assert.Nil(t, content)
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "mod-invoice")
+}
+
+func TestGenerateProfile_ReplacesModules(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml": "profile:\n  name: base\napplication:\n  name: app-base\n  port-start: 30000\n  port-end: 30999\n" +
+			"backend-modules:\n  mgr-tenants:\n  mod-users-keycloak:\n    port: 9904\n  mod-orders:\n    disable-system-user: true\n  mod-users:\n" +
+			"frontend-modules:\n  folio_users:\n  folio_orders:\n",
+		"config.search.yaml": "profile:\n  name: search\napplication:\n  port-start: 32000\n  port-end: 32999\n" +
+			"backend-modules:\n  mod-search:\n    use-okapi-url: true\nfrontend-modules: []\n",
+	})
+	templateConfigFiles := []string{filepath.Join(dir, "config.base.yaml"), filepath.Join(dir, "config.search.yaml")}
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).GenerateProfile(filepath.Join(dir, "config.base.yaml"), templateConfigFiles, "orders",
+		[]string{"mod-users-keycloak", "mod-orders", "mod-search", "mod-finance"}, []string{"folio_orders"})
+
+	// Assert
+	require.NoError(t, err)
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	assert.Equal(t, map[string]any{"name": "orders"}, config["profile"])
+	assert.Equal(t, map[string]any{"name": "app-orders", "port-start": 33000, "port-end": 33999}, config["application"])
+	assert.Equal(t, map[string]any{
+		"mgr-tenants":        nil,
+		"mod-users-keycloak": map[string]any{"port": 9904},
+		"mod-orders":         map[string]any{"disable-system-user": true},
+		"mod-search":         map[string]any{"use-okapi-url": true},
+		"mod-finance":        nil,
+	}, config["backend-modules"])
+	assert.Equal(t, map[string]any{"folio_orders": nil}, config["frontend-modules"])
+	assert.Contains(t, string(content), "  mgr-tenants:\n  mod-users-keycloak:\n    port: 9904\n  mod-orders:\n")
+}
+
+func TestGenerateProfile_EnablesSystemContainerModules(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml": "profile:\n  name: base\nbackend-modules:\n  mod-orders:\n  mod-data-export-worker:\nfrontend-modules:\n",
+	})
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).GenerateProfile(filepath.Join(dir, "config.base.yaml"), nil, "export",
+		[]string{"mod-orders", "mod-data-export-worker", "mod-search"}, nil)
+
+	// Assert
+	require.NoError(t, err)
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	assert.Equal(t, map[string]any{
+		"mod-orders":             nil,
+		"mod-data-export-worker": map[string]any{"deploy-module": true},
+		"mod-search":             map[string]any{"deploy-module": true},
+	}, config["backend-modules"])
+}
+
+func TestGenerateProfile_NoFrontendModules(t *testing.T) {
+	// Arrange
+	dir := writeConfigFiles(t, map[string]string{
+		"config.base.yaml": "profile:\n  name: base\nbackend-modules:\n  mod-orders:\nfrontend-modules:\n  folio_orders:\n",
+	})
+
+	// Act
+	content, err := configsvc.New(testhelpers.NewMockAction()).GenerateProfile(filepath.Join(dir, "config.base.yaml"), nil, "orders", []string{"mod-orders"}, nil)
+
+	// Assert
+	require.NoError(t, err)
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	assert.Equal(t, map[string]any{"name": "app-orders"}, config["application"])
+	assert.Equal(t, map[string]any{"mod-orders": nil}, config["backend-modules"])
+	assert.Equal(t, map[string]any{}, config["frontend-modules"])
+}
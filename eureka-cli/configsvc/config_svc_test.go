@@ -0,0 +1,173 @@
+package configsvc_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validate(t *testing.T, content string) []models.ConfigProblem {
+	t.Helper()
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+
+	problems, err := configsvc.New(testhelpers.NewMockAction()).ValidateConfig(configFile)
+	require.NoError(t, err)
+
+	return problems
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+
+	// Act
+	svc := configsvc.New(action)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+}
+
+func TestValidateConfig_ShippedConfigsAreValid(t *testing.T) {
+	// Arrange
+	configFiles, err := filepath.Glob("../config.*.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, configFiles)
+	svc := configsvc.New(testhelpers.NewMockAction())
+
+	for _, configFile := range configFiles {
+		// Act
+		problems, err := svc.ValidateConfig(configFile)
+
+		// Assert
+		assert.NoError(t, err)
+		for _, problem := range problems {
+			assert.True(t, problem.Warning, problem.String())
+		}
+	}
+}
+
+func TestValidateConfig_DeprecatedKey(t *testing.T) {
+	// Act
+	problems := validate(t, "sidecar-module:\n  resources:\n    cpus: 1\nusers:\n  diku_admin:\n    permissions: [\"perms.all\"]\n")
+
+	// Assert
+	require.Len(t, problems, 1)
+	assert.True(t, problems[0].Warning)
+	assert.Equal(t, 3, problems[0].Line)
+	assert.Equal(t, "sidecar-module.resources.cpus", problems[0].Path)
+	assert.Equal(t, "cpus is deprecated and has no effect, use cpu-count instead", problems[0].Message)
+	assert.Contains(t, problems[0].String(), "sidecar-module.resources.cpus: warning: cpus is deprecated")
+}
+
+func TestValidateConfig_UnknownKeyWithLine(t *testing.T) {
+	// Act
+	problems := validate(t, "application:\n  name: app-combined\n  port-strat: 30000\n")
+
+	// Assert
+	require.Len(t, problems, 1)
+	assert.Equal(t, 3, problems[0].Line)
+	assert.Equal(t, 3, problems[0].Column)
+	assert.Equal(t, "application.port-strat", problems[0].Path)
+	assert.Equal(t, "unknown key port-strat, did you mean port-start?", problems[0].Message)
+	assert.Contains(t, problems[0].String(), "config.test.yaml:3:3: application.port-strat: unknown key")
+}
+
+func TestValidateConfig_TypeMismatch(t *testing.T) {
+	// Act
+	problems := validate(t, "backend-modules:\n  mod-orders:\n    deploy-module: yes please\n    port: 9131\n    resources:\n      memory: a lot\n")
+
+	// Assert
+	require.Len(t, problems, 2)
+	assert.Equal(t, "backend-modules.mod-orders.deploy-module", problems[0].Path)
+	assert.Equal(t, `expected a boolean, got str "yes please"`, problems[0].Message)
+	assert.Equal(t, 6, problems[1].Line)
+	assert.Equal(t, `expected an integer, got str "a lot"`, problems[1].Message)
+}
+
+func TestValidateConfig_NullEntriesAndAnchorsAreAccepted(t *testing.T) {
+	// Act
+	problems := validate(t, "x-env: &env\n  A: b\n"+
+		"backend-modules:\n  mod-users:\n  mod-orders:\n    environment:\n      <<: *env\n"+
+		"frontend-modules: []\n")
+
+	// Assert
+	require.Len(t, problems, 1)
+	assert.Equal(t, "unknown key x-env", problems[0].Message)
+}
+
+func TestValidateConfig_CrossReferences(t *testing.T) {
+	// Act
+	problems := validate(t, "consortiums:\n  ecs:\n"+
+		"tenants:\n  ecs-central:\n    consortium: ecs\n  university:\n    consortium: ecss\n"+
+		"roles:\n  admin-role:\n    tenant: ecs-centrl\n"+
+		"users:\n  ecs_admin:\n    tenant: ecs-central\n    roles: [admin-role, missing-role]\n")
+
+	// Assert
+	require.Len(t, problems, 3)
+	assert.Equal(t, "tenants.university.consortium", problems[0].Path)
+	assert.Equal(t, "ecss is not defined in consortiums", problems[0].Message)
+	assert.Equal(t, "roles.admin-role.tenant", problems[1].Path)
+	assert.Equal(t, "ecs-centrl is not defined in tenants", problems[1].Message)
+	assert.Equal(t, "users.ecs_admin.roles", problems[2].Path)
+	assert.Equal(t, "missing-role is not defined in roles", problems[2].Message)
+}
+
+func TestValidateConfig_Dependencies(t *testing.T) {
+	t.Run("TestValidateConfig_Dependencies_SingleParent", func(t *testing.T) {
+		// Act
+		problems := validate(t, "application:\n  dependencies:\n    name: app-platform-minimal\n    version: 2.0.0\n")
+
+		// Assert
+		assert.Empty(t, problems)
+	})
+
+	t.Run("TestValidateConfig_Dependencies_MultipleParents", func(t *testing.T) {
+		// Act
+		problems := validate(t, "application:\n  dependencies:\n    platform:\n      name: app-platform-minimal\n      version: 2.0.0\n    search:\n      name: app-search\n")
+
+		// Assert
+		require.Len(t, problems, 1)
+		assert.Equal(t, "application.dependencies.search", problems[0].Path)
+		assert.Equal(t, "missing version of the parent application", problems[0].Message)
+	})
+
+	t.Run("TestValidateConfig_Dependencies_WrongShape", func(t *testing.T) {
+		// Act
+		problems := validate(t, "application:\n  dependencies: [app-platform-minimal]\n")
+
+		// Assert
+		require.Len(t, problems, 1)
+		assert.Equal(t, "expected a map, got a list", problems[0].Message)
+	})
+}
+
+func TestValidateConfig_PortRange(t *testing.T) {
+	// Act
+	problems := validate(t, "application:\n  port-start: 30000\n  port-end: 30000\n")
+
+	// Assert
+	require.Len(t, problems, 1)
+	assert.Equal(t, 2, problems[0].Line)
+	assert.Equal(t, "port-start 30000 must be lower than port-end 30000", problems[0].Message)
+}
+
+func TestValidateConfig_MalformedYAML(t *testing.T) {
+	// Arrange
+	configFile := filepath.Join(t.TempDir(), "config.test.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("application:\n  name: [app\n"), 0600))
+
+	// Act
+	problems, err := configsvc.New(testhelpers.NewMockAction()).ValidateConfig(configFile)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, problems)
+}
@@ -0,0 +1,500 @@
+package configsvc
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"go.yaml.in/yaml/v3"
+)
+
+// ConfigValidator defines the interface for config validation operations
+type ConfigValidator interface {
+	ValidateConfig(configFile string) ([]models.ConfigProblem, error)
+}
+
+type schemaKind int
+
+const (
+	stringKind schemaKind = iota
+	boolKind
+	intKind
+	listKind
+	envKind
+	objectKind
+	entriesKind
+	dependenciesKind
+)
+
+// schemaNode describes the allowed shape of a config value, an object has a fixed set of keys while
+// the entries of a section such as tenants or backend-modules are named by the user and share one schema
+type schemaNode struct {
+	kind   schemaKind
+	fields map[string]*schemaNode
+	entry  *schemaNode
+	// supersededBy names the key that replaces a deprecated key
+	supersededBy string
+}
+
+var (
+	stringValue       = &schemaNode{kind: stringKind}
+	boolValue         = &schemaNode{kind: boolKind}
+	intValue          = &schemaNode{kind: intKind}
+	listValue         = &schemaNode{kind: listKind}
+	envValue          = &schemaNode{kind: envKind}
+	dependenciesValue = &schemaNode{kind: dependenciesKind}
+)
+
+func object(fields map[string]*schemaNode) *schemaNode {
+	return &schemaNode{kind: objectKind, fields: fields}
+}
+
+func entries(entry *schemaNode) *schemaNode {
+	return &schemaNode{kind: entriesKind, entry: entry}
+}
+
+// deprecated accepts a key that is kept for compatibility with older configs and warns about it
+func deprecated(node *schemaNode, supersededBy string) *schemaNode {
+	return &schemaNode{kind: node.kind, fields: node.fields, entry: node.entry, supersededBy: supersededBy}
+}
+
+// key returns the last segment of a dotted field path, e.g. port-start of application.port-start
+func key(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+// getConfigSchema derives the schema of a config file from the keys of the field package
+func getConfigSchema() *schemaNode {
+	resources := object(map[string]*schemaNode{
+		field.ModuleResourceCpuCountEntry:          intValue,
+		field.ModuleResourceCpusEntry:              deprecated(intValue, field.ModuleResourceCpuCountEntry),
+		field.ModuleResourceMemoryReservationEntry: intValue,
+		field.ModuleResourceMemoryEntry:            intValue,
+		field.ModuleResourceMemorySwapEntry:        intValue,
+		field.ModuleResourceOomKillDisableEntry:    boolValue,
+	})
+	frontendModule := object(map[string]*schemaNode{
+		field.ModuleDeployModuleEntry:        boolValue,
+		field.ModuleVersionEntry:             stringValue,
+		field.ModuleLocalDescriptorPathEntry: stringValue,
+	})
+
+	return object(map[string]*schemaNode{
+		field.Profile: object(map[string]*schemaNode{
+			key(field.ProfileName): stringValue,
+		}),
+		field.Application: object(map[string]*schemaNode{
+			key(field.ApplicationName):             stringValue,
+			key(field.ApplicationVersion):          stringValue,
+			key(field.ApplicationFetchDescriptors): boolValue,
+			key(field.ApplicationPortStart):        intValue,
+			key(field.ApplicationPortEnd):          intValue,
+			key(field.ApplicationStripesBranch):    stringValue,
+			key(field.ApplicationGatewayHostname):  stringValue,
+			key(field.ApplicationDependencies):     dependenciesValue,
+		}),
+		field.Lsp:        object(map[string]*schemaNode{key(field.LspURL): stringValue}),
+		field.Far:        object(map[string]*schemaNode{key(field.FarURL): stringValue}),
+		field.Registry:   object(map[string]*schemaNode{key(field.RegistryURL): stringValue}),
+		field.Namespaces: object(map[string]*schemaNode{key(field.NamespacesPlatformLspUI): stringValue}),
+		field.Env:        envValue,
+		field.Consortiums: entries(object(map[string]*schemaNode{
+			field.ConsortiumCreateConsortiumEntry:      boolValue,
+			field.ConsortiumEnableCentralOrderingEntry: boolValue,
+		})),
+		field.Tenants: entries(object(map[string]*schemaNode{
+			field.TenantsDeployUIEntry:         boolValue,
+			field.TenantsSingleTenantEntry:     boolValue,
+			field.TenantsEnableEcsRequestEntry: boolValue,
+			field.TenantsConsortiumEntry:       stringValue,
+			field.TenantsCentralTenantEntry:    boolValue,
+			field.TenantsPlatformLspURLEntry:   stringValue,
+		})),
+		field.Users: entries(object(map[string]*schemaNode{
+			field.UsersConsortiumEntry:  stringValue,
+			field.UsersTenantEntry:      stringValue,
+			field.UsersPasswordEntry:    stringValue,
+			field.UsersLastNameEntry:    stringValue,
+			field.UsersFirstNameEntry:   stringValue,
+			field.UsersRolesEntry:       listValue,
+			field.UsersPermissionsEntry: listValue,
+		})),
+		field.Roles: entries(object(map[string]*schemaNode{
+			field.RolesConsortiumEntry:     stringValue,
+			field.RolesTenantEntry:         stringValue,
+			field.RolesCapabilitySetsEntry: listValue,
+		})),
+		field.SidecarModule: object(map[string]*schemaNode{
+			field.SidecarModuleImageEntry:           stringValue,
+			field.SidecarModuleCustomNamespaceEntry: boolValue,
+			field.SidecarModuleVersionEntry:         stringValue,
+			key(field.SidecarModuleCmd):             listValue,
+			key(field.SidecarModuleNativeBinaryCmd): listValue,
+			key(field.SidecarModuleEnv):             envValue,
+			key(field.SidecarModuleResources):       resources,
+		}),
+		field.BackendModules: entries(object(map[string]*schemaNode{
+			field.ModuleDeployModuleEntry:        boolValue,
+			field.ModuleDeploySidecarEntry:       boolValue,
+			field.ModuleVersionEntry:             stringValue,
+			field.ModulePortEntry:                intValue,
+			field.ModulePrivatePortEntry:         intValue,
+			field.ModulePortServerEntry:          intValue,
+			field.ModuleUseVaultEntry:            boolValue,
+			field.ModuleUseOkapiURLEntry:         boolValue,
+			field.ModuleDisableSystemUserEntry:   boolValue,
+			field.ModuleLocalDescriptorPathEntry: stringValue,
+			field.ModuleEnvEntry:                 envValue,
+			field.ModuleSidecarEnvEntry:          envValue,
+			field.ModuleVolumesEntry:             listValue,
+			field.ModuleResourceEntry:            resources,
+		})),
+		field.FrontendModules:       entries(frontendModule),
+		field.CustomFrontendModules: entries(frontendModule),
+		field.ExtraVolumes:          listValue,
+		field.TemplateEnv:           envValue,
+	})
+}
+
+// configValidator collects the problems of a merged config
+type configValidator struct {
+	config   *MergedConfig
+	problems []models.ConfigProblem
+}
+
+// ValidateConfig checks every layer of a config file against the schema and the merged config against the references
+// between its sections, the problems are reported with their file, line and column while a file that cannot be read
+// or parsed fails the validation
+func (cs *ConfigSvc) ValidateConfig(configFile string) ([]models.ConfigProblem, error) {
+	mc, err := LoadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &configValidator{config: mc}
+	schema := getConfigSchema()
+	for _, layer := range mc.Layers {
+		v.validate(layer.Root, schema, "")
+	}
+	v.checkReferences(mc.Root)
+	v.checkPortRange(mc.Root)
+
+	layerIndex := func(file string) int {
+		return slices.IndexFunc(mc.Layers, func(layer ConfigLayer) bool { return layer.File == file })
+	}
+	slices.SortStableFunc(v.problems, func(a, b models.ConfigProblem) int {
+		if a.File != b.File {
+			return layerIndex(a.File) - layerIndex(b.File)
+		}
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+
+	return v.problems, nil
+}
+
+func (v *configValidator) addProblem(node *yaml.Node, path string, format string, args ...any) {
+	v.problems = append(v.problems, models.ConfigProblem{
+		File:    v.config.Source(node),
+		Line:    node.Line,
+		Column:  node.Column,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *configValidator) addWarning(node *yaml.Node, path string, format string, args ...any) {
+	v.addProblem(node, path, format, args...)
+	v.problems[len(v.problems)-1].Warning = true
+}
+
+func (v *configValidator) addTypeMismatch(node *yaml.Node, path string, expected string) {
+	v.addProblem(node, path, "expected %s, got %s", expected, describeNode(node))
+}
+
+func (v *configValidator) validate(node *yaml.Node, schema *schemaNode, path string) {
+	node = resolveAlias(node)
+	if isNull(node) {
+		return
+	}
+
+	switch schema.kind {
+	case stringKind:
+		if node.Kind != yaml.ScalarNode {
+			v.addTypeMismatch(node, path, "a string")
+		}
+	case boolKind:
+		if node.Kind != yaml.ScalarNode || (node.Tag != "!!bool" && !hasReference(node)) {
+			v.addTypeMismatch(node, path, "a boolean")
+		}
+	case intKind:
+		if node.Kind != yaml.ScalarNode || (node.Tag != "!!int" && !hasReference(node)) {
+			v.addTypeMismatch(node, path, "an integer")
+		}
+	case listKind:
+		if node.Kind != yaml.SequenceNode {
+			v.addTypeMismatch(node, path, "a list")
+			return
+		}
+		for i, item := range node.Content {
+			if item = resolveAlias(item); item.Kind != yaml.ScalarNode {
+				v.addTypeMismatch(item, fmt.Sprintf("%s[%d]", path, i), "a scalar")
+			}
+		}
+	case envKind:
+		if node.Kind != yaml.MappingNode {
+			v.addTypeMismatch(node, path, "a map")
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "<<" {
+				continue
+			}
+			if value := resolveAlias(node.Content[i+1]); value.Kind != yaml.ScalarNode {
+				v.addTypeMismatch(value, joinPath(path, node.Content[i].Value), "a scalar")
+			}
+		}
+	case objectKind:
+		if node.Kind != yaml.MappingNode {
+			v.addTypeMismatch(node, path, "a map")
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			if keyNode.Value == "<<" {
+				continue
+			}
+			childPath := joinPath(path, keyNode.Value)
+			child, ok := schema.fields[keyNode.Value]
+			if !ok {
+				v.addUnknownKey(keyNode, childPath, schema)
+				continue
+			}
+			if child.supersededBy != "" {
+				v.addWarning(keyNode, childPath, "%s is deprecated and has no effect, use %s instead", keyNode.Value, child.supersededBy)
+			}
+			v.validate(valueNode, child, childPath)
+		}
+	case entriesKind:
+		if node.Kind == yaml.SequenceNode && len(node.Content) == 0 {
+			return
+		}
+		if node.Kind != yaml.MappingNode {
+			v.addTypeMismatch(node, path, "a map")
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v.validate(node.Content[i+1], schema.entry, joinPath(path, node.Content[i].Value))
+		}
+	case dependenciesKind:
+		v.validateDependencies(node, path)
+	}
+}
+
+func (v *configValidator) addUnknownKey(keyNode *yaml.Node, path string, schema *schemaNode) {
+	var (
+		suggestion string
+		distance   = 3
+	)
+	for name := range schema.fields {
+		if d := levenshtein(keyNode.Value, name); d < distance || (d == distance && name < suggestion) {
+			suggestion, distance = name, d
+		}
+	}
+	if suggestion == "" {
+		v.addProblem(keyNode, path, "unknown key %s", keyNode.Value)
+		return
+	}
+	v.addProblem(keyNode, path, "unknown key %s, did you mean %s?", keyNode.Value, suggestion)
+}
+
+// validateDependencies accepts the single parent format with name and version keys as well as
+// the multiple parents format where every entry has its own name and version keys
+func (v *configValidator) validateDependencies(node *yaml.Node, path string) {
+	if node.Kind != yaml.MappingNode {
+		v.addTypeMismatch(node, path, "a map")
+		return
+	}
+	if mappingValue(node, "name") != nil {
+		v.validateDependency(node, path)
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		entry := resolveAlias(node.Content[i+1])
+		entryPath := joinPath(path, node.Content[i].Value)
+		if entry.Kind != yaml.MappingNode {
+			v.addTypeMismatch(entry, entryPath, "a map with name and version")
+			continue
+		}
+		v.validateDependency(entry, entryPath)
+	}
+}
+
+func (v *configValidator) validateDependency(node *yaml.Node, path string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], resolveAlias(node.Content[i+1])
+		switch keyNode.Value {
+		case "name", "version":
+			if valueNode.Kind != yaml.ScalarNode || isNull(valueNode) || valueNode.Value == "" {
+				v.addProblem(valueNode, joinPath(path, keyNode.Value), "expected a non-empty %s of the parent application", keyNode.Value)
+			}
+		default:
+			v.addProblem(keyNode, joinPath(path, keyNode.Value), "unknown key %s, a dependency has only name and version", keyNode.Value)
+		}
+	}
+	if mappingValue(node, "version") == nil {
+		v.addProblem(node, path, "missing version of the parent application")
+	}
+}
+
+func (v *configValidator) checkReferences(root *yaml.Node) {
+	consortiums := mappingKeys(mappingValue(root, field.Consortiums))
+	tenants := mappingKeys(mappingValue(root, field.Tenants))
+	roles := mappingKeys(mappingValue(root, field.Roles))
+
+	v.checkReference(root, field.Tenants, field.TenantsConsortiumEntry, field.Consortiums, consortiums)
+	v.checkReference(root, field.Roles, field.RolesConsortiumEntry, field.Consortiums, consortiums)
+	v.checkReference(root, field.Roles, field.RolesTenantEntry, field.Tenants, tenants)
+	v.checkReference(root, field.Users, field.UsersConsortiumEntry, field.Consortiums, consortiums)
+	v.checkReference(root, field.Users, field.UsersTenantEntry, field.Tenants, tenants)
+	v.checkReference(root, field.Users, field.UsersRolesEntry, field.Roles, roles)
+}
+
+// checkReference checks that the values of an entry key, either a single name or a list of names,
+// are defined in the target section
+func (v *configValidator) checkReference(root *yaml.Node, section, entryKey, targetSection string, targets []string) {
+	sectionNode := mappingValue(root, section)
+	if sectionNode == nil || sectionNode.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(sectionNode.Content); i += 2 {
+		entry := resolveAlias(sectionNode.Content[i+1])
+		if entry.Kind != yaml.MappingNode {
+			continue
+		}
+		valueNode := mappingValue(entry, entryKey)
+		if valueNode == nil || isNull(valueNode) {
+			continue
+		}
+
+		path := joinPath(joinPath(section, sectionNode.Content[i].Value), entryKey)
+		references := []*yaml.Node{valueNode}
+		if valueNode.Kind == yaml.SequenceNode {
+			references = valueNode.Content
+		}
+		for _, reference := range references {
+			reference = resolveAlias(reference)
+			if reference.Kind == yaml.ScalarNode && !hasReference(reference) && !slices.Contains(targets, reference.Value) {
+				v.addProblem(reference, path, "%s is not defined in %s", reference.Value, targetSection)
+			}
+		}
+	}
+}
+
+func (v *configValidator) checkPortRange(root *yaml.Node) {
+	application := mappingValue(root, field.Application)
+	if application == nil || application.Kind != yaml.MappingNode {
+		return
+	}
+
+	portStart, portEnd := mappingValue(application, key(field.ApplicationPortStart)), mappingValue(application, key(field.ApplicationPortEnd))
+	if portStart == nil || portEnd == nil || portStart.Tag != "!!int" || portEnd.Tag != "!!int" {
+		return
+	}
+
+	var start, end int
+	if portStart.Decode(&start) != nil || portEnd.Decode(&end) != nil {
+		return
+	}
+	if start >= end {
+		v.addProblem(portStart, field.ApplicationPortStart, "port-start %d must be lower than port-end %d", start, end)
+	}
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	return node
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+func mappingValue(node *yaml.Node, name string) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == name {
+			return resolveAlias(node.Content[i+1])
+		}
+	}
+
+	return nil
+}
+
+func mappingKeys(node *yaml.Node) []string {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+
+	return keys
+}
+
+func describeNode(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "a map"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("%s %q", strings.TrimPrefix(node.Tag, "!!"), node.Value)
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// levenshtein returns the edit distance of two keys, it is used to suggest the intended key of a typo
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
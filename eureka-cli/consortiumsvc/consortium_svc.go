@@ -1,13 +1,14 @@
 package consortiumsvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
@@ -24,11 +25,11 @@ type ConsortiumProcessor interface {
 
 // ConsortiumManager defines the interface for consortium management operations
 type ConsortiumManager interface {
-	GetConsortiumByName(centralTenant string, consortiumName string) (any, error)
+	GetConsortiumByName(ctx context.Context, centralTenant string, consortiumName string) (any, error)
 	GetConsortiumCentralTenant(consortiumName string) string
-	GetConsortiumUsers(consortiumName string) map[string]any
-	GetAdminUsername(centralTenant string, consortiumUsers map[string]any) string
-	CreateConsortium(centralTenant string, consortiumName string) (string, error)
+	GetConsortiumUsers(consortiumName string) map[string]config.User
+	GetAdminUsername(centralTenant string, consortiumUsers map[string]config.User) string
+	CreateConsortium(ctx context.Context, centralTenant string, consortiumName string) (string, error)
 }
 
 // ConsortiumSvc provides functionality for consortium management operations
@@ -43,15 +44,15 @@ func New(action *action.Action, httpClient httpclient.HTTPClientRunner, userSvc
 	return &ConsortiumSvc{Action: action, HTTPClient: httpClient, UserSvc: userSvc}
 }
 
-func (cs *ConsortiumSvc) GetConsortiumByName(centralTenant string, consortiumName string) (any, error) {
+func (cs *ConsortiumSvc) GetConsortiumByName(ctx context.Context, centralTenant string, consortiumName string) (any, error) {
 	requestURL := cs.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/consortia?query=name==%s&limit=1", consortiumName))
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.ConsortiumResponse
-	if err := cs.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := cs.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Consortia) == 0 {
@@ -65,13 +66,8 @@ func (cs *ConsortiumSvc) GetConsortiumByName(centralTenant string, consortiumNam
 }
 
 func (cs *ConsortiumSvc) GetConsortiumCentralTenant(consortiumName string) string {
-	for tenantName, properties := range cs.Action.ConfigTenants {
-		if properties == nil || !cs.isValidConsortium(consortiumName, properties) {
-			continue
-		}
-
-		entry := properties.(map[string]any)
-		if cs.getSortableIsCentral(entry) == 0 {
+	for tenantName, tenant := range cs.Action.ConfigTenants {
+		if tenant.Consortium != consortiumName || !tenant.CentralTenant {
 			continue
 		}
 
@@ -81,22 +77,21 @@ func (cs *ConsortiumSvc) GetConsortiumCentralTenant(consortiumName string) strin
 	return ""
 }
 
-func (cs *ConsortiumSvc) GetConsortiumUsers(consortiumName string) map[string]any {
-	consortiumUsers := make(map[string]any)
-	for username, properties := range cs.Action.ConfigUsers {
-		if !cs.isValidConsortium(consortiumName, properties) {
+func (cs *ConsortiumSvc) GetConsortiumUsers(consortiumName string) map[string]config.User {
+	consortiumUsers := make(map[string]config.User)
+	for username, user := range cs.Action.ConfigUsers {
+		if user.Consortium != consortiumName {
 			continue
 		}
-		consortiumUsers[username] = properties
+		consortiumUsers[username] = user
 	}
 
 	return consortiumUsers
 }
 
-func (cs *ConsortiumSvc) GetAdminUsername(centralTenant string, consortiumUsers map[string]any) string {
-	for username, properties := range consortiumUsers {
-		entry := properties.(map[string]any)
-		if helpers.GetString(entry, field.UsersTenantEntry) == centralTenant {
+func (cs *ConsortiumSvc) GetAdminUsername(centralTenant string, consortiumUsers map[string]config.User) string {
+	for username, user := range consortiumUsers {
+		if user.Tenant == centralTenant {
 			return username
 		}
 	}
@@ -104,21 +99,16 @@ func (cs *ConsortiumSvc) GetAdminUsername(centralTenant string, consortiumUsers
 	return ""
 }
 
-func (cs *ConsortiumSvc) getSortableIsCentral(entry map[string]any) int {
-	if helpers.GetBool(entry, field.TenantsCentralTenantEntry) {
+func (cs *ConsortiumSvc) getSortableIsCentral(tenant config.Tenant) int {
+	if tenant.CentralTenant {
 		return 1
 	}
 
 	return 0
 }
 
-func (cs *ConsortiumSvc) isValidConsortium(consortiumName string, properties any) bool {
-	entry := properties.(map[string]any)
-	return helpers.GetString(entry, field.TenantsConsortiumEntry) == consortiumName
-}
-
-func (cs *ConsortiumSvc) CreateConsortium(centralTenant string, consortiumName string) (string, error) {
-	existingConsortium, err := cs.GetConsortiumByName(centralTenant, consortiumName)
+func (cs *ConsortiumSvc) CreateConsortium(ctx context.Context, centralTenant string, consortiumName string) (string, error) {
+	existingConsortium, err := cs.GetConsortiumByName(ctx, centralTenant, consortiumName)
 	if err != nil {
 		return "", err
 	}
@@ -139,11 +129,11 @@ func (cs *ConsortiumSvc) CreateConsortium(centralTenant string, consortiumName s
 	}
 
 	requestURL := cs.Action.GetRequestURL(constant.KongPort, "/consortia")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return "", err
 	}
-	if err := cs.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := cs.HTTPClient.PostReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return "", err
 	}
 	slog.Info(cs.Action.Name, "text", "Created consortium", "consortium", consortiumName)
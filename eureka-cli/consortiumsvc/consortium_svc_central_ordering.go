@@ -1,6 +1,7 @@
 package consortiumsvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -13,12 +14,12 @@ import (
 
 // ConsortiumCentralOrderingManager defines the interface for consortium central ordering operations
 type ConsortiumCentralOrderingManager interface {
-	EnableCentralOrdering(centralTenant string) error
+	EnableCentralOrdering(ctx context.Context, centralTenant string) error
 }
 
-func (cs *ConsortiumSvc) EnableCentralOrdering(centralTenant string) error {
+func (cs *ConsortiumSvc) EnableCentralOrdering(ctx context.Context, centralTenant string) error {
 	centralOrderingLookupKey := "ALLOW_ORDERING_WITH_AFFILIATED_LOCATIONS"
-	enableCentralOrdering, err := cs.getEnableCentralOrderingByKey(centralTenant, centralOrderingLookupKey)
+	enableCentralOrdering, err := cs.getEnableCentralOrderingByKey(ctx, centralTenant, centralOrderingLookupKey)
 	if err != nil {
 		return err
 	}
@@ -36,11 +37,11 @@ func (cs *ConsortiumSvc) EnableCentralOrdering(centralTenant string) error {
 	}
 
 	requestURL := cs.Action.GetRequestURL(constant.KongPort, "/orders-storage/settings")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
-	if err := cs.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := cs.HTTPClient.PostReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(cs.Action.Name, "text", "Enabled central ordering", "tenant", centralTenant)
@@ -48,15 +49,15 @@ func (cs *ConsortiumSvc) EnableCentralOrdering(centralTenant string) error {
 	return nil
 }
 
-func (cs *ConsortiumSvc) getEnableCentralOrderingByKey(centralTenant string, key string) (bool, error) {
+func (cs *ConsortiumSvc) getEnableCentralOrderingByKey(ctx context.Context, centralTenant string, key string) (bool, error) {
 	requestURL := cs.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/orders-storage/settings?query=key==%s&limit=1", key))
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return false, err
 	}
 
 	var decodedResponse models.SettingsResponse
-	if err := cs.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := cs.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return false, err
 	}
 	if len(decodedResponse.Settings) == 0 {
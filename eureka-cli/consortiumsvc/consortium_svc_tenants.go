@@ -1,11 +1,11 @@
 package consortiumsvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"sort"
-	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -16,7 +16,7 @@ import (
 // ConsortiumTenantHandler defines the interface for consortium tenant operations
 type ConsortiumTenantHandler interface {
 	GetSortedConsortiumTenants(consortiumName string) models.SortedConsortiumTenants
-	CreateConsortiumTenants(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error
+	CreateConsortiumTenants(ctx context.Context, centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error
 }
 
 func (cs *ConsortiumSvc) GetSortedConsortiumTenants(consortiumName string) models.SortedConsortiumTenants {
@@ -24,16 +24,12 @@ func (cs *ConsortiumSvc) GetSortedConsortiumTenants(consortiumName string) model
 	tenantNames := helpers.SortedMapKeys(cs.Action.ConfigTenants)
 
 	for _, tenantName := range tenantNames {
-		properties := cs.Action.ConfigTenants[tenantName]
-		if properties == nil {
-			continue
-		}
-		if !cs.isValidConsortium(consortiumName, properties) {
+		tenant := cs.Action.ConfigTenants[tenantName]
+		if tenant.Consortium != consortiumName {
 			continue
 		}
 
-		entry := properties.(map[string]any)
-		isCentral := cs.getSortableIsCentral(entry)
+		isCentral := cs.getSortableIsCentral(tenant)
 		consortiumTenants = append(consortiumTenants, &models.SortedConsortiumTenant{
 			Name:      tenantName,
 			IsCentral: isCentral,
@@ -46,8 +42,8 @@ func (cs *ConsortiumSvc) GetSortedConsortiumTenants(consortiumName string) model
 	return consortiumTenants
 }
 
-func (cs *ConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error {
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+func (cs *ConsortiumSvc) CreateConsortiumTenants(ctx context.Context, centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -62,7 +58,7 @@ func (cs *ConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiu
 			return err
 		}
 
-		existingTenant, err := cs.getConsortiumTenantByIDAndName(centralTenant, consortiumID, consortiumTenant.Name)
+		existingTenant, err := cs.getConsortiumTenantByIDAndName(ctx, centralTenant, consortiumID, consortiumTenant.Name)
 		if err != nil {
 			return err
 		}
@@ -73,7 +69,7 @@ func (cs *ConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiu
 
 		var requestURL = fmt.Sprintf("/consortia/%s/tenants", consortiumID)
 		if consortiumTenant.IsCentral == 0 {
-			user, err := cs.UserSvc.Get(centralTenant, adminUsername)
+			user, err := cs.UserSvc.Get(ctx, centralTenant, adminUsername)
 			if err != nil {
 				return err
 			}
@@ -83,10 +79,10 @@ func (cs *ConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiu
 
 		slog.Info(cs.Action.Name, "text", "Trying to create consortium tenant", "tenant", consortiumTenant.Name, "consortium", consortiumID)
 		finalRequestURL := cs.Action.GetRequestURL(constant.KongPort, requestURL)
-		if err := cs.HTTPClient.PostReturnNoContent(finalRequestURL, payload, headers); err != nil {
+		if err := cs.HTTPClient.PostReturnNoContent(ctx, finalRequestURL, payload, headers); err != nil {
 			return err
 		}
-		if err := cs.checkConsortiumTenantStatus(centralTenant, consortiumID, consortiumTenant.Name, headers); err != nil {
+		if err := cs.checkConsortiumTenantStatus(ctx, centralTenant, consortiumID, consortiumTenant.Name, headers); err != nil {
 			return err
 		}
 	}
@@ -94,15 +90,15 @@ func (cs *ConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiu
 	return nil
 }
 
-func (cs *ConsortiumSvc) getConsortiumTenantByIDAndName(centralTenant string, consortiumID string, tenant string) (any, error) {
+func (cs *ConsortiumSvc) getConsortiumTenantByIDAndName(ctx context.Context, centralTenant string, consortiumID string, tenant string) (any, error) {
 	requestURL := cs.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/consortia/%s/tenants", consortiumID))
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.ConsortiumTenantsResponse
-	if err := cs.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := cs.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Tenants) == 0 {
@@ -117,11 +113,11 @@ func (cs *ConsortiumSvc) getConsortiumTenantByIDAndName(centralTenant string, co
 	return nil, nil
 }
 
-func (cs *ConsortiumSvc) checkConsortiumTenantStatus(centralTenant string, consortiumID string, tenantName string, headers map[string]string) error {
+func (cs *ConsortiumSvc) checkConsortiumTenantStatus(ctx context.Context, centralTenant string, consortiumID string, tenantName string, headers map[string]string) error {
 	requestURL := cs.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/consortia/%s/tenants/%s", consortiumID, tenantName))
 
 	var decodedResponse models.ConsortiumTenantStatus
-	if err := cs.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := cs.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return err
 	}
 
@@ -134,8 +130,10 @@ func (cs *ConsortiumSvc) checkConsortiumTenantStatus(centralTenant string, conso
 	switch decodedResponse.SetupStatus {
 	case IN_PROGRESS:
 		slog.Warn(cs.Action.Name, "text", "Waiting for consortium tenant creation", "tenant", tenantName)
-		time.Sleep(constant.ConsortiumTenantStatusWait)
-		if err := cs.checkConsortiumTenantStatus(centralTenant, consortiumID, tenantName, headers); err != nil {
+		if err := helpers.Sleep(ctx, constant.ConsortiumTenantStatusWait); err != nil {
+			return err
+		}
+		if err := cs.checkConsortiumTenantStatus(ctx, centralTenant, consortiumID, tenantName, headers); err != nil {
 			return err
 		}
 
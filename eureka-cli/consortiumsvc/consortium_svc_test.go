@@ -1,15 +1,17 @@
 package consortiumsvc_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/consortiumsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/google/uuid"
@@ -22,7 +24,7 @@ type MockUserSvc struct {
 	mock.Mock
 }
 
-func (m *MockUserSvc) Get(tenantName string, username string) (*models.User, error) {
+func (m *MockUserSvc) Get(ctx context.Context, tenantName string, username string) (*models.User, error) {
 	args := m.Called(tenantName, username)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -68,7 +70,7 @@ func TestGetConsortiumByName_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -102,7 +104,7 @@ func TestGetConsortiumByName_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetConsortiumByName(centralTenant, consortiumName)
+	result, err := svc.GetConsortiumByName(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -117,7 +119,7 @@ func TestGetConsortiumByName_NotFound(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -139,7 +141,7 @@ func TestGetConsortiumByName_NotFound(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetConsortiumByName(centralTenant, consortiumName)
+	result, err := svc.GetConsortiumByName(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -151,7 +153,7 @@ func TestGetConsortiumByName_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -165,7 +167,7 @@ func TestGetConsortiumByName_HTTPError(t *testing.T) {
 		Return(errors.New("network error"))
 
 	// Act
-	result, err := svc.GetConsortiumByName(centralTenant, consortiumName)
+	result, err := svc.GetConsortiumByName(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -178,7 +180,6 @@ func TestGetConsortiumByName_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -186,7 +187,7 @@ func TestGetConsortiumByName_HeaderCreationError(t *testing.T) {
 	consortiumName := "test-consortium"
 
 	// Act
-	result, err := svc.GetConsortiumByName(centralTenant, consortiumName)
+	result, err := svc.GetConsortiumByName(context.Background(), centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -198,7 +199,7 @@ func TestGetConsortiumByName_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -206,7 +207,7 @@ func TestGetConsortiumByName_BlankTenantName(t *testing.T) {
 	consortiumName := "test-consortium"
 
 	// Act
-	result, err := svc.GetConsortiumByName(centralTenant, consortiumName)
+	result, err := svc.GetConsortiumByName(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -222,14 +223,14 @@ func TestGetConsortiumCentralTenant_Found(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"member-tenant": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+	action.ConfigTenants = map[string]config.Tenant{
+		"member-tenant": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
-		"central-tenant": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+		"central-tenant": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
 	}
 
@@ -248,10 +249,10 @@ func TestGetConsortiumCentralTenant_NotFound(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"member-tenant": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+	action.ConfigTenants = map[string]config.Tenant{
+		"member-tenant": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
 	}
 
@@ -270,10 +271,10 @@ func TestGetConsortiumCentralTenant_DifferentConsortium(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"central-tenant": map[string]any{
-			field.TenantsConsortiumEntry:    "other-consortium",
-			field.TenantsCentralTenantEntry: true,
+	action.ConfigTenants = map[string]config.Tenant{
+		"central-tenant": {
+			Consortium:    "other-consortium",
+			CentralTenant: true,
 		},
 	}
 
@@ -292,15 +293,15 @@ func TestGetConsortiumUsers_Success(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigUsers = map[string]any{
-		"user1": map[string]any{
-			field.UsersConsortiumEntry: consortiumName,
+	action.ConfigUsers = map[string]config.User{
+		"user1": {
+			Consortium: consortiumName,
 		},
-		"user2": map[string]any{
-			field.UsersConsortiumEntry: consortiumName,
+		"user2": {
+			Consortium: consortiumName,
 		},
-		"user3": map[string]any{
-			field.UsersConsortiumEntry: "other-consortium",
+		"user3": {
+			Consortium: "other-consortium",
 		},
 	}
 
@@ -322,9 +323,9 @@ func TestGetConsortiumUsers_NoneFound(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigUsers = map[string]any{
-		"user1": map[string]any{
-			field.UsersConsortiumEntry: "other-consortium",
+	action.ConfigUsers = map[string]config.User{
+		"user1": {
+			Consortium: "other-consortium",
 		},
 	}
 
@@ -343,12 +344,12 @@ func TestGetAdminUsername_Found(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	centralTenant := "central-tenant"
-	consortiumUsers := map[string]any{
-		"admin-user": map[string]any{
-			field.UsersTenantEntry: centralTenant,
+	consortiumUsers := map[string]config.User{
+		"admin-user": {
+			Tenant: centralTenant,
 		},
-		"member-user": map[string]any{
-			field.UsersTenantEntry: "member-tenant",
+		"member-user": {
+			Tenant: "member-tenant",
 		},
 	}
 
@@ -367,9 +368,9 @@ func TestGetAdminUsername_NotFound(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	centralTenant := "central-tenant"
-	consortiumUsers := map[string]any{
-		"member-user": map[string]any{
-			field.UsersTenantEntry: "member-tenant",
+	consortiumUsers := map[string]config.User{
+		"member-user": {
+			Tenant: "member-tenant",
 		},
 	}
 
@@ -388,8 +389,8 @@ func TestGetAdminUsername_NoTenantField(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	centralTenant := "central-tenant"
-	consortiumUsers := map[string]any{
-		"user": map[string]any{},
+	consortiumUsers := map[string]config.User{
+		"user": {},
 	}
 
 	// Act
@@ -403,7 +404,7 @@ func TestCreateConsortium_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -440,7 +441,7 @@ func TestCreateConsortium_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.CreateConsortium(centralTenant, consortiumName)
+	result, err := svc.CreateConsortium(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -454,7 +455,7 @@ func TestCreateConsortium_AlreadyExists(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -483,7 +484,7 @@ func TestCreateConsortium_AlreadyExists(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.CreateConsortium(centralTenant, consortiumName)
+	result, err := svc.CreateConsortium(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -497,7 +498,7 @@ func TestCreateConsortium_GetError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -511,7 +512,7 @@ func TestCreateConsortium_GetError(t *testing.T) {
 		Return(errors.New("get failed"))
 
 	// Act
-	result, err := svc.CreateConsortium(centralTenant, consortiumName)
+	result, err := svc.CreateConsortium(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -524,7 +525,7 @@ func TestCreateConsortium_PostError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -552,7 +553,7 @@ func TestCreateConsortium_PostError(t *testing.T) {
 		Return(errors.New("post failed"))
 
 	// Act
-	result, err := svc.CreateConsortium(centralTenant, consortiumName)
+	result, err := svc.CreateConsortium(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -565,7 +566,6 @@ func TestCreateConsortium_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -573,7 +573,7 @@ func TestCreateConsortium_HeaderCreationError(t *testing.T) {
 	consortiumName := "new-consortium"
 
 	// Act
-	result, err := svc.CreateConsortium(centralTenant, consortiumName)
+	result, err := svc.CreateConsortium(context.Background(), centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -586,7 +586,7 @@ func TestCreateConsortium_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -594,7 +594,7 @@ func TestCreateConsortium_BlankTenantName(t *testing.T) {
 	consortiumName := "new-consortium"
 
 	// Act
-	result, err := svc.CreateConsortium(centralTenant, consortiumName)
+	result, err := svc.CreateConsortium(ctx, centralTenant, consortiumName)
 
 	// Assert
 	assert.Error(t, err)
@@ -611,22 +611,22 @@ func TestGetSortedConsortiumTenants_Success(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"member1": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+	action.ConfigTenants = map[string]config.Tenant{
+		"member1": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
-		"central": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+		"central": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
-		"member2": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+		"member2": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
-		"other-tenant": map[string]any{
-			field.TenantsConsortiumEntry:    "other-consortium",
-			field.TenantsCentralTenantEntry: false,
+		"other-tenant": {
+			Consortium:    "other-consortium",
+			CentralTenant: false,
 		},
 	}
 
@@ -651,9 +651,9 @@ func TestGetSortedConsortiumTenants_EmptyConsortium(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "empty-consortium"
-	action.ConfigTenants = map[string]any{
-		"other-tenant": map[string]any{
-			field.TenantsConsortiumEntry: "other-consortium",
+	action.ConfigTenants = map[string]config.Tenant{
+		"other-tenant": {
+			Consortium: "other-consortium",
 		},
 	}
 
@@ -672,10 +672,10 @@ func TestGetSortedConsortiumTenants_OnlyCentralTenant(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"central-tenant": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+	action.ConfigTenants = map[string]config.Tenant{
+		"central-tenant": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
 	}
 
@@ -696,14 +696,14 @@ func TestGetSortedConsortiumTenants_OnlyMemberTenants(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"member1": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+	action.ConfigTenants = map[string]config.Tenant{
+		"member1": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
-		"member2": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+		"member2": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
 	}
 
@@ -724,13 +724,13 @@ func TestGetSortedConsortiumTenants_NilPropertiesHandled(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"tenant-with-properties": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+	action.ConfigTenants = map[string]config.Tenant{
+		"tenant-with-properties": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
-		"tenant-nil": map[string]any{
-			field.TenantsConsortiumEntry: consortiumName,
+		"tenant-nil": {
+			Consortium: consortiumName,
 		},
 	}
 
@@ -755,17 +755,17 @@ func TestGetSortedConsortiumTenants_MixedConsortiums(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"tenant-in-consortium": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+	action.ConfigTenants = map[string]config.Tenant{
+		"tenant-in-consortium": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
-		"tenant-different-consortium": map[string]any{
-			field.TenantsConsortiumEntry:    "different-consortium",
-			field.TenantsCentralTenantEntry: false,
+		"tenant-different-consortium": {
+			Consortium:    "different-consortium",
+			CentralTenant: false,
 		},
-		"tenant-no-consortium": map[string]any{
-			field.TenantsCentralTenantEntry: false,
+		"tenant-no-consortium": {
+			CentralTenant: false,
 		},
 	}
 
@@ -787,18 +787,18 @@ func TestGetSortedConsortiumTenants_SortingOrder(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"member1": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+	action.ConfigTenants = map[string]config.Tenant{
+		"member1": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
-		"central": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+		"central": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
-		"member2": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: false,
+		"member2": {
+			Consortium:    consortiumName,
+			CentralTenant: false,
 		},
 	}
 
@@ -823,11 +823,11 @@ func TestGetSortedConsortiumTenants_SkipsNilProperties(t *testing.T) {
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	consortiumName := "test-consortium"
-	action.ConfigTenants = map[string]any{
-		"tenant-with-nil-properties": nil,
-		"tenant-valid": map[string]any{
-			field.TenantsConsortiumEntry:    consortiumName,
-			field.TenantsCentralTenantEntry: true,
+	action.ConfigTenants = map[string]config.Tenant{
+		"tenant-with-nil-properties": {},
+		"tenant-valid": {
+			Consortium:    consortiumName,
+			CentralTenant: true,
 		},
 	}
 
@@ -845,7 +845,7 @@ func TestCreateConsortiumTenants_CentralTenant(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -909,7 +909,7 @@ func TestCreateConsortiumTenants_CentralTenant(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.NoError(t, err)
@@ -920,7 +920,7 @@ func TestCreateConsortiumTenants_MemberTenant(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -992,7 +992,7 @@ func TestCreateConsortiumTenants_MemberTenant(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1004,7 +1004,7 @@ func TestCreateConsortiumTenants_AlreadyExists(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1034,7 +1034,7 @@ func TestCreateConsortiumTenants_AlreadyExists(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1047,7 +1047,7 @@ func TestCreateConsortiumTenants_GetTenantByIDError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1066,7 +1066,7 @@ func TestCreateConsortiumTenants_GetTenantByIDError(t *testing.T) {
 		Return(errors.New("get failed"))
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.Error(t, err)
@@ -1078,7 +1078,7 @@ func TestCreateConsortiumTenants_UserGetError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1109,7 +1109,7 @@ func TestCreateConsortiumTenants_UserGetError(t *testing.T) {
 		Return(nil, errors.New("user not found"))
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.Error(t, err)
@@ -1122,7 +1122,7 @@ func TestCreateConsortiumTenants_PostError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1155,7 +1155,7 @@ func TestCreateConsortiumTenants_PostError(t *testing.T) {
 		Return(errors.New("post failed"))
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.Error(t, err)
@@ -1167,7 +1167,7 @@ func TestCreateConsortiumTenants_StatusCheckError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1210,7 +1210,7 @@ func TestCreateConsortiumTenants_StatusCheckError(t *testing.T) {
 		Return(errors.New("status check failed"))
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.Error(t, err)
@@ -1222,7 +1222,6 @@ func TestCreateConsortiumTenants_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1235,7 +1234,7 @@ func TestCreateConsortiumTenants_HeaderCreationError(t *testing.T) {
 	}
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(context.Background(), centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.Error(t, err)
@@ -1247,7 +1246,7 @@ func TestCreateConsortiumTenants_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1260,7 +1259,7 @@ func TestCreateConsortiumTenants_BlankTenantName(t *testing.T) {
 	}
 
 	// Act
-	err := svc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	err := svc.CreateConsortiumTenants(ctx, centralTenant, consortiumID, consortiumTenants, adminUsername)
 
 	// Assert
 	assert.Error(t, err)
@@ -1272,7 +1271,7 @@ func TestEnableCentralOrdering_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1310,7 +1309,7 @@ func TestEnableCentralOrdering_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.EnableCentralOrdering(centralTenant)
+	err := svc.EnableCentralOrdering(ctx, centralTenant)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1321,7 +1320,7 @@ func TestEnableCentralOrdering_AlreadyEnabled(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1348,7 +1347,7 @@ func TestEnableCentralOrdering_AlreadyEnabled(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.EnableCentralOrdering(centralTenant)
+	err := svc.EnableCentralOrdering(ctx, centralTenant)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1361,7 +1360,7 @@ func TestEnableCentralOrdering_GetError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1374,7 +1373,7 @@ func TestEnableCentralOrdering_GetError(t *testing.T) {
 		Return(errors.New("get failed"))
 
 	// Act
-	err := svc.EnableCentralOrdering(centralTenant)
+	err := svc.EnableCentralOrdering(ctx, centralTenant)
 
 	// Assert
 	assert.Error(t, err)
@@ -1386,7 +1385,7 @@ func TestEnableCentralOrdering_PostError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
@@ -1413,7 +1412,7 @@ func TestEnableCentralOrdering_PostError(t *testing.T) {
 		Return(errors.New("post failed"))
 
 	// Act
-	err := svc.EnableCentralOrdering(centralTenant)
+	err := svc.EnableCentralOrdering(ctx, centralTenant)
 
 	// Assert
 	assert.Error(t, err)
@@ -1425,14 +1424,13 @@ func TestEnableCentralOrdering_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	centralTenant := "central-tenant"
 
 	// Act
-	err := svc.EnableCentralOrdering(centralTenant)
+	err := svc.EnableCentralOrdering(context.Background(), centralTenant)
 
 	// Assert
 	assert.Error(t, err)
@@ -1444,14 +1442,14 @@ func TestEnableCentralOrdering_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockUserSvc := &MockUserSvc{}
 	svc := consortiumsvc.New(action, mockHTTP, mockUserSvc)
 
 	centralTenant := "" // Empty tenant
 
 	// Act
-	err := svc.EnableCentralOrdering(centralTenant)
+	err := svc.EnableCentralOrdering(ctx, centralTenant)
 
 	// Assert
 	assert.Error(t, err)
@@ -7,8 +7,6 @@ import (
 
 const (
 	// Command wait durations
-	DeployApplicationPartitionWait    = 15 * time.Second
-	DeploySystemWait                  = 15 * time.Second
 	DeployAdditionalSystemWait        = 15 * time.Second
 	DeployManagementWait              = 5 * time.Second
 	DeployModulesWait                 = 5 * time.Second
@@ -25,6 +23,11 @@ const (
 	ConsumerGroupRebalanceRetries = 70
 	ConsumerGroupPollMaxRetries   = 70
 
+	// Readiness probe durations
+	ReadinessProbeTimeout        = 3 * time.Minute
+	ReadinessProbeInitialBackoff = 1 * time.Second
+	ReadinessProbeMaxBackoff     = 15 * time.Second
+
 	// Context timeout durations
 	ContextTimeoutDockerList         = 30 * time.Second
 	ContextTimeoutDockerImagePull    = 5 * time.Minute
@@ -34,6 +37,20 @@ const (
 	ContextTimeoutVaultContainerLogs = 30 * time.Second
 	ContextTimeoutAWSConfig          = 30 * time.Second
 
+	// Container stats
+	StatsWatchInterval          = 5 * time.Second
+	StatsOOMKillWindow          = 24 * time.Hour
+	StatsMemoryWarningThreshold = 90.0
+
+	// Wait ready
+	DefaultWaitReadyTimeout = 15 * time.Minute
+	WaitReadyInterval       = 10 * time.Second
+	WaitReadyExitCode       = 124
+
+	// Container logs
+	DefaultLogsTail         = 200
+	LogsFollowReorderWindow = 250 * time.Millisecond
+
 	// HTTP client timeouts
 	HTTPClientPingTimeout = 15 * time.Second
 	HTTPClientTimeout     = 10 * time.Minute
@@ -56,6 +73,9 @@ const (
 	HTTPClientPingIdleConnTimeout       = 0
 	HTTPClientPingResponseHeaderTimeout = 5 * time.Second
 
+	// Step graph properties
+	StepGraphWorkers = 4
+
 	// Docker log properties
 	DockerLogHeaderSize = 8
 	DockerLogSizeOffset = 4
@@ -116,23 +136,48 @@ const (
 	ConfigDir    = ".eureka"
 	ConfigType   = "yaml"
 
+	// LocalConfigSuffix marks the local override file of a config, e.g. config.combined.local.yaml, it is never overwritten
+	LocalConfigSuffix = ".local"
+
+	// ProfilesDirEnv names an extra directory that profile configs are discovered in, next to the home directory
+	ProfilesDirEnv = "EUREKA_PROFILES_DIR"
+
+	// UpstreamDir keeps the embedded files as they were last copied to the home directory, they are the base of the next refresh
+	UpstreamDir = ".upstream"
+
 	// DirPerm is the mode for all directories created under the ~/.eureka config directory (owner-only)
 	DirPerm os.FileMode = 0700
 
 	// Logs
 	LogDir             = "logs"
 	LogTimestampFormat = "20060102-150405"
+	LogFileSuffix      = ".log"
+	TimingReportSuffix = ".timings.json"
+	DefaultTimingRuns  = 5
+
+	// Diagnostics
+	DiagnosticsFilePattern = "eureka-diagnostics-%s.tar.gz"
+	DiagnosticsRunLogs     = 3
+	DefaultDiagnosticsTail = 500
+	RedactedValue          = "<redacted>"
 
 	// Module registries
 	FolioRegistry  = "folio"
 	EurekaRegistry = "eureka"
 
+	// FrontendModulePrefix starts the name of a frontend module, e.g. folio_users
+	FrontendModulePrefix = "folio_"
+
 	// Files
 	ModulesFile               = "modules.json"
+	ModuleDescriptorsFile     = "module-descriptors.json"
 	CapabilitySetsFilePattern = "%s_capability_sets.json"
+	CheckpointFilePattern     = "%s_checkpoint.json"
+	LockFile                  = "eureka.lock.yaml"
 
 	// Docker compose properties
-	DockerComposeWorkDir = "./misc"
+	DockerComposeWorkDir      = "./misc"
+	DockerComposeProjectLabel = "com.docker.compose.project=eureka"
 
 	// Container network properties
 	NetworkID         = "eureka"
@@ -160,6 +205,9 @@ const (
 	ModuleIDPattern       = `^([a-z_-]+)([\d_.-]+)([-\w.]+)$`
 	NewLinePattern        = `[\r\n\s-]+`
 	ProtocolPattern       = `^[a-zA-Z]+://`
+	ProfileNamePattern    = `^[a-z0-9][a-z0-9_-]*$`
+	LogKeyValuePattern    = `([\w.-]+)(\\?["']?\s*[:=]\s*\\?["']?)([^\s"'\\,;&}\]]+)`
+	BearerTokenPattern    = `(?i)(bearer\s+)[\w.~+/-]+=*`
 
 	// System containers name
 	DozzleContainer        = "dozzle"
@@ -203,6 +251,10 @@ const (
 	// Backend modules
 	ModSearchModule           = "mod-search"
 	ModDataExportWorkerModule = "mod-data-export-worker"
+	ModUsersKeycloakModule    = "mod-users-keycloak"
+	ModLoginKeycloakModule    = "mod-login-keycloak"
+	ModRolesKeycloakModule    = "mod-roles-keycloak"
+	ModSchedulerModule        = "mod-scheduler"
 
 	// Kafka consumer group properties
 	ConsumerGroupSuffix = "mod-roles-keycloak-capability-group"
@@ -259,6 +311,50 @@ func GetTokenTypes() []string {
 	return []string{DefaultToken, MasterCustomToken, MasterAdminCLIToken}
 }
 
+// ==================== Output Formats ====================
+
+const (
+	TableOutput = "table"
+	JSONOutput  = "json"
+)
+
+func GetOutputFormats() []string {
+	return []string{TableOutput, JSONOutput}
+}
+
+// ==================== Log Levels ====================
+
+// GetLogLevels returns the module and sidecar log levels from the least to the most severe
+func GetLogLevels() []string {
+	return []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+}
+
+// ==================== Doctor Checks ====================
+
+const (
+	DoctorCheckOK   = "ok"
+	DoctorCheckWarn = "warn"
+	DoctorCheckFail = "fail"
+)
+
+// GetSystemHostnames returns the system container hostnames that must resolve on the host, see misc/scripts/add-hosts.sh
+func GetSystemHostnames() []string {
+	return []string{"postgres.eureka", "kafka.eureka", "vault.eureka", "keycloak.eureka", "kong.eureka"}
+}
+
+// ==================== Platform Modules ====================
+
+// GetPlatformModules returns the backend modules every application needs next to the management modules
+// for the users to log in and the capabilities to be assigned
+func GetPlatformModules() []string {
+	return []string{ModUsersKeycloakModule, ModLoginKeycloakModule, ModRolesKeycloakModule, ModSchedulerModule}
+}
+
+// GetSystemContainerModules returns the backend modules that require system containers next to the initial ones
+func GetSystemContainerModules() []string {
+	return []string{ModSearchModule, ModDataExportWorkerModule}
+}
+
 // ==================== Docker Hub & local namespaces ====================
 
 const (
@@ -289,6 +385,24 @@ func GetInitialRequiredContainers() []string {
 	}
 }
 
+// GetSystemContainerMemory returns the memory limit in MiB of the system containers, matching their mem_limit in misc/docker-compose.yaml
+func GetSystemContainerMemory() map[string]int64 {
+	return map[string]int64{
+		DozzleContainer:        64,
+		PostgreSQLContainer:    2048,
+		KafkaContainer:         1024,
+		KafkaToolsContainer:    350,
+		VaultContainer:         500,
+		KeycloakProxyContainer: 35,
+		KeycloakContainer:      2048,
+		KongContainer:          2048,
+		OpenSearchContainer:    1024,
+		MinIOContainer:         500,
+		CreateBucketsContainer: 300,
+		FTPServerContainer:     100,
+	}
+}
+
 // ==================== Profiles ====================
 
 const (
@@ -1,6 +1,7 @@
 package constant
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,6 +62,39 @@ func TestGetTokenTypes(t *testing.T) {
 	assert.Equal(t, []string{DefaultToken, MasterCustomToken, MasterAdminCLIToken}, types)
 }
 
+// ==================== GetOutputFormats Tests ====================
+
+func TestGetOutputFormats(t *testing.T) {
+	// Act
+	formats := GetOutputFormats()
+
+	// Assert
+	assert.Equal(t, []string{TableOutput, JSONOutput}, formats)
+}
+
+// ==================== GetLogLevels Tests ====================
+
+func TestGetLogLevels(t *testing.T) {
+	// Act
+	levels := GetLogLevels()
+
+	// Assert
+	assert.Equal(t, []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}, levels)
+}
+
+// ==================== GetSystemHostnames Tests ====================
+
+func TestGetSystemHostnames(t *testing.T) {
+	// Act
+	hostnames := GetSystemHostnames()
+
+	// Assert
+	assert.Len(t, hostnames, 5)
+	for _, hostname := range hostnames {
+		assert.True(t, strings.HasSuffix(hostname, ".eureka"), hostname)
+	}
+}
+
 // ==================== GetNamespaces Tests ====================
 
 func TestGetNamespaces(t *testing.T) {
@@ -128,6 +162,16 @@ func TestGetInitialRequiredContainers_OrderPreserved(t *testing.T) {
 	assert.Equal(t, KongContainer, containers[7])
 }
 
+func TestGetSystemContainerMemory_CoversRequiredContainers(t *testing.T) {
+	// Act
+	memory := GetSystemContainerMemory()
+
+	// Assert
+	for _, container := range append(GetInitialRequiredContainers(), OpenSearchContainer, MinIOContainer, CreateBucketsContainer, FTPServerContainer) {
+		assert.Positive(t, memory[container], container)
+	}
+}
+
 // ==================== GetProfiles Tests ====================
 
 func TestGetProfiles(t *testing.T) {
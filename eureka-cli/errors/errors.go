@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // FlagReader interface allows us to accept flag structs without importing the flags package
@@ -118,6 +119,40 @@ func RequestFailed(statusCode int, method, url string) error {
 	}
 }
 
+// ==================== Exit Errors ====================
+
+// ExitError carries the process exit code of a command whose failures must be told apart by scripts
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+func WithExitCode(code int, err error) error {
+	return &ExitError{Code: code, Err: err}
+}
+
+// GetExitCode returns the exit code carried by the error, 0 for no error and 1 for any other error
+func GetExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	return 1
+}
+
 // ==================== Action Errors ====================
 
 func UnsupportedPlatform(platform, address string) error {
@@ -202,6 +237,10 @@ func ConsumerGroupPollTimeout(consumerGroup string, maxRetries int) error {
 	return fmt.Errorf("%w: consumer group %s polling exceeded maximum retries (%d)", ErrTimeout, consumerGroup, maxRetries)
 }
 
+func ConsumerGroupLagging(consumerGroup string, lag int) error {
+	return fmt.Errorf("%w: consumer group %s has a lag of %d", ErrNotReady, consumerGroup, lag)
+}
+
 func ContainerCommandFailed(stderr string) error {
 	return fmt.Errorf("failed to execute container command, stderr: %s", stderr)
 }
@@ -234,6 +273,10 @@ func KongAdminAPIFailed(statusCode int, status string) error {
 	return fmt.Errorf("kong admin API failed: %d %s", statusCode, status)
 }
 
+func KongRoutesMismatched(modules int) error {
+	return fmt.Errorf("%w: kong routes of %d module(s) do not match their module descriptors", ErrNotReady, modules)
+}
+
 // ==================== Application Errors ====================
 
 func ApplicationNotFound(applicationName string) error {
@@ -252,6 +295,10 @@ func ModuleAlreadyInBaseApplication(moduleName, baseApplicationName string) erro
 	return fmt.Errorf("%w: %s is already provided by application %s; use upgradeModule to change its version", ErrInvalidInput, moduleName, baseApplicationName)
 }
 
+func ModuleNotInApplication(moduleName, applicationName string) error {
+	return fmt.Errorf("%w: module %s is not part of application %s", ErrNotFound, moduleName, applicationName)
+}
+
 // ==================== Module Errors ====================
 
 func ModuleBuildToolNotFound(modulePath string) error {
@@ -318,6 +365,10 @@ func ModulePathNotDirectory(modulePath string) error {
 	return fmt.Errorf("module path is not a directory: %s", modulePath)
 }
 
+func ModuleDependenciesUnsatisfied(unsatisfied []string) error {
+	return fmt.Errorf("%w: %d interface(s) required by the modules are not provided in a compatible version: %s", ErrNotFound, len(unsatisfied), strings.Join(unsatisfied, "; "))
+}
+
 // ==================== Tenant Errors ====================
 
 func TenantNotFound(tenantName string) error {
@@ -352,6 +403,198 @@ func FARFetchFailed(appID string, err error) error {
 	return fmt.Errorf("%w: failed to fetch application %s from FAR: %w", ErrNotFound, appID, err)
 }
 
+// ==================== Checkpoint Errors ====================
+
+func CheckpointReadFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to read checkpoint file %s: %w", filePath, err)
+}
+
+func CheckpointMismatch(filePath, field, stored, current string) error {
+	return fmt.Errorf("%w: checkpoint file %s was written for %s %s, not %s, rerun without --resume to start over", ErrInvalidInput, filePath, field, stored, current)
+}
+
+func ResumeWithCleanup() error {
+	return fmt.Errorf("%w: resume cannot be combined with cleanup", ErrInvalidInput)
+}
+
+// ==================== Lockfile Errors ====================
+
+func LockfileNotFound(filePath string, err error) error {
+	return fmt.Errorf("%w: lockfile %s not found, deploy with --lock to create it: %w", ErrNotFound, filePath, err)
+}
+
+func LockfileReadFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to read lockfile %s: %w", filePath, err)
+}
+
+func LockfileProfileMismatch(filePath, lockedProfile, profile string) error {
+	return fmt.Errorf("%w: lockfile %s pins profile %s, not %s", ErrInvalidInput, filePath, lockedProfile, profile)
+}
+
+func LockfileModulesMissing(filePath string, moduleNames []string) error {
+	return fmt.Errorf("%w: lockfile %s does not pin modules %s, run updateLock to add them", ErrNotFound, filePath, strings.Join(moduleNames, ", "))
+}
+
+func LockWithFromLock() error {
+	return fmt.Errorf("%w: lock cannot be combined with fromLock", ErrInvalidInput)
+}
+
+// ==================== Rollback Errors ====================
+
+func RollbackFailed(kind, name string, err error) error {
+	return fmt.Errorf("failed to roll back %s %s: %w", kind, name, err)
+}
+
+// ==================== Step Graph Errors ====================
+
+func StepDuplicate(step string) error {
+	return fmt.Errorf("%w: step %s is declared more than once", ErrInvalidInput, step)
+}
+
+func StepDependencyNotFound(step, dependency string) error {
+	return fmt.Errorf("%w: step %s needs undeclared step %s", ErrInvalidInput, step, dependency)
+}
+
+func StepDependencyCycle(steps []string) error {
+	return fmt.Errorf("%w: steps %s have a dependency cycle", ErrInvalidInput, strings.Join(steps, ", "))
+}
+
+func StepFailed(step string, err error) error {
+	return fmt.Errorf("step %s failed: %w", step, err)
+}
+
+func StepInterrupted(step string, err error) error {
+	return fmt.Errorf("step %s interrupted: %w", step, err)
+}
+
+// ==================== Readiness Errors ====================
+
+func ReadinessProbeTimeout(probe string, timeout time.Duration, err error) error {
+	return fmt.Errorf("%w: %s readiness probe exceeded %s: %w", ErrTimeout, probe, timeout, err)
+}
+
+func PostgresNotReady(err error) error {
+	return fmt.Errorf("%w: postgres not ready: %w", ErrNotReady, err)
+}
+
+func VaultSealed() error {
+	return fmt.Errorf("%w: vault is sealed", ErrNotReady)
+}
+
+// ==================== Output Errors ====================
+
+func UnsupportedOutputFormat(format string) error {
+	return fmt.Errorf("%w: unsupported output format %s", ErrInvalidInput, format)
+}
+
+func EventsFdInvalid(fd int, err error) error {
+	return fmt.Errorf("%w: events file descriptor %d is invalid: %w", ErrInvalidInput, fd, err)
+}
+
+func EventsFdUnsupported(fd int) error {
+	return fmt.Errorf("%w: events file descriptor %d is not supported, use 1 for stdout or an open descriptor above 1", ErrInvalidInput, fd)
+}
+
+// ==================== Status Errors ====================
+
+func EnvironmentUnhealthy(problems int) error {
+	return fmt.Errorf("%w: environment has %d problem(s)", ErrNotReady, problems)
+}
+
+func ModulesOutdated(outdated, maxBuildsBehind int) error {
+	return fmt.Errorf("%w: %d module(s) are more than %d build(s) behind the registry", ErrNotReady, outdated, maxBuildsBehind)
+}
+
+// ==================== Log Errors ====================
+
+func LogLevelUnsupported(level string) error {
+	return fmt.Errorf("%w: unsupported log level %s", ErrInvalidInput, level)
+}
+
+func LogContainersNotFound(pattern string) error {
+	return fmt.Errorf("%w: no containers match %s", ErrNotFound, pattern)
+}
+
+func LogRegexInvalid(regex string, err error) error {
+	return fmt.Errorf("%w: invalid log regex %s: %w", ErrInvalidInput, regex, err)
+}
+
+// ==================== Doctor Errors ====================
+
+func DoctorChecksFailed(failed int) error {
+	return fmt.Errorf("%w: %d doctor check(s) failed", ErrNotReady, failed)
+}
+
+// ==================== Wait Ready Errors ====================
+
+func WaitReadyTimeout(check string, timeout time.Duration, err error) error {
+	return fmt.Errorf("%w: %s not ready within %s: %w", ErrTimeout, check, timeout, err)
+}
+
+func WaitReadyContainerFailed(containerName, state string) error {
+	return fmt.Errorf("%w: container %s is %s", ErrDeploymentFailed, containerName, state)
+}
+
+func WaitReadyContainersNotFound(profileName string) error {
+	return fmt.Errorf("%w: no module containers of %s profile", ErrNotReady, profileName)
+}
+
+func WaitReadyRoutesMissing(missing int) error {
+	return fmt.Errorf("%w: %d kong route(s) missing", ErrNotReady, missing)
+}
+
+// ==================== Config Errors ====================
+
+func ConfigInvalid(configFile string, problems int) error {
+	return fmt.Errorf("%w: config %s has %d problem(s)", ErrInvalidInput, configFile, problems)
+}
+
+func ConfigNotMapping(configFile string) error {
+	return fmt.Errorf("%w: config %s must be a map of sections", ErrInvalidInput, configFile)
+}
+
+func ConfigExtendsInvalid(configFile string) error {
+	return fmt.Errorf("%w: extends of config %s must be a profile name", ErrInvalidInput, configFile)
+}
+
+func ConfigExtendsCycle(configFiles []string) error {
+	return fmt.Errorf("%w: config extends cycle %s", ErrInvalidInput, strings.Join(configFiles, " -> "))
+}
+
+func ConfigDecodeFailed(err error) error {
+	return fmt.Errorf("%w: config cannot be decoded: %w", ErrInvalidInput, err)
+}
+
+func ConfigInconsistent(problems []string) error {
+	return fmt.Errorf("%w: config is inconsistent: %s", ErrInvalidInput, strings.Join(problems, "; "))
+}
+
+func ConfigReferencesUnresolved(problems []string) error {
+	return fmt.Errorf("%w: config has unresolved references: %s", ErrInvalidInput, strings.Join(problems, "; "))
+}
+
+// ==================== Profile Errors ====================
+
+func ProfileNotFound(profile string, dirs []string) error {
+	return fmt.Errorf("%w: config of profile %s not found in %s", ErrNotFound, profile, strings.Join(dirs, ", "))
+}
+
+func ProfileNameInvalid(profile string) error {
+	return fmt.Errorf("%w: profile name %s is invalid, use lowercase letters, digits, - and _", ErrInvalidInput, profile)
+}
+
+func ProfileAlreadyExists(configFile string) error {
+	return fmt.Errorf("%w: profile config %s already exists", ErrInvalidInput, configFile)
+}
+
+func ModulesNotInProfile(profile string, moduleNames []string) error {
+	return fmt.Errorf("%w: modules %s are not in profile %s", ErrNotFound, strings.Join(moduleNames, ", "), profile)
+}
+
+func ModulesNotInRegistry(moduleNames []string) error {
+	return fmt.Errorf("%w: modules %s have no module descriptor in the platform applications", ErrNotFound, strings.Join(moduleNames, ", "))
+}
+
 // ==================== Flag Errors ====================
 
 func RegisterFlagCompletionFailed(err error) error {
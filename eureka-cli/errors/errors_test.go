@@ -1,9 +1,11 @@
 package errors_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/stretchr/testify/assert"
@@ -575,6 +577,21 @@ func TestKongRoutesNotReady(t *testing.T) {
 	})
 }
 
+func TestKongRoutesMismatched(t *testing.T) {
+	t.Run("TestKongRoutesMismatched_Success", func(t *testing.T) {
+		// Arrange
+		modules := 2
+
+		// Act
+		result := apperrors.KongRoutesMismatched(modules)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "kong routes of 2 module(s) do not match")
+		assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+	})
+}
+
 func TestKongAdminAPIFailed(t *testing.T) {
 	t.Run("TestKongAdminAPIFailed_Success", func(t *testing.T) {
 		// Arrange
@@ -656,6 +673,22 @@ func TestParentApplicationNotReachable(t *testing.T) {
 
 // ==================== Module Errors Tests ====================
 
+func TestModuleNotInApplication(t *testing.T) {
+	t.Run("TestModuleNotInApplication_Success", func(t *testing.T) {
+		// Arrange
+		moduleName := "mod-orders"
+		applicationName := "app-acquisitions"
+
+		// Act
+		result := apperrors.ModuleNotInApplication(moduleName, applicationName)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "module mod-orders is not part of application app-acquisitions")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
 func TestModulesNotDeployed(t *testing.T) {
 	t.Run("TestModulesNotDeployed_Success", func(t *testing.T) {
 		// Arrange
@@ -854,6 +887,25 @@ func TestModulePathNotDirectory(t *testing.T) {
 	})
 }
 
+func TestModuleDependenciesUnsatisfied(t *testing.T) {
+	t.Run("TestModuleDependenciesUnsatisfied_Success", func(t *testing.T) {
+		// Arrange
+		unsatisfied := []string{
+			"mod-orders-13.0.0 requires orders-storage.po-lines 12.0, provided 11.1",
+			"mod-invoice-5.0.0 requires finance.funds 3.0, not provided",
+		}
+
+		// Act
+		result := apperrors.ModuleDependenciesUnsatisfied(unsatisfied)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "2 interface(s) required by the modules are not provided")
+		assert.Contains(t, result.Error(), "orders-storage.po-lines 12.0, provided 11.1; mod-invoice-5.0.0")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
 // ==================== Tenant Errors Tests ====================
 
 func TestTenantNotFound(t *testing.T) {
@@ -1142,3 +1194,475 @@ func TestSidecarImageBlank(t *testing.T) {
 	assert.Contains(t, result.Error(), "sidecar image is blank")
 	assert.True(t, errors.Is(result, apperrors.ErrConfigMissing))
 }
+
+// ==================== Checkpoint Tests ====================
+
+func TestCheckpointReadFailed(t *testing.T) {
+	baseErr := errors.New("unexpected EOF")
+	result := apperrors.CheckpointReadFailed("/tmp/combined_checkpoint.json", baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "/tmp/combined_checkpoint.json")
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestCheckpointMismatch(t *testing.T) {
+	result := apperrors.CheckpointMismatch("/tmp/combined_checkpoint.json", "profile", "ecs", "combined")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "written for profile ecs, not combined")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestResumeWithCleanup(t *testing.T) {
+	result := apperrors.ResumeWithCleanup()
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "resume cannot be combined with cleanup")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+// ==================== Lockfile Tests ====================
+
+func TestLockfileNotFound(t *testing.T) {
+	baseErr := errors.New("open eureka.lock.yaml: no such file or directory")
+	result := apperrors.LockfileNotFound("eureka.lock.yaml", baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "lockfile eureka.lock.yaml not found, deploy with --lock to create it")
+	assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestLockfileReadFailed(t *testing.T) {
+	baseErr := errors.New("yaml: line 3: mapping values are not allowed in this context")
+	result := apperrors.LockfileReadFailed("eureka.lock.yaml", baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "failed to read lockfile eureka.lock.yaml")
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestLockfileProfileMismatch(t *testing.T) {
+	result := apperrors.LockfileProfileMismatch("eureka.lock.yaml", "combined", "ecs")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "lockfile eureka.lock.yaml pins profile combined, not ecs")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestLockfileModulesMissing(t *testing.T) {
+	result := apperrors.LockfileModulesMissing("eureka.lock.yaml", []string{"mod-orders", "folio_orders"})
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "does not pin modules mod-orders, folio_orders")
+	assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+}
+
+func TestLockWithFromLock(t *testing.T) {
+	result := apperrors.LockWithFromLock()
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "lock cannot be combined with fromLock")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+// ==================== Rollback Tests ====================
+
+func TestRollbackFailed(t *testing.T) {
+	baseErr := errors.New("connection refused")
+	result := apperrors.RollbackFailed("tenant", "diku", baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "failed to roll back tenant diku")
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+// ==================== Step Graph Tests ====================
+
+func TestStepDuplicate(t *testing.T) {
+	result := apperrors.StepDuplicate("Deploy System")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "step Deploy System is declared more than once")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestStepDependencyNotFound(t *testing.T) {
+	result := apperrors.StepDependencyNotFound("Deploy Modules", "Deploy Management")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "step Deploy Modules needs undeclared step Deploy Management")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestStepDependencyCycle(t *testing.T) {
+	result := apperrors.StepDependencyCycle([]string{"a", "b"})
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "steps a, b have a dependency cycle")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestStepFailed(t *testing.T) {
+	baseErr := errors.New("connection refused")
+	result := apperrors.StepFailed("Deploy UI", baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "step Deploy UI failed")
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestStepInterrupted(t *testing.T) {
+	result := apperrors.StepInterrupted("Deploy Modules", context.Canceled)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "step Deploy Modules interrupted")
+	assert.True(t, errors.Is(result, context.Canceled))
+}
+
+// ==================== Readiness Tests ====================
+
+func TestReadinessProbeTimeout(t *testing.T) {
+	baseErr := errors.New("connection refused")
+	result := apperrors.ReadinessProbeTimeout("vault", 2*time.Minute, baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "vault readiness probe exceeded 2m0s")
+	assert.True(t, errors.Is(result, apperrors.ErrTimeout))
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestPostgresNotReady(t *testing.T) {
+	baseErr := errors.New("exit status 2")
+	result := apperrors.PostgresNotReady(baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "postgres not ready")
+	assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestVaultSealed(t *testing.T) {
+	result := apperrors.VaultSealed()
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "vault is sealed")
+	assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+}
+
+// ==================== Output Tests ====================
+
+func TestUnsupportedOutputFormat(t *testing.T) {
+	result := apperrors.UnsupportedOutputFormat("yaml")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "unsupported output format yaml")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestEventsFdInvalid(t *testing.T) {
+	baseErr := errors.New("bad file descriptor")
+	result := apperrors.EventsFdInvalid(3, baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "events file descriptor 3 is invalid")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestEventsFdUnsupported(t *testing.T) {
+	result := apperrors.EventsFdUnsupported(0)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "events file descriptor 0 is not supported")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+// ==================== Status Tests ====================
+
+func TestEnvironmentUnhealthy(t *testing.T) {
+	result := apperrors.EnvironmentUnhealthy(2)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "environment has 2 problem(s)")
+	assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+}
+
+func TestModulesOutdated(t *testing.T) {
+	result := apperrors.ModulesOutdated(2, 10)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "2 module(s) are more than 10 build(s) behind the registry")
+	assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+}
+
+// ==================== Doctor Tests ====================
+
+func TestDoctorChecksFailed(t *testing.T) {
+	result := apperrors.DoctorChecksFailed(3)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "3 doctor check(s) failed")
+	assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+}
+
+// ==================== Log Tests ====================
+
+func TestLogLevelUnsupported(t *testing.T) {
+	result := apperrors.LogLevelUnsupported("VERBOSE")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "unsupported log level VERBOSE")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestLogRegexInvalid(t *testing.T) {
+	baseErr := errors.New("missing closing )")
+	result := apperrors.LogRegexInvalid("(ERROR", baseErr)
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "invalid log regex (ERROR")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	assert.True(t, errors.Is(result, baseErr))
+}
+
+func TestLogContainersNotFound(t *testing.T) {
+	result := apperrors.LogContainersNotFound("^eureka-combined")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "no containers match ^eureka-combined")
+	assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+}
+
+func TestExitError(t *testing.T) {
+	t.Run("TestExitError_WrapsError", func(t *testing.T) {
+		// Arrange
+		cause := apperrors.ErrTimeout
+
+		// Act
+		result := apperrors.WithExitCode(124, cause)
+
+		// Assert
+		assert.Equal(t, cause.Error(), result.Error())
+		assert.True(t, errors.Is(result, apperrors.ErrTimeout))
+	})
+}
+
+func TestGetExitCode(t *testing.T) {
+	t.Run("TestGetExitCode_NoError", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, 0, apperrors.GetExitCode(nil))
+	})
+
+	t.Run("TestGetExitCode_PlainError", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, 1, apperrors.GetExitCode(errors.New("failed")))
+	})
+
+	t.Run("TestGetExitCode_WrappedExitError", func(t *testing.T) {
+		// Arrange
+		err := fmt.Errorf("wait ready: %w", apperrors.WithExitCode(124, apperrors.ErrTimeout))
+
+		// Act & Assert
+		assert.Equal(t, 124, apperrors.GetExitCode(err))
+	})
+}
+
+func TestWaitReadyTimeout(t *testing.T) {
+	t.Run("TestWaitReadyTimeout_Success", func(t *testing.T) {
+		// Arrange
+		cause := apperrors.ModuleNotReady("mod-orders")
+
+		// Act
+		result := apperrors.WaitReadyTimeout("module containers", 10*time.Minute, cause)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "module containers not ready within 10m0s")
+		assert.True(t, errors.Is(result, apperrors.ErrTimeout))
+		assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+	})
+}
+
+func TestWaitReadyContainerFailed(t *testing.T) {
+	t.Run("TestWaitReadyContainerFailed_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.WaitReadyContainerFailed("eureka-mgr-tenants", "dead")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "container eureka-mgr-tenants is dead")
+		assert.True(t, errors.Is(result, apperrors.ErrDeploymentFailed))
+	})
+}
+
+func TestWaitReadyContainersNotFound(t *testing.T) {
+	t.Run("TestWaitReadyContainersNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.WaitReadyContainersNotFound("combined")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "no module containers of combined profile")
+		assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+	})
+}
+
+func TestWaitReadyRoutesMissing(t *testing.T) {
+	t.Run("TestWaitReadyRoutesMissing_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.WaitReadyRoutesMissing(3)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "3 kong route(s) missing")
+		assert.True(t, errors.Is(result, apperrors.ErrNotReady))
+	})
+}
+
+// ==================== Config Tests ====================
+
+func TestConfigInvalid(t *testing.T) {
+	t.Run("TestConfigInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigInvalid("config.combined.yaml", 2)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config config.combined.yaml has 2 problem(s)")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConfigNotMapping(t *testing.T) {
+	t.Run("TestConfigNotMapping_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigNotMapping("config.combined.yaml")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config config.combined.yaml must be a map of sections")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConfigExtendsInvalid(t *testing.T) {
+	t.Run("TestConfigExtendsInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigExtendsInvalid("config.mine.yaml")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "extends of config config.mine.yaml must be a profile name")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConfigExtendsCycle(t *testing.T) {
+	t.Run("TestConfigExtendsCycle_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigExtendsCycle([]string{"config.a.yaml", "config.b.yaml", "config.a.yaml"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config extends cycle config.a.yaml -> config.b.yaml -> config.a.yaml")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConfigDecodeFailed(t *testing.T) {
+	t.Run("TestConfigDecodeFailed_Success", func(t *testing.T) {
+		// Arrange
+		decodeErr := errors.New("'tenants[diku].deploy-ui' cannot parse value as 'bool'")
+
+		// Act
+		result := apperrors.ConfigDecodeFailed(decodeErr)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config cannot be decoded: 'tenants[diku].deploy-ui' cannot parse value as 'bool'")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+		assert.True(t, errors.Is(result, decodeErr))
+	})
+}
+
+func TestConfigInconsistent(t *testing.T) {
+	t.Run("TestConfigInconsistent_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigInconsistent([]string{"role admin has no tenant", "user diku_admin has no tenant"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config is inconsistent: role admin has no tenant; user diku_admin has no tenant")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConfigReferencesUnresolved(t *testing.T) {
+	t.Run("TestConfigReferencesUnresolved_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigReferencesUnresolved([]string{"environment.DB_PASSWORD: environment variable DB_PASSWORD is not set", "namespaces.mod-orders: reference ${NS is not closed"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config has unresolved references: environment.DB_PASSWORD: environment variable DB_PASSWORD is not set; namespaces.mod-orders: reference ${NS is not closed")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestProfileNotFound(t *testing.T) {
+	t.Run("TestProfileNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ProfileNotFound("acquisitions", []string{"/home/user/.eureka", "/opt/profiles"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "config of profile acquisitions not found in /home/user/.eureka, /opt/profiles")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestProfileNameInvalid(t *testing.T) {
+	result := apperrors.ProfileNameInvalid("../x")
+
+	assert.Error(t, result)
+	assert.Contains(t, result.Error(), "profile name ../x is invalid")
+	assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+}
+
+func TestProfileAlreadyExists(t *testing.T) {
+	t.Run("TestProfileAlreadyExists_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ProfileAlreadyExists("config.acquisitions.yaml")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "profile config config.acquisitions.yaml already exists")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestModulesNotInProfile(t *testing.T) {
+	t.Run("TestModulesNotInProfile_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ModulesNotInProfile("combined", []string{"mod-foo", "mod-bar"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "modules mod-foo, mod-bar are not in profile combined")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestModulesNotInRegistry(t *testing.T) {
+	t.Run("TestModulesNotInRegistry_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ModulesNotInRegistry([]string{"mod-foo", "folio_bar"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "modules mod-foo, folio_bar have no module descriptor in the platform applications")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
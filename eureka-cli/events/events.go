@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Type identifies the kind of an emitted event
+type Type string
+
+const (
+	StepStarted            Type = "stepStarted"
+	StepFinished           Type = "stepFinished"
+	StepFailed             Type = "stepFailed"
+	ModuleDeployed         Type = "moduleDeployed"
+	ModuleReady            Type = "moduleReady"
+	ApplicationCreated     Type = "applicationCreated"
+	ModuleDiscoveryCreated Type = "moduleDiscoveryCreated"
+	TenantCreated          Type = "tenantCreated"
+	TenantEntitled         Type = "tenantEntitled"
+	RoleCreated            Type = "roleCreated"
+	UserCreated            Type = "userCreated"
+	CapabilitySetsAttached Type = "capabilitySetsAttached"
+)
+
+// Event is a single machine-readable record of deployment progress
+type Event struct {
+	Type        Type      `json:"type"`
+	Timestamp   time.Time `json:"timestamp"`
+	Action      string    `json:"action"`
+	Step        string    `json:"step,omitempty"`
+	Module      string    `json:"module,omitempty"`
+	Application string    `json:"application,omitempty"`
+	Tenant      string    `json:"tenant,omitempty"`
+	Role        string    `json:"role,omitempty"`
+	User        string    `json:"user,omitempty"`
+	FlowID      string    `json:"flowId,omitempty"`
+	Count       int       `json:"count,omitempty"`
+	DurationMs  int64     `json:"durationMs,omitempty"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// Sink receives emitted events, implementations must be safe for concurrent use
+type Sink interface {
+	Emit(event Event)
+}
+
+// JSONSink writes every event as a single JSON line (NDJSON)
+type JSONSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONSink creates a new JSONSink instance
+func NewJSONSink(w io.Writer) *JSONSink {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	return &JSONSink{encoder: encoder}
+}
+
+func (s *JSONSink) Emit(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.encoder.Encode(event); err != nil {
+		slog.Warn(event.Action, "text", "Could not write event", "type", event.Type, "error", err)
+	}
+}
+
+// MultiSink fans out every event to several sinks
+type MultiSink []Sink
+
+// NewMultiSink creates a new MultiSink instance, nil sinks are dropped
+func NewMultiSink(sinks ...Sink) MultiSink {
+	var multiSink MultiSink
+	for _, sink := range sinks {
+		if sink != nil {
+			multiSink = append(multiSink, sink)
+		}
+	}
+
+	return multiSink
+}
+
+func (ms MultiSink) Emit(event Event) {
+	for _, sink := range ms {
+		sink.Emit(event)
+	}
+}
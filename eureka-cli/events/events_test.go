@@ -0,0 +1,96 @@
+package events_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]any
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func TestJSONSinkEmit_WritesOneLinePerEvent(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	sink := events.NewJSONSink(&buf)
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Act
+	sink.Emit(events.Event{Type: events.StepStarted, Timestamp: timestamp, Action: "Deploy Application", Step: "Deploy System"})
+	sink.Emit(events.Event{Type: events.TenantEntitled, Timestamp: timestamp, Action: "Deploy Application", Tenant: "diku", FlowID: "flow-1", DurationMs: 1500})
+
+	// Assert
+	lines := decodeLines(t, &buf)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, map[string]any{
+		"type":      "stepStarted",
+		"timestamp": "2026-01-02T03:04:05Z",
+		"action":    "Deploy Application",
+		"step":      "Deploy System",
+	}, lines[0])
+	assert.Equal(t, "tenantEntitled", lines[1]["type"])
+	assert.Equal(t, "flow-1", lines[1]["flowId"])
+	assert.Equal(t, float64(1500), lines[1]["durationMs"])
+}
+
+func TestJSONSinkEmit_ConcurrentEventsStayLineDelimited(t *testing.T) {
+	// Arrange
+	var (
+		buf  bytes.Buffer
+		wg   sync.WaitGroup
+		sink = events.NewJSONSink(&buf)
+	)
+
+	// Act
+	for range 50 {
+		wg.Go(func() {
+			sink.Emit(events.Event{Type: events.ModuleDeployed, Module: "mod-orders"})
+		})
+	}
+	wg.Wait()
+
+	// Assert
+	lines := decodeLines(t, &buf)
+	assert.Len(t, lines, 50)
+	for _, line := range lines {
+		assert.Equal(t, "moduleDeployed", line["type"])
+	}
+}
+
+type recordingSink struct {
+	events []events.Event
+}
+
+func (s *recordingSink) Emit(event events.Event) {
+	s.events = append(s.events, event)
+}
+
+func TestMultiSinkEmit_FansOutAndDropsNilSinks(t *testing.T) {
+	// Arrange
+	first, second := &recordingSink{}, &recordingSink{}
+	sink := events.NewMultiSink(first, nil, second)
+
+	// Act
+	sink.Emit(events.Event{Type: events.ModuleReady, Module: "mod-orders", DurationMs: 200})
+
+	// Assert
+	assert.Len(t, sink, 2)
+	assert.Equal(t, []events.Event{{Type: events.ModuleReady, Module: "mod-orders", DurationMs: 200}}, first.events)
+	assert.Equal(t, first.events, second.events)
+}
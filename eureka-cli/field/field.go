@@ -1,6 +1,7 @@
 package field
 
 const (
+	Extends                              = "extends"
 	Profile                              = "profile"
 	ProfileName                          = "profile.name"
 	Application                          = "application"
@@ -40,6 +41,7 @@ const (
 	UsersLastNameEntry                   = "last-name"
 	UsersFirstNameEntry                  = "first-name"
 	UsersRolesEntry                      = "roles"
+	UsersPermissionsEntry                = "permissions"
 	Roles                                = "roles"
 	RolesConsortiumEntry                 = "consortium"
 	RolesTenantEntry                     = "tenant"
@@ -71,6 +73,7 @@ const (
 	ModuleVolumesEntry                   = "volumes"
 	ModuleResourceEntry                  = "resources"
 	ModuleResourceCpuCountEntry          = "cpu-count"
+	ModuleResourceCpusEntry              = "cpus" // Deprecated, has no effect and is superseded by "cpu-count"
 	ModuleResourceMemoryReservationEntry = "memory-reservation"
 	ModuleResourceMemoryEntry            = "memory"
 	ModuleResourceMemorySwapEntry        = "memory-swap"
@@ -18,6 +18,7 @@ func newMockAction() *action.Action {
 		"test-action",
 		"http://localhost:%s",
 		params,
+		nil,
 	)
 }
 
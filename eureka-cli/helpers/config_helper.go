@@ -1,68 +1,108 @@
 package helpers
 
 import (
-	"slices"
+	"bytes"
+	"strings"
 
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"go.yaml.in/yaml/v3"
 )
 
-func IsModuleEnabled(module string, configBackendModules map[string]any) bool {
-	value, exists := configBackendModules[module]
-	if !exists || value == nil {
-		return false
-	}
+// IsModuleEnabled reports whether a module that needs system containers is enabled, an entry without settings
+// does not enable them, unlike its deployment with the defaults
+func IsModuleEnabled(module string, configBackendModules map[string]config.BackendModule) bool {
+	backendModule, exists := configBackendModules[module]
+
+	return exists && !backendModule.Bare && backendModule.IsDeployModule()
+}
+
+func IsUIEnabled(tenantName string, configTenants map[string]config.Tenant) bool {
+	tenant, exists := configTenants[tenantName]
+
+	return exists && tenant.DeployUI
+}
+
+func HasTenant(tenantName string, configTenants map[string]config.Tenant) bool {
+	_, exists := configTenants[tenantName]
 
-	entry, ok := value.(map[string]any)
-	if !ok {
-		return false
+	return exists
+}
+
+func GetBackendModuleNames(configBackendModules map[string]any) []string {
+	var names []string
+	for name := range configBackendModules {
+		names = append(names, name)
 	}
 
-	deploy, ok := entry[field.ModuleDeployModuleEntry]
-	if !ok {
+	return names
+}
+
+// ==================== Secrets ====================
+
+// IsSecretKey reports whether a config key or an environment variable name holds a secret, e.g. DB_PASSWORD,
+// KC_ADMIN_CLIENT_SECRET, SECRET_STORE_VAULT_TOKEN, AWS_SECRET_ACCESS_KEY or the password of a user
+func IsSecretKey(key string) bool {
+	key = strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+	if strings.Contains(key, "ACCESS_KEY") {
 		return true
 	}
-	enabled, ok := deploy.(bool)
+	for _, suffix := range []string{"PASSWORD", "SECRET", "TOKEN", "KEY"} {
+		if key == suffix || strings.HasSuffix(key, "_"+suffix) {
+			return true
+		}
+	}
 
-	return ok && enabled
+	return false
 }
 
-func IsUIEnabled(tenantName string, configTenants map[string]any) bool {
-	value, exists := configTenants[tenantName]
-	if !exists || value == nil {
-		return false
+// RedactConfigSecrets replaces the values of the secret keys of a YAML config, the comments and key order are preserved
+func RedactConfigSecrets(content []byte) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, err
 	}
+	redactYAMLNode(&root)
 
-	entry, ok := value.(map[string]any)
-	if !ok {
-		return false
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&root); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
 	}
-	deploy, ok := entry[field.TenantsDeployUIEntry]
-	enabled, isBool := deploy.(bool)
-
-	return ok && isBool && enabled
-}
 
-func HasTenant(tenantName string, configTenants map[string]any) bool {
-	tenants := ConvertMapKeyToSlice(configTenants)
-	return slices.Contains(tenants, tenantName)
+	return buffer.Bytes(), nil
 }
 
-// GetConfiguredPrivatePort resolves a module's private port from its config entry, preferring the
-// port-server compatibility alias over private-port; returns nil when no private port is configured
-func GetConfiguredPrivatePort(entry map[string]any) *int {
-	// Check for port-server (compatibility alias) first
-	if portServerPtr := GetIntPtr(entry, field.ModulePortServerEntry); portServerPtr != nil {
-		return portServerPtr
+func redactYAMLNode(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if value.Kind == yaml.ScalarNode && IsSecretKey(key.Value) {
+				value.SetString(constant.RedactedValue)
+				continue
+			}
+			redactYAMLNode(value)
+		}
+		return
+	}
+	for _, child := range node.Content {
+		redactYAMLNode(child)
 	}
-
-	return GetIntPtr(entry, field.ModulePrivatePortEntry)
 }
 
-func GetBackendModuleNames(configBackendModules map[string]any) []string {
-	var names []string
-	for name := range configBackendModules {
-		names = append(names, name)
+// RedactEnvSecrets replaces the values of the secret variables of a KEY=value environment
+func RedactEnvSecrets(env []string) []string {
+	redacted := make([]string, 0, len(env))
+	for _, variable := range env {
+		if key, _, found := strings.Cut(variable, "="); found && IsSecretKey(key) {
+			variable = key + "=" + constant.RedactedValue
+		}
+		redacted = append(redacted, variable)
 	}
 
-	return names
+	return redacted
 }
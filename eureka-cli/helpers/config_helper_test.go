@@ -3,6 +3,7 @@ package helpers_test
 import (
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/stretchr/testify/assert"
@@ -10,10 +11,8 @@ import (
 
 func TestIsModuleEnabled_EnabledModule(t *testing.T) {
 	// Arrange
-	configBackendModules := map[string]any{
-		"mod-users": map[string]any{
-			field.ModuleDeployModuleEntry: true,
-		},
+	configBackendModules := map[string]config.BackendModule{
+		"mod-users": {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	// Act
@@ -25,10 +24,8 @@ func TestIsModuleEnabled_EnabledModule(t *testing.T) {
 
 func TestIsModuleEnabled_DisabledModule(t *testing.T) {
 	// Arrange
-	configBackendModules := map[string]any{
-		"mod-users": map[string]any{
-			field.ModuleDeployModuleEntry: false,
-		},
+	configBackendModules := map[string]config.BackendModule{
+		"mod-users": {DeployModule: helpers.BoolPtr(false)},
 	}
 
 	// Act
@@ -40,7 +37,7 @@ func TestIsModuleEnabled_DisabledModule(t *testing.T) {
 
 func TestIsModuleEnabled_ModuleNotExists(t *testing.T) {
 	// Arrange
-	configBackendModules := map[string]any{}
+	configBackendModules := map[string]config.BackendModule{}
 
 	// Act
 	result := helpers.IsModuleEnabled("mod-users", configBackendModules)
@@ -51,21 +48,8 @@ func TestIsModuleEnabled_ModuleNotExists(t *testing.T) {
 
 func TestIsModuleEnabled_NilValue(t *testing.T) {
 	// Arrange
-	configBackendModules := map[string]any{
-		"mod-users": nil,
-	}
-
-	// Act
-	result := helpers.IsModuleEnabled("mod-users", configBackendModules)
-
-	// Assert
-	assert.False(t, result)
-}
-
-func TestIsModuleEnabled_InvalidEntryType(t *testing.T) {
-	// Arrange
-	configBackendModules := map[string]any{
-		"mod-users": "not-a-map",
+	configBackendModules := map[string]config.BackendModule{
+		"mod-users": {Bare: true},
 	}
 
 	// Act
@@ -77,10 +61,8 @@ func TestIsModuleEnabled_InvalidEntryType(t *testing.T) {
 
 func TestIsModuleEnabled_NoDeployEntry(t *testing.T) {
 	// Arrange
-	configBackendModules := map[string]any{
-		"mod-users": map[string]any{
-			"other-field": "value",
-		},
+	configBackendModules := map[string]config.BackendModule{
+		"mod-users": {},
 	}
 
 	// Act
@@ -90,27 +72,10 @@ func TestIsModuleEnabled_NoDeployEntry(t *testing.T) {
 	assert.True(t, result) // Default is true when deploy entry doesn't exist
 }
 
-func TestIsModuleEnabled_InvalidDeployType(t *testing.T) {
-	// Arrange
-	configBackendModules := map[string]any{
-		"mod-users": map[string]any{
-			field.ModuleDeployModuleEntry: "true",
-		},
-	}
-
-	// Act
-	result := helpers.IsModuleEnabled("mod-users", configBackendModules)
-
-	// Assert
-	assert.False(t, result)
-}
-
 func TestIsUIEnabled_EnabledUI(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{
-		"diku": map[string]any{
-			field.TenantsDeployUIEntry: true,
-		},
+	configTenants := map[string]config.Tenant{
+		"diku": {DeployUI: true},
 	}
 
 	// Act
@@ -122,10 +87,8 @@ func TestIsUIEnabled_EnabledUI(t *testing.T) {
 
 func TestIsUIEnabled_DisabledUI(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{
-		"diku": map[string]any{
-			field.TenantsDeployUIEntry: false,
-		},
+	configTenants := map[string]config.Tenant{
+		"diku": {DeployUI: false},
 	}
 
 	// Act
@@ -137,33 +100,7 @@ func TestIsUIEnabled_DisabledUI(t *testing.T) {
 
 func TestIsUIEnabled_TenantNotExists(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{}
-
-	// Act
-	result := helpers.IsUIEnabled("diku", configTenants)
-
-	// Assert
-	assert.False(t, result)
-}
-
-func TestIsUIEnabled_NilValue(t *testing.T) {
-	// Arrange
-	configTenants := map[string]any{
-		"diku": nil,
-	}
-
-	// Act
-	result := helpers.IsUIEnabled("diku", configTenants)
-
-	// Assert
-	assert.False(t, result)
-}
-
-func TestIsUIEnabled_InvalidEntryType(t *testing.T) {
-	// Arrange
-	configTenants := map[string]any{
-		"diku": "not-a-map",
-	}
+	configTenants := map[string]config.Tenant{}
 
 	// Act
 	result := helpers.IsUIEnabled("diku", configTenants)
@@ -174,25 +111,8 @@ func TestIsUIEnabled_InvalidEntryType(t *testing.T) {
 
 func TestIsUIEnabled_NoDeployUIEntry(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{
-		"diku": map[string]any{
-			"other-field": "value",
-		},
-	}
-
-	// Act
-	result := helpers.IsUIEnabled("diku", configTenants)
-
-	// Assert
-	assert.False(t, result)
-}
-
-func TestIsUIEnabled_InvalidDeployUIType(t *testing.T) {
-	// Arrange
-	configTenants := map[string]any{
-		"diku": map[string]any{
-			field.TenantsDeployUIEntry: "true",
-		},
+	configTenants := map[string]config.Tenant{
+		"diku": {},
 	}
 
 	// Act
@@ -204,9 +124,9 @@ func TestIsUIEnabled_InvalidDeployUIType(t *testing.T) {
 
 func TestHasTenant_TenantExists(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{
-		"diku":    map[string]any{},
-		"tenant2": map[string]any{},
+	configTenants := map[string]config.Tenant{
+		"diku":    {},
+		"tenant2": {},
 	}
 
 	// Act
@@ -218,8 +138,8 @@ func TestHasTenant_TenantExists(t *testing.T) {
 
 func TestHasTenant_TenantNotExists(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{
-		"tenant1": map[string]any{},
+	configTenants := map[string]config.Tenant{
+		"tenant1": {},
 	}
 
 	// Act
@@ -231,7 +151,7 @@ func TestHasTenant_TenantNotExists(t *testing.T) {
 
 func TestHasTenant_EmptyMap(t *testing.T) {
 	// Arrange
-	configTenants := map[string]any{}
+	configTenants := map[string]config.Tenant{}
 
 	// Act
 	result := helpers.HasTenant("diku", configTenants)
@@ -311,70 +231,80 @@ func TestGetBackendModuleNames_ModulesWithDifferentValues(t *testing.T) {
 	assert.ElementsMatch(t, []string{"mod-users", "mod-orders", "mod-audit", "mod-notes"}, result)
 }
 
-// ==================== GetConfiguredPrivatePort Tests ====================
-
-func TestGetConfiguredPrivatePort_PrivatePort(t *testing.T) {
-	// Arrange
-	entry := map[string]any{field.ModulePrivatePortEntry: 8080}
-
-	// Act
-	result := helpers.GetConfiguredPrivatePort(entry)
-
-	// Assert
-	assert.Equal(t, helpers.IntPtr(8080), result)
-}
-
-func TestGetConfiguredPrivatePort_PortServerAliasTakesPrecedence(t *testing.T) {
-	// Arrange
-	entry := map[string]any{
-		field.ModulePrivatePortEntry: 8080,
-		field.ModulePortServerEntry:  9090,
+// ==================== Secrets Tests ====================
+
+func TestIsSecretKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{"DB_PASSWORD", true},
+		{"KC_ADMIN_CLIENT_SECRET", true},
+		{"password", true},
+		{"db_password", true},
+		{"SECRET_STORE_VAULT_TOKEN", true},
+		{"AWS_SECRET_ACCESS_KEY", true},
+		{"S3_SECRET_ACCESS_KEY", true},
+		{"S3_ACCESS_KEY_ID", true},
+		{"api-key", true},
+		{"DB_PASSWORD_LENGTH_MIN", false},
+		{"KC_ADMIN_TOKEN_TTL", false},
+		{"X_OKAPI_TOKEN_HEADER_ENABLED", false},
+		{"SECRET_STORE_TYPE", false},
+		{"DB_HOST", false},
 	}
 
-	// Act
-	result := helpers.GetConfiguredPrivatePort(entry)
-
-	// Assert
-	assert.Equal(t, helpers.IntPtr(9090), result)
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.expected, helpers.IsSecretKey(tt.key))
+		})
+	}
 }
 
-func TestGetConfiguredPrivatePort_PortServerAliasWithoutPrivatePort(t *testing.T) {
+func TestRedactConfigSecrets(t *testing.T) {
 	// Arrange
-	entry := map[string]any{field.ModulePortServerEntry: 9090}
+	content := []byte(`# Environment
+environment:
+  DB_HOST: postgres.eureka
+  DB_PASSWORD: supersecret
+  KC_ADMIN_CLIENT_SECRET: supersecret
+users:
+  diku_admin:
+    password: admin
+    roles:
+      - adminRole
+`)
 
 	// Act
-	result := helpers.GetConfiguredPrivatePort(entry)
+	redacted, err := helpers.RedactConfigSecrets(content)
 
 	// Assert
-	assert.Equal(t, helpers.IntPtr(9090), result)
+	assert.NoError(t, err)
+	assert.Equal(t, `# Environment
+environment:
+  DB_HOST: postgres.eureka
+  DB_PASSWORD: <redacted>
+  KC_ADMIN_CLIENT_SECRET: <redacted>
+users:
+  diku_admin:
+    password: <redacted>
+    roles:
+      - adminRole
+`, string(redacted))
 }
 
-func TestGetConfiguredPrivatePort_NotConfigured(t *testing.T) {
+func TestRedactConfigSecrets_InvalidYAML(t *testing.T) {
 	// Act
-	result := helpers.GetConfiguredPrivatePort(map[string]any{})
+	_, err := helpers.RedactConfigSecrets([]byte("environment: [unclosed"))
 
 	// Assert
-	assert.Nil(t, result)
+	assert.Error(t, err)
 }
 
-func TestGetConfiguredPrivatePort_NilPrivatePort(t *testing.T) {
-	// Arrange
-	entry := map[string]any{field.ModulePrivatePortEntry: nil}
-
+func TestRedactEnvSecrets(t *testing.T) {
 	// Act
-	result := helpers.GetConfiguredPrivatePort(entry)
+	redacted := helpers.RedactEnvSecrets([]string{"DB_HOST=postgres.eureka", "DB_PASSWORD=supersecret", "EMPTY"})
 
 	// Assert
-	assert.Nil(t, result)
-}
-
-func TestGetConfiguredPrivatePort_NonIntPrivatePort(t *testing.T) {
-	// Arrange
-	entry := map[string]any{field.ModulePrivatePortEntry: "8080"}
-
-	// Act
-	result := helpers.GetConfiguredPrivatePort(entry)
-
-	// Assert
-	assert.Nil(t, result)
+	assert.Equal(t, []string{"DB_HOST=postgres.eureka", "DB_PASSWORD=<redacted>", "EMPTY"}, redacted)
 }
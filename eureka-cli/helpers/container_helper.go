@@ -1,14 +1,17 @@
 package helpers
 
 import (
+	"encoding/binary"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/netip"
 	"strconv"
+	"strings"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/api/types/network"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
@@ -45,6 +48,16 @@ func GetSidecarName(moduleName string) string {
 	return fmt.Sprintf("%s-sc", moduleName)
 }
 
+// SplitImageTag splits an image name into its repository and its tag, a colon of a registry port is not a tag separator
+func SplitImageTag(imageName string) (string, string) {
+	separator := strings.LastIndex(imageName, ":")
+	if separator <= strings.LastIndex(imageName, "/") {
+		return imageName, ""
+	}
+
+	return imageName[:separator], imageName[separator+1:]
+}
+
 // privateDebugPort and hostIP are parsed from compile-time constants, so Must* parsing cannot panic at runtime
 var (
 	privateDebugPort = network.MustParsePort(constant.PrivateDebugPort)
@@ -97,20 +110,28 @@ func CreatePortBindings(hostServerPort int, hostServerDebugPort int, privateServ
 	return &portMap, nil
 }
 
-func CreateResources(isModule bool, r map[string]any) *container.Resources {
-	if len(r) == 0 {
+func CreateResources(isModule bool, r config.Resources) *container.Resources {
+	if r.IsEmpty() {
 		return createDefaultResources(isModule)
 	}
 
 	return &container.Resources{
-		CPUCount:          GetIntOrDefault(r, field.ModuleResourceCpuCountEntry, constant.ModuleCPU),
-		MemoryReservation: ConvertMemory(MibToBytes, GetIntOrDefault(r, field.ModuleResourceMemoryReservationEntry, constant.ModuleMemoryReservation)),
-		Memory:            ConvertMemory(MibToBytes, GetIntOrDefault(r, field.ModuleResourceMemoryEntry, constant.ModuleMemory)),
-		MemorySwap:        ConvertMemory(MibToBytes, GetIntOrDefault(r, field.ModuleResourceMemorySwapEntry, constant.ModuleSwap)),
-		OomKillDisable:    BoolPtr(GetBoolOrDefault(r, field.ModuleResourceOomKillDisableEntry, false)),
+		CPUCount:          valueOrDefault(r.CPUCount, constant.ModuleCPU),
+		MemoryReservation: ConvertMemory(MibToBytes, valueOrDefault(r.MemoryReservation, constant.ModuleMemoryReservation)),
+		Memory:            ConvertMemory(MibToBytes, valueOrDefault(r.Memory, constant.ModuleMemory)),
+		MemorySwap:        ConvertMemory(MibToBytes, valueOrDefault(r.MemorySwap, constant.ModuleSwap)),
+		OomKillDisable:    BoolPtr(valueOrDefault(r.OomKillDisable, false)),
 	}
 }
 
+func valueOrDefault[T any](value *T, defaultValue T) T {
+	if value == nil {
+		return defaultValue
+	}
+
+	return *value
+}
+
 func createDefaultResources(isModule bool) *container.Resources {
 	if isModule {
 		return &container.Resources{
@@ -131,7 +152,7 @@ func createDefaultResources(isModule bool) *container.Resources {
 	}
 }
 
-func AppendRequiredContainers(actionName string, containers []string, backendModules map[string]any) []string {
+func AppendRequiredContainers(actionName string, containers []string, backendModules map[string]config.BackendModule) []string {
 	if IsModuleEnabled(constant.ModSearchModule, backendModules) {
 		containers = append(containers, constant.OpenSearchContainer)
 	}
@@ -145,3 +166,49 @@ func AppendRequiredContainers(actionName string, containers []string, backendMod
 
 	return containers
 }
+
+// ReadDockerLogFrame reads a single frame of a multiplexed container log stream, the frame header
+// holds the stream type (stdout or stderr) followed by the big endian size of the payload
+func ReadDockerLogFrame(r io.Reader) (stream byte, payload []byte, err error) {
+	header := make([]byte, constant.DockerLogHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+
+	payload = make([]byte, binary.BigEndian.Uint32(header[constant.DockerLogSizeOffset:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+
+	return header[0], payload, nil
+}
+
+// GetContainerCPUPercent returns the CPU usage of a container between the previous and the current stats sample,
+// 100% is a single fully used CPU
+func GetContainerCPUPercent(stats container.StatsResponse) float64 {
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	return cpuDelta / systemDelta * onlineCPUs * 100
+}
+
+// GetContainerMemoryUsage returns the memory used by a container without the inactive page cache, the same way as `docker stats`
+func GetContainerMemoryUsage(stats container.StatsResponse) int64 {
+	usage := stats.MemoryStats.Usage
+	// cgroup v1 and cgroup v2 name the inactive page cache differently
+	for _, key := range []string{"total_inactive_file", "inactive_file"} {
+		if inactive, ok := stats.MemoryStats.Stats[key]; ok && inactive < usage {
+			return int64(usage - inactive)
+		}
+	}
+
+	return int64(usage)
+}
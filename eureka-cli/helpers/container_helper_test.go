@@ -1,11 +1,14 @@
 package helpers_test
 
 import (
+	"bytes"
+	"io"
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/api/types/network"
 	"github.com/stretchr/testify/assert"
 )
@@ -92,6 +95,24 @@ func TestGetSidecarName_EmptyModule(t *testing.T) {
 	assert.Equal(t, "-sc", result)
 }
 
+func TestSplitImageTag_WithTag(t *testing.T) {
+	// Act
+	repository, tag := helpers.SplitImageTag("folioorg/mod-users:19.5.0")
+
+	// Assert
+	assert.Equal(t, "folioorg/mod-users", repository)
+	assert.Equal(t, "19.5.0", tag)
+}
+
+func TestSplitImageTag_RegistryPortWithoutTag(t *testing.T) {
+	// Act
+	repository, tag := helpers.SplitImageTag("localhost:5000/mod-users")
+
+	// Assert
+	assert.Equal(t, "localhost:5000/mod-users", repository)
+	assert.Empty(t, tag)
+}
+
 func TestCreateExposedPorts_ValidPort(t *testing.T) {
 	// Arrange
 	privateServerPort := 8081
@@ -154,12 +175,12 @@ func TestCreatePortBindings_InvalidPrivatePort(t *testing.T) {
 
 func TestCreateResources_WithCustomResources(t *testing.T) {
 	// Arrange
-	resources := map[string]any{
-		field.ModuleResourceCpuCountEntry:          2,
-		field.ModuleResourceMemoryReservationEntry: 256,
-		field.ModuleResourceMemoryEntry:            1024,
-		field.ModuleResourceMemorySwapEntry:        2048,
-		field.ModuleResourceOomKillDisableEntry:    true,
+	resources := config.Resources{
+		CPUCount:          helpers.Int64Ptr(2),
+		MemoryReservation: helpers.Int64Ptr(256),
+		Memory:            helpers.Int64Ptr(1024),
+		MemorySwap:        helpers.Int64Ptr(2048),
+		OomKillDisable:    helpers.BoolPtr(true),
 	}
 
 	// Act
@@ -175,9 +196,27 @@ func TestCreateResources_WithCustomResources(t *testing.T) {
 	assert.True(t, *result.OomKillDisable)
 }
 
+func TestCreateResources_PartialResources(t *testing.T) {
+	// Arrange
+	resources := config.Resources{
+		Memory: helpers.Int64Ptr(1024),
+	}
+
+	// Act
+	result := helpers.CreateResources(true, resources)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, int64(constant.ModuleCPU), result.CPUCount)
+	assert.Equal(t, int64(constant.ModuleMemoryReservation*1024*1024), result.MemoryReservation)
+	assert.Equal(t, int64(1073741824), result.Memory) // 1024 MiB in bytes
+	assert.Equal(t, int64(constant.ModuleSwap), result.MemorySwap)
+	assert.False(t, *result.OomKillDisable)
+}
+
 func TestCreateResources_EmptyResourcesForModule(t *testing.T) {
 	// Arrange
-	resources := map[string]any{}
+	resources := config.Resources{}
 
 	// Act
 	result := helpers.CreateResources(true, resources)
@@ -194,7 +233,7 @@ func TestCreateResources_EmptyResourcesForModule(t *testing.T) {
 
 func TestCreateResources_EmptyResourcesForSidecar(t *testing.T) {
 	// Arrange
-	resources := map[string]any{}
+	resources := config.Resources{}
 
 	// Act
 	result := helpers.CreateResources(false, resources)
@@ -213,10 +252,8 @@ func TestAppendRequiredContainers_WithSearchModule(t *testing.T) {
 	// Arrange
 	actionName := "TestAction"
 	requiredContainers := []string{}
-	configBackendModules := map[string]any{
-		constant.ModSearchModule: map[string]any{
-			field.ModuleDeployModuleEntry: true,
-		},
+	configBackendModules := map[string]config.BackendModule{
+		constant.ModSearchModule: {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	// Act
@@ -230,10 +267,8 @@ func TestAppendRequiredContainers_WithDataExportWorkerModule(t *testing.T) {
 	// Arrange
 	actionName := "TestAction"
 	requiredContainers := []string{}
-	configBackendModules := map[string]any{
-		constant.ModDataExportWorkerModule: map[string]any{
-			field.ModuleDeployModuleEntry: true,
-		},
+	configBackendModules := map[string]config.BackendModule{
+		constant.ModDataExportWorkerModule: {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	// Act
@@ -249,13 +284,9 @@ func TestAppendRequiredContainers_WithBothModules(t *testing.T) {
 	// Arrange
 	actionName := "TestAction"
 	requiredContainers := []string{"existing-container"}
-	configBackendModules := map[string]any{
-		constant.ModSearchModule: map[string]any{
-			field.ModuleDeployModuleEntry: true,
-		},
-		constant.ModDataExportWorkerModule: map[string]any{
-			field.ModuleDeployModuleEntry: true,
-		},
+	configBackendModules := map[string]config.BackendModule{
+		constant.ModSearchModule:           {DeployModule: helpers.BoolPtr(true)},
+		constant.ModDataExportWorkerModule: {DeployModule: helpers.BoolPtr(true)},
 	}
 
 	// Act
@@ -274,7 +305,7 @@ func TestAppendRequiredContainers_NoModulesEnabled(t *testing.T) {
 	// Arrange
 	actionName := "TestAction"
 	requiredContainers := []string{}
-	configBackendModules := map[string]any{}
+	configBackendModules := map[string]config.BackendModule{}
 
 	// Act
 	result := helpers.AppendRequiredContainers(actionName, requiredContainers, configBackendModules)
@@ -287,10 +318,8 @@ func TestAppendRequiredContainers_ModulesDisabled(t *testing.T) {
 	// Arrange
 	actionName := "TestAction"
 	requiredContainers := []string{}
-	configBackendModules := map[string]any{
-		constant.ModSearchModule: map[string]any{
-			field.ModuleDeployModuleEntry: false,
-		},
+	configBackendModules := map[string]config.BackendModule{
+		constant.ModSearchModule: {DeployModule: helpers.BoolPtr(false)},
 	}
 
 	// Act
@@ -299,3 +328,83 @@ func TestAppendRequiredContainers_ModulesDisabled(t *testing.T) {
 	// Assert
 	assert.Empty(t, result)
 }
+
+func TestReadDockerLogFrame_ReadsFramesUntilEOF(t *testing.T) {
+	// Arrange
+	stream := bytes.NewBuffer([]byte{1, 0, 0, 0, 0, 0, 0, 6})
+	stream.WriteString("hello\n")
+	stream.Write([]byte{2, 0, 0, 0, 0, 0, 0, 5})
+	stream.WriteString("oops\n")
+
+	// Act
+	firstStream, firstPayload, firstErr := helpers.ReadDockerLogFrame(stream)
+	secondStream, secondPayload, secondErr := helpers.ReadDockerLogFrame(stream)
+	_, _, eofErr := helpers.ReadDockerLogFrame(stream)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.Equal(t, byte(1), firstStream)
+	assert.Equal(t, "hello\n", string(firstPayload))
+	assert.NoError(t, secondErr)
+	assert.Equal(t, byte(2), secondStream)
+	assert.Equal(t, "oops\n", string(secondPayload))
+	assert.ErrorIs(t, eofErr, io.EOF)
+}
+
+func TestReadDockerLogFrame_TruncatedPayload(t *testing.T) {
+	// Arrange
+	stream := bytes.NewBuffer([]byte{1, 0, 0, 0, 0, 0, 0, 10})
+	stream.WriteString("short")
+
+	// Act
+	_, _, err := helpers.ReadDockerLogFrame(stream)
+
+	// Assert
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestGetContainerCPUPercent(t *testing.T) {
+	// Arrange
+	stats := container.StatsResponse{
+		CPUStats:    container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: 300}, SystemUsage: 2000, OnlineCPUs: 4},
+		PreCPUStats: container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: 100}, SystemUsage: 1000},
+	}
+
+	// Act
+	percent := helpers.GetContainerCPUPercent(stats)
+
+	// Assert
+	assert.InDelta(t, 80.0, percent, 0.001)
+}
+
+func TestGetContainerCPUPercent_NoPreviousSample(t *testing.T) {
+	// Arrange
+	stats := container.StatsResponse{
+		CPUStats: container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: 300}, SystemUsage: 0, OnlineCPUs: 4},
+	}
+
+	// Act
+	percent := helpers.GetContainerCPUPercent(stats)
+
+	// Assert
+	assert.Zero(t, percent)
+}
+
+func TestGetContainerMemoryUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    map[string]uint64
+		expected int64
+	}{
+		{"cgroup v1", map[string]uint64{"total_inactive_file": 100}, 900},
+		{"cgroup v2", map[string]uint64{"inactive_file": 200}, 800},
+		{"no page cache", nil, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := container.StatsResponse{MemoryStats: container.MemoryStats{Usage: 1000, Stats: tt.stats}}
+			assert.Equal(t, tt.expected, helpers.GetContainerMemoryUsage(stats))
+		})
+	}
+}
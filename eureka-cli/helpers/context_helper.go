@@ -0,0 +1,48 @@
+package helpers
+
+import (
+	"context"
+	"time"
+)
+
+// Sleep pauses for the duration or until the context is cancelled, returning the context error in the latter case
+func Sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type accessTokenKey struct{}
+
+type masterAccessTokenKey struct{}
+
+// WithAccessToken returns a context carrying the access token of a tenant realm, the token is scoped to the work done
+// with the context so that the steps of different tenants can run concurrently
+func WithAccessToken(ctx context.Context, accessToken string) context.Context {
+	return context.WithValue(ctx, accessTokenKey{}, accessToken)
+}
+
+// GetAccessToken returns the access token of a tenant realm carried by the context, or an empty token
+func GetAccessToken(ctx context.Context) string {
+	accessToken, _ := ctx.Value(accessTokenKey{}).(string)
+
+	return accessToken
+}
+
+// WithMasterAccessToken returns a context carrying the access token of the master realm
+func WithMasterAccessToken(ctx context.Context, accessToken string) context.Context {
+	return context.WithValue(ctx, masterAccessTokenKey{}, accessToken)
+}
+
+// GetMasterAccessToken returns the access token of the master realm carried by the context, or an empty token
+func GetMasterAccessToken(ctx context.Context) string {
+	accessToken, _ := ctx.Value(masterAccessTokenKey{}).(string)
+
+	return accessToken
+}
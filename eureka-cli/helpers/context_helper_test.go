@@ -0,0 +1,58 @@
+package helpers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSleep_Elapsed(t *testing.T) {
+	// Act
+	err := helpers.Sleep(context.Background(), time.Millisecond)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestSleep_Cancelled(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+
+	// Act
+	err := helpers.Sleep(ctx, time.Minute)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestAccessTokens(t *testing.T) {
+	t.Run("TestAccessTokens_EmptyByDefault", func(t *testing.T) {
+		// Act
+		ctx := context.Background()
+
+		// Assert
+		assert.Empty(t, helpers.GetAccessToken(ctx))
+		assert.Empty(t, helpers.GetMasterAccessToken(ctx))
+	})
+
+	t.Run("TestAccessTokens_ScopedToContext", func(t *testing.T) {
+		// Arrange
+		parent := helpers.WithMasterAccessToken(context.Background(), "master-token")
+
+		// Act
+		diku := helpers.WithAccessToken(parent, "diku-token")
+		consortium := helpers.WithAccessToken(parent, "consortium-token")
+
+		// Assert
+		assert.Equal(t, "diku-token", helpers.GetAccessToken(diku))
+		assert.Equal(t, "consortium-token", helpers.GetAccessToken(consortium))
+		assert.Empty(t, helpers.GetAccessToken(parent))
+		assert.Equal(t, "master-token", helpers.GetMasterAccessToken(diku))
+	})
+}
@@ -2,14 +2,9 @@ package helpers
 
 import (
 	"bufio"
-	"context"
-	"embed"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
-	"io/fs"
-	"log/slog"
 	"os"
 	"path/filepath"
 
@@ -87,34 +82,6 @@ func CloseFile(file *os.File) {
 	_ = file.Close()
 }
 
-func CopyMultipleFiles(homeDir string, srcFs *embed.FS) error {
-	return fs.WalkDir(*srcFs, ".", func(path string, dir fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-
-		dstPath := filepath.Join(homeDir, path)
-		if dir.IsDir() {
-			if err := os.MkdirAll(dstPath, constant.DirPerm); err != nil {
-				return err
-			}
-		} else {
-			content, err := fs.ReadFile(*srcFs, path)
-			if err != nil {
-				return err
-			}
-			if err := os.WriteFile(dstPath, content, 0644); err != nil {
-				return err
-			}
-			if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
-				fmt.Println("Created file:", dstPath)
-			}
-		}
-
-		return nil
-	})
-}
-
 func IsRegularFile(fileName string) error {
 	s, err := os.Stat(fileName)
 	if err != nil {
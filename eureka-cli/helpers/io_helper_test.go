@@ -68,7 +68,7 @@ func TestReadJSONFromFile_FromEmbeddedTestData(t *testing.T) {
 		tmpDir := t.TempDir()
 
 		// Copy embedded config.json to temp directory
-		err := helpers.CopyMultipleFiles(tmpDir, &testEmbedFS)
+		_, _, err := helpers.MergeMultipleFiles(tmpDir, &testEmbedFS)
 		assert.NoError(t, err)
 
 		configPath := filepath.Join(tmpDir, "testdata", "config.json")
@@ -90,7 +90,7 @@ func TestReadJSONFromFile_StructuredData(t *testing.T) {
 		tmpDir := t.TempDir()
 
 		// Copy embedded test data
-		err := helpers.CopyMultipleFiles(tmpDir, &testEmbedFS)
+		_, _, err := helpers.MergeMultipleFiles(tmpDir, &testEmbedFS)
 		assert.NoError(t, err)
 
 		configPath := filepath.Join(tmpDir, "testdata", "config.json")
@@ -145,7 +145,7 @@ func TestWriteJSONToFile_ReadWriteRoundTrip(t *testing.T) {
 		tmpDir := t.TempDir()
 
 		// First, copy and read from embedded test data
-		err := helpers.CopyMultipleFiles(tmpDir, &testEmbedFS)
+		_, _, err := helpers.MergeMultipleFiles(tmpDir, &testEmbedFS)
 		assert.NoError(t, err)
 
 		srcPath := filepath.Join(tmpDir, "testdata", "config.json")
@@ -250,7 +250,7 @@ func TestCopySingleFile_FromEmbeddedTestData(t *testing.T) {
 
 		// First copy embedded files to a source directory
 		srcDir := filepath.Join(tmpDir, "src")
-		err := helpers.CopyMultipleFiles(srcDir, &testEmbedFS)
+		_, _, err := helpers.MergeMultipleFiles(srcDir, &testEmbedFS)
 		assert.NoError(t, err)
 
 		srcFile := filepath.Join(srcDir, "testdata", "file1.txt")
@@ -279,7 +279,7 @@ func TestCopySingleFile_NestedFile(t *testing.T) {
 
 		// Copy embedded test data
 		srcDir := filepath.Join(tmpDir, "src")
-		err := helpers.CopyMultipleFiles(srcDir, &testEmbedFS)
+		_, _, err := helpers.MergeMultipleFiles(srcDir, &testEmbedFS)
 		assert.NoError(t, err)
 
 		nestedSrc := filepath.Join(srcDir, "testdata", "subdir", "nested.txt")
@@ -304,7 +304,7 @@ func TestCopySingleFile_JSONFile(t *testing.T) {
 
 		// Copy embedded test data
 		srcDir := filepath.Join(tmpDir, "src")
-		err := helpers.CopyMultipleFiles(srcDir, &testEmbedFS)
+		_, _, err := helpers.MergeMultipleFiles(srcDir, &testEmbedFS)
 		assert.NoError(t, err)
 
 		jsonSrc := filepath.Join(srcDir, "testdata", "config.json")
@@ -477,150 +477,3 @@ func TestCloseReader_NoError(t *testing.T) {
 	// Act & Assert - Should not panic
 	helpers.CloseReader(file)
 }
-
-// Tests for CopyMultipleFiles
-
-func TestCopyMultipleFiles_Success(t *testing.T) {
-	t.Run("TestCopyMultipleFiles_Success", func(t *testing.T) {
-		// Arrange
-		dstDir := t.TempDir()
-
-		// Act
-		err := helpers.CopyMultipleFiles(dstDir, &testEmbedFS)
-
-		// Assert
-		assert.NoError(t, err)
-
-		// Verify all files were copied
-		file1Path := filepath.Join(dstDir, "testdata", "file1.txt")
-		file2Path := filepath.Join(dstDir, "testdata", "file2.txt")
-		configPath := filepath.Join(dstDir, "testdata", "config.json")
-		nestedPath := filepath.Join(dstDir, "testdata", "subdir", "nested.txt")
-
-		// Check file1.txt
-		content1, err := os.ReadFile(file1Path)
-		assert.NoError(t, err)
-		assert.Contains(t, string(content1), "Test content for file 1")
-
-		// Check file2.txt
-		content2, err := os.ReadFile(file2Path)
-		assert.NoError(t, err)
-		assert.Contains(t, string(content2), "Test content for file 2")
-
-		// Check config.json
-		contentJSON, err := os.ReadFile(configPath)
-		assert.NoError(t, err)
-		assert.Contains(t, string(contentJSON), "test")
-		assert.Contains(t, string(contentJSON), "1.0.0")
-
-		// Check nested file
-		contentNested, err := os.ReadFile(nestedPath)
-		assert.NoError(t, err)
-		assert.Contains(t, string(contentNested), "Nested file content")
-	})
-}
-
-func TestCopyMultipleFiles_CreatesDirectories(t *testing.T) {
-	t.Run("TestCopyMultipleFiles_CreatesDirectories", func(t *testing.T) {
-		// Arrange
-		dstDir := t.TempDir()
-
-		// Act
-		err := helpers.CopyMultipleFiles(dstDir, &testEmbedFS)
-
-		// Assert
-		assert.NoError(t, err)
-
-		// Verify directories were created
-		testdataDir := filepath.Join(dstDir, "testdata")
-		subdirPath := filepath.Join(dstDir, "testdata", "subdir")
-
-		testdataInfo, err := os.Stat(testdataDir)
-		assert.NoError(t, err)
-		assert.True(t, testdataInfo.IsDir(), "testdata should be a directory")
-
-		subdirInfo, err := os.Stat(subdirPath)
-		assert.NoError(t, err)
-		assert.True(t, subdirInfo.IsDir(), "subdir should be a directory")
-	})
-}
-
-func TestCopyMultipleFiles_PreservesFilePermissions(t *testing.T) {
-	t.Run("TestCopyMultipleFiles_PreservesFilePermissions", func(t *testing.T) {
-		// Arrange
-		dstDir := t.TempDir()
-
-		// Act
-		err := helpers.CopyMultipleFiles(dstDir, &testEmbedFS)
-
-		// Assert
-		assert.NoError(t, err)
-
-		// Verify file exists and is readable
-		file1Path := filepath.Join(dstDir, "testdata", "file1.txt")
-		fileInfo, err := os.Stat(file1Path)
-		assert.NoError(t, err)
-		assert.False(t, fileInfo.IsDir(), "should be a file not a directory")
-
-		// Verify we can read the file (basic permission check)
-		content, err := os.ReadFile(file1Path)
-		assert.NoError(t, err)
-		assert.NotEmpty(t, content)
-	})
-}
-
-func TestCopyMultipleFiles_InvalidDestination(t *testing.T) {
-	t.Run("TestCopyMultipleFiles_InvalidDestination", func(t *testing.T) {
-		// Arrange - Use an invalid path that cannot be written to
-		invalidPath := filepath.Join(string([]byte{0}), "invalid")
-
-		// Act
-		err := helpers.CopyMultipleFiles(invalidPath, &testEmbedFS)
-
-		// Assert
-		assert.Error(t, err)
-	})
-}
-
-func TestCopyMultipleFiles_OverwritesExistingFiles(t *testing.T) {
-	t.Run("TestCopyMultipleFiles_OverwritesExistingFiles", func(t *testing.T) {
-		// Arrange
-		dstDir := t.TempDir()
-
-		// Create pre-existing directory and file
-		testdataDir := filepath.Join(dstDir, "testdata")
-		err := os.MkdirAll(testdataDir, 0755)
-		assert.NoError(t, err)
-
-		existingFilePath := filepath.Join(testdataDir, "file1.txt")
-		err = os.WriteFile(existingFilePath, []byte("old content"), 0644)
-		assert.NoError(t, err)
-
-		// Act
-		err = helpers.CopyMultipleFiles(dstDir, &testEmbedFS)
-
-		// Assert
-		assert.NoError(t, err)
-
-		// Verify file was overwritten with new content
-		content, err := os.ReadFile(existingFilePath)
-		assert.NoError(t, err)
-		assert.Contains(t, string(content), "Test content for file 1")
-		assert.NotContains(t, string(content), "old content")
-	})
-}
-
-func TestCopyMultipleFiles_EmptyEmbedFS(t *testing.T) {
-	t.Run("TestCopyMultipleFiles_EmptyEmbedFS", func(t *testing.T) {
-		// Arrange
-		dstDir := t.TempDir()
-		var emptyFS embed.FS
-
-		// Act
-		err := helpers.CopyMultipleFiles(dstDir, &emptyFS)
-
-		// Assert
-		// Should complete without error even with empty FS
-		assert.NoError(t, err)
-	})
-}
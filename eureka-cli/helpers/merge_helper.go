@@ -0,0 +1,232 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// MergeConflict is a region of a home directory file that was changed both locally and upstream, the local lines are kept
+type MergeConflict struct {
+	Path     string
+	Line     int
+	Local    []string
+	Upstream []string
+}
+
+// UnmergedFile is a home directory file that differs from its upstream version but has no upstream copy to merge with,
+// e.g. after upgrading from a CLI version that did not keep them, the file is kept and Diff holds the upstream changes
+type UnmergedFile struct {
+	Path string
+	Diff string
+}
+
+// String formats the conflict as a diff from the kept local lines to the upstream lines that were not applied
+func (c MergeConflict) String() string {
+	var builder strings.Builder
+	_, _ = fmt.Fprintf(&builder, "%s:%d\n", c.Path, c.Line)
+	for _, line := range c.Local {
+		builder.WriteString("-" + strings.TrimSuffix(line, "\n") + "\n")
+	}
+	for _, line := range c.Upstream {
+		builder.WriteString("+" + strings.TrimSuffix(line, "\n") + "\n")
+	}
+
+	return builder.String()
+}
+
+// SplitFileLines splits the content of a file into lines that keep their line endings, so that joining them restores the content
+func SplitFileLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// MergeLines does a three-way merge of the local and upstream changes made to the base lines, the local lines are kept
+// where both changed the same region
+func MergeLines(base, local, upstream []string) ([]string, []MergeConflict) {
+	var (
+		merged          []string
+		conflicts       []MergeConflict
+		localMatches    = matchLines(base, local)
+		upstreamMatches = matchLines(base, upstream)
+		baseIndex       int
+		localIndex      int
+		upstreamIndex   int
+	)
+	for baseIndex < len(base) || localIndex < len(local) || upstreamIndex < len(upstream) {
+		if baseIndex < len(base) && localMatches[baseIndex] == localIndex && upstreamMatches[baseIndex] == upstreamIndex {
+			merged = append(merged, base[baseIndex])
+			baseIndex, localIndex, upstreamIndex = baseIndex+1, localIndex+1, upstreamIndex+1
+			continue
+		}
+
+		// The changed region ends at the next base line that is kept by both sides
+		nextBase, nextLocal, nextUpstream := len(base), len(local), len(upstream)
+		for i := baseIndex; i < len(base); i++ {
+			if localMatches[i] >= 0 && upstreamMatches[i] >= 0 {
+				nextBase, nextLocal, nextUpstream = i, localMatches[i], upstreamMatches[i]
+				break
+			}
+		}
+		baseChunk, localChunk, upstreamChunk := base[baseIndex:nextBase], local[localIndex:nextLocal], upstream[upstreamIndex:nextUpstream]
+		switch {
+		case slices.Equal(localChunk, baseChunk):
+			merged = append(merged, upstreamChunk...)
+		case slices.Equal(upstreamChunk, baseChunk), slices.Equal(localChunk, upstreamChunk):
+			merged = append(merged, localChunk...)
+		default:
+			conflicts = append(conflicts, MergeConflict{Line: len(merged) + 1, Local: localChunk, Upstream: upstreamChunk})
+			merged = append(merged, localChunk...)
+		}
+		baseIndex, localIndex, upstreamIndex = nextBase, nextLocal, nextUpstream
+	}
+
+	return merged, conflicts
+}
+
+// matchLines maps every line of a to the index of its matching line in b or to -1 when it was changed or removed
+func matchLines(a, b []string) []int {
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+	for _, block := range difflib.NewMatcherWithJunk(a, b, false, nil).GetMatchingBlocks() {
+		for k := range block.Size {
+			matches[block.A+k] = block.B + k
+		}
+	}
+
+	return matches
+}
+
+// withLineEndings returns a copy of the lines where the last line ends with a line ending as well
+func withLineEndings(lines []string) []string {
+	result := slices.Clone(lines)
+	for i, line := range result {
+		if !strings.HasSuffix(line, "\n") {
+			result[i] = line + "\n"
+		}
+	}
+
+	return result
+}
+
+// UnifiedDiff returns the unified diff between two versions of a file or an empty string when they are equal
+func UnifiedDiff(fromFile, toFile string, from, to []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        withLineEndings(SplitFileLines(from)),
+		B:        withLineEndings(SplitFileLines(to)),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+}
+
+// MergeMultipleFiles refreshes the home directory files from their embedded versions, the local changes made to a file
+// since its upstream version was last copied are merged with the upstream changes, the copied upstream versions are kept
+// in the upstream directory for the next refresh
+func MergeMultipleFiles(homeDir string, srcFs *embed.FS) ([]MergeConflict, []UnmergedFile, error) {
+	upstreamDir := filepath.Join(homeDir, constant.UpstreamDir)
+	var (
+		conflicts []MergeConflict
+		unmerged  []UnmergedFile
+	)
+	err := fs.WalkDir(*srcFs, ".", func(path string, dir fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if IsLocalConfigFile(path) {
+			return nil
+		}
+
+		dstPath, upstreamPath := filepath.Join(homeDir, path), filepath.Join(upstreamDir, path)
+		if dir.IsDir() {
+			if err := os.MkdirAll(dstPath, constant.DirPerm); err != nil {
+				return err
+			}
+			return os.MkdirAll(upstreamPath, constant.DirPerm)
+		}
+
+		upstream, err := fs.ReadFile(*srcFs, path)
+		if err != nil {
+			return err
+		}
+		fileConflicts, diff, err := mergeFile(dstPath, upstreamPath, upstream)
+		if err != nil {
+			return err
+		}
+		for _, conflict := range fileConflicts {
+			conflict.Path = path
+			conflicts = append(conflicts, conflict)
+		}
+		if diff != "" {
+			unmerged = append(unmerged, UnmergedFile{Path: path, Diff: diff})
+		}
+
+		return nil
+	})
+
+	return conflicts, unmerged, err
+}
+
+// mergeFile merges the upstream changes into a home directory file, a file without an upstream copy is kept and the
+// diff to its upstream version is returned instead, since it is unknown which side changed a line
+func mergeFile(dstPath, upstreamPath string, upstream []byte) ([]MergeConflict, string, error) {
+	local, err := os.ReadFile(dstPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err := os.WriteFile(dstPath, upstream, 0644); err != nil {
+			return nil, "", err
+		}
+		if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
+			fmt.Println("Created file:", dstPath)
+		}
+		return nil, "", os.WriteFile(upstreamPath, upstream, 0644)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	base, err := os.ReadFile(upstreamPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		diff, err := UnifiedDiff(dstPath, upstreamPath, local, upstream)
+		if err != nil {
+			return nil, "", err
+		}
+		return nil, diff, os.WriteFile(upstreamPath, upstream, 0644)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	mergedLines, conflicts := MergeLines(SplitFileLines(base), SplitFileLines(local), SplitFileLines(upstream))
+	if merged := []byte(strings.Join(mergedLines, "")); !bytes.Equal(merged, local) {
+		if err := os.WriteFile(dstPath, merged, 0644); err != nil {
+			return nil, "", err
+		}
+		if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
+			fmt.Println("Merged file:", dstPath)
+		}
+	}
+
+	return conflicts, "", os.WriteFile(upstreamPath, upstream, 0644)
+}
+
+// IsLocalConfigFile reports whether a file is a local override file of a config, these files belong to the user and are never copied over
+func IsLocalConfigFile(path string) bool {
+	return strings.HasSuffix(path, constant.LocalConfigSuffix+"."+constant.ConfigType)
+}
@@ -0,0 +1,200 @@
+package helpers_test
+
+import (
+	"embed"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lines(content string) []string {
+	return helpers.SplitFileLines([]byte(content))
+}
+
+func TestSplitFileLines(t *testing.T) {
+	assert.Equal(t, []string{"a\n", "b"}, lines("a\nb"))
+	assert.Equal(t, []string{"a\n", "b\n"}, lines("a\nb\n"))
+	assert.Empty(t, lines(""))
+}
+
+func TestMergeLines(t *testing.T) {
+	base := "profile:\n  name: combined\napplication:\n  version: 1.0.0\n  port-start: 30000\n"
+
+	t.Run("TestMergeLines_AppliesUpstreamChanges", func(t *testing.T) {
+		// Arrange
+		local := "profile:\n  name: mine\napplication:\n  version: 1.0.0\n  port-start: 30000\n"
+		upstream := "profile:\n  name: combined\napplication:\n  version: 2.0.0\n  port-start: 30000\n  port-end: 30999\n"
+
+		// Act
+		merged, conflicts := helpers.MergeLines(lines(base), lines(local), lines(upstream))
+
+		// Assert
+		assert.Empty(t, conflicts)
+		assert.Equal(t, "profile:\n  name: mine\napplication:\n  version: 2.0.0\n  port-start: 30000\n  port-end: 30999\n", strings.Join(merged, ""))
+	})
+
+	t.Run("TestMergeLines_SameChangeOnBothSides", func(t *testing.T) {
+		// Arrange
+		changed := strings.Replace(base, "1.0.0", "2.0.0", 1)
+
+		// Act
+		merged, conflicts := helpers.MergeLines(lines(base), lines(changed), lines(changed))
+
+		// Assert
+		assert.Empty(t, conflicts)
+		assert.Equal(t, changed, strings.Join(merged, ""))
+	})
+
+	t.Run("TestMergeLines_ConflictKeepsLocalLines", func(t *testing.T) {
+		// Arrange
+		local := strings.Replace(base, "1.0.0", "1.5.0", 1)
+		upstream := strings.Replace(strings.Replace(base, "1.0.0", "2.0.0", 1), "combined", "upstream", 1)
+
+		// Act
+		merged, conflicts := helpers.MergeLines(lines(base), lines(local), lines(upstream))
+
+		// Assert
+		assert.Equal(t, "profile:\n  name: upstream\napplication:\n  version: 1.5.0\n  port-start: 30000\n", strings.Join(merged, ""))
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, 4, conflicts[0].Line)
+		assert.Equal(t, []string{"  version: 1.5.0\n"}, conflicts[0].Local)
+		assert.Equal(t, []string{"  version: 2.0.0\n"}, conflicts[0].Upstream)
+	})
+
+	t.Run("TestMergeLines_WithoutBaseEqualFiles", func(t *testing.T) {
+		// Act
+		merged, conflicts := helpers.MergeLines(nil, lines(base), lines(base))
+
+		// Assert
+		assert.Empty(t, conflicts)
+		assert.Equal(t, base, strings.Join(merged, ""))
+	})
+}
+
+func TestMergeConflict_String(t *testing.T) {
+	// Arrange
+	conflict := helpers.MergeConflict{Path: "config.combined.yaml", Line: 4, Local: []string{"  version: 1.5.0\n"}, Upstream: []string{"  version: 2.0.0\n"}}
+
+	// Act
+	result := conflict.String()
+
+	// Assert
+	assert.Equal(t, "config.combined.yaml:4\n-  version: 1.5.0\n+  version: 2.0.0\n", result)
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	// Act
+	diff, err := helpers.UnifiedDiff("a.yaml", "b.yaml", []byte("a: 1\nb: 2"), []byte("a: 1\nb: 3\n"))
+	unchanged, unchangedErr := helpers.UnifiedDiff("a.yaml", "b.yaml", []byte("a: 1\n"), []byte("a: 1\n"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "--- a.yaml\n+++ b.yaml\n@@ -1,2 +1,2 @@\n a: 1\n-b: 2\n+b: 3\n", diff)
+	assert.NoError(t, unchangedErr)
+	assert.Empty(t, unchanged)
+}
+
+func TestMergeMultipleFiles(t *testing.T) {
+	t.Run("TestMergeMultipleFiles_CreatesFilesAndUpstreamCopies", func(t *testing.T) {
+		// Arrange
+		homeDir := t.TempDir()
+
+		// Act
+		conflicts, _, err := helpers.MergeMultipleFiles(homeDir, &testEmbedFS)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, conflicts)
+		assert.FileExists(t, filepath.Join(homeDir, "testdata", "file1.txt"))
+		assert.FileExists(t, filepath.Join(homeDir, constant.UpstreamDir, "testdata", "subdir", "nested.txt"))
+		assert.NoFileExists(t, filepath.Join(homeDir, "testdata", "config.test.local.yaml"))
+	})
+
+	t.Run("TestMergeMultipleFiles_KeepsLocalChanges", func(t *testing.T) {
+		// Arrange
+		homeDir := t.TempDir()
+		_, _, err := helpers.MergeMultipleFiles(homeDir, &testEmbedFS)
+		require.NoError(t, err)
+		filePath := filepath.Join(homeDir, "testdata", "file1.txt")
+		require.NoError(t, os.WriteFile(filePath, []byte("Local content\n"), 0644))
+
+		// Act
+		conflicts, _, err := helpers.MergeMultipleFiles(homeDir, &testEmbedFS)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, conflicts)
+		content, err := os.ReadFile(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, "Local content\n", string(content))
+	})
+
+	t.Run("TestMergeMultipleFiles_KeepsFileWithoutUpstreamCopy", func(t *testing.T) {
+		// Arrange
+		homeDir := t.TempDir()
+		filePath := filepath.Join(homeDir, "testdata", "file1.txt")
+		upstreamPath := filepath.Join(homeDir, constant.UpstreamDir, "testdata", "file1.txt")
+		require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "testdata"), 0700))
+		require.NoError(t, os.WriteFile(filePath, []byte("Local content\n"), 0644))
+
+		// Act
+		conflicts, unmerged, err := helpers.MergeMultipleFiles(homeDir, &testEmbedFS)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, conflicts)
+		require.Len(t, unmerged, 1)
+		assert.Equal(t, "testdata/file1.txt", unmerged[0].Path)
+		assert.Contains(t, unmerged[0].Diff, "--- "+filePath+"\n+++ "+upstreamPath+"\n")
+		assert.Contains(t, unmerged[0].Diff, "-Local content\n")
+		content, err := os.ReadFile(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, "Local content\n", string(content))
+		upstream, err := os.ReadFile(upstreamPath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(upstream), "Test content for file 1")
+	})
+
+	t.Run("TestMergeMultipleFiles_EqualFileWithoutUpstreamCopy", func(t *testing.T) {
+		// Arrange
+		homeDir := t.TempDir()
+		_, _, err := helpers.MergeMultipleFiles(homeDir, &testEmbedFS)
+		require.NoError(t, err)
+		require.NoError(t, os.RemoveAll(filepath.Join(homeDir, constant.UpstreamDir)))
+
+		// Act
+		conflicts, unmerged, err := helpers.MergeMultipleFiles(homeDir, &testEmbedFS)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, conflicts)
+		assert.Empty(t, unmerged)
+		assert.FileExists(t, filepath.Join(homeDir, constant.UpstreamDir, "testdata", "file1.txt"))
+	})
+
+	t.Run("TestMergeMultipleFiles_EmptyEmbedFS", func(t *testing.T) {
+		// Arrange
+		var emptyFS embed.FS
+
+		// Act
+		conflicts, _, err := helpers.MergeMultipleFiles(t.TempDir(), &emptyFS)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, conflicts)
+	})
+
+	t.Run("TestMergeMultipleFiles_InvalidDestination", func(t *testing.T) {
+		// Act
+		_, _, err := helpers.MergeMultipleFiles(filepath.Join(string([]byte{0}), "invalid"), &testEmbedFS)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
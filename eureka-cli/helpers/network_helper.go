@@ -20,6 +20,19 @@ func IsHostnameReachable(actionName string, hostname string) error {
 	return nil
 }
 
+// ==================== Port ====================
+
+// IsTCPPortFree reports whether the TCP port can be bound on all host interfaces
+func IsTCPPortFree(port int) bool {
+	tcpListen, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	_ = tcpListen.Close()
+
+	return true
+}
+
 // ==================== Hostname ====================
 
 func ConstructURL(url string, gatewayURL string) string {
@@ -2,6 +2,7 @@ package helpers_test
 
 import (
 	"errors"
+	"net"
 	"testing"
 
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -33,6 +34,18 @@ func TestIsHostnameReachable_InvalidHostname(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestIsTCPPortFree(t *testing.T) {
+	// Arrange
+	listener, err := net.Listen("tcp", ":0")
+	assert.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	// Act & Assert
+	assert.False(t, helpers.IsTCPPortFree(port))
+	assert.NoError(t, listener.Close())
+	assert.True(t, helpers.IsTCPPortFree(port))
+}
+
 func TestConstructURL_WithHTTPPrefix(t *testing.T) {
 	// Arrange
 	url := "http://example.com/api"
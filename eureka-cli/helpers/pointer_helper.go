@@ -14,6 +14,10 @@ func IntPtr(value int) *int {
 	return &value
 }
 
+func Int64Ptr(value int64) *int64 {
+	return &value
+}
+
 func DefaultInt(value, defaultValue int) int {
 	if value == 0 {
 		return defaultValue
@@ -93,6 +93,18 @@ func TestIntPtr_Negative(t *testing.T) {
 	assert.Equal(t, -10, *result)
 }
 
+func TestInt64Ptr(t *testing.T) {
+	// Arrange
+	var value int64 = 1024
+
+	// Act
+	result := helpers.Int64Ptr(value)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, int64(1024), *result)
+}
+
 func TestDefaultInt_WithValue(t *testing.T) {
 	// Arrange
 	value := 100
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+)
+
+// GetProfileDirs returns the directories that profile configs are discovered in, the home directory
+// is followed by the optional extra profiles directory
+func GetProfileDirs() ([]string, error) {
+	homeDir, err := GetHomeDirPath()
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := []string{homeDir}
+	if profilesDir := os.Getenv(constant.ProfilesDirEnv); profilesDir != "" {
+		dirs = append(dirs, profilesDir)
+	}
+
+	return dirs, nil
+}
+
+// GetProfiles returns the embedded profiles followed by the profiles discovered from the config files in the profile directories
+func GetProfiles(dirs []string) []string {
+	profiles := constant.GetProfiles()
+	var discovered []string
+	for _, dir := range dirs {
+		configFiles, err := filepath.Glob(filepath.Join(dir, fmt.Sprintf("%s.*.%s", constant.ConfigPrefix, constant.ConfigType)))
+		if err != nil {
+			continue
+		}
+		for _, configFile := range configFiles {
+			if IsLocalConfigFile(configFile) {
+				continue
+			}
+			profile := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(configFile), constant.ConfigPrefix+"."), "."+constant.ConfigType)
+			if !slices.Contains(profiles, profile) && !slices.Contains(discovered, profile) {
+				discovered = append(discovered, profile)
+			}
+		}
+	}
+	slices.Sort(discovered)
+
+	return append(profiles, discovered...)
+}
+
+// GetProfileConfigFile returns the config file of a profile in a directory
+func GetProfileConfigFile(dir, profile string) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.%s.%s", constant.ConfigPrefix, profile, constant.ConfigType))
+}
+
+// FindProfileConfigFile returns the config file of a profile from the first profile directory that has it
+func FindProfileConfigFile(profile string, dirs []string) (string, error) {
+	for _, dir := range dirs {
+		configFile := GetProfileConfigFile(dir, profile)
+		if err := IsRegularFile(configFile); err == nil {
+			return configFile, nil
+		}
+	}
+
+	return "", errors.ProfileNotFound(profile, dirs)
+}
@@ -0,0 +1,74 @@
+package helpers_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProfileDirs(t *testing.T) {
+	// Arrange
+	userHome := t.TempDir()
+	t.Setenv("HOME", userHome)
+	t.Setenv(constant.ProfilesDirEnv, "/opt/profiles")
+
+	// Act
+	dirs, err := helpers.GetProfileDirs()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(userHome, constant.ConfigDir), "/opt/profiles"}, dirs)
+}
+
+func TestGetProfiles_DiscoversConfigFiles(t *testing.T) {
+	// Arrange
+	homeDir, profilesDir := t.TempDir(), t.TempDir()
+	for _, configFile := range []string{
+		filepath.Join(homeDir, "config.combined.yaml"),
+		filepath.Join(homeDir, "config.orders.yaml"),
+		filepath.Join(homeDir, "config.orders.local.yaml"),
+		filepath.Join(profilesDir, "config.acquisitions.yaml"),
+		filepath.Join(profilesDir, "config.orders.yaml"),
+		filepath.Join(profilesDir, "notes.yaml"),
+	} {
+		require.NoError(t, os.WriteFile(configFile, []byte("profile:\n"), 0600))
+	}
+
+	// Act
+	profiles := helpers.GetProfiles([]string{homeDir, profilesDir})
+
+	// Assert
+	embedded := constant.GetProfiles()
+	assert.Equal(t, embedded, profiles[:len(embedded)])
+	assert.Equal(t, []string{"acquisitions", "orders"}, profiles[len(embedded):])
+}
+
+func TestFindProfileConfigFile(t *testing.T) {
+	// Arrange
+	homeDir, profilesDir := t.TempDir(), t.TempDir()
+	configFile := helpers.GetProfileConfigFile(profilesDir, "acquisitions")
+	require.NoError(t, os.WriteFile(configFile, []byte("profile:\n"), 0600))
+
+	// Act
+	found, err := helpers.FindProfileConfigFile("acquisitions", []string{homeDir, profilesDir})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, configFile, found)
+}
+
+func TestFindProfileConfigFile_NotFound(t *testing.T) {
+	// Act
+	found, err := helpers.FindProfileConfigFile("missing", []string{t.TempDir()})
+
+	// Assert
+	assert.Empty(t, found)
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "config of profile missing not found")
+}
@@ -14,6 +14,9 @@ var (
 	moduleId       = regexp.MustCompile(constant.ModuleIDPattern)
 	newLine        = regexp.MustCompile(constant.NewLinePattern)
 	protocol       = regexp.MustCompile(constant.ProtocolPattern)
+	profileName    = regexp.MustCompile(constant.ProfileNamePattern)
+	logKeyValue    = regexp.MustCompile(constant.LogKeyValuePattern)
+	bearerToken    = regexp.MustCompile(constant.BearerTokenPattern)
 )
 
 // ==================== Vault ====================
@@ -22,6 +25,14 @@ func GetVaultRootTokenFromLogs(logLine string) string {
 	return strings.TrimSpace(colonDelimited.ReplaceAllString(logLine, `$1`))
 }
 
+// ==================== Profile ====================
+
+// IsValidProfileName reports whether a profile name can be used in the file name of a profile config, so that
+// the config of a new profile stays in the profile directory
+func IsValidProfileName(name string) bool {
+	return profileName.MatchString(name)
+}
+
 // ==================== Hostname ====================
 
 func GetPortFromURL(url string) (int, error) {
@@ -84,6 +95,27 @@ func MatchesModuleName(moduleID string, moduleName string) bool {
 	return pattern.MatchString(moduleID)
 }
 
+// ==================== Secrets ====================
+
+// RedactLogSecrets replaces the values of the secret keys and the bearer tokens on every line of a log,
+// e.g. DB_PASSWORD=secret, "password":"secret" or Authorization: Bearer eyJhbGciOi...
+func RedactLogSecrets(content []byte) []byte {
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		line = logKeyValue.ReplaceAllStringFunc(line, func(match string) string {
+			groups := logKeyValue.FindStringSubmatch(match)
+			if !IsSecretKey(strings.ReplaceAll(groups[1], ".", "_")) {
+				return match
+			}
+
+			return groups[1] + groups[2] + constant.RedactedValue
+		})
+		lines[i] = bearerToken.ReplaceAllString(line, "${1}"+constant.RedactedValue)
+	}
+
+	return []byte(strings.Join(lines, "\n"))
+}
+
 // ==================== Kafka ====================
 
 func GetKafkaConsumerLagFromLogLine(stdout bytes.Buffer) string {
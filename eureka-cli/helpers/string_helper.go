@@ -93,7 +93,7 @@ func GenerateRandomCode(length int) string {
 	return string(code)
 }
 
-func SortedMapKeys(m map[string]any) []string {
+func SortedMapKeys[V any](m map[string]V) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -2,6 +2,7 @@ package httpclient
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"log/slog"
 	"net/http"
@@ -42,7 +43,7 @@ func New(action *action.Action, logger *slog.Logger) *HTTPClient {
 	}
 }
 
-func (hc *HTTPClient) doRequest(method, url string, payload []byte, headers map[string]string, useRetry bool) (*http.Response, error) {
+func (hc *HTTPClient) doRequest(ctx context.Context, method, url string, payload []byte, headers map[string]string, useRetry bool) (*http.Response, error) {
 	if payload != nil {
 		helpers.DumpRequestJSON(payload)
 	}
@@ -52,7 +53,7 @@ func (hc *HTTPClient) doRequest(method, url string, payload []byte, headers map[
 		bodyReader = bytes.NewReader(payload)
 	}
 
-	httpRequest, err := http.NewRequest(method, url, bodyReader)
+	httpRequest, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -8,13 +9,13 @@ import (
 
 // HTTPClientDeleteManager defines the interface for HTTP DELETE operations
 type HTTPClientDeleteManager interface {
-	Delete(url string, headers map[string]string) error
-	DeleteReturnStruct(url string, headers map[string]string, target any) error
-	DeleteWithPayloadReturnStruct(url string, payload []byte, headers map[string]string, target any) error
+	Delete(ctx context.Context, url string, headers map[string]string) error
+	DeleteReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error
+	DeleteWithPayloadReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error
 }
 
-func (hc *HTTPClient) Delete(url string, headers map[string]string) error {
-	httpResponse, err := hc.doRequest(http.MethodDelete, url, nil, headers, false)
+func (hc *HTTPClient) Delete(ctx context.Context, url string, headers map[string]string) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodDelete, url, nil, headers, false)
 	if err != nil {
 		return err
 	}
@@ -23,8 +24,8 @@ func (hc *HTTPClient) Delete(url string, headers map[string]string) error {
 	return nil
 }
 
-func (hc *HTTPClient) DeleteReturnStruct(url string, headers map[string]string, target any) error {
-	httpResponse, err := hc.doRequest(http.MethodDelete, url, nil, headers, false)
+func (hc *HTTPClient) DeleteReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodDelete, url, nil, headers, false)
 	if err != nil {
 		return err
 	}
@@ -41,8 +42,8 @@ func (hc *HTTPClient) DeleteReturnStruct(url string, headers map[string]string,
 	return json.Unmarshal(body, target)
 }
 
-func (hc *HTTPClient) DeleteWithPayloadReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
-	httpResponse, err := hc.doRequest(http.MethodDelete, url, payload, headers, false)
+func (hc *HTTPClient) DeleteWithPayloadReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodDelete, url, payload, headers, false)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -8,21 +9,21 @@ import (
 
 // HTTPClientGetManager defines the interface for HTTP GET operations
 type HTTPClientGetManager interface {
-	GetReturnStruct(url string, headers map[string]string, target any) error
-	GetRetryReturnStruct(url string, headers map[string]string, target any) error
-	GetReturnRawBytes(url string, headers map[string]string) ([]byte, error)
+	GetReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error
+	GetRetryReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error
+	GetReturnRawBytes(ctx context.Context, url string, headers map[string]string) ([]byte, error)
 }
 
-func (hc *HTTPClient) GetReturnStruct(url string, headers map[string]string, target any) error {
-	return hc.getAndDecode(url, headers, false, target)
+func (hc *HTTPClient) GetReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error {
+	return hc.getAndDecode(ctx, url, headers, false, target)
 }
 
-func (hc *HTTPClient) GetRetryReturnStruct(url string, headers map[string]string, target any) error {
-	return hc.getAndDecode(url, headers, true, target)
+func (hc *HTTPClient) GetRetryReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error {
+	return hc.getAndDecode(ctx, url, headers, true, target)
 }
 
-func (hc *HTTPClient) GetReturnRawBytes(url string, headers map[string]string) ([]byte, error) {
-	httpResponse, err := hc.doRequest(http.MethodGet, url, nil, headers, false)
+func (hc *HTTPClient) GetReturnRawBytes(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
+	httpResponse, err := hc.doRequest(ctx, http.MethodGet, url, nil, headers, false)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +32,8 @@ func (hc *HTTPClient) GetReturnRawBytes(url string, headers map[string]string) (
 	return io.ReadAll(httpResponse.Body)
 }
 
-func (hc *HTTPClient) getAndDecode(url string, headers map[string]string, useRetry bool, target any) error {
-	httpResponse, err := hc.doRequest(http.MethodGet, url, nil, headers, useRetry)
+func (hc *HTTPClient) getAndDecode(ctx context.Context, url string, headers map[string]string, useRetry bool, target any) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodGet, url, nil, headers, useRetry)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -9,12 +10,12 @@ import (
 
 // HTTPClientPinger defines the interface for HTTP ping operations
 type HTTPClientPinger interface {
-	PingRetry(url string) error
-	Ping(url string) (int, error)
+	PingRetry(ctx context.Context, url string) error
+	Ping(ctx context.Context, url string) (int, error)
 }
 
-func (hc *HTTPClient) PingRetry(url string) error {
-	statusCode, err := hc.doStatusCheck(url, true)
+func (hc *HTTPClient) PingRetry(ctx context.Context, url string) error {
+	statusCode, err := hc.doStatusCheck(ctx, url, true)
 	if err != nil {
 		return errors.PingFailed(url, err)
 	}
@@ -25,12 +26,12 @@ func (hc *HTTPClient) PingRetry(url string) error {
 	return nil
 }
 
-func (hc *HTTPClient) Ping(url string) (int, error) {
-	return hc.doStatusCheck(url, false)
+func (hc *HTTPClient) Ping(ctx context.Context, url string) (int, error) {
+	return hc.doStatusCheck(ctx, url, false)
 }
 
-func (hc *HTTPClient) doStatusCheck(url string, useRetry bool) (int, error) {
-	httpRequest, err := http.NewRequest(http.MethodGet, url, nil)
+func (hc *HTTPClient) doStatusCheck(ctx context.Context, url string, useRetry bool) (int, error) {
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -12,14 +13,14 @@ import (
 
 // HTTPClientPostManager defines the interface for HTTP POST operations
 type HTTPClientPostManager interface {
-	PostReturnNoContent(url string, payload []byte, headers map[string]string) error
-	PostRetryReturnNoContent(url string, payload []byte, headers map[string]string) error
-	PostReturnStruct(url string, payload []byte, headers map[string]string, target any) error
-	PostFormDataReturnStruct(url string, formValues url.Values, headers map[string]string, target any) error
+	PostReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error
+	PostRetryReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error
+	PostReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error
+	PostFormDataReturnStruct(ctx context.Context, url string, formValues url.Values, headers map[string]string, target any) error
 }
 
-func (hc *HTTPClient) PostReturnNoContent(url string, payload []byte, headers map[string]string) error {
-	httpResponse, err := hc.doRequest(http.MethodPost, url, payload, headers, false)
+func (hc *HTTPClient) PostReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodPost, url, payload, headers, false)
 	if err != nil {
 		return err
 	}
@@ -28,8 +29,8 @@ func (hc *HTTPClient) PostReturnNoContent(url string, payload []byte, headers ma
 	return nil
 }
 
-func (hc *HTTPClient) PostRetryReturnNoContent(url string, payload []byte, headers map[string]string) error {
-	httpResponse, err := hc.doRequest(http.MethodPost, url, payload, headers, true)
+func (hc *HTTPClient) PostRetryReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodPost, url, payload, headers, true)
 	if err != nil {
 		return err
 	}
@@ -38,8 +39,8 @@ func (hc *HTTPClient) PostRetryReturnNoContent(url string, payload []byte, heade
 	return nil
 }
 
-func (hc *HTTPClient) PostReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
-	httpResponse, err := hc.doRequest(http.MethodPost, url, payload, headers, false)
+func (hc *HTTPClient) PostReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodPost, url, payload, headers, false)
 	if err != nil {
 		return err
 	}
@@ -60,10 +61,10 @@ func (hc *HTTPClient) PostReturnStruct(url string, payload []byte, headers map[s
 	return json.Unmarshal(body, target)
 }
 
-func (hc *HTTPClient) PostFormDataReturnStruct(url string, formValues url.Values, headers map[string]string, target any) error {
+func (hc *HTTPClient) PostFormDataReturnStruct(ctx context.Context, url string, formValues url.Values, headers map[string]string, target any) error {
 	helpers.DumpRequestFormData(formValues)
 
-	httpRequest, err := http.NewRequest(http.MethodPost, url, strings.NewReader(formValues.Encode()))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(formValues.Encode()))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -8,12 +9,12 @@ import (
 
 // HTTPClientPutManager defines the interface for HTTP PUT operations
 type HTTPClientPutManager interface {
-	PutReturnNoContent(url string, payload []byte, headers map[string]string) error
-	PutReturnStruct(url string, payload []byte, headers map[string]string, target any) error
+	PutReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error
+	PutReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error
 }
 
-func (hc *HTTPClient) PutReturnNoContent(url string, payload []byte, headers map[string]string) error {
-	httpResponse, err := hc.doRequest(http.MethodPut, url, payload, headers, false)
+func (hc *HTTPClient) PutReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodPut, url, payload, headers, false)
 	if err != nil {
 		return err
 	}
@@ -22,8 +23,8 @@ func (hc *HTTPClient) PutReturnNoContent(url string, payload []byte, headers map
 	return nil
 }
 
-func (hc *HTTPClient) PutReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
-	httpResponse, err := hc.doRequest(http.MethodPut, url, payload, headers, false)
+func (hc *HTTPClient) PutReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error {
+	httpResponse, err := hc.doRequest(ctx, http.MethodPut, url, payload, headers, false)
 	if err != nil {
 		return err
 	}
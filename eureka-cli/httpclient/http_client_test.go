@@ -1,6 +1,7 @@
 package httpclient_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log/slog"
@@ -42,7 +43,7 @@ func TestGetReturnStruct_Success(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.GetReturnStruct(server.URL, nil, &result)
+	err := client.GetReturnStruct(context.Background(), server.URL, nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -62,7 +63,7 @@ func TestGetReturnStruct_EmptyResponse(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.GetReturnStruct(server.URL, nil, &result)
+	err := client.GetReturnStruct(context.Background(), server.URL, nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -80,7 +81,7 @@ func TestGetReturnStruct_InvalidJSON(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.GetReturnStruct(server.URL, nil, &result)
+	err := client.GetReturnStruct(context.Background(), server.URL, nil, &result)
 
 	// Assert
 	assert.Error(t, err)
@@ -98,7 +99,7 @@ func TestGetRetryReturnStruct_Success(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.GetRetryReturnStruct(server.URL, nil, &result)
+	err := client.GetRetryReturnStruct(context.Background(), server.URL, nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -106,6 +107,26 @@ func TestGetRetryReturnStruct_Success(t *testing.T) {
 	assert.Equal(t, "retry success", result.Message)
 }
 
+func TestGetRetryReturnStruct_Cancelled(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	testAction := createTestAction()
+	client := httpclient.New(testAction, createTestLogger())
+	var result TestResponse
+
+	// Act
+	err := client.GetRetryReturnStruct(ctx, server.URL, nil, &result)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 // POST Tests
 
 func TestPostReturnNoContent_Success(t *testing.T) {
@@ -122,7 +143,7 @@ func TestPostReturnNoContent_Success(t *testing.T) {
 	payload := []byte(`{"test": "data"}`)
 
 	// Act
-	err := client.PostReturnNoContent(server.URL, payload, nil)
+	err := client.PostReturnNoContent(context.Background(), server.URL, payload, nil)
 
 	// Assert
 	assert.NoError(t, err)
@@ -138,7 +159,7 @@ func TestPostReturnNoContent_WithNilPayload(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.PostReturnNoContent(server.URL, nil, nil)
+	err := client.PostReturnNoContent(context.Background(), server.URL, nil, nil)
 
 	// Assert
 	assert.NoError(t, err)
@@ -155,7 +176,7 @@ func TestPostRetryReturnNoContent_Success(t *testing.T) {
 	payload := []byte(`{"retry": "test"}`)
 
 	// Act
-	err := client.PostRetryReturnNoContent(server.URL, payload, nil)
+	err := client.PostRetryReturnNoContent(context.Background(), server.URL, payload, nil)
 
 	// Assert
 	assert.NoError(t, err)
@@ -174,7 +195,7 @@ func TestPostReturnStruct_Success(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostReturnStruct(server.URL, payload, nil, &result)
+	err := client.PostReturnStruct(context.Background(), server.URL, payload, nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -194,7 +215,7 @@ func TestPostReturnStruct_EmptyResponse(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostReturnStruct(server.URL, []byte(`{}`), nil, &result)
+	err := client.PostReturnStruct(context.Background(), server.URL, []byte(`{}`), nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -212,7 +233,7 @@ func TestPostReturnStruct_InvalidJSON(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostReturnStruct(server.URL, []byte(`{}`), nil, &result)
+	err := client.PostReturnStruct(context.Background(), server.URL, []byte(`{}`), nil, &result)
 
 	// Assert
 	assert.Error(t, err)
@@ -230,7 +251,7 @@ func TestPostReturnStruct_EOFResponse(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostReturnStruct(server.URL, []byte(`{}`), nil, &result)
+	err := client.PostReturnStruct(context.Background(), server.URL, []byte(`{}`), nil, &result)
 
 	// Assert
 	assert.NoError(t, err) // EOF is ignored
@@ -259,7 +280,7 @@ func TestPostFormDataReturnStruct_Success(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostFormDataReturnStruct(server.URL, formData, nil, &result)
+	err := client.PostFormDataReturnStruct(context.Background(), server.URL, formData, nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -285,7 +306,7 @@ func TestPostFormDataReturnStruct_WithCustomHeaders(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostFormDataReturnStruct(server.URL, formData, headers, &result)
+	err := client.PostFormDataReturnStruct(context.Background(), server.URL, formData, headers, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -305,7 +326,7 @@ func TestPostFormDataReturnStruct_EmptyResponse(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostFormDataReturnStruct(server.URL, formData, nil, &result)
+	err := client.PostFormDataReturnStruct(context.Background(), server.URL, formData, nil, &result)
 
 	// Assert
 	assert.NoError(t, err)
@@ -324,7 +345,7 @@ func TestPostFormDataReturnStruct_InvalidJSON(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostFormDataReturnStruct(server.URL, formData, nil, &result)
+	err := client.PostFormDataReturnStruct(context.Background(), server.URL, formData, nil, &result)
 
 	// Assert
 	assert.Error(t, err)
@@ -342,7 +363,7 @@ func TestPostFormDataReturnStruct_ValidationError(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostFormDataReturnStruct(server.URL, formData, nil, &result)
+	err := client.PostFormDataReturnStruct(context.Background(), server.URL, formData, nil, &result)
 
 	// Assert
 	assert.Error(t, err)
@@ -361,7 +382,7 @@ func TestPostFormDataReturnStruct_EOFResponse(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.PostFormDataReturnStruct(server.URL, formData, nil, &result)
+	err := client.PostFormDataReturnStruct(context.Background(), server.URL, formData, nil, &result)
 
 	// Assert
 	assert.NoError(t, err) // EOF is ignored
@@ -383,7 +404,7 @@ func TestPutReturnNoContent_Success(t *testing.T) {
 	payload := []byte(`{"updated": "data"}`)
 
 	// Act
-	err := client.PutReturnNoContent(server.URL, payload, nil)
+	err := client.PutReturnNoContent(context.Background(), server.URL, payload, nil)
 
 	// Assert
 	assert.NoError(t, err)
@@ -402,7 +423,7 @@ func TestPutReturnNoContent_WithHeaders(t *testing.T) {
 	payload := []byte(`{"id": 1}`)
 
 	// Act
-	err := client.PutReturnNoContent(server.URL, payload, headers)
+	err := client.PutReturnNoContent(context.Background(), server.URL, payload, headers)
 
 	// Assert
 	assert.NoError(t, err)
@@ -421,7 +442,7 @@ func TestDelete_Success(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.Delete(server.URL, nil)
+	err := client.Delete(context.Background(), server.URL, nil)
 
 	// Assert
 	assert.NoError(t, err)
@@ -439,7 +460,7 @@ func TestDelete_WithHeaders(t *testing.T) {
 	headers := map[string]string{"Authorization": "Bearer token"}
 
 	// Act
-	err := client.Delete(server.URL, headers)
+	err := client.Delete(context.Background(), server.URL, headers)
 
 	// Assert
 	assert.NoError(t, err)
@@ -461,7 +482,7 @@ func TestDeleteWithPayloadReturnStruct_Success(t *testing.T) {
 	var response map[string]any
 
 	// Act
-	err := client.DeleteWithPayloadReturnStruct(server.URL, payload, nil, &response)
+	err := client.DeleteWithPayloadReturnStruct(context.Background(), server.URL, payload, nil, &response)
 
 	// Assert
 	assert.NoError(t, err)
@@ -480,7 +501,7 @@ func TestPingRetry_Success(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.PingRetry(server.URL)
+	err := client.PingRetry(context.Background(), server.URL)
 
 	// Assert
 	assert.NoError(t, err)
@@ -491,7 +512,7 @@ func TestPingRetry_Failure(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.PingRetry("http://localhost:99999/nonexistent")
+	err := client.PingRetry(context.Background(), "http://localhost:99999/nonexistent")
 
 	// Assert
 	assert.Error(t, err)
@@ -507,7 +528,7 @@ func TestPing_Success(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	statusCode, err := client.Ping(server.URL)
+	statusCode, err := client.Ping(context.Background(), server.URL)
 
 	// Assert
 	assert.NoError(t, err)
@@ -524,7 +545,7 @@ func TestPing_NonOKStatus(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	statusCode, err := client.Ping(server.URL)
+	statusCode, err := client.Ping(context.Background(), server.URL)
 
 	// Assert
 	assert.NoError(t, err)
@@ -536,7 +557,7 @@ func TestPing_NetworkError(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	statusCode, err := client.Ping("http://localhost:99999/nonexistent")
+	statusCode, err := client.Ping(context.Background(), "http://localhost:99999/nonexistent")
 
 	// Assert
 	assert.Error(t, err)
@@ -603,7 +624,7 @@ func TestGetReturnStruct_ServerError(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.GetReturnStruct(server.URL, nil, &result)
+	err := client.GetReturnStruct(context.Background(), server.URL, nil, &result)
 
 	// Assert
 	assert.Error(t, err)
@@ -619,7 +640,7 @@ func TestPostReturnNoContent_ServerError(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.PostReturnNoContent(server.URL, []byte(`{}`), nil)
+	err := client.PostReturnNoContent(context.Background(), server.URL, []byte(`{}`), nil)
 
 	// Assert
 	assert.Error(t, err)
@@ -635,7 +656,7 @@ func TestDelete_ServerError(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.Delete(server.URL, nil)
+	err := client.Delete(context.Background(), server.URL, nil)
 
 	// Assert
 	assert.Error(t, err)
@@ -651,7 +672,7 @@ func TestPutReturnNoContent_ServerError(t *testing.T) {
 	client := httpclient.New(createTestAction(), createTestLogger())
 
 	// Act
-	err := client.PutReturnNoContent(server.URL, []byte(`{}`), nil)
+	err := client.PutReturnNoContent(context.Background(), server.URL, []byte(`{}`), nil)
 
 	// Assert
 	assert.Error(t, err)
@@ -669,7 +690,7 @@ func TestGetReturnStruct_EOFHandling(t *testing.T) {
 	var result TestResponse
 
 	// Act
-	err := client.GetReturnStruct(server.URL, nil, &result)
+	err := client.GetReturnStruct(context.Background(), server.URL, nil, &result)
 
 	// Assert
 	assert.NoError(t, err) // EOF is handled gracefully
@@ -1,6 +1,7 @@
 package interceptmodulesvc
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -12,8 +13,8 @@ import (
 // TODO Add testcontainers tests
 // InterceptModuleProcessor defines the interface for module interception operations
 type InterceptModuleProcessor interface {
-	DeployDefaultModuleAndSidecarPair(client *client.Client, pair *modulesvc.ModulePair) error
-	DeployCustomSidecarForInterception(client *client.Client, pair *modulesvc.ModulePair) error
+	DeployDefaultModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error
+	DeployCustomSidecarForInterception(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error
 }
 
 // InterceptModuleSvc provides functionality for intercepting and redirecting module traffic
@@ -28,9 +29,9 @@ func New(action *action.Action, ModuleSvc modulesvc.ModuleProcessor, managementS
 	return &InterceptModuleSvc{Action: action, ModuleSvc: ModuleSvc, ManagementSvc: managementSvc}
 }
 
-func (is *InterceptModuleSvc) updateModuleDiscovery(pair *modulesvc.ModulePair) error {
+func (is *InterceptModuleSvc) updateModuleDiscovery(ctx context.Context, pair *modulesvc.ModulePair) error {
 	slog.Info(is.Action.Name, "text", "UPDATING MODULE DISCOVERY", "module", is.Action.Param.ModuleName, "id", is.Action.Param.ID, "port", pair.BackendModule.PrivatePort)
-	err := is.ManagementSvc.UpdateModuleDiscovery(is.Action.Param.ID, is.Action.Param.Restore, pair.BackendModule.PrivatePort, pair.SidecarURL)
+	err := is.ManagementSvc.UpdateModuleDiscovery(ctx, is.Action.Param.ID, is.Action.Param.Restore, pair.BackendModule.PrivatePort, pair.SidecarURL)
 	if err != nil {
 		return err
 	}
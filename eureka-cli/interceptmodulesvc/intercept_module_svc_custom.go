@@ -1,6 +1,7 @@
 package interceptmodulesvc
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
@@ -8,23 +9,23 @@ import (
 	"github.com/moby/moby/client"
 )
 
-func (is *InterceptModuleSvc) DeployCustomSidecarForInterception(client *client.Client, pair *modulesvc.ModulePair) error {
-	if err := is.ModuleSvc.UndeployModuleAndSidecarPair(client, pair); err != nil {
+func (is *InterceptModuleSvc) DeployCustomSidecarForInterception(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error {
+	if err := is.ModuleSvc.UndeployModuleAndSidecarPair(ctx, client, pair); err != nil {
 		return err
 	}
-	if err := is.prepareSidecarNetwork(pair); err != nil {
+	if err := is.prepareSidecarNetwork(ctx, pair); err != nil {
 		return err
 	}
 
 	slog.Info(is.Action.Name, "text", "DEPLOYING CUSTOM SIDECAR FOR INTERCEPTION")
-	if err := is.ModuleSvc.DeployCustomSidecar(client, pair); err != nil {
+	if err := is.ModuleSvc.DeployCustomSidecar(ctx, client, pair); err != nil {
 		return err
 	}
 
-	return is.ModuleSvc.CheckModuleAndSidecarReadiness(pair)
+	return is.ModuleSvc.CheckModuleAndSidecarReadiness(ctx, pair)
 }
 
-func (is *InterceptModuleSvc) prepareSidecarNetwork(pair *modulesvc.ModulePair) error {
+func (is *InterceptModuleSvc) prepareSidecarNetwork(ctx context.Context, pair *modulesvc.ModulePair) error {
 	slog.Info(is.Action.Name, "text", "PREPARING SIDECAR NETWORK")
 	moduleServerPort, err := helpers.GetPortFromURL(pair.ModuleURL)
 	if err != nil {
@@ -51,7 +52,7 @@ func (is *InterceptModuleSvc) prepareSidecarNetwork(pair *modulesvc.ModulePair)
 	if err != nil {
 		return err
 	}
-	if err := is.updateModuleDiscovery(pair); err != nil {
+	if err := is.updateModuleDiscovery(ctx, pair); err != nil {
 		return err
 	}
 	pair.Module.Metadata.Version = pair.BackendModule.ModuleVersion
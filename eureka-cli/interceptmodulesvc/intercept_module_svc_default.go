@@ -1,6 +1,7 @@
 package interceptmodulesvc
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
@@ -8,28 +9,28 @@ import (
 	"github.com/moby/moby/client"
 )
 
-func (is *InterceptModuleSvc) DeployDefaultModuleAndSidecarPair(client *client.Client, pair *modulesvc.ModulePair) error {
+func (is *InterceptModuleSvc) DeployDefaultModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error {
 	pair.ClearModuleURL()
 	pair.ClearSidecarURL()
-	if err := is.ModuleSvc.UndeployModuleAndSidecarPair(client, pair); err != nil {
+	if err := is.ModuleSvc.UndeployModuleAndSidecarPair(ctx, client, pair); err != nil {
 		return err
 	}
-	if err := is.prepareModuleAndSidecarPairNetwork(pair); err != nil {
+	if err := is.prepareModuleAndSidecarPairNetwork(ctx, pair); err != nil {
 		return err
 	}
 
 	slog.Info(is.Action.Name, "text", "DEPLOYING DEFAULT MODULE AND SIDECAR PAIR")
-	if err := is.ModuleSvc.DeployCustomModule(client, pair); err != nil {
+	if err := is.ModuleSvc.DeployCustomModule(ctx, client, pair); err != nil {
 		return err
 	}
-	if err := is.ModuleSvc.DeployCustomSidecar(client, pair); err != nil {
+	if err := is.ModuleSvc.DeployCustomSidecar(ctx, client, pair); err != nil {
 		return err
 	}
 
-	return is.ModuleSvc.CheckModuleAndSidecarReadiness(pair)
+	return is.ModuleSvc.CheckModuleAndSidecarReadiness(ctx, pair)
 }
 
-func (is *InterceptModuleSvc) prepareModuleAndSidecarPairNetwork(pair *modulesvc.ModulePair) error {
+func (is *InterceptModuleSvc) prepareModuleAndSidecarPairNetwork(ctx context.Context, pair *modulesvc.ModulePair) error {
 	slog.Info(is.Action.Name, "text", "PREPARING MODULE AND SIDECAR PAIR NETWORK")
 	ports, err := is.Action.GetPreReservedPortSet(4)
 	if err != nil {
@@ -51,7 +52,7 @@ func (is *InterceptModuleSvc) prepareModuleAndSidecarPairNetwork(pair *modulesvc
 	if err != nil {
 		return err
 	}
-	if err := is.updateModuleDiscovery(pair); err != nil {
+	if err := is.updateModuleDiscovery(ctx, pair); err != nil {
 		return err
 	}
 	pair.Module.Metadata.Version = pair.BackendModule.ModuleVersion
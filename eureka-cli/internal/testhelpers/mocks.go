@@ -2,6 +2,7 @@ package testhelpers
 
 import (
 	"bytes"
+	"context"
 	"net/url"
 	"os/exec"
 
@@ -16,17 +17,17 @@ type MockHTTPClient struct {
 	mock.Mock
 }
 
-func (m *MockHTTPClient) PingRetry(url string) error {
+func (m *MockHTTPClient) PingRetry(ctx context.Context, url string) error {
 	args := m.Called(url)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) Ping(url string) (int, error) {
+func (m *MockHTTPClient) Ping(ctx context.Context, url string) (int, error) {
 	args := m.Called(url)
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockHTTPClient) GetReturnRawBytes(url string, headers map[string]string) ([]byte, error) {
+func (m *MockHTTPClient) GetReturnRawBytes(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
 	args := m.Called(url, headers)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -34,42 +35,42 @@ func (m *MockHTTPClient) GetReturnRawBytes(url string, headers map[string]string
 	return args.Get(0).([]byte), args.Error(1)
 }
 
-func (m *MockHTTPClient) GetReturnStruct(url string, headers map[string]string, target any) error {
+func (m *MockHTTPClient) GetReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error {
 	args := m.Called(url, headers, target)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) GetRetryReturnStruct(url string, headers map[string]string, target any) error {
+func (m *MockHTTPClient) GetRetryReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error {
 	args := m.Called(url, headers, target)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) PostReturnNoContent(url string, payload []byte, headers map[string]string) error {
+func (m *MockHTTPClient) PostReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error {
 	args := m.Called(url, payload, headers)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) PostRetryReturnNoContent(url string, payload []byte, headers map[string]string) error {
+func (m *MockHTTPClient) PostRetryReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error {
 	args := m.Called(url, payload, headers)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) PostReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
+func (m *MockHTTPClient) PostReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error {
 	args := m.Called(url, payload, headers, target)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) PostFormDataReturnStruct(urlStr string, formValues url.Values, headers map[string]string, target any) error {
+func (m *MockHTTPClient) PostFormDataReturnStruct(ctx context.Context, urlStr string, formValues url.Values, headers map[string]string, target any) error {
 	args := m.Called(urlStr, formValues, headers, target)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) PutReturnNoContent(url string, payload []byte, headers map[string]string) error {
+func (m *MockHTTPClient) PutReturnNoContent(ctx context.Context, url string, payload []byte, headers map[string]string) error {
 	args := m.Called(url, payload, headers)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) PutReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
+func (m *MockHTTPClient) PutReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error {
 	args := m.Called(url, payload, headers, target)
 	return args.Error(0)
 }
@@ -84,17 +85,17 @@ func (m *MockHTTPClient) DeleteRetryReturnNoContent(url string, headers map[stri
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) Delete(url string, headers map[string]string) error {
+func (m *MockHTTPClient) Delete(ctx context.Context, url string, headers map[string]string) error {
 	args := m.Called(url, headers)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) DeleteReturnStruct(url string, headers map[string]string, target any) error {
+func (m *MockHTTPClient) DeleteReturnStruct(ctx context.Context, url string, headers map[string]string, target any) error {
 	args := m.Called(url, headers, target)
 	return args.Error(0)
 }
 
-func (m *MockHTTPClient) DeleteWithPayloadReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
+func (m *MockHTTPClient) DeleteWithPayloadReturnStruct(ctx context.Context, url string, payload []byte, headers map[string]string, target any) error {
 	args := m.Called(url, payload, headers, target)
 	return args.Error(0)
 }
@@ -106,6 +107,7 @@ func NewMockAction() *action.Action {
 		"test-action",
 		"http://localhost:%s", // Gateway URL template
 		params,
+		nil,
 	)
 }
 
@@ -147,12 +149,16 @@ func (m *MockRegistrySvc) ResolveModuleMetadata(modules *models.ProxyModulesByRe
 	m.Called(modules)
 }
 
-func (m *MockRegistrySvc) GetAuthorizationToken() (string, error) {
+func (m *MockRegistrySvc) PinModuleVersions(modules []models.ApplicationModule) {
+	m.Called(modules)
+}
+
+func (m *MockRegistrySvc) GetAuthorizationToken(ctx context.Context) (string, error) {
 	args := m.Called()
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockRegistrySvc) GetModules(verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error) {
+func (m *MockRegistrySvc) GetModules(ctx context.Context, verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error) {
 	args := m.Called(verbose, forceRefresh)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -160,6 +166,30 @@ func (m *MockRegistrySvc) GetModules(verbose bool, forceRefresh bool) (*models.P
 	return args.Get(0).(*models.ProxyModulesByRegistry), args.Error(1)
 }
 
+func (m *MockRegistrySvc) FetchModules(ctx context.Context, verbose bool) (*models.ProxyModulesByRegistry, error) {
+	args := m.Called(verbose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProxyModulesByRegistry), args.Error(1)
+}
+
+func (m *MockRegistrySvc) GetModuleDescriptors(ctx context.Context, forceRefresh bool) (*models.PlatformModuleDescriptors, error) {
+	args := m.Called(forceRefresh)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PlatformModuleDescriptors), args.Error(1)
+}
+
+func (m *MockRegistrySvc) GetPlatformDescriptor(ctx context.Context) (*models.PlatformDescriptor, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PlatformDescriptor), args.Error(1)
+}
+
 // MockModuleEnv is a mock implementation of moduleenv.ModuleEnvProcessor
 type MockModuleEnv struct {
 	mock.Mock
@@ -236,3 +266,48 @@ func (m *MockTenantSvc) SetConfigTenantParams(tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
+
+// MockReadinessSvc is a mock implementation of readinesssvc.ReadinessProcessor
+type MockReadinessSvc struct {
+	mock.Mock
+}
+
+func (m *MockReadinessSvc) WaitForSystem(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForPostgres(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForKafka(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForVault(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForKeycloak(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForKeycloakRealm(ctx context.Context, realm string) error {
+	args := m.Called(realm)
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForKong(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReadinessSvc) WaitForCapabilities(ctx context.Context, tenant string) error {
+	args := m.Called(tenant)
+	return args.Error(0)
+}
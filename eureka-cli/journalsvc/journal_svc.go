@@ -0,0 +1,73 @@
+package journalsvc
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+const (
+	ContainerKind       = "container"
+	ApplicationKind     = "application"
+	ModuleDiscoveryKind = "moduleDiscovery"
+	TenantKind          = "tenant"
+	TenantEntitledKind  = "tenantEntitlement"
+	RoleKind            = "role"
+	UserKind            = "user"
+)
+
+// JournalProcessor defines the interface for resource journal operations
+type JournalProcessor interface {
+	events.Sink
+	GetEntries() []models.JournalEntry
+}
+
+// JournalSvc records every resource created by the current run, in creation order,
+// so that a failed deployment can be rolled back without touching pre-existing state
+type JournalSvc struct {
+	Action  *action.Action
+	entries []models.JournalEntry
+	mu      sync.Mutex
+}
+
+// New creates a new JournalSvc instance
+func New(action *action.Action) *JournalSvc {
+	return &JournalSvc{Action: action}
+}
+
+func (js *JournalSvc) Emit(event events.Event) {
+	var entry models.JournalEntry
+	switch event.Type {
+	case events.ModuleDeployed:
+		entry = models.JournalEntry{Kind: ContainerKind, Name: event.Module}
+	case events.ApplicationCreated:
+		entry = models.JournalEntry{Kind: ApplicationKind, Name: event.Application}
+	case events.ModuleDiscoveryCreated:
+		entry = models.JournalEntry{Kind: ModuleDiscoveryKind, Name: event.Module}
+	case events.TenantCreated:
+		entry = models.JournalEntry{Kind: TenantKind, Name: event.Tenant}
+	case events.TenantEntitled:
+		entry = models.JournalEntry{Kind: TenantEntitledKind, Name: event.Application, Tenant: event.Tenant}
+	case events.RoleCreated:
+		entry = models.JournalEntry{Kind: RoleKind, Name: event.Role, Tenant: event.Tenant}
+	case events.UserCreated:
+		entry = models.JournalEntry{Kind: UserKind, Name: event.User, Tenant: event.Tenant}
+	default:
+		return
+	}
+
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	js.entries = append(js.entries, entry)
+}
+
+// GetEntries returns the recorded resources in creation order
+func (js *JournalSvc) GetEntries() []models.JournalEntry {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+
+	return slices.Clone(js.entries)
+}
@@ -0,0 +1,64 @@
+package journalsvc_test
+
+import (
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+
+	// Act
+	svc := journalsvc.New(action)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+	assert.Empty(t, svc.GetEntries())
+}
+
+func TestEmit_RecordsCreatedResourcesInOrder(t *testing.T) {
+	// Arrange
+	svc := journalsvc.New(testhelpers.NewMockAction())
+
+	// Act
+	svc.Emit(events.Event{Type: events.StepStarted, Step: "Deploy Modules"})
+	svc.Emit(events.Event{Type: events.ModuleDeployed, Module: "eureka-combined-mod-orders"})
+	svc.Emit(events.Event{Type: events.ModuleReady, Module: "mod-orders"})
+	svc.Emit(events.Event{Type: events.ApplicationCreated, Application: "app-combined-1.0.0"})
+	svc.Emit(events.Event{Type: events.ModuleDiscoveryCreated, Module: "mod-orders-13.0.0"})
+	svc.Emit(events.Event{Type: events.TenantCreated, Tenant: "diku"})
+	svc.Emit(events.Event{Type: events.TenantEntitled, Tenant: "diku", Application: "app-combined-1.0.0"})
+	svc.Emit(events.Event{Type: events.RoleCreated, Tenant: "diku", Role: "admin"})
+	svc.Emit(events.Event{Type: events.UserCreated, Tenant: "diku", User: "diku_admin"})
+
+	// Assert
+	assert.Equal(t, []models.JournalEntry{
+		{Kind: journalsvc.ContainerKind, Name: "eureka-combined-mod-orders"},
+		{Kind: journalsvc.ApplicationKind, Name: "app-combined-1.0.0"},
+		{Kind: journalsvc.ModuleDiscoveryKind, Name: "mod-orders-13.0.0"},
+		{Kind: journalsvc.TenantKind, Name: "diku"},
+		{Kind: journalsvc.TenantEntitledKind, Name: "app-combined-1.0.0", Tenant: "diku"},
+		{Kind: journalsvc.RoleKind, Name: "admin", Tenant: "diku"},
+		{Kind: journalsvc.UserKind, Name: "diku_admin", Tenant: "diku"},
+	}, svc.GetEntries())
+}
+
+func TestGetEntries_ReturnsCopy(t *testing.T) {
+	// Arrange
+	svc := journalsvc.New(testhelpers.NewMockAction())
+	svc.Emit(events.Event{Type: events.TenantCreated, Tenant: "diku"})
+
+	// Act
+	entries := svc.GetEntries()
+	entries[0].Name = "changed"
+
+	// Assert
+	assert.Equal(t, "diku", svc.GetEntries()[0].Name)
+}
@@ -1,6 +1,8 @@
 package kafkasvc
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log/slog"
 	"os/exec"
@@ -18,7 +20,8 @@ import (
 // KafkaProcessor defines the interface for Kafka service operations
 type KafkaProcessor interface {
 	CheckBrokerReadiness() error
-	PollConsumerGroup(tenantName string) error
+	PollConsumerGroup(ctx context.Context, tenantName string) error
+	CheckConsumerGroupLag(ctx context.Context, tenantName string) error
 }
 
 // KafkaSvc provides functionality for Kafka operations including health checks and consumer lag monitoring
@@ -54,7 +57,7 @@ func (ks *KafkaSvc) CheckBrokerReadiness() error {
 	return nil
 }
 
-func (ks *KafkaSvc) PollConsumerGroup(tenantName string) error {
+func (ks *KafkaSvc) PollConsumerGroup(ctx context.Context, tenantName string) error {
 	slog.Info(ks.Action.Name, "text", "Preparing broker readiness check")
 	if err := ks.CheckBrokerReadiness(); err != nil {
 		slog.Warn(ks.Action.Name, "text", "Broker is not fully ready", "error", err)
@@ -70,15 +73,20 @@ func (ks *KafkaSvc) PollConsumerGroup(tenantName string) error {
 	rebalanceWait := helpers.DefaultDuration(ks.RebalanceWait, constant.AttachCapabilitySetsRebalanceWait)
 	pollWait := helpers.DefaultDuration(ks.PollWait, constant.AttachCapabilitySetsPollWait)
 	for pollRetryCount := range pollMaxRetries {
-		lag, err := ks.getConsumerGroupLag(tenantName, consumerGroup, lag)
+		lag, err := ks.getConsumerGroupLag(ctx, tenantName, consumerGroup, lag)
 		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
 			rebalanceRetryCount++
 			if rebalanceRetryCount >= rebalanceMaxRetries {
 				return errors.ConsumerGroupRebalanceTimeout(consumerGroup, err)
 			}
 
 			slog.Warn(ks.Action.Name, "text", "Waiting for consumer group to rebalance", "count", rebalanceRetryCount, "max", rebalanceMaxRetries)
-			time.Sleep(rebalanceWait)
+			if err := helpers.Sleep(ctx, rebalanceWait); err != nil {
+				return err
+			}
 			continue
 		}
 
@@ -89,18 +97,42 @@ func (ks *KafkaSvc) PollConsumerGroup(tenantName string) error {
 		}
 
 		slog.Warn(ks.Action.Name, "text", "Waiting for consumer group", "consumerGroup", consumerGroup, "lag", lag, "count", pollRetryCount, "max", pollMaxRetries)
-		time.Sleep(pollWait)
+		if err := helpers.Sleep(ctx, pollWait); err != nil {
+			return err
+		}
 	}
 
 	return errors.ConsumerGroupPollTimeout(consumerGroup, pollMaxRetries)
 }
 
-func (ks *KafkaSvc) getConsumerGroupLag(tenant string, consumerGroup string, initialLag int) (lag int, err error) {
+// CheckConsumerGroupLag checks once that the consumer group has no lag on the topic of the tenant,
+// a rebalancing or timed out consumer group is reported as not ready
+func (ks *KafkaSvc) CheckConsumerGroupLag(ctx context.Context, tenantName string) error {
+	consumerGroup := fmt.Sprintf("%s-%s", ks.Action.ConfigEnvFolio, constant.ConsumerGroupSuffix)
+	stdout, stderr, err := ks.describeConsumerGroup(ctx, tenantName, consumerGroup)
+	if err != nil {
+		return errors.KafkaNotReady(err)
+	}
+	if stderr.Len() > 0 {
+		return errors.KafkaNotReady(errors.ContainerCommandFailed(stderr.String()))
+	}
+
+	lag, err := strconv.Atoi(helpers.GetKafkaConsumerLagFromLogLine(stdout))
+	if err != nil {
+		return errors.KafkaNotReady(err)
+	}
+	if lag > 0 {
+		return errors.ConsumerGroupLagging(consumerGroup, lag)
+	}
+
+	return nil
+}
+
+func (ks *KafkaSvc) getConsumerGroupLag(ctx context.Context, tenant string, consumerGroup string, initialLag int) (lag int, err error) {
 	rebalanceWait := helpers.DefaultDuration(ks.RebalanceWait, constant.AttachCapabilitySetsRebalanceWait)
 	timeoutWait := helpers.DefaultDuration(ks.TimeoutWait, constant.AttachCapabilitySetsTimeoutWait)
 
-	kafkaCmd := fmt.Sprintf("timeout 30s kafka-consumer-groups.sh --bootstrap-server %s --describe --group %s | grep %s | awk '{print $6}'", constant.KafkaTCP, consumerGroup, tenant)
-	stdout, stderr, err := ks.ExecSvc.ExecReturnOutput(exec.Command("docker", "exec", "-i", "kafka-tools", "bash", "-c", kafkaCmd))
+	stdout, stderr, err := ks.describeConsumerGroup(ctx, tenant, consumerGroup)
 	if err != nil {
 		return initialLag, err
 	}
@@ -109,12 +141,10 @@ func (ks *KafkaSvc) getConsumerGroupLag(tenant string, consumerGroup string, ini
 		stderrText := stderr.String()
 		if strings.Contains(stderrText, constant.ErrNoActiveMembers) ||
 			strings.Contains(stderrText, constant.ErrRebalancing) {
-			time.Sleep(rebalanceWait)
-			return initialLag, nil
+			return initialLag, helpers.Sleep(ctx, rebalanceWait)
 		}
 		if strings.Contains(stderrText, constant.ErrTimeoutException) {
-			time.Sleep(timeoutWait)
-			return initialLag, nil
+			return initialLag, helpers.Sleep(ctx, timeoutWait)
 		}
 
 		return initialLag, errors.ContainerCommandFailed(stderrText)
@@ -128,3 +158,8 @@ func (ks *KafkaSvc) getConsumerGroupLag(tenant string, consumerGroup string, ini
 
 	return lag, nil
 }
+
+func (ks *KafkaSvc) describeConsumerGroup(ctx context.Context, tenant string, consumerGroup string) (stdout bytes.Buffer, stderr bytes.Buffer, err error) {
+	kafkaCmd := fmt.Sprintf("timeout 30s kafka-consumer-groups.sh --bootstrap-server %s --describe --group %s | grep %s | awk '{print $6}'", constant.KafkaTCP, consumerGroup, tenant)
+	return ks.ExecSvc.ExecReturnOutput(exec.CommandContext(ctx, "docker", "exec", "-i", "kafka-tools", "bash", "-c", kafkaCmd))
+}
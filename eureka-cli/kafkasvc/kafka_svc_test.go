@@ -2,11 +2,13 @@ package kafkasvc
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os/exec"
 	"testing"
 	"time"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/stretchr/testify/assert"
@@ -137,13 +139,38 @@ func TestPollConsumerGroup_ZeroLag(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	err := svc.PollConsumerGroup(tenantName)
+	err := svc.PollConsumerGroup(context.Background(), tenantName)
 
 	// Assert
 	assert.NoError(t, err)
 	mockExec.AssertExpectations(t)
 }
 
+func TestPollConsumerGroup_Cancelled(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	action := testhelpers.NewMockAction()
+	action.ConfigEnvFolio = "test-env"
+	mockExec := new(testhelpers.MockCommandExecutor)
+	svc := New(action, mockExec)
+	svc.PollMaxRetries = 3
+	svc.PollWait = time.Minute
+
+	stdout := bytes.NewBufferString("broker ready")
+	stderr := bytes.NewBuffer(nil)
+	mockExec.On("ExecReturnOutput", mock.Anything).Return(*stdout, *stderr, nil).Once()
+	lagStdout := bytes.NewBufferString("5\n")
+	lagStderr := bytes.NewBuffer(nil)
+	mockExec.On("ExecReturnOutput", mock.Anything).Run(func(mock.Arguments) { cancel() }).Return(*lagStdout, *lagStderr, nil).Once()
+
+	// Act
+	err := svc.PollConsumerGroup(ctx, "diku")
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	mockExec.AssertExpectations(t)
+}
+
 func TestPollConsumerGroup_LagDecreases(t *testing.T) {
 	t.Skip("Skipping complex mock scenario - basic flow covered in TestPollConsumerGroup_ZeroLag")
 }
@@ -156,6 +183,58 @@ func TestPollConsumerGroup_RebalanceError(t *testing.T) {
 	t.Skip("Skipping complex mock scenario - rebalance logic covered by unit tests")
 }
 
+func TestCheckConsumerGroupLag_NoLag(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	action.ConfigEnvFolio = "test-env"
+	mockExec := new(testhelpers.MockCommandExecutor)
+	svc := New(action, mockExec)
+
+	mockExec.On("ExecReturnOutput", mock.Anything).Return(*bytes.NewBufferString("0\n"), bytes.Buffer{}, nil).Once()
+
+	// Act
+	err := svc.CheckConsumerGroupLag(context.Background(), "diku")
+
+	// Assert
+	assert.NoError(t, err)
+	mockExec.AssertExpectations(t)
+}
+
+func TestCheckConsumerGroupLag_Lagging(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	action.ConfigEnvFolio = "test-env"
+	mockExec := new(testhelpers.MockCommandExecutor)
+	svc := New(action, mockExec)
+
+	mockExec.On("ExecReturnOutput", mock.Anything).Return(*bytes.NewBufferString("7\n"), bytes.Buffer{}, nil).Once()
+
+	// Act
+	err := svc.CheckConsumerGroupLag(context.Background(), "diku")
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrNotReady)
+	assert.Contains(t, err.Error(), "has a lag of 7")
+	mockExec.AssertExpectations(t)
+}
+
+func TestCheckConsumerGroupLag_Rebalancing(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	action.ConfigEnvFolio = "test-env"
+	mockExec := new(testhelpers.MockCommandExecutor)
+	svc := New(action, mockExec)
+
+	mockExec.On("ExecReturnOutput", mock.Anything).Return(bytes.Buffer{}, *bytes.NewBufferString(constant.ErrRebalancing), nil).Once()
+
+	// Act
+	err := svc.CheckConsumerGroupLag(context.Background(), "diku")
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrNotReady)
+	mockExec.AssertExpectations(t)
+}
+
 func TestGetConsumerGroupLag_Success(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
@@ -171,7 +250,7 @@ func TestGetConsumerGroupLag_Success(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.NoError(t, err)
@@ -195,7 +274,7 @@ func TestGetConsumerGroupLag_CommandError(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, cmdErr).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.Error(t, err)
@@ -220,7 +299,7 @@ func TestGetConsumerGroupLag_NoActiveMembers(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.NoError(t, err)
@@ -244,7 +323,7 @@ func TestGetConsumerGroupLag_Rebalancing(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.NoError(t, err)
@@ -268,7 +347,7 @@ func TestGetConsumerGroupLag_TimeoutException(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.NoError(t, err)
@@ -292,7 +371,7 @@ func TestGetConsumerGroupLag_OtherStderrError(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.Error(t, err)
@@ -316,7 +395,7 @@ func TestGetConsumerGroupLag_InvalidLagValue(t *testing.T) {
 	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(context.Background(), tenantName, consumerGroup, initialLag)
 
 	// Assert
 	assert.NoError(t, err)
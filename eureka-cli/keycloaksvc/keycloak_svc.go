@@ -27,10 +27,10 @@ type KeycloakProcessor interface {
 
 // KeycloakAdminManager defines the interface for Keycloak admin operations
 type KeycloakAdminManager interface {
-	GetAccessToken(tenantName string) (string, error)
-	GetMasterAccessToken(grantType constant.KeycloakGrantType) (string, error)
-	UpdateRealmAccessTokenSettings(tenantName string, lifespan int) error
-	UpdatePublicClientSettings(tenantName string, url string) error
+	GetAccessToken(ctx context.Context, tenantName string) (string, error)
+	GetMasterAccessToken(ctx context.Context, grantType constant.KeycloakGrantType) (string, error)
+	UpdateRealmAccessTokenSettings(ctx context.Context, tenantName string, lifespan int) error
+	UpdatePublicClientSettings(ctx context.Context, tenantName string, url string) error
 }
 
 // KeycloakSvc provides functionality for Keycloak operations including user and role management
@@ -49,13 +49,13 @@ func New(action *action.Action,
 	return &KeycloakSvc{Action: action, HTTPClient: httpClient, VaultClient: vaultClient, ManagementSvc: managementSvc}
 }
 
-func (ks *KeycloakSvc) GetAccessToken(tenantName string) (string, error) {
+func (ks *KeycloakSvc) GetAccessToken(ctx context.Context, tenantName string) (string, error) {
 	client, err := ks.VaultClient.Create()
 	if err != nil {
 		return "", err
 	}
 
-	secrets, err := ks.VaultClient.GetSecretKey(context.Background(), client, ks.Action.VaultRootToken, fmt.Sprintf("folio/%s", tenantName))
+	secrets, err := ks.VaultClient.GetSecretKey(ctx, client, ks.Action.VaultRootToken, fmt.Sprintf("folio/%s", tenantName))
 	if err != nil {
 		return "", err
 	}
@@ -76,7 +76,7 @@ func (ks *KeycloakSvc) GetAccessToken(tenantName string) (string, error) {
 	headers := helpers.ApplicationFormURLEncodedHeaders()
 
 	var tokenData map[string]any
-	if err := ks.HTTPClient.PostFormDataReturnStruct(requestURL, formData, headers, &tokenData); err != nil {
+	if err := ks.HTTPClient.PostFormDataReturnStruct(ctx, requestURL, formData, headers, &tokenData); err != nil {
 		return "", err
 	}
 	if tokenData["access_token"] == nil {
@@ -86,7 +86,7 @@ func (ks *KeycloakSvc) GetAccessToken(tenantName string) (string, error) {
 	return helpers.GetString(tokenData, "access_token"), nil
 }
 
-func (ks *KeycloakSvc) GetMasterAccessToken(grantType constant.KeycloakGrantType) (string, error) {
+func (ks *KeycloakSvc) GetMasterAccessToken(ctx context.Context, grantType constant.KeycloakGrantType) (string, error) {
 	formData := url.Values{}
 	switch grantType {
 	case constant.ClientCredentials:
@@ -104,7 +104,7 @@ func (ks *KeycloakSvc) GetMasterAccessToken(grantType constant.KeycloakGrantType
 	headers := helpers.ApplicationFormURLEncodedHeaders()
 
 	var tokenData map[string]any
-	if err := ks.HTTPClient.PostFormDataReturnStruct(requestURL, formData, headers, &tokenData); err != nil {
+	if err := ks.HTTPClient.PostFormDataReturnStruct(ctx, requestURL, formData, headers, &tokenData); err != nil {
 		return "", err
 	}
 	if tokenData["access_token"] == nil {
@@ -114,7 +114,7 @@ func (ks *KeycloakSvc) GetMasterAccessToken(grantType constant.KeycloakGrantType
 	return helpers.GetString(tokenData, "access_token"), nil
 }
 
-func (ks *KeycloakSvc) UpdateRealmAccessTokenSettings(tenantName string, lifespan int) error {
+func (ks *KeycloakSvc) UpdateRealmAccessTokenSettings(ctx context.Context, tenantName string, lifespan int) error {
 	payload, err := json.Marshal(map[string]any{
 		"accessTokenLifespan": lifespan,
 	})
@@ -123,11 +123,11 @@ func (ks *KeycloakSvc) UpdateRealmAccessTokenSettings(tenantName string, lifespa
 	}
 
 	requestURL := fmt.Sprintf("%s/admin/realms/%s", constant.KeycloakHTTP, tenantName)
-	headers, err := helpers.SecureApplicationJSONHeaders(ks.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
-	if err := ks.HTTPClient.PutReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := ks.HTTPClient.PutReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(ks.Action.Name, "text", "Updated keycloak realm settings", "realm", tenantName, "accessTokenLifespan", lifespan)
@@ -135,16 +135,16 @@ func (ks *KeycloakSvc) UpdateRealmAccessTokenSettings(tenantName string, lifespa
 	return nil
 }
 
-func (ks *KeycloakSvc) UpdatePublicClientSettings(tenantName string, url string) error {
+func (ks *KeycloakSvc) UpdatePublicClientSettings(ctx context.Context, tenantName string, url string) error {
 	clientID := fmt.Sprintf("%s%s", tenantName, action.GetConfigEnv("KC_LOGIN_CLIENT_SUFFIX", ks.Action.ConfigGlobalEnv))
 	getRequestURL := fmt.Sprintf("%s/admin/realms/%s/clients?clientId=%s", constant.KeycloakHTTP, tenantName, clientID)
-	headers, err := helpers.SecureApplicationJSONHeaders(ks.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
 	var decodedResponse models.KeycloakClientsResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(getRequestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, getRequestURL, headers, &decodedResponse); err != nil {
 		return err
 	}
 	if len(decodedResponse) != 1 {
@@ -170,7 +170,7 @@ func (ks *KeycloakSvc) UpdatePublicClientSettings(tenantName string, url string)
 	}
 
 	putRequestURL := fmt.Sprintf("%s/admin/realms/%s/clients/%s", constant.KeycloakHTTP, tenantName, clientUUID)
-	if err := ks.HTTPClient.PutReturnNoContent(putRequestURL, payload, headers); err != nil {
+	if err := ks.HTTPClient.PutReturnNoContent(ctx, putRequestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(ks.Action.Name, "text", "Updated keycloak public client", "client", clientID, "realm", tenantName)
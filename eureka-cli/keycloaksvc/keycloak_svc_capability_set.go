@@ -1,32 +1,34 @@
 package keycloaksvc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"slices"
+	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
 
 // KeycloakCapabilitySetManager defines the interface for Keycloak capability set management operations
 type KeycloakCapabilitySetManager interface {
-	GetCapabilitySets(headers map[string]string) ([]any, error)
-	GetCapabilitySetsByName(headers map[string]string, capabilityName string) ([]any, error)
-	HasCapabilitySets(tenantName string) (bool, error)
-	CountCapabilitySets(tenantName string) (int, error)
-	AttachCapabilitySetsToRoles(tenantName string) error
-	DetachCapabilitySetsFromRoles(tenantName string) error
+	GetCapabilitySets(ctx context.Context, headers map[string]string) ([]any, error)
+	GetCapabilitySetsByName(ctx context.Context, headers map[string]string, capabilityName string) ([]any, error)
+	HasCapabilitySets(ctx context.Context, tenantName string) (bool, error)
+	CountCapabilitySets(ctx context.Context, tenantName string) (int, error)
+	AttachCapabilitySetsToRoles(ctx context.Context, tenantName string) error
+	DetachCapabilitySetsFromRoles(ctx context.Context, tenantName string) error
 }
 
-func (ks *KeycloakSvc) GetCapabilitySets(headers map[string]string) ([]any, error) {
+func (ks *KeycloakSvc) GetCapabilitySets(ctx context.Context, headers map[string]string) ([]any, error) {
 	var capabilitySets []any
-	applications, err := ks.ManagementSvc.GetApplications()
+	applications, err := ks.ManagementSvc.GetApplications(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +38,7 @@ func (ks *KeycloakSvc) GetCapabilitySets(headers map[string]string) ([]any, erro
 		requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/capability-sets?query=applicationId==%s&offset=0&limit=10000", applicationID))
 
 		var decodedResponse models.KeycloakCapabilitySetsResponse
-		if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+		if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 			return nil, err
 		}
 		if len(decodedResponse.CapabilitySets) == 0 {
@@ -57,11 +59,11 @@ func (ks *KeycloakSvc) GetCapabilitySets(headers map[string]string) ([]any, erro
 	return capabilitySets, nil
 }
 
-func (ks *KeycloakSvc) GetCapabilitySetsByName(headers map[string]string, capabilityName string) ([]any, error) {
+func (ks *KeycloakSvc) GetCapabilitySetsByName(ctx context.Context, headers map[string]string, capabilityName string) ([]any, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/capability-sets?query=name==%s&limit=1", capabilityName))
 
 	var decodedResponse models.KeycloakCapabilitySetsResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.CapabilitySets) == 0 {
@@ -83,24 +85,24 @@ func (ks *KeycloakSvc) GetCapabilitySetsByName(headers map[string]string, capabi
 	return result, nil
 }
 
-func (ks *KeycloakSvc) HasCapabilitySets(tenantName string) (bool, error) {
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+func (ks *KeycloakSvc) HasCapabilitySets(ctx context.Context, tenantName string) (bool, error) {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return false, err
 	}
-	sets, err := ks.GetCapabilitySets(headers)
+	sets, err := ks.GetCapabilitySets(ctx, headers)
 	if err != nil {
 		return false, err
 	}
 	return len(sets) > 0, nil
 }
 
-func (ks *KeycloakSvc) CountCapabilitySets(tenantName string) (int, error) {
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+func (ks *KeycloakSvc) CountCapabilitySets(ctx context.Context, tenantName string) (int, error) {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return 0, err
 	}
-	sets, err := ks.GetCapabilitySets(headers)
+	sets, err := ks.GetCapabilitySets(ctx, headers)
 	if err != nil {
 		return 0, err
 	}
@@ -108,13 +110,13 @@ func (ks *KeycloakSvc) CountCapabilitySets(tenantName string) (int, error) {
 	return len(sets), nil
 }
 
-func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(ctx context.Context, tenantName string) error {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
-	roles, err := ks.GetRoles(headers)
+	roles, err := ks.GetRoles(ctx, headers)
 	if err != nil {
 		return err
 	}
@@ -127,17 +129,12 @@ func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
 	for _, roleValue := range roles {
 		entry := roleValue.(map[string]any)
 		roleName := ks.Action.Caser.String(helpers.GetString(entry, "name"))
-		if ks.Action.ConfigRoles[roleName] == nil {
+		role, exists := ks.Action.ConfigRoles[roleName]
+		if !exists || tenantName != role.Tenant {
 			continue
 		}
 
-		rolesMapConfig := helpers.GetMapOrDefault(ks.Action.ConfigRoles, roleName, nil)
-		if tenantName != helpers.GetString(rolesMapConfig, field.RolesTenantEntry) {
-			continue
-		}
-
-		rolesCapabilitySets := helpers.GetAnySlice(rolesMapConfig, field.RolesCapabilitySetsEntry)
-		capabilitySets, err := ks.populateCapabilitySets(headers, rolesCapabilitySets)
+		capabilitySets, err := ks.populateCapabilitySets(ctx, headers, role.CapabilitySets)
 		if err != nil {
 			return err
 		}
@@ -146,7 +143,7 @@ func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
 			continue
 		}
 
-		alreadyAttached, err := ks.getRoleCapabilitySetIDs(helpers.GetString(entry, "id"), headers)
+		alreadyAttached, err := ks.getRoleCapabilitySetIDs(ctx, helpers.GetString(entry, "id"), headers)
 		if err != nil {
 			return err
 		}
@@ -168,6 +165,7 @@ func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
 			continue
 		}
 
+		start := time.Now()
 		batchSize := 250
 		for lowerBound := 0; lowerBound < len(capabilitySets); lowerBound += batchSize {
 			upperBound := min(lowerBound+batchSize, len(capabilitySets))
@@ -181,17 +179,18 @@ func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
 			if err != nil {
 				return err
 			}
-			if err := ks.HTTPClient.PostRetryReturnNoContent(requestURL, payload, headers); err != nil {
+			if err := ks.HTTPClient.PostRetryReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 				return err
 			}
 		}
 		slog.Info(ks.Action.Name, "text", "Attached capability sets", "count", len(capabilitySets), "role", roleName, "tenant", tenantName)
+		ks.Action.EmitEvent(events.Event{Type: events.CapabilitySetsAttached, Tenant: tenantName, Role: roleName, Count: len(capabilitySets), DurationMs: time.Since(start).Milliseconds()})
 	}
 
 	return nil
 }
 
-func (ks *KeycloakSvc) populateCapabilitySets(headers map[string]string, rolesCapabilitySets []any) ([]string, error) {
+func (ks *KeycloakSvc) populateCapabilitySets(ctx context.Context, headers map[string]string, rolesCapabilitySets []string) ([]string, error) {
 	if len(rolesCapabilitySets) == 0 {
 		return []string{}, nil
 	}
@@ -199,7 +198,7 @@ func (ks *KeycloakSvc) populateCapabilitySets(headers map[string]string, rolesCa
 	if len(rolesCapabilitySets) == 1 && !slices.Contains(rolesCapabilitySets, "all") {
 		var capabilitySets = []string{}
 		for _, capabilitySetName := range rolesCapabilitySets {
-			capabilitySetsFound, err := ks.GetCapabilitySetsByName(headers, capabilitySetName.(string))
+			capabilitySetsFound, err := ks.GetCapabilitySetsByName(ctx, headers, capabilitySetName)
 			if err != nil {
 				return nil, err
 			}
@@ -212,7 +211,7 @@ func (ks *KeycloakSvc) populateCapabilitySets(headers map[string]string, rolesCa
 	}
 
 	var capabilitySets = []string{}
-	allCapabilitySets, err := ks.GetCapabilitySets(headers)
+	allCapabilitySets, err := ks.GetCapabilitySets(ctx, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -224,11 +223,11 @@ func (ks *KeycloakSvc) populateCapabilitySets(headers map[string]string, rolesCa
 	return capabilitySets, nil
 }
 
-func (ks *KeycloakSvc) getRoleCapabilitySetIDs(roleID string, headers map[string]string) ([]string, error) {
+func (ks *KeycloakSvc) getRoleCapabilitySetIDs(ctx context.Context, roleID string, headers map[string]string) ([]string, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/roles/%s/capability-sets?limit=10000", roleID))
 
 	var decodedResponse models.KeycloakCapabilitySetsResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		if errors.Is(err, apperrors.ErrHTTP404NotFound) {
 			return nil, nil
 		}
@@ -243,13 +242,13 @@ func (ks *KeycloakSvc) getRoleCapabilitySetIDs(roleID string, headers map[string
 	return ids, nil
 }
 
-func (ks *KeycloakSvc) DetachCapabilitySetsFromRoles(tenantName string) error {
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+func (ks *KeycloakSvc) DetachCapabilitySetsFromRoles(ctx context.Context, tenantName string) error {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
-	roles, err := ks.GetRoles(headers)
+	roles, err := ks.GetRoles(ctx, headers)
 	if err != nil {
 		return err
 	}
@@ -261,12 +260,12 @@ func (ks *KeycloakSvc) DetachCapabilitySetsFromRoles(tenantName string) error {
 	for _, value := range roles {
 		entry := value.(map[string]any)
 		roleName := ks.Action.Caser.String(helpers.GetString(entry, "name"))
-		if ks.Action.ConfigRoles[roleName] == nil {
+		if _, exists := ks.Action.ConfigRoles[roleName]; !exists {
 			continue
 		}
 
 		requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/roles/%s/capability-sets", helpers.GetString(entry, "id")))
-		if err := ks.HTTPClient.Delete(requestURL, headers); err != nil {
+		if err := ks.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 			if errors.Is(err, apperrors.ErrHTTP404NotFound) {
 				slog.Debug(ks.Action.Name, "text", "No capability sets to detach (already detached or not found)", "role", roleName, "tenant", tenantName)
 				continue
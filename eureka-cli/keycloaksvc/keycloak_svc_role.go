@@ -1,29 +1,32 @@
 package keycloaksvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
 
 // KeycloakRoleManager defines the interface for Keycloak role management operations
 type KeycloakRoleManager interface {
-	GetRoles(headers map[string]string) ([]any, error)
-	GetRoleByName(roleName string, headers map[string]string) (map[string]any, error)
-	CreateRoles(configTenant string) error
-	RemoveRoles(tenantName string) error
+	GetRoles(ctx context.Context, headers map[string]string) ([]any, error)
+	GetRoleByName(ctx context.Context, roleName string, headers map[string]string) (map[string]any, error)
+	CreateRoles(ctx context.Context, configTenant string) error
+	RemoveRoles(ctx context.Context, tenantName string) error
+	RemoveRole(ctx context.Context, tenantName string, roleName string) error
 }
 
-func (ks *KeycloakSvc) GetRoles(headers map[string]string) ([]any, error) {
+func (ks *KeycloakSvc) GetRoles(ctx context.Context, headers map[string]string) ([]any, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles?offset=0&limit=10000")
 
 	var decodedResponse models.KeycloakRolesResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Roles) == 0 {
@@ -42,11 +45,11 @@ func (ks *KeycloakSvc) GetRoles(headers map[string]string) ([]any, error) {
 	return result, nil
 }
 
-func (ks *KeycloakSvc) GetRoleByName(roleName string, headers map[string]string) (map[string]any, error) {
+func (ks *KeycloakSvc) GetRoleByName(ctx context.Context, roleName string, headers map[string]string) (map[string]any, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/roles?query=name==%s&limit=1", roleName))
 
 	var decodedResponse models.KeycloakRolesResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Roles) == 0 {
@@ -63,24 +66,22 @@ func (ks *KeycloakSvc) GetRoleByName(roleName string, headers map[string]string)
 	}, nil
 }
 
-func (ks *KeycloakSvc) CreateRoles(configTenant string) error {
+func (ks *KeycloakSvc) CreateRoles(ctx context.Context, configTenant string) error {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles")
 	roleNames := helpers.SortedMapKeys(ks.Action.ConfigRoles)
 
 	for _, role := range roleNames {
-		value := ks.Action.ConfigRoles[role]
-		entry := value.(map[string]any)
-		tenantName := helpers.GetString(entry, "tenant")
+		tenantName := ks.Action.ConfigRoles[role].Tenant
 		if configTenant != tenantName {
 			continue
 		}
 
-		headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+		headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 		if err != nil {
 			return err
 		}
 
-		existingRole, err := ks.GetRoleByName(ks.Action.Caser.String(role), headers)
+		existingRole, err := ks.GetRoleByName(ctx, ks.Action.Caser.String(role), headers)
 		if err != nil {
 			return err
 		}
@@ -96,22 +97,37 @@ func (ks *KeycloakSvc) CreateRoles(configTenant string) error {
 		if err != nil {
 			return err
 		}
-		if err := ks.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+		if err := ks.HTTPClient.PostReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 			return err
 		}
 		slog.Info(ks.Action.Name, "text", "Created role", "role", role, "tenant", tenantName)
+		ks.Action.EmitEvent(events.Event{Type: events.RoleCreated, Tenant: tenantName, Role: role})
 	}
 
 	return nil
 }
 
-func (ks *KeycloakSvc) RemoveRoles(tenantName string) error {
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+func (ks *KeycloakSvc) RemoveRoles(ctx context.Context, tenantName string) error {
+	return ks.removeRoles(ctx, tenantName, func(roleName string) bool {
+		_, exists := ks.Action.ConfigRoles[roleName]
+		return exists
+	})
+}
+
+// RemoveRole removes the role of the tenant by name, independently of the roles of the config
+func (ks *KeycloakSvc) RemoveRole(ctx context.Context, tenantName string, roleName string) error {
+	return ks.removeRoles(ctx, tenantName, func(name string) bool {
+		return name == roleName
+	})
+}
+
+func (ks *KeycloakSvc) removeRoles(ctx context.Context, tenantName string, shouldRemove func(roleName string) bool) error {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
-	roles, err := ks.GetRoles(headers)
+	roles, err := ks.GetRoles(ctx, headers)
 	if err != nil {
 		return err
 	}
@@ -119,12 +135,12 @@ func (ks *KeycloakSvc) RemoveRoles(tenantName string) error {
 	for _, value := range roles {
 		entry := value.(map[string]any)
 		roleName := ks.Action.Caser.String(helpers.GetString(entry, "name"))
-		if ks.Action.ConfigRoles[roleName] == nil {
+		if !shouldRemove(roleName) {
 			continue
 		}
 
 		requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/roles/%s", helpers.GetString(entry, "id")))
-		if err := ks.HTTPClient.Delete(requestURL, headers); err != nil {
+		if err := ks.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 			return err
 		}
 		slog.Info(ks.Action.Name, "text", "Removed role", "role", roleName, "tenant", tenantName)
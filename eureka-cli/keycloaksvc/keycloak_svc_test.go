@@ -9,8 +9,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/keycloaksvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
@@ -45,7 +47,7 @@ type MockManagementSvc struct {
 	mock.Mock
 }
 
-func (m *MockManagementSvc) GetTenants(consortiumName string, tenantType constant.TenantType) ([]any, error) {
+func (m *MockManagementSvc) GetTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) ([]any, error) {
 	args := m.Called(consortiumName, tenantType)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -53,17 +55,27 @@ func (m *MockManagementSvc) GetTenants(consortiumName string, tenantType constan
 	return args.Get(0).([]any), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateTenants() error {
+func (m *MockManagementSvc) CreateTenants(ctx context.Context) error {
 	args := m.Called()
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveTenants(consortiumName string, tenantType constant.TenantType) error {
+func (m *MockManagementSvc) RemoveTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	args := m.Called(consortiumName, tenantType)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) GetApplications() (models.ApplicationsResponse, error) {
+func (m *MockManagementSvc) RemoveTenant(ctx context.Context, tenantName string) error {
+	args := m.Called(tenantName)
+	return args.Error(0)
+}
+
+func (m *MockManagementSvc) GetTenantType(tenant config.Tenant) string {
+	args := m.Called(tenant)
+	return args.String(0)
+}
+
+func (m *MockManagementSvc) GetApplications(ctx context.Context) (models.ApplicationsResponse, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
 		return models.ApplicationsResponse{}, args.Error(1)
@@ -71,7 +83,7 @@ func (m *MockManagementSvc) GetApplications() (models.ApplicationsResponse, erro
 	return args.Get(0).(models.ApplicationsResponse), args.Error(1)
 }
 
-func (m *MockManagementSvc) GetLatestApplication() (map[string]any, error) {
+func (m *MockManagementSvc) GetLatestApplication(ctx context.Context) (map[string]any, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -79,7 +91,7 @@ func (m *MockManagementSvc) GetLatestApplication() (map[string]any, error) {
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
-func (m *MockManagementSvc) GetLatestApplicationByName(appName string) (map[string]any, error) {
+func (m *MockManagementSvc) GetLatestApplicationByName(ctx context.Context, appName string) (map[string]any, error) {
 	args := m.Called(appName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -87,76 +99,138 @@ func (m *MockManagementSvc) GetLatestApplicationByName(appName string) (map[stri
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateApplication(extract *models.RegistryExtract) error {
+func (m *MockManagementSvc) CreateApplication(ctx context.Context, extract *models.RegistryExtract) error {
 	args := m.Called(extract)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) CreateNewApplication(r *models.ApplicationUpgradeRequest) error {
+func (m *MockManagementSvc) BuildApplicationPayload(ctx context.Context, extract *models.RegistryExtract) (*models.ApplicationPayload, error) {
+	args := m.Called(extract)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ApplicationPayload), args.Error(1)
+}
+
+func (m *MockManagementSvc) CreateNewApplication(ctx context.Context, r *models.ApplicationUpgradeRequest) error {
 	args := m.Called(r)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveApplication(applicationID string) error {
+func (m *MockManagementSvc) RemoveApplication(ctx context.Context, applicationID string) error {
 	args := m.Called(applicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveApplications(applicationName, ignoreAppID string) error {
+func (m *MockManagementSvc) RemoveApplications(ctx context.Context, applicationName, ignoreAppID string) error {
 	args := m.Called(applicationName, ignoreAppID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) GetModuleDiscovery(name string) (models.ModuleDiscoveryResponse, error) {
+func (m *MockManagementSvc) GetModuleDiscovery(ctx context.Context, name string) (models.ModuleDiscoveryResponse, error) {
 	args := m.Called(name)
 	return args.Get(0).(models.ModuleDiscoveryResponse), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateNewModuleDiscovery(newDiscoveryModules []map[string]string) error {
+func (m *MockManagementSvc) GetModuleDiscoveries(ctx context.Context) (models.ModuleDiscoveryResponse, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return models.ModuleDiscoveryResponse{}, args.Error(1)
+	}
+	return args.Get(0).(models.ModuleDiscoveryResponse), args.Error(1)
+}
+
+func (m *MockManagementSvc) CreateNewModuleDiscovery(ctx context.Context, newDiscoveryModules []map[string]string) error {
 	args := m.Called(newDiscoveryModules)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) UpdateModuleDiscovery(id string, restore bool, privatePort int, sidecarURL string) error {
+func (m *MockManagementSvc) UpdateModuleDiscovery(ctx context.Context, id string, restore bool, privatePort int, sidecarURL string) error {
 	args := m.Called(id, restore, privatePort, sidecarURL)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveModuleDiscovery(id string) error {
+func (m *MockManagementSvc) RemoveModuleDiscovery(ctx context.Context, id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) GetTenantEntitlements(tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
+func (m *MockManagementSvc) GetTenantEntitlements(ctx context.Context, tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
 	args := m.Called(tenantName, includeModules)
 	return args.Get(0).(models.TenantEntitlementResponse), args.Error(1)
 }
 
-func (m *MockManagementSvc) CreateTenantEntitlement(consortiumName string, tenantType constant.TenantType) error {
+func (m *MockManagementSvc) CreateTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
 	args := m.Called(consortiumName, tenantType)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) CreateTenantEntitlementForApplication(consortiumName string, tenantType constant.TenantType, applicationID string) error {
+func (m *MockManagementSvc) CreateTenantEntitlementForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string) error {
 	args := m.Called(consortiumName, tenantType, applicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
+func (m *MockManagementSvc) UpgradeTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
 	args := m.Called(consortiumName, tenantType, newApplicationID)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveTenantEntitlements(consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error {
+func (m *MockManagementSvc) RemoveTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error {
 	args := m.Called(consortiumName, tenantType, purgeSchemas)
 	return args.Error(0)
 }
 
-func (m *MockManagementSvc) RemoveTenantEntitlementsForApplication(consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error {
+func (m *MockManagementSvc) RemoveTenantEntitlementsForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error {
 	args := m.Called(consortiumName, tenantType, applicationID, purgeSchemas)
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) RemoveTenantEntitlement(ctx context.Context, tenantName string, applicationID string, purgeSchemas bool) error {
+	args := m.Called(tenantName, applicationID, purgeSchemas)
+	return args.Error(0)
+}
+
+func (m *MockManagementSvc) GetApplicationModuleDescriptors(ctx context.Context, extract *models.RegistryExtract, applicationPayload *models.ApplicationPayload) ([]any, error) {
+	args := m.Called(extract, applicationPayload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]any), args.Error(1)
+}
+
+func (m *MockManagementSvc) GetParentModuleDescriptors(ctx context.Context, applicationIDs []string) ([]any, error) {
+	args := m.Called(applicationIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]any), args.Error(1)
+}
+
+func (m *MockManagementSvc) GetManagementModuleDescriptors(ctx context.Context, modules *models.ProxyModulesByRegistry, managementModules map[string]models.BackendModule) ([]any, error) {
+	args := m.Called(modules, managementModules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]any), args.Error(1)
+}
+
+func (m *MockManagementSvc) CheckModuleDependencies(moduleDescriptors []any, providerModuleDescriptors []any) []models.UnsatisfiedInterface {
+	args := m.Called(moduleDescriptors, providerModuleDescriptors)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]models.UnsatisfiedInterface)
+}
+
+func (m *MockManagementSvc) ResolveModuleDependencies(moduleDescriptors []any, moduleNames []string, preferredModuleNames []string) ([]string, []models.UnsatisfiedInterface) {
+	args := m.Called(moduleDescriptors, moduleNames, preferredModuleNames)
+	var unsatisfied []models.UnsatisfiedInterface
+	if args.Get(1) != nil {
+		unsatisfied = args.Get(1).([]models.UnsatisfiedInterface)
+	}
+	return args.Get(0).([]string), unsatisfied
+}
+
 func TestNew(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
@@ -207,7 +281,7 @@ func TestGetMasterAccessToken_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	token, err := svc.GetMasterAccessToken(constant.Password)
+	token, err := svc.GetMasterAccessToken(context.Background(), constant.Password)
 
 	// Assert
 	assert.NoError(t, err)
@@ -232,7 +306,7 @@ func TestGetMasterAccessToken_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	token, err := svc.GetMasterAccessToken(constant.Password)
+	token, err := svc.GetMasterAccessToken(context.Background(), constant.Password)
 
 	// Assert
 	assert.Error(t, err)
@@ -263,7 +337,7 @@ func TestGetMasterAccessToken_NoToken(t *testing.T) {
 		Return(nil)
 
 	// Act
-	token, err := svc.GetMasterAccessToken(constant.Password)
+	token, err := svc.GetMasterAccessToken(context.Background(), constant.Password)
 
 	// Assert
 	assert.Error(t, err)
@@ -308,7 +382,7 @@ func TestGetMasterAccessToken_ClientCredentials_Success(t *testing.T) {
 			Return(nil)
 
 		// Act
-		token, err := svc.GetMasterAccessToken(constant.ClientCredentials)
+		token, err := svc.GetMasterAccessToken(context.Background(), constant.ClientCredentials)
 
 		// Assert
 		assert.NoError(t, err)
@@ -339,7 +413,7 @@ func TestGetMasterAccessToken_ClientCredentials_HTTPError(t *testing.T) {
 			Return(expectedError)
 
 		// Act
-		token, err := svc.GetMasterAccessToken(constant.ClientCredentials)
+		token, err := svc.GetMasterAccessToken(context.Background(), constant.ClientCredentials)
 
 		// Assert
 		assert.Error(t, err)
@@ -376,7 +450,7 @@ func TestGetMasterAccessToken_ClientCredentials_NoToken(t *testing.T) {
 			Return(nil)
 
 		// Act
-		token, err := svc.GetMasterAccessToken(constant.ClientCredentials)
+		token, err := svc.GetMasterAccessToken(context.Background(), constant.ClientCredentials)
 
 		// Assert
 		assert.Error(t, err)
@@ -391,7 +465,7 @@ func TestUpdateRealmAccessTokenSettings_Success(t *testing.T) {
 		// Arrange
 		mockHTTP := &testhelpers.MockHTTPClient{}
 		action := testhelpers.NewMockAction()
-		action.KeycloakMasterAccessToken = "test-master-token"
+		ctx := helpers.WithMasterAccessToken(context.Background(), "test-master-token")
 		mockVault := &MockVaultClient{}
 		mockMgmt := &MockManagementSvc{}
 		svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -418,7 +492,7 @@ func TestUpdateRealmAccessTokenSettings_Success(t *testing.T) {
 			Return(nil)
 
 		// Act
-		err := svc.UpdateRealmAccessTokenSettings(tenantName, lifespan)
+		err := svc.UpdateRealmAccessTokenSettings(ctx, tenantName, lifespan)
 
 		// Assert
 		assert.NoError(t, err)
@@ -431,7 +505,7 @@ func TestUpdateRealmAccessTokenSettings_HTTPError(t *testing.T) {
 		// Arrange
 		mockHTTP := &testhelpers.MockHTTPClient{}
 		action := testhelpers.NewMockAction()
-		action.KeycloakMasterAccessToken = "test-master-token"
+		ctx := helpers.WithMasterAccessToken(context.Background(), "test-master-token")
 		mockVault := &MockVaultClient{}
 		mockMgmt := &MockManagementSvc{}
 		svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -444,7 +518,7 @@ func TestUpdateRealmAccessTokenSettings_HTTPError(t *testing.T) {
 			Return(expectedError)
 
 		// Act
-		err := svc.UpdateRealmAccessTokenSettings("test-tenant", 1800)
+		err := svc.UpdateRealmAccessTokenSettings(ctx, "test-tenant", 1800)
 
 		// Assert
 		assert.Error(t, err)
@@ -458,7 +532,7 @@ func TestUpdateRealmAccessTokenSettings_ZeroLifespan(t *testing.T) {
 		// Arrange
 		mockHTTP := &testhelpers.MockHTTPClient{}
 		action := testhelpers.NewMockAction()
-		action.KeycloakMasterAccessToken = "test-master-token"
+		ctx := helpers.WithMasterAccessToken(context.Background(), "test-master-token")
 		mockVault := &MockVaultClient{}
 		mockMgmt := &MockManagementSvc{}
 		svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -477,7 +551,7 @@ func TestUpdateRealmAccessTokenSettings_ZeroLifespan(t *testing.T) {
 			Return(nil)
 
 		// Act
-		err := svc.UpdateRealmAccessTokenSettings("test-tenant", 0)
+		err := svc.UpdateRealmAccessTokenSettings(ctx, "test-tenant", 0)
 
 		// Assert
 		assert.NoError(t, err)
@@ -490,7 +564,7 @@ func TestUpdateRealmAccessTokenSettings_LargeLifespan(t *testing.T) {
 		// Arrange
 		mockHTTP := &testhelpers.MockHTTPClient{}
 		action := testhelpers.NewMockAction()
-		action.KeycloakMasterAccessToken = "test-master-token"
+		ctx := helpers.WithMasterAccessToken(context.Background(), "test-master-token")
 		mockVault := &MockVaultClient{}
 		mockMgmt := &MockManagementSvc{}
 		svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -511,7 +585,7 @@ func TestUpdateRealmAccessTokenSettings_LargeLifespan(t *testing.T) {
 			Return(nil)
 
 		// Act
-		err := svc.UpdateRealmAccessTokenSettings("test-tenant", lifespan)
+		err := svc.UpdateRealmAccessTokenSettings(ctx, "test-tenant", lifespan)
 
 		// Assert
 		assert.NoError(t, err)
@@ -524,13 +598,12 @@ func TestUpdateRealmAccessTokenSettings_HeaderCreationError(t *testing.T) {
 		// Arrange
 		mockHTTP := &testhelpers.MockHTTPClient{}
 		action := testhelpers.NewMockAction()
-		action.KeycloakMasterAccessToken = "" // Empty token
 		mockVault := &MockVaultClient{}
 		mockMgmt := &MockManagementSvc{}
 		svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 		// Act
-		err := svc.UpdateRealmAccessTokenSettings("test-tenant", 1800)
+		err := svc.UpdateRealmAccessTokenSettings(context.Background(), "test-tenant", 1800)
 
 		// Assert
 		assert.Error(t, err)
@@ -542,7 +615,7 @@ func TestUpdatePublicClientSettings_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-master-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-master-token")
 	action.ConfigGlobalEnv = map[string]string{
 		"KC_LOGIN_CLIENT_SUFFIX": "",
 	}
@@ -591,7 +664,7 @@ func TestUpdatePublicClientSettings_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.UpdatePublicClientSettings(tenantName, baseURL)
+	err := svc.UpdatePublicClientSettings(ctx, tenantName, baseURL)
 
 	// Assert
 	assert.NoError(t, err)
@@ -602,7 +675,7 @@ func TestUpdatePublicClientSettings_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-master-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-master-token")
 	action.ConfigGlobalEnv = map[string]string{
 		"KC_LOGIN_CLIENT_SUFFIX": "",
 	}
@@ -618,7 +691,7 @@ func TestUpdatePublicClientSettings_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.UpdatePublicClientSettings("test-tenant", "http://test.com")
+	err := svc.UpdatePublicClientSettings(ctx, "test-tenant", "http://test.com")
 
 	// Assert
 	assert.Error(t, err)
@@ -630,7 +703,6 @@ func TestUpdatePublicClientSettings_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token
 	action.ConfigGlobalEnv = map[string]string{
 		"KC_LOGIN_CLIENT_SUFFIX": "",
 	}
@@ -639,7 +711,7 @@ func TestUpdatePublicClientSettings_HeaderCreationError(t *testing.T) {
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.UpdatePublicClientSettings("test-tenant", "http://test.com")
+	err := svc.UpdatePublicClientSettings(context.Background(), "test-tenant", "http://test.com")
 
 	// Assert
 	assert.Error(t, err)
@@ -693,7 +765,7 @@ func TestGetAccessToken_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	token, err := svc.GetAccessToken("test-tenant")
+	token, err := svc.GetAccessToken(context.Background(), "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -714,7 +786,7 @@ func TestGetAccessToken_VaultCreateError(t *testing.T) {
 	mockVault.On("Create").Return(nil, expectedError)
 
 	// Act
-	token, err := svc.GetAccessToken("test-tenant")
+	token, err := svc.GetAccessToken(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -744,7 +816,7 @@ func TestGetAccessToken_VaultGetSecretError(t *testing.T) {
 		Return(nil, expectedError)
 
 	// Act
-	token, err := svc.GetAccessToken("test-tenant")
+	token, err := svc.GetAccessToken(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -788,7 +860,7 @@ func TestGetAccessToken_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	token, err := svc.GetAccessToken("test-tenant")
+	token, err := svc.GetAccessToken(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -838,7 +910,7 @@ func TestGetAccessToken_NoToken(t *testing.T) {
 		Return(nil)
 
 	// Act
-	token, err := svc.GetAccessToken("test-tenant")
+	token, err := svc.GetAccessToken(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -876,7 +948,7 @@ func TestGetRoles_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	roles, err := svc.GetRoles(map[string]string{})
+	roles, err := svc.GetRoles(context.Background(), map[string]string{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -908,7 +980,7 @@ func TestGetRoles_EmptyResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	roles, err := svc.GetRoles(map[string]string{})
+	roles, err := svc.GetRoles(context.Background(), map[string]string{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -932,7 +1004,7 @@ func TestGetRoles_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	roles, err := svc.GetRoles(map[string]string{})
+	roles, err := svc.GetRoles(context.Background(), map[string]string{})
 
 	// Assert
 	assert.Error(t, err)
@@ -968,7 +1040,7 @@ func TestGetRoleByName_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	role, err := svc.GetRoleByName("Admin", map[string]string{})
+	role, err := svc.GetRoleByName(context.Background(), "Admin", map[string]string{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -999,7 +1071,7 @@ func TestGetRoleByName_NotFound(t *testing.T) {
 		Return(nil)
 
 	// Act
-	role, err := svc.GetRoleByName("NonExistent", map[string]string{})
+	role, err := svc.GetRoleByName(context.Background(), "NonExistent", map[string]string{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -1033,7 +1105,7 @@ func TestGetRoleByName_MultipleFound(t *testing.T) {
 		Return(nil)
 
 	// Act
-	role, err := svc.GetRoleByName("Admin", map[string]string{})
+	role, err := svc.GetRoleByName(context.Background(), "Admin", map[string]string{})
 
 	// Assert
 	assert.Error(t, err)
@@ -1046,13 +1118,13 @@ func TestCreateRoles_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant": "test-tenant",
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant: "test-tenant",
 		},
-		"user": map[string]any{
-			"tenant": "test-tenant",
+		"user": {
+			Tenant: "test-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1080,7 +1152,7 @@ func TestCreateRoles_Success(t *testing.T) {
 		Return(nil).Times(2)
 
 	// Act
-	err := svc.CreateRoles("test-tenant")
+	err := svc.CreateRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1091,9 +1163,9 @@ func TestCreateRoles_SkipsDifferentTenant(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant": "other-tenant",
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant: "other-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1101,7 +1173,7 @@ func TestCreateRoles_SkipsDifferentTenant(t *testing.T) {
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.CreateRoles("test-tenant")
+	err := svc.CreateRoles(context.Background(), "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1112,10 +1184,10 @@ func TestCreateRoles_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant": "test-tenant",
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant: "test-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1138,7 +1210,7 @@ func TestCreateRoles_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateRoles("test-tenant")
+	err := svc.CreateRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1150,10 +1222,9 @@ func TestCreateRoles_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant": "test-tenant",
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant: "test-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1161,7 +1232,7 @@ func TestCreateRoles_HeaderCreationError(t *testing.T) {
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.CreateRoles("test-tenant")
+	err := svc.CreateRoles(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1172,10 +1243,10 @@ func TestRemoveRoles_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant": "test-tenant",
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant: "test-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1206,18 +1277,56 @@ func TestRemoveRoles_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.RemoveRoles("test-tenant")
+	err := svc.RemoveRoles(ctx, "test-tenant")
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestRemoveRole_OnlyRemovesNamedRole(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin":  {Tenant: "test-tenant"},
+		"viewer": {Tenant: "test-tenant"},
+	}
+	svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KeycloakRolesResponse)
+			*target = models.KeycloakRolesResponse{
+				Roles: []models.KeycloakRole{{ID: "role-1", Name: "admin"}, {ID: "role-2", Name: "viewer"}},
+			}
+		}).
+		Return(nil)
+	mockHTTP.On("Delete",
+		mock.MatchedBy(func(urlStr string) bool {
+			return strings.Contains(urlStr, "/roles/role-1")
+		}),
+		mock.Anything).
+		Return(nil)
+
+	// Act
+	err := svc.RemoveRole(ctx, "test-tenant", "admin")
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
+	mockHTTP.AssertNumberOfCalls(t, "Delete", 1)
 }
 
 func TestRemoveRoles_GetRolesError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -1230,7 +1339,7 @@ func TestRemoveRoles_GetRolesError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveRoles("test-tenant")
+	err := svc.RemoveRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1242,13 +1351,12 @@ func TestRemoveRoles_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.RemoveRoles("test-tenant")
+	err := svc.RemoveRoles(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1262,7 +1370,7 @@ func TestGetUsers_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -1296,7 +1404,7 @@ func TestGetUsers_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	users, err := svc.GetUsers("test-tenant")
+	users, err := svc.GetUsers(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1311,7 +1419,7 @@ func TestGetUsers_EmptyResponse(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -1329,7 +1437,7 @@ func TestGetUsers_EmptyResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	users, err := svc.GetUsers("test-tenant")
+	users, err := svc.GetUsers(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1341,7 +1449,7 @@ func TestGetUsers_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -1354,7 +1462,7 @@ func TestGetUsers_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	users, err := svc.GetUsers("test-tenant")
+	users, err := svc.GetUsers(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1367,14 +1475,14 @@ func TestCreateUsers_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigUsers = map[string]any{
-		"testuser": map[string]any{
-			"tenant":     "test-tenant",
-			"password":   "pass123",
-			"first-name": "Test",
-			"last-name":  "User",
-			"roles":      []any{"admin"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigUsers = map[string]config.User{
+		"testuser": {
+			Tenant:    "test-tenant",
+			Password:  "pass123",
+			FirstName: "Test",
+			LastName:  "User",
+			Roles:     []string{"admin"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1455,7 +1563,7 @@ func TestCreateUsers_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateUsers("test-tenant")
+	err := svc.CreateUsers(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1466,9 +1574,9 @@ func TestCreateUsers_SkipsDifferentTenant(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.ConfigUsers = map[string]any{
-		"testuser": map[string]any{
-			"tenant": "other-tenant",
+	action.ConfigUsers = map[string]config.User{
+		"testuser": {
+			Tenant: "other-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1476,7 +1584,7 @@ func TestCreateUsers_SkipsDifferentTenant(t *testing.T) {
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.CreateUsers("test-tenant")
+	err := svc.CreateUsers(context.Background(), "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1487,14 +1595,13 @@ func TestCreateUsers_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
-	action.ConfigUsers = map[string]any{
-		"testuser": map[string]any{
-			"tenant":     "test-tenant",
-			"password":   "pass123",
-			"first-name": "Test",
-			"last-name":  "User",
-			"roles":      []any{"admin"},
+	action.ConfigUsers = map[string]config.User{
+		"testuser": {
+			Tenant:    "test-tenant",
+			Password:  "pass123",
+			FirstName: "Test",
+			LastName:  "User",
+			Roles:     []string{"admin"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -1502,7 +1609,7 @@ func TestCreateUsers_HeaderCreationError(t *testing.T) {
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.CreateUsers("test-tenant")
+	err := svc.CreateUsers(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1514,22 +1621,22 @@ func TestCreateUsers_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigUsers = map[string]any{
-		"testuser": map[string]any{
-			"tenant":     "", // Empty tenant in user config
-			"password":   "pass123",
-			"first-name": "Test",
-			"last-name":  "User",
-			"roles":      []any{"admin"},
-		},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigUsers = map[string]config.User{
+		"testuser": {
+			Tenant:    "",
+			Password:  "pass123",
+			FirstName: "Test",
+			LastName:  "User",
+			Roles:     []string{"admin"},
+		}, // Empty tenant in user config
 	}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.CreateUsers("") // Call with empty tenant to match the user's tenant
+	err := svc.CreateUsers(ctx, "") // Call with empty tenant to match the user's tenant
 
 	// Assert
 	assert.Error(t, err) // Error because tenant is blank when creating headers
@@ -1540,9 +1647,9 @@ func TestRemoveUsers_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigUsers = map[string]any{
-		"testuser": map[string]any{},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigUsers = map[string]config.User{
+		"testuser": {},
 	}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
@@ -1572,18 +1679,56 @@ func TestRemoveUsers_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.RemoveUsers("test-tenant")
+	err := svc.RemoveUsers(ctx, "test-tenant")
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestRemoveUser_OnlyRemovesNamedUser(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigUsers = map[string]config.User{
+		"testuser":  {},
+		"otheruser": {},
+	}
+	svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KeycloakUsersResponse)
+			*target = models.KeycloakUsersResponse{
+				Users: []models.KeycloakUser{{ID: "user-1", Username: "testuser"}, {ID: "user-2", Username: "otheruser"}},
+			}
+		}).
+		Return(nil)
+	mockHTTP.On("Delete",
+		mock.MatchedBy(func(urlStr string) bool {
+			return strings.Contains(urlStr, "/users-keycloak/users/user-1")
+		}),
+		mock.Anything).
+		Return(nil)
+
+	// Act
+	err := svc.RemoveUser(ctx, "test-tenant", "testuser")
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
+	mockHTTP.AssertNumberOfCalls(t, "Delete", 1)
 }
 
 func TestRemoveUsers_GetUsersError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -1596,7 +1741,7 @@ func TestRemoveUsers_GetUsersError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveUsers("test-tenant")
+	err := svc.RemoveUsers(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1608,13 +1753,12 @@ func TestRemoveUsers_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.RemoveUsers("test-tenant")
+	err := svc.RemoveUsers(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1626,13 +1770,13 @@ func TestRemoveUsers_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.RemoveUsers("") // Empty tenant
+	err := svc.RemoveUsers(ctx, "") // Empty tenant
 
 	// Assert
 	assert.Error(t, err)
@@ -1683,7 +1827,7 @@ func TestGetCapabilitySets_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	capSets, err := svc.GetCapabilitySets(map[string]string{})
+	capSets, err := svc.GetCapabilitySets(context.Background(), map[string]string{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -1707,7 +1851,7 @@ func TestGetCapabilitySets_GetApplicationsError(t *testing.T) {
 	mockMgmt.On("GetApplications").Return(nil, expectedError)
 
 	// Act
-	capSets, err := svc.GetCapabilitySets(map[string]string{})
+	capSets, err := svc.GetCapabilitySets(context.Background(), map[string]string{})
 
 	// Assert
 	assert.Error(t, err)
@@ -1720,7 +1864,7 @@ func TestHasCapabilitySets_ReturnsFalse_WhenEmpty(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	act := testhelpers.NewMockAction()
-	act.KeycloakAccessToken = "token"
+	ctx := helpers.WithAccessToken(context.Background(), "token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
@@ -1729,7 +1873,7 @@ func TestHasCapabilitySets_ReturnsFalse_WhenEmpty(t *testing.T) {
 	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// Act
-	has, err := svc.HasCapabilitySets("test-tenant")
+	has, err := svc.HasCapabilitySets(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1740,7 +1884,7 @@ func TestHasCapabilitySets_ReturnsTrue_WhenNonEmpty(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	act := testhelpers.NewMockAction()
-	act.KeycloakAccessToken = "token"
+	ctx := helpers.WithAccessToken(context.Background(), "token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
@@ -1756,7 +1900,7 @@ func TestHasCapabilitySets_ReturnsTrue_WhenNonEmpty(t *testing.T) {
 		Return(nil)
 
 	// Act
-	has, err := svc.HasCapabilitySets("test-tenant")
+	has, err := svc.HasCapabilitySets(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1773,7 +1917,7 @@ func TestHasCapabilitySets_HeadersError(t *testing.T) {
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	has, err := svc.HasCapabilitySets("test-tenant")
+	has, err := svc.HasCapabilitySets(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1784,7 +1928,7 @@ func TestHasCapabilitySets_GetCapabilitySetsError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	act := testhelpers.NewMockAction()
-	act.KeycloakAccessToken = "token"
+	ctx := helpers.WithAccessToken(context.Background(), "token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
@@ -1793,7 +1937,7 @@ func TestHasCapabilitySets_GetCapabilitySetsError(t *testing.T) {
 	mockMgmt.On("GetApplications").Return(models.ApplicationsResponse{}, expectedError)
 
 	// Act
-	has, err := svc.HasCapabilitySets("test-tenant")
+	has, err := svc.HasCapabilitySets(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1805,7 +1949,7 @@ func TestCountCapabilitySets_ReturnsCount(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	act := testhelpers.NewMockAction()
-	act.KeycloakAccessToken = "token"
+	ctx := helpers.WithAccessToken(context.Background(), "token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
@@ -1824,7 +1968,7 @@ func TestCountCapabilitySets_ReturnsCount(t *testing.T) {
 		Return(nil)
 
 	// Act
-	count, err := svc.CountCapabilitySets("test-tenant")
+	count, err := svc.CountCapabilitySets(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1835,7 +1979,7 @@ func TestCountCapabilitySets_ReturnsZero_WhenEmpty(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	act := testhelpers.NewMockAction()
-	act.KeycloakAccessToken = "token"
+	ctx := helpers.WithAccessToken(context.Background(), "token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
@@ -1844,7 +1988,7 @@ func TestCountCapabilitySets_ReturnsZero_WhenEmpty(t *testing.T) {
 	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// Act
-	count, err := svc.CountCapabilitySets("test-tenant")
+	count, err := svc.CountCapabilitySets(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1861,7 +2005,7 @@ func TestCountCapabilitySets_HeadersError(t *testing.T) {
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	count, err := svc.CountCapabilitySets("test-tenant")
+	count, err := svc.CountCapabilitySets(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1872,7 +2016,7 @@ func TestCountCapabilitySets_GetCapabilitySetsError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	act := testhelpers.NewMockAction()
-	act.KeycloakAccessToken = "token"
+	ctx := helpers.WithAccessToken(context.Background(), "token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(act, mockHTTP, mockVault, mockMgmt)
@@ -1881,7 +2025,7 @@ func TestCountCapabilitySets_GetCapabilitySetsError(t *testing.T) {
 	mockMgmt.On("GetApplications").Return(models.ApplicationsResponse{}, expectedError)
 
 	// Act
-	count, err := svc.CountCapabilitySets("test-tenant")
+	count, err := svc.CountCapabilitySets(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -1920,7 +2064,7 @@ func TestGetCapabilitySetsByName_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	capSets, err := svc.GetCapabilitySetsByName(map[string]string{}, "users.read")
+	capSets, err := svc.GetCapabilitySetsByName(context.Background(), map[string]string{}, "users.read")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1949,7 +2093,7 @@ func TestGetCapabilitySetsByName_EmptyResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	capSets, err := svc.GetCapabilitySetsByName(map[string]string{}, "nonexistent")
+	capSets, err := svc.GetCapabilitySetsByName(context.Background(), map[string]string{}, "nonexistent")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1961,11 +2105,11 @@ func TestAttachCapabilitySetsToRoles_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"users.read"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"users.read"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2025,7 +2169,7 @@ func TestAttachCapabilitySetsToRoles_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2036,7 +2180,7 @@ func TestAttachCapabilitySetsToRoles_NoRoles(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -2054,7 +2198,7 @@ func TestAttachCapabilitySetsToRoles_NoRoles(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2065,7 +2209,7 @@ func TestAttachCapabilitySetsToRoles_GetRolesError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -2078,7 +2222,7 @@ func TestAttachCapabilitySetsToRoles_GetRolesError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2090,13 +2234,12 @@ func TestAttachCapabilitySetsToRoles_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2108,9 +2251,9 @@ func TestDetachCapabilitySetsFromRoles_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {},
 	}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
@@ -2140,7 +2283,7 @@ func TestDetachCapabilitySetsFromRoles_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2151,7 +2294,7 @@ func TestDetachCapabilitySetsFromRoles_NoRoles(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -2169,7 +2312,7 @@ func TestDetachCapabilitySetsFromRoles_NoRoles(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2180,13 +2323,12 @@ func TestDetachCapabilitySetsFromRoles_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2198,7 +2340,7 @@ func TestDetachCapabilitySetsFromRoles_GetRolesError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -2210,7 +2352,7 @@ func TestDetachCapabilitySetsFromRoles_GetRolesError(t *testing.T) {
 		Return(errors.New("get roles failed"))
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2222,9 +2364,9 @@ func TestDetachCapabilitySetsFromRoles_NotFoundIgnored(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {},
 	}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
@@ -2254,7 +2396,7 @@ func TestDetachCapabilitySetsFromRoles_NotFoundIgnored(t *testing.T) {
 		Return(apperrors.ErrHTTP404NotFound)
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(ctx, "test-tenant")
 
 	// Assert
 	// 404 errors should be logged but not returned as errors
@@ -2266,9 +2408,9 @@ func TestDetachCapabilitySetsFromRoles_DeleteError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {},
 	}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
@@ -2298,7 +2440,7 @@ func TestDetachCapabilitySetsFromRoles_DeleteError(t *testing.T) {
 		Return(errors.New("delete failed"))
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2310,9 +2452,9 @@ func TestDetachCapabilitySetsFromRoles_RoleNotInConfig(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {},
 	}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
@@ -2344,7 +2486,7 @@ func TestDetachCapabilitySetsFromRoles_RoleNotInConfig(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.DetachCapabilitySetsFromRoles("test-tenant")
+	err := svc.DetachCapabilitySetsFromRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2361,11 +2503,11 @@ func TestAttachCapabilitySetsToRoles_WithAllCapabilitySets(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"all"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"all"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2434,7 +2576,7 @@ func TestAttachCapabilitySetsToRoles_WithAllCapabilitySets(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2446,11 +2588,11 @@ func TestAttachCapabilitySetsToRoles_LargeBatchSplitting(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"all"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"all"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2523,7 +2665,7 @@ func TestAttachCapabilitySetsToRoles_LargeBatchSplitting(t *testing.T) {
 		Return(nil).Times(2)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2535,11 +2677,11 @@ func TestAttachCapabilitySetsToRoles_SkipsDifferentTenant(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "different-tenant",
-			"capability-sets": []any{"users.read"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "different-tenant",
+			CapabilitySets: []string{"users.read"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2565,7 +2707,7 @@ func TestAttachCapabilitySetsToRoles_SkipsDifferentTenant(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2577,8 +2719,8 @@ func TestAttachCapabilitySetsToRoles_SkipsRoleNotInConfig(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{}
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{}
 	mockVault := &MockVaultClient{}
 	mockMgmt := &MockManagementSvc{}
 	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
@@ -2602,7 +2744,7 @@ func TestAttachCapabilitySetsToRoles_SkipsRoleNotInConfig(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2613,11 +2755,11 @@ func TestAttachCapabilitySetsToRoles_NoCapabilitySetsFound(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"nonexistent"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"nonexistent"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2657,7 +2799,7 @@ func TestAttachCapabilitySetsToRoles_NoCapabilitySetsFound(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2669,11 +2811,11 @@ func TestAttachCapabilitySetsToRoles_PopulateCapabilitySetsError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"users.read"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"users.read"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2708,7 +2850,7 @@ func TestAttachCapabilitySetsToRoles_PopulateCapabilitySetsError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2720,11 +2862,11 @@ func TestAttachCapabilitySetsToRoles_PostError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"users.read"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"users.read"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2783,7 +2925,7 @@ func TestAttachCapabilitySetsToRoles_PostError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -2797,10 +2939,10 @@ func TestCreateRoles_RoleAlreadyExists_Skipped(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant": "test-tenant",
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant: "test-tenant",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2821,7 +2963,7 @@ func TestCreateRoles_RoleAlreadyExists_Skipped(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateRoles("test-tenant")
+	err := svc.CreateRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2832,13 +2974,13 @@ func TestCreateUsers_UserAlreadyExists_Skipped(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigUsers = map[string]any{
-		"testuser": map[string]any{
-			"tenant":     "test-tenant",
-			"password":   "pass123",
-			"first-name": "Test",
-			"last-name":  "User",
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigUsers = map[string]config.User{
+		"testuser": {
+			Tenant:    "test-tenant",
+			Password:  "pass123",
+			FirstName: "Test",
+			LastName:  "User",
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2858,7 +3000,7 @@ func TestCreateUsers_UserAlreadyExists_Skipped(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateUsers("test-tenant")
+	err := svc.CreateUsers(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2870,11 +3012,11 @@ func TestAttachCapabilitySetsToRoles_AllAlreadyAttached_Skipped(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"users.read"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"users.read"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -2926,7 +3068,7 @@ func TestAttachCapabilitySetsToRoles_AllAlreadyAttached_Skipped(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -2937,11 +3079,11 @@ func TestAttachCapabilitySetsToRoles_PartiallyAttached_PostsDelta(t *testing.T)
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
-	action.ConfigRoles = map[string]any{
-		"admin": map[string]any{
-			"tenant":          "test-tenant",
-			"capability-sets": []any{"users.read"},
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
+	action.ConfigRoles = map[string]config.Role{
+		"admin": {
+			Tenant:         "test-tenant",
+			CapabilitySets: []string{"users.read"},
 		},
 	}
 	mockVault := &MockVaultClient{}
@@ -3010,7 +3152,7 @@ func TestAttachCapabilitySetsToRoles_PartiallyAttached_PostsDelta(t *testing.T)
 		Return(nil)
 
 	// Act
-	err := svc.AttachCapabilitySetsToRoles("test-tenant")
+	err := svc.AttachCapabilitySetsToRoles(ctx, "test-tenant")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1,31 +1,35 @@
 package keycloaksvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
 
 // KeycloakUserManager defines the interface for Keycloak user management operations
 type KeycloakUserManager interface {
-	GetUsers(tenantName string) ([]any, error)
-	CreateUsers(configTenant string) error
-	RemoveUsers(tenantName string) error
+	GetUsers(ctx context.Context, tenantName string) ([]any, error)
+	CreateUsers(ctx context.Context, configTenant string) error
+	RemoveUsers(ctx context.Context, tenantName string) error
+	RemoveUser(ctx context.Context, tenantName string, username string) error
 }
 
-func (ks *KeycloakSvc) GetUsers(tenantName string) ([]any, error) {
+func (ks *KeycloakSvc) GetUsers(ctx context.Context, tenantName string) ([]any, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/users?offset=0&limit=10000")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.KeycloakUsersResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Users) == 0 {
@@ -46,18 +50,17 @@ func (ks *KeycloakSvc) GetUsers(tenantName string) ([]any, error) {
 	return result, nil
 }
 
-func (ks *KeycloakSvc) CreateUsers(configTenant string) error {
+func (ks *KeycloakSvc) CreateUsers(ctx context.Context, configTenant string) error {
 	usernames := helpers.SortedMapKeys(ks.Action.ConfigUsers)
 
 	for _, username := range usernames {
-		value := ks.Action.ConfigUsers[username]
-		entry := value.(map[string]any)
-		tenantName := helpers.GetString(entry, "tenant")
+		user := ks.Action.ConfigUsers[username]
+		tenantName := user.Tenant
 		if configTenant != tenantName {
 			continue
 		}
 
-		existingUser, err := ks.getUserByUsername(tenantName, username)
+		existingUser, err := ks.getUserByUsername(ctx, tenantName, username)
 		if err != nil {
 			return err
 		}
@@ -66,19 +69,19 @@ func (ks *KeycloakSvc) CreateUsers(configTenant string) error {
 			continue
 		}
 
-		createdUser, err := ks.createUser(tenantName, username, entry)
+		createdUser, err := ks.createUser(ctx, tenantName, username, user)
 		if err != nil {
 			return err
 		}
+		ks.Action.EmitEvent(events.Event{Type: events.UserCreated, Tenant: tenantName, User: username})
 
 		userID := helpers.GetString(createdUser, "id")
-		if err := ks.attachUserPassword(tenantName, userID, username, entry); err != nil {
+		if err := ks.attachUserPassword(ctx, tenantName, userID, username, user.Password); err != nil {
 			return err
 		}
 
-		userRoles := helpers.GetAnySlice(entry, "roles")
-		if len(userRoles) > 0 {
-			if err := ks.attachUserRoles(tenantName, userID, username, userRoles); err != nil {
+		if len(user.Roles) > 0 {
+			if err := ks.attachUserRoles(ctx, tenantName, userID, username, user.Roles); err != nil {
 				return err
 			}
 		}
@@ -87,15 +90,15 @@ func (ks *KeycloakSvc) CreateUsers(configTenant string) error {
 	return nil
 }
 
-func (ks *KeycloakSvc) getUserByUsername(tenantName, username string) (map[string]any, error) {
+func (ks *KeycloakSvc) getUserByUsername(ctx context.Context, tenantName, username string) (map[string]any, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/users?query=username==%s&limit=1", username))
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.KeycloakUsersResponse
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Users) == 0 {
@@ -108,14 +111,14 @@ func (ks *KeycloakSvc) getUserByUsername(tenantName, username string) (map[strin
 	}, nil
 }
 
-func (ks *KeycloakSvc) createUser(tenantName string, username string, entry map[string]any) (map[string]any, error) {
+func (ks *KeycloakSvc) createUser(ctx context.Context, tenantName string, username string, user config.User) (map[string]any, error) {
 	payload, err := json.Marshal(map[string]any{
 		"username": username,
 		"active":   true,
 		"type":     "staff",
 		"personal": map[string]any{
-			"firstName":              helpers.GetString(entry, "first-name"),
-			"lastName":               helpers.GetString(entry, "last-name"),
+			"firstName":              user.FirstName,
+			"lastName":               user.LastName,
 			"email":                  fmt.Sprintf("%s_%s@test.org", tenantName, username),
 			"preferredContactTypeId": "002",
 		},
@@ -124,13 +127,13 @@ func (ks *KeycloakSvc) createUser(tenantName string, username string, entry map[
 		return nil, err
 	}
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/users-keycloak/users")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse map[string]any
-	if err := ks.HTTPClient.PostReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.PostReturnStruct(ctx, requestURL, payload, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	slog.Info(ks.Action.Name, "text", "Created user with password", "username", username, "tenant", tenantName)
@@ -138,22 +141,22 @@ func (ks *KeycloakSvc) createUser(tenantName string, username string, entry map[
 	return decodedResponse, nil
 }
 
-func (ks *KeycloakSvc) attachUserPassword(tenantName, userID, username string, entry map[string]any) error {
+func (ks *KeycloakSvc) attachUserPassword(ctx context.Context, tenantName, userID, username, password string) error {
 	payload, err := json.Marshal(map[string]any{
 		"userId":   userID,
 		"username": username,
-		"password": helpers.GetString(entry, "password"),
+		"password": password,
 	})
 	if err != nil {
 		return err
 	}
 
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/authn/credentials")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
-	if err := ks.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := ks.HTTPClient.PostReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(ks.Action.Name, "text", "Attached password to user", "username", username, "tenant", tenantName)
@@ -161,16 +164,16 @@ func (ks *KeycloakSvc) attachUserPassword(tenantName, userID, username string, e
 	return nil
 }
 
-func (ks *KeycloakSvc) attachUserRoles(tenantName, userID, username string, userRoles []any) error {
+func (ks *KeycloakSvc) attachUserRoles(ctx context.Context, tenantName, userID, username string, userRoles []string) error {
 	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles/users")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
 	var roleIDs []string
 	for _, userRole := range userRoles {
-		role, err := ks.GetRoleByName(userRole.(string), headers)
+		role, err := ks.GetRoleByName(ctx, userRole, headers)
 		if err != nil {
 			return err
 		}
@@ -190,7 +193,7 @@ func (ks *KeycloakSvc) attachUserRoles(tenantName, userID, username string, user
 	if err != nil {
 		return err
 	}
-	if err := ks.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := ks.HTTPClient.PostReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(ks.Action.Name, "text", "Attached roles to user", "username", username, "tenant", tenantName, "count", len(roleIDs))
@@ -198,13 +201,27 @@ func (ks *KeycloakSvc) attachUserRoles(tenantName, userID, username string, user
 	return nil
 }
 
-func (ks *KeycloakSvc) RemoveUsers(tenantName string) error {
-	users, err := ks.GetUsers(tenantName)
+func (ks *KeycloakSvc) RemoveUsers(ctx context.Context, tenantName string) error {
+	return ks.removeUsers(ctx, tenantName, func(username string) bool {
+		_, exists := ks.Action.ConfigUsers[username]
+		return exists
+	})
+}
+
+// RemoveUser removes the user of the tenant by username, independently of the users of the config
+func (ks *KeycloakSvc) RemoveUser(ctx context.Context, tenantName string, username string) error {
+	return ks.removeUsers(ctx, tenantName, func(name string) bool {
+		return name == username
+	})
+}
+
+func (ks *KeycloakSvc) removeUsers(ctx context.Context, tenantName string, shouldRemove func(username string) bool) error {
+	users, err := ks.GetUsers(ctx, tenantName)
 	if err != nil {
 		return err
 	}
 
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -212,12 +229,12 @@ func (ks *KeycloakSvc) RemoveUsers(tenantName string) error {
 	for _, value := range users {
 		entry := value.(map[string]any)
 		username := helpers.GetString(entry, "username")
-		if ks.Action.ConfigUsers[username] == nil {
+		if !shouldRemove(username) {
 			continue
 		}
 
 		requestURL := ks.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/users-keycloak/users/%s", helpers.GetString(entry, "id")))
-		if err := ks.HTTPClient.Delete(requestURL, headers); err != nil {
+		if err := ks.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 			return err
 		}
 		slog.Info(ks.Action.Name, "text", "Removed user", "username", username, "tenant", tenantName)
@@ -1,6 +1,7 @@
 package kongsvc
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"slices"
@@ -17,13 +18,14 @@ import (
 type KongProcessor interface {
 	KongRouteReader
 	KongRouteReadinessChecker
+	KongRouteCoverageChecker
 }
 
 // KongRouteReader defines the interface for Kong route read operations
 type KongRouteReader interface {
-	ListAllRoutes() ([]models.KongRoute, error)
-	FindRouteByExpressions(expressions []string) ([]*models.KongRoute, error)
-	CheckRouteExists(routeID string) (bool, *models.KongRoute, error)
+	ListAllRoutes(ctx context.Context) ([]models.KongRoute, error)
+	FindRouteByExpressions(ctx context.Context, expressions []string) ([]*models.KongRoute, error)
+	CheckRouteExists(ctx context.Context, routeID string) (bool, *models.KongRoute, error)
 }
 
 // KongSvc provides functionality for Kong API gateway operations
@@ -45,14 +47,14 @@ func New(action *action.Action, httpClient interface {
 	return &KongSvc{Action: action, HTTPClient: httpClient}
 }
 
-func (ks *KongSvc) ListAllRoutes() ([]models.KongRoute, error) {
+func (ks *KongSvc) ListAllRoutes(ctx context.Context) ([]models.KongRoute, error) {
 	var allRoutes []models.KongRoute
 	path := "/routes"
 	for {
 		requestURL := ks.Action.GetRequestURL(constant.KongAdminPort, path)
 		
 		var decodedResponse models.KongRoutesResponse
-		if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, nil, &decodedResponse); err != nil {
+		if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, nil, &decodedResponse); err != nil {
 			return nil, err
 		}
 		
@@ -67,8 +69,8 @@ func (ks *KongSvc) ListAllRoutes() ([]models.KongRoute, error) {
 }
 
 
-func (ks *KongSvc) FindRouteByExpressions(expressions []string) ([]*models.KongRoute, error) {
-	allRoutes, err := ks.ListAllRoutes()
+func (ks *KongSvc) FindRouteByExpressions(ctx context.Context, expressions []string) ([]*models.KongRoute, error) {
+	allRoutes, err := ks.ListAllRoutes(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +85,9 @@ func (ks *KongSvc) FindRouteByExpressions(expressions []string) ([]*models.KongR
 	return routes, nil
 }
 
-func (ks *KongSvc) CheckRouteExists(routeID string) (bool, *models.KongRoute, error) {
+func (ks *KongSvc) CheckRouteExists(ctx context.Context, routeID string) (bool, *models.KongRoute, error) {
 	requestURL := ks.Action.GetRequestURL(constant.KongAdminPort, fmt.Sprintf("/routes/%s", routeID))
-	statusCode, err := ks.HTTPClient.Ping(requestURL)
+	statusCode, err := ks.HTTPClient.Ping(ctx, requestURL)
 	if err != nil {
 		return false, nil, err
 	}
@@ -98,7 +100,7 @@ func (ks *KongSvc) CheckRouteExists(routeID string) (bool, *models.KongRoute, er
 	}
 
 	var decodedResponse models.KongRoute
-	if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, nil, &decodedResponse); err != nil {
+	if err := ks.HTTPClient.GetRetryReturnStruct(ctx, requestURL, nil, &decodedResponse); err != nil {
 		return false, nil, err
 	}
 
@@ -1,8 +1,8 @@
 package kongsvc
 
 import (
+	"context"
 	"log/slog"
-	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -11,10 +11,10 @@ import (
 
 // KongRouteReadinessChecker defines the interface for Kong route readiness check operations
 type KongRouteReadinessChecker interface {
-	CheckRouteReadiness() error
+	CheckRouteReadiness(ctx context.Context) error
 }
 
-func (ks *KongSvc) CheckRouteReadiness() error {
+func (ks *KongSvc) CheckRouteReadiness(ctx context.Context) error {
 	var (
 		expressions = []string{
 			// Applications
@@ -44,7 +44,7 @@ func (ks *KongSvc) CheckRouteReadiness() error {
 	)
 	slog.Info(ks.Action.Name, "text", "Preparing route readiness check", "expected", expected)
 	for retryCount := range maxRetries {
-		matchedRoutes, _ := ks.FindRouteByExpressions(expressions)
+		matchedRoutes, _ := ks.FindRouteByExpressions(ctx, expressions)
 		if len(matchedRoutes) == expected {
 			for _, route := range matchedRoutes {
 				slog.Info(ks.Action.Name, "text", "Kong route is ready", "expression", route.Expression)
@@ -53,7 +53,9 @@ func (ks *KongSvc) CheckRouteReadiness() error {
 		}
 
 		slog.Warn(ks.Action.Name, "text", "Kong routes are unready", "count", retryCount, "max", maxRetries)
-		time.Sleep(waitDuration)
+		if err := helpers.Sleep(ctx, waitDuration); err != nil {
+			return err
+		}
 	}
 
 	return errors.KongRoutesNotReady(expected)
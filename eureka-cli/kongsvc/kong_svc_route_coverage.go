@@ -0,0 +1,152 @@
+package kongsvc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// KongRouteCoverageChecker defines the interface for Kong route coverage operations
+type KongRouteCoverageChecker interface {
+	GetExpectedRouteExpressions(moduleDescriptor map[string]any) []string
+	CheckRouteCoverage(ctx context.Context, moduleDescriptors []any) ([]models.ModuleRouteCoverage, error)
+}
+
+// GetExpectedRouteExpressions computes the Kong expressions of the routes the management components create for
+// the handlers a module provides, system interfaces such as _tenant are not exposed through the gateway
+func (ks *KongSvc) GetExpectedRouteExpressions(moduleDescriptor map[string]any) []string {
+	moduleID := helpers.GetString(moduleDescriptor, "id")
+	var expressions []string
+	for _, value := range helpers.GetAnySlice(moduleDescriptor, "provides") {
+		providedInterface, ok := value.(map[string]any)
+		if !ok || isSystemInterface(providedInterface) {
+			continue
+		}
+
+		multiple := helpers.GetString(providedInterface, "interfaceType") == "multiple"
+		for _, handlerValue := range helpers.GetAnySlice(providedInterface, "handlers") {
+			handler, ok := handlerValue.(map[string]any)
+			if !ok {
+				continue
+			}
+			expression := getRouteExpression(handler, moduleID, multiple)
+			if expression != "" && !slices.Contains(expressions, expression) {
+				expressions = append(expressions, expression)
+			}
+		}
+	}
+
+	return expressions
+}
+
+// CheckRouteCoverage compares the expected routes of every module with the Kong routes tagged with its module ID
+func (ks *KongSvc) CheckRouteCoverage(ctx context.Context, moduleDescriptors []any) ([]models.ModuleRouteCoverage, error) {
+	allRoutes, err := ks.ListAllRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var coverages []models.ModuleRouteCoverage
+	for _, value := range moduleDescriptors {
+		moduleDescriptor, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		moduleID := helpers.GetString(moduleDescriptor, "id")
+		expected := ks.GetExpectedRouteExpressions(moduleDescriptor)
+		var actual []string
+		for _, route := range allRoutes {
+			if slices.Contains(route.Tags, moduleID) {
+				actual = append(actual, route.Expression)
+			}
+		}
+
+		coverage := models.ModuleRouteCoverage{ModuleID: moduleID, Expected: len(expected), Actual: len(actual), Missing: []string{}, Extra: []string{}}
+		for _, expression := range expected {
+			if !slices.Contains(actual, expression) {
+				coverage.Missing = append(coverage.Missing, expression)
+			}
+		}
+		for _, expression := range actual {
+			if !slices.Contains(expected, expression) {
+				coverage.Extra = append(coverage.Extra, expression)
+			}
+		}
+		coverages = append(coverages, coverage)
+	}
+	slices.SortFunc(coverages, func(a, b models.ModuleRouteCoverage) int { return strings.Compare(a.ModuleID, b.ModuleID) })
+
+	return coverages, nil
+}
+
+func isSystemInterface(providedInterface map[string]any) bool {
+	return helpers.GetString(providedInterface, "interfaceType") == "system" ||
+		strings.HasPrefix(helpers.GetString(providedInterface, "id"), "_")
+}
+
+// getRouteExpression builds the expression of a handler, a pathPattern with {placeholders} or wildcards becomes
+// a regex match, a legacy path becomes a prefix match and a handler of a multiple interface is scoped to its module
+func getRouteExpression(handler map[string]any, moduleID string, multiple bool) string {
+	var conditions []string
+	switch {
+	case helpers.GetString(handler, "pathPattern") != "":
+		conditions = append(conditions, getPathPatternCondition(helpers.GetString(handler, "pathPattern")))
+	case helpers.GetString(handler, "path") != "":
+		conditions = append(conditions, fmt.Sprintf(`http.path ^= "%s"`, helpers.GetString(handler, "path")))
+	default:
+		return ""
+	}
+
+	var methods []string
+	for _, value := range helpers.GetAnySlice(handler, "methods") {
+		if method, ok := value.(string); ok && method != "*" {
+			methods = append(methods, fmt.Sprintf(`http.method == "%s"`, method))
+		}
+	}
+	switch len(methods) {
+	case 0:
+	case 1:
+		conditions = append(conditions, methods[0])
+	default:
+		conditions = append(conditions, "("+strings.Join(methods, " || ")+")")
+	}
+	if multiple {
+		conditions = append(conditions, fmt.Sprintf(`http.headers.x_okapi_module_id == "%s"`, moduleID))
+	}
+
+	return "(" + strings.Join(conditions, " && ") + ")"
+}
+
+func getPathPatternCondition(pathPattern string) string {
+	var (
+		regex       strings.Builder
+		placeholder bool
+		changed     bool
+	)
+	for _, char := range pathPattern {
+		switch {
+		case char == '{':
+			placeholder = true
+			changed = true
+			regex.WriteString("([^/]+)")
+		case char == '}':
+			placeholder = false
+		case placeholder:
+		case char == '*':
+			changed = true
+			regex.WriteString("(.*)")
+		default:
+			regex.WriteRune(char)
+		}
+	}
+	if !changed {
+		return fmt.Sprintf(`http.path == "%s"`, pathPattern)
+	}
+
+	return fmt.Sprintf(`http.path ~ "^%s$"`, regex.String())
+}
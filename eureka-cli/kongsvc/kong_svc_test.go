@@ -1,10 +1,12 @@
 package kongsvc_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/kongsvc"
@@ -57,7 +59,7 @@ func TestCheckRouteExists_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	exists, route, err := svc.CheckRouteExists(routeID)
+	exists, route, err := svc.CheckRouteExists(context.Background(), routeID)
 
 	// Assert
 	assert.NoError(t, err)
@@ -83,7 +85,7 @@ func TestCheckRouteExists_NotFound(t *testing.T) {
 		Return(http.StatusNotFound, nil)
 
 	// Act
-	exists, route, err := svc.CheckRouteExists(routeID)
+	exists, route, err := svc.CheckRouteExists(context.Background(), routeID)
 
 	// Assert
 	assert.NoError(t, err)
@@ -108,7 +110,7 @@ func TestCheckRouteExists_HTTPError(t *testing.T) {
 		Return(0, expectedError)
 
 	// Act
-	exists, route, err := svc.CheckRouteExists(routeID)
+	exists, route, err := svc.CheckRouteExists(context.Background(), routeID)
 
 	// Assert
 	assert.Error(t, err)
@@ -133,7 +135,7 @@ func TestCheckRouteExists_InternalServerError(t *testing.T) {
 		Return(http.StatusInternalServerError, nil)
 
 	// Act
-	exists, route, err := svc.CheckRouteExists(routeID)
+	exists, route, err := svc.CheckRouteExists(context.Background(), routeID)
 
 	// Assert
 	assert.Error(t, err)
@@ -167,7 +169,7 @@ func TestCheckRouteExists_GetStructError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	exists, route, err := svc.CheckRouteExists(routeID)
+	exists, route, err := svc.CheckRouteExists(context.Background(), routeID)
 
 	// Assert
 	assert.Error(t, err)
@@ -211,7 +213,7 @@ func TestListAllRoutes_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	routes, err := svc.ListAllRoutes()
+	routes, err := svc.ListAllRoutes(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -265,7 +267,7 @@ func TestListAllRoutes_PaginatedResponse(t *testing.T) {
 		Return(nil).Once()
 
 	// Act
-	routes, err := svc.ListAllRoutes()
+	routes, err := svc.ListAllRoutes(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -295,7 +297,7 @@ func TestListAllRoutes_EmptyResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	routes, err := svc.ListAllRoutes()
+	routes, err := svc.ListAllRoutes(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -318,7 +320,7 @@ func TestListAllRoutes_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	routes, err := svc.ListAllRoutes()
+	routes, err := svc.ListAllRoutes(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -369,7 +371,7 @@ func TestFindRouteByExpressions_Success(t *testing.T) {
 	}
 
 	// Act
-	routes, err := svc.FindRouteByExpressions(expressions)
+	routes, err := svc.FindRouteByExpressions(context.Background(), expressions)
 
 	// Assert
 	assert.NoError(t, err)
@@ -410,7 +412,7 @@ func TestFindRouteByExpressions_NoMatches(t *testing.T) {
 	}
 
 	// Act
-	routes, err := svc.FindRouteByExpressions(expressions)
+	routes, err := svc.FindRouteByExpressions(context.Background(), expressions)
 
 	// Assert
 	assert.NoError(t, err)
@@ -437,7 +439,7 @@ func TestFindRouteByExpressions_ListAllRoutesError(t *testing.T) {
 	}
 
 	// Act
-	routes, err := svc.FindRouteByExpressions(expressions)
+	routes, err := svc.FindRouteByExpressions(context.Background(), expressions)
 
 	// Assert
 	assert.Error(t, err)
@@ -481,13 +483,32 @@ func TestCheckRouteReadiness_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CheckRouteReadiness()
+	err := svc.CheckRouteReadiness(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
 }
 
+func TestCheckRouteReadiness_Cancelled(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	ctx, cancel := context.WithCancel(context.Background())
+	action := testhelpers.NewMockAction()
+	svc := kongsvc.New(action, mockHTTP).(*kongsvc.KongSvc)
+	svc.ReadinessWait = time.Minute
+	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(nil).Once()
+
+	// Act
+	err := svc.CheckRouteReadiness(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	mockHTTP.AssertExpectations(t)
+}
+
 func TestFindRouteByExpressions_AllExpressionsMatched(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
@@ -539,10 +560,115 @@ func TestFindRouteByExpressions_AllExpressionsMatched(t *testing.T) {
 	}
 
 	// Act
-	routes, err := svc.FindRouteByExpressions(expressions)
+	routes, err := svc.FindRouteByExpressions(context.Background(), expressions)
 
 	// Assert
 	assert.NoError(t, err)
 	assert.Len(t, routes, 13)
 	mockHTTP.AssertExpectations(t)
 }
+
+func newOrdersModuleDescriptor() map[string]any {
+	return map[string]any{
+		"id": "mod-orders-13.1.0",
+		"provides": []any{
+			map[string]any{
+				"id": "orders",
+				"handlers": []any{
+					map[string]any{"methods": []any{"GET"}, "pathPattern": "/orders/composite-orders"},
+					map[string]any{"methods": []any{"GET", "PUT"}, "pathPattern": "/orders/composite-orders/{id}"},
+					map[string]any{"methods": []any{"POST"}, "pathPattern": "/orders/*/export"},
+				},
+			},
+			map[string]any{
+				"id":            "_tenant",
+				"interfaceType": "system",
+				"handlers": []any{
+					map[string]any{"methods": []any{"POST"}, "pathPattern": "/_/tenant"},
+				},
+			},
+			map[string]any{
+				"id":            "orders-storage",
+				"interfaceType": "multiple",
+				"handlers": []any{
+					map[string]any{"methods": []any{"*"}, "path": "/orders-storage"},
+				},
+			},
+		},
+	}
+}
+
+func TestGetExpectedRouteExpressions(t *testing.T) {
+	// Arrange
+	svc := kongsvc.New(testhelpers.NewMockAction(), &testhelpers.MockHTTPClient{})
+
+	// Act
+	expressions := svc.GetExpectedRouteExpressions(newOrdersModuleDescriptor())
+
+	// Assert
+	assert.Equal(t, []string{
+		`(http.path == "/orders/composite-orders" && http.method == "GET")`,
+		`(http.path ~ "^/orders/composite-orders/([^/]+)$" && (http.method == "GET" || http.method == "PUT"))`,
+		`(http.path ~ "^/orders/(.*)/export$" && http.method == "POST")`,
+		`(http.path ^= "/orders-storage" && http.headers.x_okapi_module_id == "mod-orders-13.1.0")`,
+	}, expressions)
+}
+
+func TestCheckRouteCoverage(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	svc := kongsvc.New(testhelpers.NewMockAction(), mockHTTP)
+	routesResponse := models.KongRoutesResponse{
+		Data: []models.KongRoute{
+			{ID: "route-1", Tags: []string{"mod-orders-13.1.0"}, Expression: `(http.path == "/orders/composite-orders" && http.method == "GET")`},
+			{ID: "route-2", Tags: []string{"mod-orders-13.1.0"}, Expression: `(http.path ~ "^/orders/(.*)/export$" && http.method == "POST")`},
+			{ID: "route-3", Tags: []string{"mod-orders-13.1.0"}, Expression: `(http.path == "/orders/legacy" && http.method == "GET")`},
+			{ID: "route-4", Tags: []string{"mod-users-19.5.0"}, Expression: `(http.path == "/users" && http.method == "GET")`},
+		},
+	}
+	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KongRoutesResponse)
+			*target = routesResponse
+		}).
+		Return(nil)
+	usersDescriptor := map[string]any{
+		"id": "mod-users-19.5.0",
+		"provides": []any{
+			map[string]any{"id": "users", "handlers": []any{map[string]any{"methods": []any{"GET"}, "pathPattern": "/users"}}},
+		},
+	}
+
+	// Act
+	coverages, err := svc.CheckRouteCoverage(context.Background(), []any{usersDescriptor, newOrdersModuleDescriptor()})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, coverages, 2)
+	assert.Equal(t, "mod-orders-13.1.0", coverages[0].ModuleID)
+	assert.Equal(t, 4, coverages[0].Expected)
+	assert.Equal(t, 3, coverages[0].Actual)
+	assert.Equal(t, []string{
+		`(http.path ~ "^/orders/composite-orders/([^/]+)$" && (http.method == "GET" || http.method == "PUT"))`,
+		`(http.path ^= "/orders-storage" && http.headers.x_okapi_module_id == "mod-orders-13.1.0")`,
+	}, coverages[0].Missing)
+	assert.Equal(t, []string{`(http.path == "/orders/legacy" && http.method == "GET")`}, coverages[0].Extra)
+	assert.Equal(t, "mod-users-19.5.0", coverages[1].ModuleID)
+	assert.Empty(t, coverages[1].Missing)
+	assert.Empty(t, coverages[1].Extra)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestCheckRouteCoverage_ListError(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	svc := kongsvc.New(testhelpers.NewMockAction(), mockHTTP)
+	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	// Act
+	coverages, err := svc.CheckRouteCoverage(context.Background(), []any{newOrdersModuleDescriptor()})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, coverages)
+}
@@ -0,0 +1,125 @@
+package locksvc
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"go.yaml.in/yaml/v3"
+)
+
+const lockfileHeader = "# Pinned module versions and image digests of the environment, commit this file to share the environment\n"
+
+// LockProcessor defines the interface for module version lockfile operations
+type LockProcessor interface {
+	GetFilePath() string
+	Read() (*models.Lockfile, error)
+	Write(lockfile *models.Lockfile) error
+	Diff(locked, resolved *models.Lockfile) []models.LockChange
+}
+
+// LockSvc reads and writes the lockfile of the current directory that pins the module versions, the sidecar version
+// and the image digests of a profile, so that the environment can be deployed again exactly as it was
+type LockSvc struct {
+	Action   *action.Action
+	FilePath string
+}
+
+// New creates a new LockSvc instance
+func New(action *action.Action) *LockSvc {
+	return &LockSvc{Action: action, FilePath: constant.LockFile}
+}
+
+func (ls *LockSvc) GetFilePath() string {
+	return ls.FilePath
+}
+
+func (ls *LockSvc) Read() (*models.Lockfile, error) {
+	content, err := os.ReadFile(ls.FilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.LockfileNotFound(ls.FilePath, err)
+		}
+		return nil, errors.LockfileReadFailed(ls.FilePath, err)
+	}
+
+	var lockfile models.Lockfile
+	if err := yaml.Unmarshal(content, &lockfile); err != nil {
+		return nil, errors.LockfileReadFailed(ls.FilePath, err)
+	}
+	if lockfile.Profile != ls.Action.ConfigProfileName {
+		return nil, errors.LockfileProfileMismatch(ls.FilePath, lockfile.Profile, ls.Action.ConfigProfileName)
+	}
+
+	return &lockfile, nil
+}
+
+func (ls *LockSvc) Write(lockfile *models.Lockfile) error {
+	var buffer bytes.Buffer
+	buffer.WriteString(lockfileHeader)
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(lockfile); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	return os.WriteFile(ls.FilePath, buffer.Bytes(), 0644)
+}
+
+// Diff returns the pins of the platform descriptor, the sidecar and the modules that differ between a locked and
+// a resolved lockfile, sorted by name, a pin missing on one side has an empty value
+func (ls *LockSvc) Diff(locked, resolved *models.Lockfile) []models.LockChange {
+	lockedPins, resolvedPins := getPins(locked), getPins(resolved)
+
+	var changes []models.LockChange
+	for name, lockedPin := range lockedPins {
+		if resolvedPin := resolvedPins[name]; resolvedPin != lockedPin {
+			changes = append(changes, models.LockChange{Name: name, Locked: lockedPin, Resolved: resolvedPin})
+		}
+	}
+	for name, resolvedPin := range resolvedPins {
+		if _, ok := lockedPins[name]; !ok {
+			changes = append(changes, models.LockChange{Name: name, Resolved: resolvedPin})
+		}
+	}
+	slices.SortFunc(changes, func(a, b models.LockChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return changes
+}
+
+func getPins(lockfile *models.Lockfile) map[string]string {
+	pins := make(map[string]string)
+	if lockfile == nil {
+		return pins
+	}
+	if lockfile.Platform.Version != "" {
+		pins[lockfile.Platform.Name] = lockfile.Platform.Version
+	}
+	for _, module := range append([]models.LockedModule{lockfile.Sidecar}, lockfile.Modules...) {
+		if module.Name != "" {
+			pins[module.Name] = describeLockedModule(module)
+		}
+	}
+
+	return pins
+}
+
+// describeLockedModule returns the version of a module followed by the short digest of its image when there is one
+func describeLockedModule(module models.LockedModule) string {
+	digest, found := strings.CutPrefix(module.Digest, "sha256:")
+	if !found || len(digest) < 12 {
+		return module.Version
+	}
+
+	return module.Version + " (sha256:" + digest[:12] + ")"
+}
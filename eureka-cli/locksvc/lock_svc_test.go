@@ -0,0 +1,163 @@
+package locksvc_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/locksvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func newTestSvc(t *testing.T) *locksvc.LockSvc {
+	t.Helper()
+
+	action := testhelpers.NewMockAction()
+	action.ConfigProfileName = "combined"
+	svc := locksvc.New(action)
+	svc.FilePath = filepath.Join(t.TempDir(), constant.LockFile)
+
+	return svc
+}
+
+func newTestLockfile() *models.Lockfile {
+	return &models.Lockfile{
+		Profile:  "combined",
+		Platform: models.LockedPlatform{URL: "https://example.org/platform-lsp", Name: "platform-lsp", Version: "R1-2025"},
+		Sidecar:  models.LockedModule{Name: "folio-module-sidecar", Version: "3.0.0", Image: "folioorg/folio-module-sidecar:3.0.0", Digest: testDigest},
+		Modules: []models.LockedModule{
+			{ID: "mod-orders-13.1.0", Name: "mod-orders", Version: "13.1.0", Image: "folioorg/mod-orders:13.1.0", Digest: testDigest},
+			{ID: "folio_users-12.0.0", Name: "folio_users", Version: "12.0.0"},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+
+	// Act
+	svc := locksvc.New(action)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+	assert.Equal(t, constant.LockFile, svc.GetFilePath())
+}
+
+// ==================== Read/Write Tests ====================
+
+func TestWrite_ThenRead_RoundTrips(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	lockfile := newTestLockfile()
+
+	// Act
+	writeErr := svc.Write(lockfile)
+	result, readErr := svc.Read()
+
+	// Assert
+	assert.NoError(t, writeErr)
+	assert.NoError(t, readErr)
+	assert.Equal(t, lockfile, result)
+}
+
+func TestRead_MissingFile(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+
+	// Act
+	result, err := svc.Read()
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, apperrors.ErrNotFound))
+}
+
+func TestRead_InvalidContent(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	assert.NoError(t, os.WriteFile(svc.FilePath, []byte("modules: [unclosed"), 0644))
+
+	// Act
+	result, err := svc.Read()
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "failed to read lockfile")
+}
+
+func TestRead_ProfileMismatch(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	lockfile := newTestLockfile()
+	lockfile.Profile = "export"
+	assert.NoError(t, svc.Write(lockfile))
+
+	// Act
+	result, err := svc.Read()
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidInput))
+	assert.ErrorContains(t, err, "pins profile export, not combined")
+}
+
+// ==================== Diff Tests ====================
+
+func TestDiff_NoChanges(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+
+	// Act
+	changes := svc.Diff(newTestLockfile(), newTestLockfile())
+
+	// Assert
+	assert.Empty(t, changes)
+}
+
+func TestDiff_ReportsChangedAddedAndRemovedPins(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	locked := newTestLockfile()
+	resolved := newTestLockfile()
+	resolved.Platform.Version = "R2-2025"
+	resolved.Modules = []models.LockedModule{
+		{ID: "mod-orders-13.2.0", Name: "mod-orders", Version: "13.2.0", Image: "folioorg/mod-orders:13.2.0", Digest: testDigest},
+		{ID: "mod-finance-5.0.0", Name: "mod-finance", Version: "5.0.0"},
+	}
+
+	// Act
+	changes := svc.Diff(locked, resolved)
+
+	// Assert
+	assert.Equal(t, []models.LockChange{
+		{Name: "folio_users", Locked: "12.0.0"},
+		{Name: "mod-finance", Resolved: "5.0.0"},
+		{Name: "mod-orders", Locked: "13.1.0 (sha256:0123456789ab)", Resolved: "13.2.0 (sha256:0123456789ab)"},
+		{Name: "platform-lsp", Locked: "R1-2025", Resolved: "R2-2025"},
+	}, changes)
+}
+
+func TestDiff_ReportsChangedDigest(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	locked := newTestLockfile()
+	resolved := newTestLockfile()
+	resolved.Sidecar.Digest = "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+
+	// Act
+	changes := svc.Diff(locked, resolved)
+
+	// Assert
+	assert.Equal(t, []models.LockChange{
+		{Name: "folio-module-sidecar", Locked: "3.0.0 (sha256:0123456789ab)", Resolved: "3.0.0 (sha256:fedcba987654)"},
+	}, changes)
+}
@@ -0,0 +1,389 @@
+package logsvc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/moby/moby/client"
+)
+
+// LogProcessor defines the interface for container log operations
+type LogProcessor interface {
+	StreamLogs(ctx context.Context, reader ContainerLogReader, containerNames []string, w io.Writer) error
+	ReadLogs(ctx context.Context, reader ContainerLogReader, containerName string, tail int) ([]byte, error)
+}
+
+// ContainerLogReader defines the interface for reading the log stream of a container, it is satisfied by the Docker client
+type ContainerLogReader interface {
+	ContainerLogs(ctx context.Context, containerID string, options client.ContainerLogsOptions) (client.ContainerLogsResult, error)
+}
+
+// LogSvc provides functionality for streaming the logs of module and sidecar containers
+type LogSvc struct {
+	Action        *action.Action
+	ReorderWindow time.Duration
+}
+
+// New creates a new LogSvc instance
+func New(action *action.Action) *LogSvc {
+	return &LogSvc{Action: action}
+}
+
+var logLevelPattern = regexp.MustCompile(`\b(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|FATAL)\b`)
+
+// logLine is a single decoded log line of a container
+type logLine struct {
+	container string
+	timestamp time.Time
+	text      string
+}
+
+// pendingLogLine is a followed log line held back for the reorder window
+type pendingLogLine struct {
+	logLine
+	arrived time.Time
+}
+
+// StreamLogs writes the logs of the containers interleaved by their timestamps, every line is prefixed with
+// the container name; without --since only the last lines of every container are read, and in follow mode the new
+// lines are written until the context is cancelled
+func (ls *LogSvc) StreamLogs(ctx context.Context, reader ContainerLogReader, containerNames []string, w io.Writer) error {
+	filter, err := ls.newLogFilter()
+	if err != nil {
+		return err
+	}
+
+	prefixes := ls.getContainerPrefixes(containerNames)
+	cutoff := time.Now().UTC().Format(time.RFC3339Nano)
+
+	var history []logLine
+	for _, containerName := range containerNames {
+		lines, err := ls.readHistory(ctx, reader, containerName, cutoff)
+		if err != nil {
+			return err
+		}
+		history = append(history, filter.apply(lines)...)
+	}
+	slices.SortStableFunc(history, func(a, b logLine) int { return a.timestamp.Compare(b.timestamp) })
+	for _, line := range history {
+		if _, err := fmt.Fprintf(w, "%s | %s\n", prefixes[line.container], line.text); err != nil {
+			return err
+		}
+	}
+	if !ls.Action.Param.Follow {
+		return nil
+	}
+
+	return ls.followLogs(ctx, reader, containerNames, cutoff, filter, prefixes, w)
+}
+
+// ReadLogs returns the last lines of the log of a container with their timestamps, the lines are not filtered
+func (ls *LogSvc) ReadLogs(ctx context.Context, reader ContainerLogReader, containerName string, tail int) ([]byte, error) {
+	logStream, err := reader.ContainerLogs(ctx, containerName, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Tail:       strconv.Itoa(tail),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer helpers.CloseReader(logStream)
+
+	var buffer bytes.Buffer
+	err = decodeLogStream(logStream, containerName, func(line logLine) error {
+		if !line.timestamp.IsZero() {
+			buffer.WriteString(line.timestamp.Format(time.RFC3339Nano))
+			buffer.WriteByte(' ')
+		}
+		buffer.WriteString(line.text)
+		buffer.WriteByte('\n')
+		return nil
+	})
+
+	return buffer.Bytes(), err
+}
+
+// readHistory reads the log of a container up to the cutoff, the last --tail lines are read, or by default the last
+// lines when no --since is given so that a long running container does not print its whole log
+func (ls *LogSvc) readHistory(ctx context.Context, reader ContainerLogReader, containerName string, cutoff string) ([]logLine, error) {
+	tail := "all"
+	if ls.Action.Param.Tail > 0 {
+		tail = strconv.Itoa(ls.Action.Param.Tail)
+	} else if ls.Action.Param.Since == "" {
+		tail = strconv.Itoa(constant.DefaultLogsTail)
+	}
+	logStream, err := reader.ContainerLogs(ctx, containerName, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Since:      ls.Action.Param.Since,
+		Until:      cutoff,
+		Tail:       tail,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer helpers.CloseReader(logStream)
+
+	var lines []logLine
+	err = decodeLogStream(logStream, containerName, func(line logLine) error {
+		lines = append(lines, line)
+		return nil
+	})
+
+	return lines, err
+}
+
+// followLogs writes the new lines of the containers merged by their timestamps, a line is held back for the reorder
+// window after it arrives so that a line of another container with an earlier timestamp that arrives shortly after it
+// is written first; a line arriving later than the window is written in arrival order
+func (ls *LogSvc) followLogs(ctx context.Context, reader ContainerLogReader, containerNames []string, cutoff string, filter *logFilter, prefixes map[string]string, w io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lines := make(chan logLine)
+	errs := make(chan error, len(containerNames))
+	var wg sync.WaitGroup
+	for _, containerName := range containerNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- ls.followContainer(ctx, reader, containerName, cutoff, lines)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+
+	window := helpers.DefaultDuration(ls.ReorderWindow, constant.LogsFollowReorderWindow)
+	ticker := time.NewTicker(window / 2)
+	defer ticker.Stop()
+
+	var pending []pendingLogLine
+	write := func(due time.Time) error {
+		slices.SortStableFunc(pending, func(a, b pendingLogLine) int { return a.timestamp.Compare(b.timestamp) })
+		last := -1
+		for i, line := range pending {
+			if !line.arrived.After(due) {
+				last = i
+			}
+		}
+		for _, line := range pending[:last+1] {
+			if _, err := fmt.Fprintf(w, "%s | %s\n", prefixes[line.container], line.text); err != nil {
+				return err
+			}
+		}
+		pending = slices.Delete(pending, 0, last+1)
+
+		return nil
+	}
+	for open := true; open; {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				open = false
+				break
+			}
+			if filter.accepts(line) {
+				pending = append(pending, pendingLogLine{logLine: line, arrived: time.Now()})
+			}
+		case now := <-ticker.C:
+			if err := write(now.Add(-window)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := write(time.Now()); err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ls *LogSvc) followContainer(ctx context.Context, reader ContainerLogReader, containerName string, cutoff string, lines chan<- logLine) error {
+	logStream, err := reader.ContainerLogs(ctx, containerName, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Follow:     true,
+		Since:      cutoff,
+	})
+	if err != nil {
+		return err
+	}
+	defer helpers.CloseReader(logStream)
+
+	err = decodeLogStream(logStream, containerName, func(line logLine) error {
+		select {
+		case lines <- line:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+// decodeLogStream splits the multiplexed log stream of a container into lines, a line can span several frames
+// so the partial lines of the stdout and stderr streams are buffered separately
+func decodeLogStream(logStream io.Reader, containerName string, emit func(logLine) error) error {
+	partials := make(map[byte]*bytes.Buffer)
+	flush := func(buffer *bytes.Buffer) error {
+		if buffer.Len() == 0 {
+			return nil
+		}
+		line := parseLogLine(containerName, buffer.String())
+		buffer.Reset()
+
+		return emit(line)
+	}
+
+	for {
+		stream, payload, err := helpers.ReadDockerLogFrame(logStream)
+		if errors.Is(err, io.EOF) {
+			for _, buffer := range partials {
+				if err := flush(buffer); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		buffer, ok := partials[stream]
+		if !ok {
+			buffer = &bytes.Buffer{}
+			partials[stream] = buffer
+		}
+		for len(payload) > 0 {
+			index := bytes.IndexByte(payload, '\n')
+			if index < 0 {
+				buffer.Write(payload)
+				break
+			}
+			buffer.Write(payload[:index])
+			payload = payload[index+1:]
+			if err := flush(buffer); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseLogLine splits the RFC3339 timestamp that Docker prepends to every line from the log text
+func parseLogLine(containerName string, rawLine string) logLine {
+	rawLine = strings.TrimSuffix(rawLine, "\r")
+	rawTimestamp, text, found := strings.Cut(rawLine, " ")
+	timestamp, err := time.Parse(time.RFC3339Nano, rawTimestamp)
+	if !found || err != nil {
+		return logLine{container: containerName, text: rawLine}
+	}
+
+	return logLine{container: containerName, timestamp: timestamp, text: text}
+}
+
+// getContainerPrefixes shortens the container names to the module or sidecar names and pads them to a common width
+func (ls *LogSvc) getContainerPrefixes(containerNames []string) map[string]string {
+	profilePrefix := fmt.Sprintf("eureka-%s-", ls.Action.ConfigProfileName)
+	var width int
+	shortNames := make(map[string]string, len(containerNames))
+	for _, containerName := range containerNames {
+		shortName := strings.TrimPrefix(strings.TrimPrefix(containerName, profilePrefix), "eureka-")
+		shortNames[containerName] = shortName
+		width = max(width, len(shortName))
+	}
+
+	prefixes := make(map[string]string, len(containerNames))
+	for containerName, shortName := range shortNames {
+		prefixes[containerName] = fmt.Sprintf("%-*s", width, shortName)
+	}
+
+	return prefixes
+}
+
+// logFilter keeps the lines at or above the minimum level that match the regular expression, lines without a level
+// such as stack traces inherit the decision of the previous line of the same container
+type logFilter struct {
+	minLevel int
+	regex    *regexp.Regexp
+	accepted map[string]bool
+	mu       sync.Mutex
+}
+
+func (ls *LogSvc) newLogFilter() (*logFilter, error) {
+	filter := &logFilter{minLevel: -1, accepted: make(map[string]bool)}
+	if level := ls.Action.Param.Level; level != "" {
+		filter.minLevel = slices.Index(constant.GetLogLevels(), strings.ToUpper(level))
+		if filter.minLevel < 0 {
+			return nil, apperrors.LogLevelUnsupported(level)
+		}
+	}
+	if ls.Action.Param.Regex != "" {
+		regex, err := regexp.Compile(ls.Action.Param.Regex)
+		if err != nil {
+			return nil, apperrors.LogRegexInvalid(ls.Action.Param.Regex, err)
+		}
+		filter.regex = regex
+	}
+
+	return filter, nil
+}
+
+func (lf *logFilter) apply(lines []logLine) []logLine {
+	return slices.DeleteFunc(lines, func(line logLine) bool { return !lf.accepts(line) })
+}
+
+func (lf *logFilter) accepts(line logLine) bool {
+	if lf.minLevel >= 0 && !lf.acceptsLevel(line) {
+		return false
+	}
+
+	return lf.regex == nil || lf.regex.MatchString(line.text)
+}
+
+func (lf *logFilter) acceptsLevel(line logLine) bool {
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+
+	match := logLevelPattern.FindString(line.text)
+	if match == "" {
+		return lf.accepted[line.container]
+	}
+	if match == "WARNING" {
+		match = "WARN"
+	}
+	accepted := slices.Index(constant.GetLogLevels(), match) >= lf.minLevel
+	lf.accepted[line.container] = accepted
+
+	return accepted
+}
@@ -0,0 +1,255 @@
+package logsvc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/logsvc"
+	"github.com/moby/moby/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLogReader serves the history and follow log streams of containers as multiplexed frames
+type fakeLogReader struct {
+	history map[string][]string
+	follow  map[string][]string
+	options []client.ContainerLogsOptions
+	mu      sync.Mutex
+}
+
+func (r *fakeLogReader) ContainerLogs(_ context.Context, containerID string, options client.ContainerLogsOptions) (client.ContainerLogsResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.options = append(r.options, options)
+	frames := r.history
+	if options.Follow {
+		frames = r.follow
+	}
+	payloads, ok := frames[containerID]
+	if !ok {
+		return nil, errors.New("no such container: " + containerID)
+	}
+
+	var stream bytes.Buffer
+	for _, payload := range payloads {
+		header := make([]byte, 8)
+		header[0] = 1
+		binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+		stream.Write(header)
+		stream.WriteString(payload)
+	}
+
+	return io.NopCloser(&stream), nil
+}
+
+func newTestSvc() *logsvc.LogSvc {
+	action := testhelpers.NewMockAction()
+	action.ConfigProfileName = "combined"
+
+	return logsvc.New(action)
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+
+	// Act
+	svc := logsvc.New(action)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+}
+
+func TestStreamLogs_InterleavesByTimestamp(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	svc.Action.Param.Since = "10m"
+	reader := &fakeLogReader{history: map[string][]string{
+		"eureka-combined-mod-orders": {
+			"2026-01-01T10:00:01.000000000Z INFO first order\n2026-01-01T10:00:03.000000000Z INFO ",
+			"second order\n",
+		},
+		"eureka-combined-mod-orders-sc": {"2026-01-01T10:00:02.000000000Z INFO sidecar\n"},
+	}}
+	var out bytes.Buffer
+
+	// Act
+	err := svc.StreamLogs(context.Background(), reader, []string{"eureka-combined-mod-orders", "eureka-combined-mod-orders-sc"}, &out)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "mod-orders    | INFO first order\n"+
+		"mod-orders-sc | INFO sidecar\n"+
+		"mod-orders    | INFO second order\n", out.String())
+	assert.Len(t, reader.options, 2)
+	assert.Equal(t, "10m", reader.options[0].Since)
+	assert.True(t, reader.options[0].Timestamps)
+	assert.NotEmpty(t, reader.options[0].Until)
+}
+
+func TestStreamLogs_FiltersByLevelAndRegex(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	svc.Action.Param.Level = "warn"
+	svc.Action.Param.Regex = "order"
+	reader := &fakeLogReader{history: map[string][]string{
+		"eureka-combined-mod-orders": {
+			"2026-01-01T10:00:01Z INFO order created\n",
+			"2026-01-01T10:00:02Z ERROR order failed\n",
+			"2026-01-01T10:00:03Z \tat org.folio.orders.Service\n",
+			"2026-01-01T10:00:04Z WARNING invoice missing\n",
+			"2026-01-01T10:00:05Z DEBUG order details\n",
+			"2026-01-01T10:00:06Z \tat org.folio.orders.Debug\n",
+		},
+	}}
+	var out bytes.Buffer
+
+	// Act
+	err := svc.StreamLogs(context.Background(), reader, []string{"eureka-combined-mod-orders"}, &out)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "mod-orders | ERROR order failed\n"+
+		"mod-orders | \tat org.folio.orders.Service\n", out.String())
+}
+
+func TestStreamLogs_FollowAppendsNewLines(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	svc.Action.Param.Follow = true
+	reader := &fakeLogReader{
+		history: map[string][]string{"eureka-mgr-tenants": {"2026-01-01T10:00:01Z INFO started\n"}},
+		follow:  map[string][]string{"eureka-mgr-tenants": {"2026-01-01T10:00:02Z INFO tenant created\n"}},
+	}
+	var out bytes.Buffer
+
+	// Act
+	err := svc.StreamLogs(context.Background(), reader, []string{"eureka-mgr-tenants"}, &out)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "mgr-tenants | INFO started\nmgr-tenants | INFO tenant created\n", out.String())
+	assert.Len(t, reader.options, 2)
+	assert.True(t, reader.options[1].Follow)
+	assert.Equal(t, reader.options[0].Until, reader.options[1].Since)
+}
+
+func TestStreamLogs_FollowMergesByTimestamp(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	svc.Action.Param.Follow = true
+	svc.ReorderWindow = time.Minute
+	reader := &fakeLogReader{
+		history: map[string][]string{"eureka-combined-mod-orders": {}, "eureka-combined-mod-orders-sc": {}},
+		follow: map[string][]string{
+			"eureka-combined-mod-orders":    {"2026-01-01T10:00:03Z INFO second order\n"},
+			"eureka-combined-mod-orders-sc": {"2026-01-01T10:00:01Z INFO sidecar\n2026-01-01T10:00:04Z INFO sidecar again\n"},
+		},
+	}
+	var out bytes.Buffer
+
+	// Act
+	err := svc.StreamLogs(context.Background(), reader, []string{"eureka-combined-mod-orders", "eureka-combined-mod-orders-sc"}, &out)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "mod-orders-sc | INFO sidecar\n"+
+		"mod-orders    | INFO second order\n"+
+		"mod-orders-sc | INFO sidecar again\n", out.String())
+}
+
+func TestStreamLogs_Tail(t *testing.T) {
+	tests := []struct {
+		name     string
+		since    string
+		tail     int
+		expected string
+	}{
+		{"default without since", "", 0, strconv.Itoa(constant.DefaultLogsTail)},
+		{"all since a timestamp", "10m", 0, "all"},
+		{"explicit tail", "", 1000, "1000"},
+		{"explicit tail since a timestamp", "10m", 50, "50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			svc := newTestSvc()
+			svc.Action.Param.Since, svc.Action.Param.Tail = tt.since, tt.tail
+			reader := &fakeLogReader{history: map[string][]string{"eureka-mgr-tenants": {"2026-01-01T10:00:01Z INFO started\n"}}}
+
+			// Act
+			err := svc.StreamLogs(context.Background(), reader, []string{"eureka-mgr-tenants"}, io.Discard)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, reader.options[0].Tail)
+		})
+	}
+}
+
+func TestStreamLogs_ContainerLogsError(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	reader := &fakeLogReader{}
+
+	// Act
+	err := svc.StreamLogs(context.Background(), reader, []string{"eureka-combined-mod-orders"}, io.Discard)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no such container")
+}
+
+func TestStreamLogs_UnsupportedLevel(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	svc.Action.Param.Level = "VERBOSE"
+
+	// Act
+	err := svc.StreamLogs(context.Background(), &fakeLogReader{}, nil, io.Discard)
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "unsupported log level VERBOSE")
+}
+
+func TestStreamLogs_InvalidRegex(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	svc.Action.Param.Regex = "(ERROR"
+
+	// Act
+	err := svc.StreamLogs(context.Background(), &fakeLogReader{}, nil, io.Discard)
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "invalid log regex (ERROR")
+}
+
+func TestReadLogs_ReturnsTail(t *testing.T) {
+	// Arrange
+	svc := newTestSvc()
+	reader := &fakeLogReader{history: map[string][]string{
+		"eureka-combined-mod-orders": {"2026-01-01T10:00:01Z INFO first\n", "2026-01-01T10:00:02Z ERROR second\n"},
+	}}
+
+	// Act
+	logs, err := svc.ReadLogs(context.Background(), reader, "eureka-combined-mod-orders", 200)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T10:00:01Z INFO first\n2026-01-01T10:00:02Z ERROR second\n", string(logs))
+	assert.Equal(t, "200", reader.options[0].Tail)
+	assert.False(t, reader.options[0].Follow)
+}
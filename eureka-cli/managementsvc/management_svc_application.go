@@ -1,6 +1,7 @@
 package managementsvc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,9 +13,11 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/readinesssvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/tenantsvc"
 )
 
@@ -23,52 +26,56 @@ type ManagementProcessor interface {
 	ManagementApplicationManager
 	ManagementTenantManager
 	ManagementTenantEntitlementManager
+	ManagementModuleDependencyChecker
 }
 
 // ManagementApplicationManager defines the interface for application management operations
 type ManagementApplicationManager interface {
-	GetApplications() (models.ApplicationsResponse, error)
-	GetLatestApplication() (map[string]any, error)
-	GetLatestApplicationByName(appName string) (map[string]any, error)
-	CreateApplication(extract *models.RegistryExtract) error
-	CreateNewApplication(r *models.ApplicationUpgradeRequest) error
-	RemoveApplication(applicationID string) error
-	RemoveApplications(applicationName, ignoreApplicationID string) error
-	GetModuleDiscovery(name string) (models.ModuleDiscoveryResponse, error)
-	CreateNewModuleDiscovery(newDiscoveryModules []map[string]string) error
-	UpdateModuleDiscovery(id string, restore bool, privatePort int, sidecarURL string) error
-	RemoveModuleDiscovery(id string) error
+	GetApplications(ctx context.Context) (models.ApplicationsResponse, error)
+	GetLatestApplication(ctx context.Context) (map[string]any, error)
+	GetLatestApplicationByName(ctx context.Context, appName string) (map[string]any, error)
+	CreateApplication(ctx context.Context, extract *models.RegistryExtract) error
+	BuildApplicationPayload(ctx context.Context, extract *models.RegistryExtract) (*models.ApplicationPayload, error)
+	CreateNewApplication(ctx context.Context, r *models.ApplicationUpgradeRequest) error
+	RemoveApplication(ctx context.Context, applicationID string) error
+	RemoveApplications(ctx context.Context, applicationName, ignoreApplicationID string) error
+	GetModuleDiscovery(ctx context.Context, name string) (models.ModuleDiscoveryResponse, error)
+	GetModuleDiscoveries(ctx context.Context) (models.ModuleDiscoveryResponse, error)
+	CreateNewModuleDiscovery(ctx context.Context, newDiscoveryModules []map[string]string) error
+	UpdateModuleDiscovery(ctx context.Context, id string, restore bool, privatePort int, sidecarURL string) error
+	RemoveModuleDiscovery(ctx context.Context, id string) error
 }
 
 // ManagementSvc defines the service for management operations including applications and tenants
 type ManagementSvc struct {
-	Action     *action.Action
-	HTTPClient httpclient.HTTPClientRunner
-	TenantSvc  tenantsvc.TenantProcessor
+	Action       *action.Action
+	HTTPClient   httpclient.HTTPClientRunner
+	TenantSvc    tenantsvc.TenantProcessor
+	ReadinessSvc readinesssvc.ReadinessProcessor
 }
 
 // New creates a new ManagementSvc instance
-func New(action *action.Action, httpClient httpclient.HTTPClientRunner, tenantSvc tenantsvc.TenantProcessor) *ManagementSvc {
-	return &ManagementSvc{Action: action, HTTPClient: httpClient, TenantSvc: tenantSvc}
+func New(action *action.Action, httpClient httpclient.HTTPClientRunner, tenantSvc tenantsvc.TenantProcessor, readinessSvc readinesssvc.ReadinessProcessor) *ManagementSvc {
+	return &ManagementSvc{Action: action, HTTPClient: httpClient, TenantSvc: tenantSvc, ReadinessSvc: readinessSvc}
 }
 
-func (ms *ManagementSvc) GetApplications() (models.ApplicationsResponse, error) {
+func (ms *ManagementSvc) GetApplications(ctx context.Context) (models.ApplicationsResponse, error) {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, "/applications")
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return models.ApplicationsResponse{}, err
 	}
 
 	var decodedResponse models.ApplicationsResponse
-	if err := ms.HTTPClient.GetReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return models.ApplicationsResponse{}, err
 	}
 
 	return decodedResponse, nil
 }
 
-func (ms *ManagementSvc) GetLatestApplication() (map[string]any, error) {
-	app, err := ms.GetLatestApplicationByName(ms.Action.ConfigApplicationName)
+func (ms *ManagementSvc) GetLatestApplication(ctx context.Context) (map[string]any, error) {
+	app, err := ms.GetLatestApplicationByName(ctx, ms.Action.ConfigApplicationName)
 	if err != nil {
 		return nil, err
 	}
@@ -81,15 +88,15 @@ func (ms *ManagementSvc) GetLatestApplication() (map[string]any, error) {
 
 // GetLatestApplicationByName returns the latest version of the named application, or (nil, nil) when
 // no application with that name exists yet - letting callers distinguish "not deployed" from an error.
-func (ms *ManagementSvc) GetLatestApplicationByName(appName string) (map[string]any, error) {
+func (ms *ManagementSvc) GetLatestApplicationByName(ctx context.Context, appName string) (map[string]any, error) {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/applications?appName=%s&latest=1&full=true", appName))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.ApplicationsResponse
-	if err := ms.HTTPClient.GetReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.ApplicationDescriptors) == 0 {
@@ -99,15 +106,15 @@ func (ms *ManagementSvc) GetLatestApplicationByName(appName string) (map[string]
 	return decodedResponse.ApplicationDescriptors[0], nil
 }
 
-func (ms *ManagementSvc) getApplicationByID(id string) (map[string]any, error) {
+func (ms *ManagementSvc) getApplicationByID(ctx context.Context, id string) (map[string]any, error) {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/applications/%s", id))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse map[string]any
-	if err := ms.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		if errors.Is(err, apperrors.ErrHTTP404NotFound) {
 			return nil, nil
 		}
@@ -117,8 +124,8 @@ func (ms *ManagementSvc) getApplicationByID(id string) (map[string]any, error) {
 	return decodedResponse, nil
 }
 
-func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) error {
-	existing, err := ms.getApplicationByID(ms.Action.ConfigApplicationID)
+func (ms *ManagementSvc) CreateApplication(ctx context.Context, extract *models.RegistryExtract) error {
+	existing, err := ms.getApplicationByID(ctx, ms.Action.ConfigApplicationID)
 	if err != nil {
 		return err
 	}
@@ -127,6 +134,52 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 		return nil
 	}
 
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
+	if err != nil {
+		return err
+	}
+
+	applicationPayload, err := ms.BuildApplicationPayload(ctx, extract)
+	if err != nil {
+		return err
+	}
+
+	payload1, err := json.Marshal(applicationPayload.Descriptor)
+	if err != nil {
+		return err
+	}
+	appRequestURL := ms.Action.GetRequestURL(constant.KongPort, "/applications?check=true")
+
+	var appResponse models.ApplicationDescriptor
+	if err := ms.HTTPClient.PostReturnStruct(ctx, appRequestURL, payload1, headers, &appResponse); err != nil {
+		return err
+	}
+	slog.Info(ms.Action.Name, "text", "Created application", "id", appResponse.ID, "backendModules", len(applicationPayload.BackendModules), "frontendModules", len(applicationPayload.FrontendModules))
+	ms.Action.EmitEvent(events.Event{Type: events.ApplicationCreated, Application: ms.Action.ConfigApplicationID})
+
+	if len(applicationPayload.DiscoveryModules) > 0 {
+		payload2, err := json.Marshal(map[string]any{
+			"discovery": applicationPayload.DiscoveryModules,
+		})
+		if err != nil {
+			return err
+		}
+		discoveryRequestURL := ms.Action.GetRequestURL(constant.KongPort, "/modules/discovery")
+
+		var discoveryResponse models.ModuleDiscoveryResponse
+		if err := ms.HTTPClient.PostReturnStruct(ctx, discoveryRequestURL, payload2, headers, &discoveryResponse); err != nil {
+			return err
+		}
+		slog.Info(ms.Action.Name, "text", "Created module discovery", "count", len(applicationPayload.DiscoveryModules), "totalRecords", discoveryResponse.TotalRecords)
+		ms.emitModuleDiscoveryCreated(applicationPayload.DiscoveryModules)
+	}
+
+	return nil
+}
+
+// BuildApplicationPayload builds the application descriptor and module discovery that CreateApplication posts,
+// without calling the management API
+func (ms *ManagementSvc) BuildApplicationPayload(ctx context.Context, extract *models.RegistryExtract) (*models.ApplicationPayload, error) {
 	var (
 		backendModules            []map[string]string
 		frontendModules           []map[string]string
@@ -139,11 +192,6 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 		dependencies = ms.Action.ConfigApplicationDependencies
 	}
 
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
-	if err != nil {
-		return err
-	}
-
 	allModules := [][]*models.ProxyModule{extract.Modules.FolioModules, extract.Modules.EurekaModules}
 	for _, modules := range allModules {
 		for _, module := range modules {
@@ -176,8 +224,8 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 				} else if isLocalFrontendModule {
 					descriptorPath = frontendModule.LocalDescriptorPath
 				}
-				if err := ms.FetchModuleDescriptor(extract, module.ID, moduleDescriptorURL, descriptorPath, isLocalModule); err != nil {
-					return err
+				if err := ms.FetchModuleDescriptor(ctx, extract, module.ID, moduleDescriptorURL, descriptorPath, isLocalModule); err != nil {
+					return nil, err
 				}
 			}
 
@@ -217,48 +265,25 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 		}
 	}
 
-	payload1, err := json.Marshal(map[string]any{
-		"id":                  ms.Action.ConfigApplicationID,
-		"name":                ms.Action.ConfigApplicationName,
-		"version":             ms.Action.ConfigApplicationVersion,
-		"description":         "Default",
-		"dependencies":        dependencies,
-		"modules":             backendModules,
-		"uiModules":           frontendModules,
-		"moduleDescriptors":   backendModuleDescriptors,
-		"uiModuleDescriptors": frontendModuleDescriptors,
-	})
-	if err != nil {
-		return err
-	}
-	appRequestURL := ms.Action.GetRequestURL(constant.KongPort, "/applications?check=true")
-
-	var appResponse models.ApplicationDescriptor
-	if err := ms.HTTPClient.PostReturnStruct(appRequestURL, payload1, headers, &appResponse); err != nil {
-		return err
-	}
-	slog.Info(ms.Action.Name, "text", "Created application", "id", appResponse.ID, "backendModules", len(backendModules), "frontendModules", len(frontendModules))
-
-	if len(discoveryModules) > 0 {
-		payload2, err := json.Marshal(map[string]any{
-			"discovery": discoveryModules,
-		})
-		if err != nil {
-			return err
-		}
-		discoveryRequestURL := ms.Action.GetRequestURL(constant.KongPort, "/modules/discovery")
-
-		var discoveryResponse models.ModuleDiscoveryResponse
-		if err := ms.HTTPClient.PostReturnStruct(discoveryRequestURL, payload2, headers, &discoveryResponse); err != nil {
-			return err
-		}
-		slog.Info(ms.Action.Name, "text", "Created module discovery", "count", len(discoveryModules), "totalRecords", discoveryResponse.TotalRecords)
-	}
-
-	return nil
+	return &models.ApplicationPayload{
+		Descriptor: map[string]any{
+			"id":                  ms.Action.ConfigApplicationID,
+			"name":                ms.Action.ConfigApplicationName,
+			"version":             ms.Action.ConfigApplicationVersion,
+			"description":         "Default",
+			"dependencies":        dependencies,
+			"modules":             backendModules,
+			"uiModules":           frontendModules,
+			"moduleDescriptors":   backendModuleDescriptors,
+			"uiModuleDescriptors": frontendModuleDescriptors,
+		},
+		BackendModules:   backendModules,
+		FrontendModules:  frontendModules,
+		DiscoveryModules: discoveryModules,
+	}, nil
 }
 
-func (ms *ManagementSvc) FetchModuleDescriptor(extract *models.RegistryExtract, moduleID, moduleDescriptorURL, descriptorPath string, isLocalModule bool) error {
+func (ms *ManagementSvc) FetchModuleDescriptor(ctx context.Context, extract *models.RegistryExtract, moduleID, moduleDescriptorURL, descriptorPath string, isLocalModule bool) error {
 	if isLocalModule {
 		slog.Info(ms.Action.Name, "text", "Fetching local module descriptor", "module", moduleID)
 
@@ -274,7 +299,7 @@ func (ms *ManagementSvc) FetchModuleDescriptor(extract *models.RegistryExtract,
 	slog.Info(ms.Action.Name, "text", "Fetching module descriptor", "module", moduleID, "url", moduleDescriptorURL)
 
 	var decodedResponse any
-	if err := ms.HTTPClient.GetRetryReturnStruct(moduleDescriptorURL, map[string]string{}, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetRetryReturnStruct(ctx, moduleDescriptorURL, map[string]string{}, &decodedResponse); err != nil {
 		return err
 	}
 	extract.ModuleDescriptors[moduleID] = decodedResponse
@@ -283,10 +308,10 @@ func (ms *ManagementSvc) FetchModuleDescriptor(extract *models.RegistryExtract,
 	return nil
 }
 
-func (ms *ManagementSvc) CreateNewApplication(r *models.ApplicationUpgradeRequest) error {
+func (ms *ManagementSvc) CreateNewApplication(ctx context.Context, r *models.ApplicationUpgradeRequest) error {
 	slog.Info(ms.Action.Name, "text", "CREATING NEW APPLICATION", "name", r.ApplicationName, "version", r.NewApplicationVersion)
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, "/applications?check=true")
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -315,22 +340,23 @@ func (ms *ManagementSvc) CreateNewApplication(r *models.ApplicationUpgradeReques
 	}
 
 	var appResponse models.ApplicationDescriptor
-	if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &appResponse); err != nil {
+	if err := ms.HTTPClient.PostReturnStruct(ctx, requestURL, payload, headers, &appResponse); err != nil {
 		return err
 	}
 	slog.Info(ms.Action.Name, "text", "Created application", "id", appResponse.ID, "backendModules", len(r.NewBackendModules), "frontendModules", len(r.NewFrontendModules))
+	ms.Action.EmitEvent(events.Event{Type: events.ApplicationCreated, Application: r.NewApplicationID})
 
 	return nil
 }
 
-func (ms *ManagementSvc) RemoveApplication(applicationID string) error {
+func (ms *ManagementSvc) RemoveApplication(ctx context.Context, applicationID string) error {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/applications/%s", applicationID))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
-	if err := ms.HTTPClient.Delete(requestURL, headers); err != nil {
+	if err := ms.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 		return err
 	}
 	slog.Info(ms.Action.Name, "text", "Removed application", "id", applicationID)
@@ -338,13 +364,13 @@ func (ms *ManagementSvc) RemoveApplication(applicationID string) error {
 	return nil
 }
 
-func (ms *ManagementSvc) RemoveApplications(applicationName, ignoreAppID string) error {
-	apps, err := ms.GetApplications()
+func (ms *ManagementSvc) RemoveApplications(ctx context.Context, applicationName, ignoreAppID string) error {
+	apps, err := ms.GetApplications(ctx)
 	if err != nil {
 		return err
 	}
 
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -360,7 +386,7 @@ func (ms *ManagementSvc) RemoveApplications(applicationName, ignoreAppID string)
 		}
 		requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/applications/%s", id))
 
-		if err := ms.HTTPClient.Delete(requestURL, headers); err != nil {
+		if err := ms.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 			return err
 		}
 		slog.Info(ms.Action.Name, "text", "Removed application", "id", id)
@@ -369,16 +395,16 @@ func (ms *ManagementSvc) RemoveApplications(applicationName, ignoreAppID string)
 	return nil
 }
 
-func (ms *ManagementSvc) GetModuleDiscovery(name string) (models.ModuleDiscoveryResponse, error) {
+func (ms *ManagementSvc) GetModuleDiscovery(ctx context.Context, name string) (models.ModuleDiscoveryResponse, error) {
 	rawQuery := fmt.Sprintf("(name==%s) sortby version", name)
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/modules/discovery?query=%s", url.QueryEscape(rawQuery)))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return models.ModuleDiscoveryResponse{}, err
 	}
 
 	var decodedResponse models.ModuleDiscoveryResponse
-	if err := ms.HTTPClient.GetReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return models.ModuleDiscoveryResponse{}, err
 	}
 
@@ -393,9 +419,25 @@ func (ms *ManagementSvc) GetModuleDiscovery(name string) (models.ModuleDiscovery
 	return decodedResponse, nil
 }
 
-func (ms *ManagementSvc) CreateNewModuleDiscovery(newDiscoveryModules []map[string]string) error {
+func (ms *ManagementSvc) GetModuleDiscoveries(ctx context.Context) (models.ModuleDiscoveryResponse, error) {
+	rawQuery := "(cql.allRecords=1) sortby name"
+	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/modules/discovery?query=%s&offset=0&limit=10000", url.QueryEscape(rawQuery)))
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
+	if err != nil {
+		return models.ModuleDiscoveryResponse{}, err
+	}
+
+	var decodedResponse models.ModuleDiscoveryResponse
+	if err := ms.HTTPClient.GetReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
+		return models.ModuleDiscoveryResponse{}, err
+	}
+
+	return decodedResponse, nil
+}
+
+func (ms *ManagementSvc) CreateNewModuleDiscovery(ctx context.Context, newDiscoveryModules []map[string]string) error {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, "/modules/discovery")
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -408,23 +450,30 @@ func (ms *ManagementSvc) CreateNewModuleDiscovery(newDiscoveryModules []map[stri
 	}
 
 	var discoveryResponse models.ModuleDiscoveryResponse
-	if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &discoveryResponse); err != nil {
+	if err := ms.HTTPClient.PostReturnStruct(ctx, requestURL, payload, headers, &discoveryResponse); err != nil {
 		return err
 	}
 	slog.Info(ms.Action.Name, "text", "Created module discovery", "count", len(newDiscoveryModules), "totalRecords", discoveryResponse.TotalRecords)
+	ms.emitModuleDiscoveryCreated(newDiscoveryModules)
 
 	return nil
 }
 
+func (ms *ManagementSvc) emitModuleDiscoveryCreated(discoveryModules []map[string]string) {
+	for _, discoveryModule := range discoveryModules {
+		ms.Action.EmitEvent(events.Event{Type: events.ModuleDiscoveryCreated, Module: discoveryModule["id"]})
+	}
+}
+
 // RemoveModuleDiscovery deletes the Kong module discovery registration for the given module id.
-func (ms *ManagementSvc) RemoveModuleDiscovery(id string) error {
+func (ms *ManagementSvc) RemoveModuleDiscovery(ctx context.Context, id string) error {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/modules/%s/discovery", id))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 
-	if err := ms.HTTPClient.Delete(requestURL, headers); err != nil {
+	if err := ms.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 		return err
 	}
 	slog.Info(ms.Action.Name, "text", "Removed module discovery", "id", id)
@@ -432,9 +481,9 @@ func (ms *ManagementSvc) RemoveModuleDiscovery(id string) error {
 	return nil
 }
 
-func (ms *ManagementSvc) UpdateModuleDiscovery(id string, restore bool, privatePort int, sidecarURL string) error {
+func (ms *ManagementSvc) UpdateModuleDiscovery(ctx context.Context, id string, restore bool, privatePort int, sidecarURL string) error {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/modules/%s/discovery", id))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -455,7 +504,7 @@ func (ms *ManagementSvc) UpdateModuleDiscovery(id string, restore bool, privateP
 		return err
 	}
 
-	if err := ms.HTTPClient.PutReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := ms.HTTPClient.PutReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(ms.Action.Name, "text", "Updated module discovery", "module", name, "location", sidecarURL)
@@ -0,0 +1,296 @@
+package managementsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// ManagementModuleDependencyChecker defines the interface for module dependency operations
+type ManagementModuleDependencyChecker interface {
+	GetApplicationModuleDescriptors(ctx context.Context, extract *models.RegistryExtract, applicationPayload *models.ApplicationPayload) ([]any, error)
+	GetParentModuleDescriptors(ctx context.Context, applicationIDs []string) ([]any, error)
+	GetManagementModuleDescriptors(ctx context.Context, modules *models.ProxyModulesByRegistry, managementModules map[string]models.BackendModule) ([]any, error)
+	CheckModuleDependencies(moduleDescriptors []any, providerModuleDescriptors []any) []models.UnsatisfiedInterface
+	ResolveModuleDependencies(moduleDescriptors []any, moduleNames []string, preferredModuleNames []string) ([]string, []models.UnsatisfiedInterface)
+}
+
+// GetApplicationModuleDescriptors returns the module descriptors of the modules of an application payload, the
+// descriptors already loaded into the extract are reused and the others are fetched from the registry or read locally
+func (ms *ManagementSvc) GetApplicationModuleDescriptors(ctx context.Context, extract *models.RegistryExtract, applicationPayload *models.ApplicationPayload) ([]any, error) {
+	var moduleDescriptors []any
+	for _, module := range slices.Concat(applicationPayload.BackendModules, applicationPayload.FrontendModules) {
+		moduleID := module["id"]
+		if _, ok := extract.ModuleDescriptors[moduleID]; !ok {
+			var descriptorPath string
+			if backendModule, ok := extract.BackendModules[module["name"]]; ok {
+				descriptorPath = backendModule.LocalDescriptorPath
+			} else if frontendModule, ok := extract.FrontendModules[module["name"]]; ok {
+				descriptorPath = frontendModule.LocalDescriptorPath
+			}
+			if err := ms.FetchModuleDescriptor(ctx, extract, moduleID, ms.Action.GetModuleURL(moduleID), descriptorPath, descriptorPath != ""); err != nil {
+				return nil, err
+			}
+		}
+		moduleDescriptors = append(moduleDescriptors, extract.ModuleDescriptors[moduleID])
+	}
+
+	return moduleDescriptors, nil
+}
+
+// GetParentModuleDescriptors returns the backend module descriptors of the parent applications that provide
+// the interfaces a child application can rely on
+func (ms *ManagementSvc) GetParentModuleDescriptors(ctx context.Context, applicationIDs []string) ([]any, error) {
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var moduleDescriptors []any
+	for _, applicationID := range applicationIDs {
+		requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/applications/%s?full=true", applicationID))
+
+		var decodedResponse map[string]any
+		if err := ms.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
+			if errors.Is(err, apperrors.ErrHTTP404NotFound) {
+				return nil, apperrors.ParentApplicationNotFound([]string{applicationID})
+			}
+			return nil, err
+		}
+		parentModuleDescriptors := helpers.GetAnySlice(decodedResponse, "moduleDescriptors")
+		moduleDescriptors = append(moduleDescriptors, parentModuleDescriptors...)
+		slog.Info(ms.Action.Name, "text", "Loaded parent application module descriptors", "application", applicationID, "count", len(parentModuleDescriptors))
+	}
+
+	return moduleDescriptors, nil
+}
+
+// GetManagementModuleDescriptors returns the module descriptors of the deployed management modules, which are not part of
+// the application but provide interfaces, e.g. the entitlements and tenants interfaces, to its modules
+func (ms *ManagementSvc) GetManagementModuleDescriptors(ctx context.Context, modules *models.ProxyModulesByRegistry, managementModules map[string]models.BackendModule) ([]any, error) {
+	extract := &models.RegistryExtract{ModuleDescriptors: make(map[string]any)}
+	var moduleDescriptors []any
+	for _, module := range slices.Concat(modules.FolioModules, modules.EurekaModules) {
+		managementModule, ok := managementModules[module.Metadata.Name]
+		if !ok || !managementModule.DeployModule {
+			continue
+		}
+		moduleID := module.ID
+		if managementModule.ModuleVersion != nil {
+			moduleID = fmt.Sprintf("%s-%s", module.Metadata.Name, *managementModule.ModuleVersion)
+		}
+		descriptorPath := managementModule.LocalDescriptorPath
+		if err := ms.FetchModuleDescriptor(ctx, extract, moduleID, ms.Action.GetModuleURL(moduleID), descriptorPath, descriptorPath != ""); err != nil {
+			return nil, err
+		}
+		moduleDescriptors = append(moduleDescriptors, extract.ModuleDescriptors[moduleID])
+	}
+
+	return moduleDescriptors, nil
+}
+
+// CheckModuleDependencies resolves the required and optional interfaces of the modules against the interfaces provided
+// by the modules and the provider modules, i.e. the management modules and the modules of the parent applications,
+// an optional interface is only reported when it is provided in an incompatible version
+func (ms *ManagementSvc) CheckModuleDependencies(moduleDescriptors []any, providerModuleDescriptors []any) []models.UnsatisfiedInterface {
+	providedVersions := make(map[string][]string)
+	for _, value := range slices.Concat(moduleDescriptors, providerModuleDescriptors) {
+		moduleDescriptor, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, providedValue := range helpers.GetAnySlice(moduleDescriptor, "provides") {
+			providedInterface, ok := providedValue.(map[string]any)
+			if !ok {
+				continue
+			}
+			interfaceID := helpers.GetString(providedInterface, "id")
+			version := helpers.GetString(providedInterface, "version")
+			if !slices.Contains(providedVersions[interfaceID], version) {
+				providedVersions[interfaceID] = append(providedVersions[interfaceID], version)
+			}
+		}
+	}
+
+	var unsatisfied []models.UnsatisfiedInterface
+	for _, value := range moduleDescriptors {
+		moduleDescriptor, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		moduleID := helpers.GetString(moduleDescriptor, "id")
+		for _, optional := range []bool{false, true} {
+			key := "requires"
+			if optional {
+				key = "optional"
+			}
+			for _, requiredValue := range helpers.GetAnySlice(moduleDescriptor, key) {
+				requiredInterface, ok := requiredValue.(map[string]any)
+				if !ok {
+					continue
+				}
+				interfaceID := helpers.GetString(requiredInterface, "id")
+				requiredVersion := helpers.GetString(requiredInterface, "version")
+				versions := providedVersions[interfaceID]
+				if optional && len(versions) == 0 || slices.ContainsFunc(versions, func(providedVersion string) bool {
+					return isInterfaceVersionCompatible(providedVersion, requiredVersion)
+				}) {
+					continue
+				}
+				unsatisfied = append(unsatisfied, models.UnsatisfiedInterface{
+					ModuleID:  moduleID,
+					Interface: interfaceID,
+					Version:   requiredVersion,
+					Optional:  optional,
+					Provided:  append([]string{}, slices.Sorted(slices.Values(versions))...),
+				})
+			}
+		}
+	}
+	slices.SortStableFunc(unsatisfied, func(a, b models.UnsatisfiedInterface) int {
+		if c := strings.Compare(a.ModuleID, b.ModuleID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Interface, b.Interface)
+	})
+
+	return unsatisfied
+}
+
+// ResolveModuleDependencies returns the module names followed by the names of the modules providing the interfaces they
+// require transitively, optional interfaces are not followed, an interface is taken from a module already resolved
+// before a preferred module and before any other module in alphabetical order
+func (ms *ManagementSvc) ResolveModuleDependencies(moduleDescriptors []any, moduleNames []string, preferredModuleNames []string) ([]string, []models.UnsatisfiedInterface) {
+	descriptorsByName := make(map[string]map[string]any)
+	for _, value := range moduleDescriptors {
+		moduleDescriptor, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		descriptorsByName[helpers.GetModuleNameFromID(helpers.GetString(moduleDescriptor, "id"))] = moduleDescriptor
+	}
+
+	var preferred, others []string
+	for _, moduleName := range slices.Sorted(maps.Keys(descriptorsByName)) {
+		if slices.Contains(preferredModuleNames, moduleName) {
+			preferred = append(preferred, moduleName)
+		} else {
+			others = append(others, moduleName)
+		}
+	}
+
+	resolved := slices.Clone(moduleNames)
+	var unsatisfied []models.UnsatisfiedInterface
+	for i := 0; i < len(resolved); i++ {
+		moduleDescriptor, ok := descriptorsByName[resolved[i]]
+		if !ok {
+			continue
+		}
+		for _, requiredValue := range helpers.GetAnySlice(moduleDescriptor, "requires") {
+			requiredInterface, ok := requiredValue.(map[string]any)
+			if !ok {
+				continue
+			}
+			interfaceID := helpers.GetString(requiredInterface, "id")
+			requiredVersion := helpers.GetString(requiredInterface, "version")
+
+			var versions []string
+			providerName := ""
+			for _, moduleName := range slices.Concat(resolved, preferred, others) {
+				for _, providedVersion := range getProvidedInterfaceVersions(descriptorsByName[moduleName], interfaceID) {
+					if isInterfaceVersionCompatible(providedVersion, requiredVersion) {
+						providerName = moduleName
+						break
+					}
+					if !slices.Contains(versions, providedVersion) {
+						versions = append(versions, providedVersion)
+					}
+				}
+				if providerName != "" {
+					break
+				}
+			}
+			if providerName == "" {
+				unsatisfied = append(unsatisfied, models.UnsatisfiedInterface{
+					ModuleID:  helpers.GetString(moduleDescriptor, "id"),
+					Interface: interfaceID,
+					Version:   requiredVersion,
+					Provided:  append([]string{}, slices.Sorted(slices.Values(versions))...),
+				})
+				continue
+			}
+			if !slices.Contains(resolved, providerName) {
+				resolved = append(resolved, providerName)
+			}
+		}
+	}
+	slices.SortStableFunc(unsatisfied, func(a, b models.UnsatisfiedInterface) int {
+		if c := strings.Compare(a.ModuleID, b.ModuleID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Interface, b.Interface)
+	})
+
+	return resolved, unsatisfied
+}
+
+func getProvidedInterfaceVersions(moduleDescriptor map[string]any, interfaceID string) []string {
+	var versions []string
+	for _, providedValue := range helpers.GetAnySlice(moduleDescriptor, "provides") {
+		providedInterface, ok := providedValue.(map[string]any)
+		if ok && helpers.GetString(providedInterface, "id") == interfaceID {
+			versions = append(versions, helpers.GetString(providedInterface, "version"))
+		}
+	}
+
+	return versions
+}
+
+// isInterfaceVersionCompatible reports whether a provided interface version satisfies one of the space separated
+// required versions, a provided version is compatible when it has the same major and an equal or greater minor
+// and patch version
+func isInterfaceVersionCompatible(providedVersion, requiredVersions string) bool {
+	provided, ok := parseInterfaceVersion(providedVersion)
+	if !ok {
+		return false
+	}
+	for _, requiredVersion := range strings.Fields(requiredVersions) {
+		required, ok := parseInterfaceVersion(requiredVersion)
+		if !ok || provided[0] != required[0] {
+			continue
+		}
+		if provided[1] > required[1] || provided[1] == required[1] && provided[2] >= required[2] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseInterfaceVersion parses a major.minor or major.minor.patch interface version
+func parseInterfaceVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return parsed, false
+	}
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, false
+		}
+		parsed[i] = number
+	}
+
+	return parsed, true
+}
@@ -1,25 +1,29 @@
 package managementsvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/url"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
 
 // ManagementTenantManager defines the interface for tenant management operations
 type ManagementTenantManager interface {
-	GetTenants(consortiumName string, tenantType constant.TenantType) ([]any, error)
-	CreateTenants() error
-	RemoveTenants(consortiumName string, tenantType constant.TenantType) error
+	GetTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) ([]any, error)
+	CreateTenants(ctx context.Context) error
+	RemoveTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) error
+	RemoveTenant(ctx context.Context, tenantName string) error
+	GetTenantType(tenant config.Tenant) string
 }
 
-func (ms *ManagementSvc) GetTenants(consortiumName string, tenantType constant.TenantType) ([]any, error) {
+func (ms *ManagementSvc) GetTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) ([]any, error) {
 	var rawQuery string
 	if tenantType != constant.All {
 		rawQuery = fmt.Sprintf("(description==%s-%s) sortby name", consortiumName, tenantType)
@@ -28,13 +32,13 @@ func (ms *ManagementSvc) GetTenants(consortiumName string, tenantType constant.T
 	}
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/tenants?query=%s", url.QueryEscape(rawQuery)))
 
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.TenantsResponse
-	if err := ms.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 
@@ -55,16 +59,16 @@ func (ms *ManagementSvc) GetTenants(consortiumName string, tenantType constant.T
 	return result, nil
 }
 
-func (ms *ManagementSvc) CreateTenants() error {
+func (ms *ManagementSvc) CreateTenants(ctx context.Context) error {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, "/tenants")
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
 	tenantNames := helpers.SortedMapKeys(ms.Action.ConfigTenants)
 
 	for _, tenantName := range tenantNames {
-		existing, err := ms.getTenantByName(tenantName)
+		existing, err := ms.getTenantByName(ctx, tenantName)
 		if err != nil {
 			return err
 		}
@@ -73,36 +77,35 @@ func (ms *ManagementSvc) CreateTenants() error {
 			continue
 		}
 
-		properties := ms.Action.ConfigTenants[tenantName]
-		entry := properties.(map[string]any)
 		payload, err := json.Marshal(map[string]string{
 			"name":        tenantName,
-			"description": ms.GetTenantType(entry),
+			"description": ms.GetTenantType(ms.Action.ConfigTenants[tenantName]),
 		})
 		if err != nil {
 			return err
 		}
 
 		var tenant models.Tenant
-		if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &tenant); err != nil {
+		if err := ms.HTTPClient.PostReturnStruct(ctx, requestURL, payload, headers, &tenant); err != nil {
 			return err
 		}
 		slog.Info(ms.Action.Name, "text", "Created tenant", "tenant", tenant.Name, "id", tenant.ID, "description", tenant.Description)
+		ms.Action.EmitEvent(events.Event{Type: events.TenantCreated, Tenant: tenantName})
 	}
 
 	return nil
 }
 
-func (ms *ManagementSvc) getTenantByName(name string) (*models.Tenant, error) {
+func (ms *ManagementSvc) getTenantByName(ctx context.Context, name string) (*models.Tenant, error) {
 	rawQuery := fmt.Sprintf("name==%s", name)
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/tenants?query=%s&limit=1", url.QueryEscape(rawQuery)))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.TenantsResponse
-	if err := ms.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := ms.HTTPClient.GetRetryReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Tenants) == 0 {
@@ -112,27 +115,40 @@ func (ms *ManagementSvc) getTenantByName(name string) (*models.Tenant, error) {
 	return &decodedResponse.Tenants[0], nil
 }
 
-func (ms *ManagementSvc) GetTenantType(entry map[string]any) string {
-	consortiumName := helpers.GetString(entry, field.TenantsConsortiumEntry)
+func (ms *ManagementSvc) GetTenantType(tenant config.Tenant) string {
+	consortiumName := tenant.Consortium
 	if consortiumName == "" {
 		return fmt.Sprintf("%s-%s", constant.NoneConsortium, constant.Default)
 	}
 
 	tenantType := constant.Member
-	if helpers.GetBool(entry, field.TenantsCentralTenantEntry) {
+	if tenant.CentralTenant {
 		tenantType = constant.Central
 	}
 
 	return fmt.Sprintf("%s-%s", consortiumName, tenantType)
 }
 
-func (ms *ManagementSvc) RemoveTenants(consortiumName string, tenantType constant.TenantType) error {
-	tenants, err := ms.GetTenants(consortiumName, tenantType)
+func (ms *ManagementSvc) RemoveTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return ms.removeTenants(ctx, consortiumName, tenantType, func(tenantName string) bool {
+		return helpers.HasTenant(tenantName, ms.Action.ConfigTenants)
+	})
+}
+
+// RemoveTenant removes the tenant by name, independently of the tenants of the config
+func (ms *ManagementSvc) RemoveTenant(ctx context.Context, tenantName string) error {
+	return ms.removeTenants(ctx, constant.NoneConsortium, constant.All, func(name string) bool {
+		return name == tenantName
+	})
+}
+
+func (ms *ManagementSvc) removeTenants(ctx context.Context, consortiumName string, tenantType constant.TenantType, shouldRemove func(tenantName string) bool) error {
+	tenants, err := ms.GetTenants(ctx, consortiumName, tenantType)
 	if err != nil {
 		return err
 	}
 
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -140,12 +156,12 @@ func (ms *ManagementSvc) RemoveTenants(consortiumName string, tenantType constan
 	for _, value := range tenants {
 		entry := value.(map[string]any)
 		tenantName := helpers.GetString(entry, "name")
-		if !helpers.HasTenant(tenantName, ms.Action.ConfigTenants) {
+		if !shouldRemove(tenantName) {
 			continue
 		}
 
 		requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/tenants/%s?purgeKafkaTopics=true", helpers.GetString(entry, "id")))
-		if err := ms.HTTPClient.Delete(requestURL, headers); err != nil {
+		if err := ms.HTTPClient.Delete(ctx, requestURL, headers); err != nil {
 			return err
 		}
 		slog.Info(ms.Action.Name, "text", "Removed tenant", "tenant", tenantName, "tenantType", tenantType)
@@ -1,58 +1,61 @@
 package managementsvc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
 
 // ManagementTenantEntitlementManager defines the interface for tenant entitlement management operations
 type ManagementTenantEntitlementManager interface {
-	GetTenantEntitlements(tenantName string, includeModules bool) (models.TenantEntitlementResponse, error)
-	CreateTenantEntitlement(consortiumName string, tenantType constant.TenantType) error
-	CreateTenantEntitlementForApplication(consortiumName string, tenantType constant.TenantType, applicationID string) error
-	UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error
-	RemoveTenantEntitlements(consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error
-	RemoveTenantEntitlementsForApplication(consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error
+	GetTenantEntitlements(ctx context.Context, tenantName string, includeModules bool) (models.TenantEntitlementResponse, error)
+	CreateTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType) error
+	CreateTenantEntitlementForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string) error
+	UpgradeTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType, newApplicationID string) error
+	RemoveTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error
+	RemoveTenantEntitlementsForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error
+	RemoveTenantEntitlement(ctx context.Context, tenantName string, applicationID string, purgeSchemas bool) error
 }
 
-func (ms *ManagementSvc) GetTenantEntitlements(tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
+func (ms *ManagementSvc) GetTenantEntitlements(ctx context.Context, tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/entitlements?tenant=%s&includeModules=%t", tenantName, includeModules))
-	headers, err := helpers.SecureOkapiApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureOkapiApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return models.TenantEntitlementResponse{}, err
 	}
 
 	var response models.TenantEntitlementResponse
-	if err := ms.HTTPClient.GetReturnStruct(requestURL, headers, &response); err != nil {
+	if err := ms.HTTPClient.GetReturnStruct(ctx, requestURL, headers, &response); err != nil {
 		return models.TenantEntitlementResponse{}, err
 	}
 
 	return response, nil
 }
 
-func (ms *ManagementSvc) CreateTenantEntitlement(consortiumName string, tenantType constant.TenantType) error {
-	return ms.CreateTenantEntitlementForApplication(consortiumName, tenantType, ms.Action.ConfigApplicationID)
+func (ms *ManagementSvc) CreateTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType) error {
+	return ms.CreateTenantEntitlementForApplication(ctx, consortiumName, tenantType, ms.Action.ConfigApplicationID)
 }
 
-func (ms *ManagementSvc) CreateTenantEntitlementForApplication(consortiumName string, tenantType constant.TenantType, applicationID string) error {
+func (ms *ManagementSvc) CreateTenantEntitlementForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string) error {
 	tenantParameters, err := ms.TenantSvc.GetEntitlementTenantParameters(consortiumName)
 	if err != nil {
 		return err
 	}
 
-	tenants, err := ms.GetTenants(consortiumName, tenantType)
+	tenants, err := ms.GetTenants(ctx, consortiumName, tenantType)
 	if err != nil {
 		return nil
 	}
 
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/entitlements?purgeOnRollback=true&ignoreErrors=false&async=false&tenantParameters=%s", tenantParameters))
-	headers, err := helpers.SecureOkapiApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureOkapiApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -64,7 +67,7 @@ func (ms *ManagementSvc) CreateTenantEntitlementForApplication(consortiumName st
 			continue
 		}
 
-		existingEntitlements, err := ms.GetTenantEntitlements(tenantName, false)
+		existingEntitlements, err := ms.GetTenantEntitlements(ctx, tenantName, false)
 		if err != nil {
 			return err
 		}
@@ -88,31 +91,35 @@ func (ms *ManagementSvc) CreateTenantEntitlementForApplication(consortiumName st
 			return err
 		}
 
+		start := time.Now()
 		var decodedResponse models.TenantEntitlementResponse
-		if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+		if err := ms.HTTPClient.PostReturnStruct(ctx, requestURL, payload, headers, &decodedResponse); err != nil {
 			return err
 		}
 		slog.Info(ms.Action.Name, "text", "Created tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
+		ms.Action.EmitEvent(events.Event{Type: events.TenantEntitled, Tenant: tenantName, Application: applicationID, FlowID: decodedResponse.FlowID, DurationMs: time.Since(start).Milliseconds()})
 
-		time.Sleep(30 * time.Second)
+		if err := ms.ReadinessSvc.WaitForCapabilities(ctx, tenantName); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (ms *ManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
+func (ms *ManagementSvc) UpgradeTenantEntitlement(ctx context.Context, consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
 	tenantParameters, err := ms.TenantSvc.GetEntitlementTenantParameters(consortiumName)
 	if err != nil {
 		return err
 	}
 
-	tenants, err := ms.GetTenants(consortiumName, tenantType)
+	tenants, err := ms.GetTenants(ctx, consortiumName, tenantType)
 	if err != nil {
 		return nil
 	}
 
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/entitlements?async=false&tenantParameters=%s", tenantParameters))
-	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return nil
 	}
@@ -132,29 +139,44 @@ func (ms *ManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantT
 			return err
 		}
 
+		start := time.Now()
 		var decodedResponse models.TenantEntitlementResponse
-		if err := ms.HTTPClient.PutReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+		if err := ms.HTTPClient.PutReturnStruct(ctx, requestURL, payload, headers, &decodedResponse); err != nil {
 			return err
 		}
 		slog.Info(ms.Action.Name, "text", "Upgraded tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
+		ms.Action.EmitEvent(events.Event{Type: events.TenantEntitled, Tenant: tenantName, Application: newApplicationID, FlowID: decodedResponse.FlowID, DurationMs: time.Since(start).Milliseconds()})
 	}
 
 	return nil
 }
 
-func (ms *ManagementSvc) RemoveTenantEntitlements(consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error {
-	return ms.RemoveTenantEntitlementsForApplication(consortiumName, tenantType, ms.Action.ConfigApplicationID, purgeSchemas)
+func (ms *ManagementSvc) RemoveTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error {
+	return ms.RemoveTenantEntitlementsForApplication(ctx, consortiumName, tenantType, ms.Action.ConfigApplicationID, purgeSchemas)
 }
 
 
-func (ms *ManagementSvc) RemoveTenantEntitlementsForApplication(consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error {
-	tenants, err := ms.GetTenants(consortiumName, tenantType)
+func (ms *ManagementSvc) RemoveTenantEntitlementsForApplication(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool) error {
+	return ms.removeTenantEntitlements(ctx, consortiumName, tenantType, applicationID, purgeSchemas, func(tenantName string) bool {
+		return helpers.HasTenant(tenantName, ms.Action.ConfigTenants)
+	})
+}
+
+// RemoveTenantEntitlement removes the entitlement of the tenant to the application, independently of the tenants of the config
+func (ms *ManagementSvc) RemoveTenantEntitlement(ctx context.Context, tenantName string, applicationID string, purgeSchemas bool) error {
+	return ms.removeTenantEntitlements(ctx, constant.NoneConsortium, constant.All, applicationID, purgeSchemas, func(name string) bool {
+		return name == tenantName
+	})
+}
+
+func (ms *ManagementSvc) removeTenantEntitlements(ctx context.Context, consortiumName string, tenantType constant.TenantType, applicationID string, purgeSchemas bool, shouldRemove func(tenantName string) bool) error {
+	tenants, err := ms.GetTenants(ctx, consortiumName, tenantType)
 	if err != nil {
 		return err
 	}
 
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/entitlements?purge=%t&ignoreErrors=false", purgeSchemas))
-	headers, err := helpers.SecureOkapiApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	headers, err := helpers.SecureOkapiApplicationJSONHeaders(helpers.GetMasterAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -162,7 +184,7 @@ func (ms *ManagementSvc) RemoveTenantEntitlementsForApplication(consortiumName s
 	for _, value := range tenants {
 		entry := value.(map[string]any)
 		tenantName := helpers.GetString(entry, "name")
-		if !helpers.HasTenant(tenantName, ms.Action.ConfigTenants) {
+		if !shouldRemove(tenantName) {
 			continue
 		}
 		tenantID := helpers.GetString(entry, "id")
@@ -176,7 +198,7 @@ func (ms *ManagementSvc) RemoveTenantEntitlementsForApplication(consortiumName s
 		}
 
 		var decodedResponse models.TenantEntitlementResponse
-		if err := ms.HTTPClient.DeleteWithPayloadReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+		if err := ms.HTTPClient.DeleteWithPayloadReturnStruct(ctx, requestURL, payload, headers, &decodedResponse); err != nil {
 			return err
 		}
 		slog.Info(ms.Action.Name, "text", "Removed tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
@@ -1,13 +1,18 @@
 package managementsvc_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/managementsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
@@ -45,7 +50,7 @@ func TestNew(t *testing.T) {
 	mockTenantSvc := &MockTenantSvc{}
 
 	// Act
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Assert
 	assert.NotNil(t, svc)
@@ -58,9 +63,9 @@ func TestGetTenants_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	consortiumName := "test-consortium"
 	tenantType := constant.TenantType(constant.Member)
@@ -95,7 +100,7 @@ func TestGetTenants_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetTenants(consortiumName, tenantType)
+	result, err := svc.GetTenants(ctx, consortiumName, tenantType)
 
 	// Assert
 	assert.NoError(t, err)
@@ -110,9 +115,9 @@ func TestGetTenants_AllTenantType(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	consortiumName := "test-consortium"
 	tenantType := constant.TenantType(constant.All)
@@ -140,7 +145,7 @@ func TestGetTenants_AllTenantType(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetTenants(consortiumName, tenantType)
+	result, err := svc.GetTenants(ctx, consortiumName, tenantType)
 
 	// Assert
 	assert.NoError(t, err)
@@ -153,9 +158,9 @@ func TestGetTenants_NotFound(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	consortiumName := "test-consortium"
 	tenantType := constant.TenantType(constant.Central)
@@ -175,7 +180,7 @@ func TestGetTenants_NotFound(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetTenants(consortiumName, tenantType)
+	result, err := svc.GetTenants(ctx, consortiumName, tenantType)
 
 	// Assert
 	assert.NoError(t, err)
@@ -187,9 +192,9 @@ func TestGetTenants_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	consortiumName := "test-consortium"
 	tenantType := constant.TenantType(constant.Member)
@@ -202,7 +207,7 @@ func TestGetTenants_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetTenants(consortiumName, tenantType)
+	result, err := svc.GetTenants(ctx, consortiumName, tenantType)
 
 	// Assert
 	assert.Error(t, err)
@@ -215,12 +220,11 @@ func TestGetTenants_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	result, err := svc.GetTenants("test-consortium", constant.TenantType(constant.Member))
+	result, err := svc.GetTenants(context.Background(), "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.Error(t, err)
@@ -233,12 +237,11 @@ func TestGetApplications_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	result, err := svc.GetApplications()
+	result, err := svc.GetApplications(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -251,12 +254,11 @@ func TestRemoveApplication_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	err := svc.RemoveApplication("app-123")
+	err := svc.RemoveApplication(context.Background(), "app-123")
 
 	// Assert
 	assert.Error(t, err)
@@ -268,12 +270,11 @@ func TestGetModuleDiscovery_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	result, err := svc.GetModuleDiscovery("mod-test")
+	result, err := svc.GetModuleDiscovery(context.Background(), "mod-test")
 
 	// Assert
 	assert.Error(t, err)
@@ -286,12 +287,11 @@ func TestUpdateModuleDiscovery_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	err := svc.UpdateModuleDiscovery("mod-test-1.0.0", false, 8080, "http://test:8080")
+	err := svc.UpdateModuleDiscovery(context.Background(), "mod-test-1.0.0", false, 8080, "http://test:8080")
 
 	// Assert
 	assert.Error(t, err)
@@ -303,15 +303,14 @@ func TestCreateTenants_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	err := svc.CreateTenants()
+	err := svc.CreateTenants(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -323,12 +322,11 @@ func TestRemoveTenants_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	err := svc.RemoveTenants("test-consortium", constant.TenantType(constant.Member))
+	err := svc.RemoveTenants(context.Background(), "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.Error(t, err)
@@ -340,15 +338,14 @@ func TestCreateTenantEntitlement_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
 		Return("params", nil)
 
 	// Act - GetTenants will fail with header creation error, but the function returns nil instead of error (BUG in actual code)
-	err := svc.CreateTenantEntitlement("test-consortium", constant.TenantType(constant.Member))
+	err := svc.CreateTenantEntitlement(context.Background(), "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert - Current behavior returns nil even on error (this is a bug in the actual implementation)
 	assert.NoError(t, err)
@@ -359,12 +356,11 @@ func TestRemoveTenantEntitlements_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	err := svc.RemoveTenantEntitlements("test-consortium", constant.TenantType(constant.Member), false)
+	err := svc.RemoveTenantEntitlements(context.Background(), "test-consortium", constant.TenantType(constant.Member), false)
 
 	// Assert
 	assert.Error(t, err)
@@ -376,12 +372,11 @@ func TestCreateApplication_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Empty token will cause header creation to fail
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		Modules: &models.ProxyModulesByRegistry{
@@ -394,7 +389,7 @@ func TestCreateApplication_HeaderCreationError(t *testing.T) {
 	}
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(context.Background(), extract)
 
 	// Assert
 	assert.Error(t, err)
@@ -406,9 +401,9 @@ func TestGetApplications_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetReturnStruct",
 		mock.MatchedBy(func(url string) bool {
@@ -424,7 +419,7 @@ func TestGetApplications_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetApplications()
+	result, err := svc.GetApplications(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -437,9 +432,9 @@ func TestGetApplications_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("HTTP request failed")
 
@@ -450,7 +445,7 @@ func TestGetApplications_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetApplications()
+	result, err := svc.GetApplications(ctx)
 
 	// Assert
 	assert.Error(t, err)
@@ -463,9 +458,9 @@ func TestGetApplications_NilResponse(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetReturnStruct",
 		mock.Anything,
@@ -474,7 +469,7 @@ func TestGetApplications_NilResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetApplications()
+	result, err := svc.GetApplications(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -486,9 +481,9 @@ func TestRemoveApplication_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	applicationID := "app-123"
 
@@ -500,7 +495,7 @@ func TestRemoveApplication_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.RemoveApplication(applicationID)
+	err := svc.RemoveApplication(ctx, applicationID)
 
 	// Assert
 	assert.NoError(t, err)
@@ -511,9 +506,9 @@ func TestRemoveApplication_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	applicationID := "app-123"
 	expectedError := errors.New("delete failed")
@@ -524,7 +519,7 @@ func TestRemoveApplication_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveApplication(applicationID)
+	err := svc.RemoveApplication(ctx, applicationID)
 
 	// Assert
 	assert.Error(t, err)
@@ -536,9 +531,9 @@ func TestGetModuleDiscovery_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	moduleName := "mod-test"
 
@@ -562,7 +557,7 @@ func TestGetModuleDiscovery_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetModuleDiscovery(moduleName)
+	result, err := svc.GetModuleDiscovery(ctx, moduleName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -571,13 +566,43 @@ func TestGetModuleDiscovery_Success(t *testing.T) {
 	mockHTTP.AssertExpectations(t)
 }
 
+func TestGetModuleDiscoveries_Success(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	svc := managementsvc.New(action, mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+
+	mockHTTP.On("GetReturnStruct",
+		mock.MatchedBy(func(url string) bool {
+			return strings.Contains(url, "/modules/discovery") && strings.Contains(url, "allRecords") && strings.Contains(url, "limit=10000")
+		}),
+		mock.Anything,
+		mock.AnythingOfType("*models.ModuleDiscoveryResponse")).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.ModuleDiscoveryResponse)
+			target.Discovery = []models.ModuleDiscovery{{ID: "mod-orders-13.1.0"}, {ID: "mod-users-19.4.0"}}
+			target.TotalRecords = 2
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.GetModuleDiscoveries(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Discovery, 2)
+	assert.Equal(t, 2, result.TotalRecords)
+	mockHTTP.AssertExpectations(t)
+}
+
 func TestGetModuleDiscovery_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	moduleName := "mod-test"
 	expectedError := errors.New("HTTP request failed")
@@ -589,7 +614,7 @@ func TestGetModuleDiscovery_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetModuleDiscovery(moduleName)
+	result, err := svc.GetModuleDiscovery(ctx, moduleName)
 
 	// Assert
 	assert.Error(t, err)
@@ -602,9 +627,9 @@ func TestUpdateModuleDiscovery_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	moduleID := "mod-test-1.0.0"
 	sidecarURL := "http://custom-url:8080"
@@ -622,7 +647,7 @@ func TestUpdateModuleDiscovery_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.UpdateModuleDiscovery(moduleID, false, 8080, sidecarURL)
+	err := svc.UpdateModuleDiscovery(ctx, moduleID, false, 8080, sidecarURL)
 
 	// Assert
 	assert.NoError(t, err)
@@ -633,9 +658,9 @@ func TestUpdateModuleDiscovery_RestoreURL(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	moduleID := "mod-test-1.0.0"
 	privatePort := 8080
@@ -652,7 +677,7 @@ func TestUpdateModuleDiscovery_RestoreURL(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.UpdateModuleDiscovery(moduleID, true, privatePort, "")
+	err := svc.UpdateModuleDiscovery(ctx, moduleID, true, privatePort, "")
 
 	// Assert
 	assert.NoError(t, err)
@@ -663,9 +688,9 @@ func TestUpdateModuleDiscovery_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	moduleID := "mod-test-1.0.0"
 	expectedError := errors.New("HTTP PUT failed")
@@ -677,7 +702,7 @@ func TestUpdateModuleDiscovery_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.UpdateModuleDiscovery(moduleID, false, 8080, "http://test:8080")
+	err := svc.UpdateModuleDiscovery(ctx, moduleID, false, 8080, "http://test:8080")
 
 	// Assert
 	assert.Error(t, err)
@@ -689,15 +714,16 @@ func TestCreateTenants_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"consortium":    "test-consortium",
-			"centralTenant": false,
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			Consortium: "test-consortium",
 		},
 	}
+	var eventsBuf bytes.Buffer
+	action.Events = events.NewJSONSink(&eventsBuf)
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetRetryReturnStruct",
 		mock.MatchedBy(func(url string) bool {
@@ -727,26 +753,30 @@ func TestCreateTenants_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateTenants()
+	err := svc.CreateTenants(ctx)
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
+	var event events.Event
+	assert.NoError(t, json.Unmarshal(eventsBuf.Bytes(), &event))
+	assert.Equal(t, events.TenantCreated, event.Type)
+	assert.Equal(t, "test-tenant", event.Tenant)
 }
 
 func TestCreateTenants_CentralTenant(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"central-tenant": map[string]any{
-			"consortium":     "test-consortium",
-			"central-tenant": true,
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"central-tenant": {
+			Consortium:    "test-consortium",
+			CentralTenant: true,
 		},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetRetryReturnStruct",
 		mock.MatchedBy(func(url string) bool {
@@ -774,7 +804,7 @@ func TestCreateTenants_CentralTenant(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateTenants()
+	err := svc.CreateTenants(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -785,12 +815,12 @@ func TestCreateTenants_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetRetryReturnStruct",
 		mock.MatchedBy(func(url string) bool {
@@ -809,7 +839,7 @@ func TestCreateTenants_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateTenants()
+	err := svc.CreateTenants(ctx)
 
 	// Assert
 	assert.Error(t, err)
@@ -821,12 +851,12 @@ func TestRemoveTenants_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant", "description": "test-consortium-member"}], "totalRecords": 1}`
 
@@ -848,7 +878,7 @@ func TestRemoveTenants_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.RemoveTenants("test-consortium", constant.TenantType(constant.Member))
+	err := svc.RemoveTenants(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.NoError(t, err)
@@ -859,9 +889,9 @@ func TestRemoveTenants_GetTenantsError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("failed to get tenants")
 	mockHTTP.On("GetRetryReturnStruct",
@@ -871,7 +901,7 @@ func TestRemoveTenants_GetTenantsError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveTenants("test-consortium", constant.TenantType(constant.Member))
+	err := svc.RemoveTenants(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.Error(t, err)
@@ -883,13 +913,16 @@ func TestCreateTenantEntitlement_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	action.ConfigApplicationID = "app-123"
+	var eventsBuf bytes.Buffer
+	action.Events = events.NewJSONSink(&eventsBuf)
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	mockReadinessSvc := &testhelpers.MockReadinessSvc{}
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, mockReadinessSvc)
 
 	tenantParam := "param1=value1"
 	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
@@ -933,13 +966,88 @@ func TestCreateTenantEntitlement_Success(t *testing.T) {
 		}).
 		Return(nil)
 
+	mockReadinessSvc.On("WaitForCapabilities", "test-tenant").Return(nil)
+
 	// Act
-	err := svc.CreateTenantEntitlement("test-consortium", constant.TenantType(constant.Member))
+	err := svc.CreateTenantEntitlement(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
 	mockTenantSvc.AssertExpectations(t)
+	mockReadinessSvc.AssertExpectations(t)
+	var event events.Event
+	assert.NoError(t, json.Unmarshal(eventsBuf.Bytes(), &event))
+	assert.Equal(t, events.TenantEntitled, event.Type)
+	assert.Equal(t, "test-tenant", event.Tenant)
+	assert.Equal(t, "flow-123", event.FlowID)
+}
+
+func TestCreateTenantEntitlement_RealmNotReady(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
+	}
+	action.ConfigApplicationID = "app-123"
+	mockTenantSvc := &MockTenantSvc{}
+	mockReadinessSvc := &testhelpers.MockReadinessSvc{}
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, mockReadinessSvc)
+
+	tenantParam := "param1=value1"
+	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
+		Return(tenantParam, nil)
+
+	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}], "totalRecords": 1}`
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.TenantsResponse)
+			_ = json.Unmarshal([]byte(responseBody), target)
+		}).
+		Return(nil)
+
+	mockHTTP.On("GetReturnStruct",
+		mock.MatchedBy(func(url string) bool {
+			return strings.Contains(url, "/entitlements?tenant=")
+		}),
+		mock.Anything,
+		mock.Anything).
+		Return(nil)
+
+	mockHTTP.On("PostReturnStruct",
+		mock.MatchedBy(func(url string) bool {
+			return strings.Contains(url, "/entitlements") && strings.Contains(url, tenantParam)
+		}),
+		mock.MatchedBy(func(payload []byte) bool {
+			var data map[string]any
+			_ = json.Unmarshal(payload, &data)
+			apps := data["applications"].([]any)
+			return data["tenantId"] == "tenant-123" && len(apps) == 1 && apps[0] == "app-123"
+		}),
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(3).(*models.TenantEntitlementResponse)
+			target.FlowID = "flow-123"
+			target.TotalRecords = 1
+		}).
+		Return(nil)
+
+	mockReadinessSvc.On("WaitForCapabilities", "test-tenant").Return(assert.AnError)
+
+	// Act
+	err := svc.CreateTenantEntitlement(ctx, "test-consortium", constant.TenantType(constant.Member))
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	mockHTTP.AssertExpectations(t)
+	mockTenantSvc.AssertExpectations(t)
+	mockReadinessSvc.AssertExpectations(t)
 }
 
 func TestCreateTenantEntitlement_GetParametersError(t *testing.T) {
@@ -947,14 +1055,14 @@ func TestCreateTenantEntitlement_GetParametersError(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("failed to get parameters")
 	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
 		Return("", expectedError)
 
 	// Act
-	err := svc.CreateTenantEntitlement("test-consortium", constant.TenantType(constant.Member))
+	err := svc.CreateTenantEntitlement(context.Background(), "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.Error(t, err)
@@ -966,13 +1074,13 @@ func TestRemoveTenantEntitlements_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	action.ConfigApplicationID = "app-123"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}], "totalRecords": 1}`
 	mockHTTP.On("GetRetryReturnStruct",
@@ -1004,20 +1112,58 @@ func TestRemoveTenantEntitlements_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.RemoveTenantEntitlements("test-consortium", constant.TenantType(constant.Member), true)
+	err := svc.RemoveTenantEntitlements(ctx, "test-consortium", constant.TenantType(constant.Member), true)
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestRemoveTenantEntitlement_OnlyRemovesNamedTenant(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigApplicationID = "app-123"
+	svc := managementsvc.New(action, mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+
+	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}, {"id": "tenant-456", "name": "other-tenant"}], "totalRecords": 2}`
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.TenantsResponse)
+			_ = json.Unmarshal([]byte(responseBody), target)
+		}).
+		Return(nil)
+	mockHTTP.On("DeleteWithPayloadReturnStruct",
+		mock.Anything,
+		mock.MatchedBy(func(payload []byte) bool {
+			var data map[string]any
+			_ = json.Unmarshal(payload, &data)
+			return data["tenantId"] == "tenant-123" && data["applications"].([]any)[0] == "app-combined-1.0.0"
+		}),
+		mock.Anything,
+		mock.Anything).
+		Return(nil)
+
+	// Act
+	err := svc.RemoveTenantEntitlement(ctx, "test-tenant", "app-combined-1.0.0", true)
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
+	mockHTTP.AssertNumberOfCalls(t, "DeleteWithPayloadReturnStruct", 1)
 }
 
 func TestRemoveTenantEntitlements_GetTenantsError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("failed to get tenants")
 	mockHTTP.On("GetRetryReturnStruct",
@@ -1027,7 +1173,7 @@ func TestRemoveTenantEntitlements_GetTenantsError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveTenantEntitlements("test-consortium", constant.TenantType(constant.Member), false)
+	err := svc.RemoveTenantEntitlements(ctx, "test-consortium", constant.TenantType(constant.Member), false)
 
 	// Assert
 	assert.Error(t, err)
@@ -1039,9 +1185,9 @@ func TestGetModuleDiscovery_NilResponse(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetReturnStruct",
 		mock.Anything,
@@ -1050,7 +1196,7 @@ func TestGetModuleDiscovery_NilResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetModuleDiscovery("mod-test")
+	result, err := svc.GetModuleDiscovery(ctx, "mod-test")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1062,9 +1208,9 @@ func TestUpdateModuleDiscovery_EdgeModule(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	moduleID := "edge-test-1.0.0"
 	privatePort := 8080
@@ -1082,7 +1228,7 @@ func TestUpdateModuleDiscovery_EdgeModule(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.UpdateModuleDiscovery(moduleID, true, privatePort, "")
+	err := svc.UpdateModuleDiscovery(ctx, moduleID, true, privatePort, "")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1093,12 +1239,12 @@ func TestCreateTenants_NoConsortium(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"standalone-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"standalone-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetRetryReturnStruct",
 		mock.MatchedBy(func(url string) bool {
@@ -1127,7 +1273,7 @@ func TestCreateTenants_NoConsortium(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateTenants()
+	err := svc.CreateTenants(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1138,12 +1284,12 @@ func TestRemoveTenants_TenantNotInConfig(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"other-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"other-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant", "description": "test-consortium-member"}], "totalRecords": 1}`
 
@@ -1158,7 +1304,7 @@ func TestRemoveTenants_TenantNotInConfig(t *testing.T) {
 		Return(nil)
 
 	// Act - should not call Delete since "test-tenant" is not in ConfigTenants
-	err := svc.RemoveTenants("test-consortium", constant.TenantType(constant.Member))
+	err := svc.RemoveTenants(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.NoError(t, err)
@@ -1167,16 +1313,55 @@ func TestRemoveTenants_TenantNotInConfig(t *testing.T) {
 	mockHTTP.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
 }
 
+func TestRemoveTenant_OnlyRemovesNamedTenant(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant":  {},
+		"other-tenant": {},
+	}
+	svc := managementsvc.New(action, mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+
+	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}, {"id": "tenant-456", "name": "other-tenant"}], "totalRecords": 2}`
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.MatchedBy(func(url string) bool {
+			return strings.Contains(url, "cql.allRecords")
+		}),
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.TenantsResponse)
+			_ = json.Unmarshal([]byte(responseBody), target)
+		}).
+		Return(nil)
+	mockHTTP.On("Delete",
+		mock.MatchedBy(func(url string) bool {
+			return strings.Contains(url, "/tenants/tenant-123")
+		}),
+		mock.Anything).
+		Return(nil)
+
+	// Act
+	err := svc.RemoveTenant(ctx, "test-tenant")
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+	mockHTTP.AssertNumberOfCalls(t, "Delete", 1)
+}
+
 func TestCreateTenantEntitlement_TenantNotInConfig(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"other-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"other-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	tenantParam := "param1=value1"
 	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
@@ -1194,7 +1379,7 @@ func TestCreateTenantEntitlement_TenantNotInConfig(t *testing.T) {
 		Return(nil)
 
 	// Act - should not call PostReturnNoContent since "test-tenant" is not in ConfigTenants
-	err := svc.CreateTenantEntitlement("test-consortium", constant.TenantType(constant.Member))
+	err := svc.CreateTenantEntitlement(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.NoError(t, err)
@@ -1208,12 +1393,12 @@ func TestRemoveTenantEntitlements_TenantNotInConfig(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"other-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"other-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}], "totalRecords": 1}`
 	mockHTTP.On("GetRetryReturnStruct",
@@ -1227,7 +1412,7 @@ func TestRemoveTenantEntitlements_TenantNotInConfig(t *testing.T) {
 		Return(nil)
 
 	// Act - should not call DeleteWithBody since "test-tenant" is not in ConfigTenants
-	err := svc.RemoveTenantEntitlements("test-consortium", constant.TenantType(constant.Member), false)
+	err := svc.RemoveTenantEntitlements(ctx, "test-consortium", constant.TenantType(constant.Member), false)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1240,9 +1425,9 @@ func TestGetApplications_DecodeError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Simulate error from GetReturnStruct (e.g., decode error)
 	expectedError := errors.New("decode error: invalid character")
@@ -1254,7 +1439,7 @@ func TestGetApplications_DecodeError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetApplications()
+	result, err := svc.GetApplications(ctx)
 
 	// Assert
 	assert.Error(t, err)
@@ -1267,9 +1452,9 @@ func TestGetModuleDiscovery_DecodeError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Simulate error from GetReturnStruct (e.g., decode error)
 	expectedError := errors.New("decode error: invalid character")
@@ -1281,7 +1466,7 @@ func TestGetModuleDiscovery_DecodeError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetModuleDiscovery("mod-test")
+	result, err := svc.GetModuleDiscovery(ctx, "mod-test")
 
 	// Assert
 	assert.Error(t, err)
@@ -1294,12 +1479,12 @@ func TestRemoveTenants_DeleteError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}], "totalRecords": 1}`
 
@@ -1320,7 +1505,7 @@ func TestRemoveTenants_DeleteError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveTenants("test-consortium", constant.TenantType(constant.Member))
+	err := svc.RemoveTenants(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.Error(t, err)
@@ -1332,13 +1517,13 @@ func TestCreateTenantEntitlement_PostError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	action.ConfigApplicationID = "app-123"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
 		Return("params", nil)
@@ -1371,7 +1556,7 @@ func TestCreateTenantEntitlement_PostError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateTenantEntitlement("test-consortium", constant.TenantType(constant.Member))
+	err := svc.CreateTenantEntitlement(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.Error(t, err)
@@ -1384,13 +1569,13 @@ func TestRemoveTenantEntitlements_DeleteError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	action.ConfigApplicationID = "app-123"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	responseBody := `{"tenants": [{"id": "tenant-123", "name": "test-tenant"}], "totalRecords": 1}`
 	mockHTTP.On("GetRetryReturnStruct",
@@ -1412,7 +1597,7 @@ func TestRemoveTenantEntitlements_DeleteError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveTenantEntitlements("test-consortium", constant.TenantType(constant.Member), false)
+	err := svc.RemoveTenantEntitlements(ctx, "test-consortium", constant.TenantType(constant.Member), false)
 
 	// Assert
 	assert.Error(t, err)
@@ -1424,12 +1609,12 @@ func TestCreateApplication_MinimalSuccess(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Create minimal extract with one backend module
 	version := "1.0.0"
@@ -1504,7 +1689,7 @@ func TestCreateApplication_MinimalSuccess(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1515,12 +1700,12 @@ func TestCreateApplication_WithFrontendModule(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -1575,7 +1760,7 @@ func TestCreateApplication_WithFrontendModule(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1586,12 +1771,12 @@ func TestCreateApplication_SkipsManagementModule(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -1648,7 +1833,7 @@ func TestCreateApplication_SkipsManagementModule(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1659,12 +1844,12 @@ func TestCreateApplication_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		Modules: &models.ProxyModulesByRegistry{
@@ -1694,7 +1879,7 @@ func TestCreateApplication_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.Error(t, err)
@@ -1706,12 +1891,12 @@ func TestCreateApplication_WithModuleVersionOverride(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	originalVersion := "1.0.0"
 	overrideVersion := "2.0.0"
@@ -1788,7 +1973,7 @@ func TestCreateApplication_WithModuleVersionOverride(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1799,13 +1984,13 @@ func TestCreateApplication_WithFetchDescriptorsFromRemote(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	action.ConfigApplicationFetchDescriptors = true // Enable descriptor fetching
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -1894,7 +2079,7 @@ func TestCreateApplication_WithFetchDescriptorsFromRemote(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1905,13 +2090,13 @@ func TestCreateApplication_FetchDescriptorError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	action.ConfigApplicationFetchDescriptors = true
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -1945,7 +2130,7 @@ func TestCreateApplication_FetchDescriptorError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.Error(t, err)
@@ -1957,7 +2142,7 @@ func TestCreateApplication_WithDependencies(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
@@ -1966,7 +2151,7 @@ func TestCreateApplication_WithDependencies(t *testing.T) {
 		"dependency2": "value2",
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		Modules: &models.ProxyModulesByRegistry{
@@ -2008,7 +2193,7 @@ func TestCreateApplication_WithDependencies(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2020,12 +2205,12 @@ func TestGetTenantType_NoConsortium(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
-	entry := map[string]any{}
+	tenant := config.Tenant{}
 
 	// Act
-	result := svc.GetTenantType(entry)
+	result := svc.GetTenantType(tenant)
 
 	// Assert
 	assert.Equal(t, "nop-default", result)
@@ -2036,15 +2221,15 @@ func TestGetTenantType_MemberTenant(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
-	entry := map[string]any{
-		"consortium":     "test-consortium",
-		"central-tenant": false,
+	tenant := config.Tenant{
+		Consortium:    "test-consortium",
+		CentralTenant: false,
 	}
 
 	// Act
-	result := svc.GetTenantType(entry)
+	result := svc.GetTenantType(tenant)
 
 	// Assert
 	assert.Equal(t, "test-consortium-member", result)
@@ -2055,15 +2240,15 @@ func TestGetTenantType_CentralTenant(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
-	entry := map[string]any{
-		"consortium":     "test-consortium",
-		"central-tenant": true,
+	tenant := config.Tenant{
+		Consortium:    "test-consortium",
+		CentralTenant: true,
 	}
 
 	// Act
-	result := svc.GetTenantType(entry)
+	result := svc.GetTenantType(tenant)
 
 	// Assert
 	assert.Equal(t, "test-consortium-central", result)
@@ -2074,15 +2259,15 @@ func TestGetTenantType_EmptyConsortiumName(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
-	entry := map[string]any{
-		"consortium":     "",
-		"central-tenant": true,
+	tenant := config.Tenant{
+		Consortium:    "",
+		CentralTenant: true,
 	}
 
 	// Act
-	result := svc.GetTenantType(entry)
+	result := svc.GetTenantType(tenant)
 
 	// Assert
 	assert.Equal(t, "nop-default", result)
@@ -2093,14 +2278,14 @@ func TestGetTenantType_MissingCentralTenantField(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
-	entry := map[string]any{
-		"consortium": "test-consortium",
+	tenant := config.Tenant{
+		Consortium: "test-consortium",
 	}
 
 	// Act
-	result := svc.GetTenantType(entry)
+	result := svc.GetTenantType(tenant)
 
 	// Assert
 	// When central-tenant field is missing, defaults to member
@@ -2111,12 +2296,12 @@ func TestCreateApplication_SkipsModuleNotInConfig(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2170,7 +2355,7 @@ func TestCreateApplication_SkipsModuleNotInConfig(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2181,12 +2366,12 @@ func TestCreateApplication_SkipsModuleWithDeployFalse(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2243,23 +2428,73 @@ func TestCreateApplication_SkipsModuleWithDeployFalse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertExpectations(t)
 }
 
+func TestBuildApplicationPayload_DoesNotCallManagementAPI(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.ConfigApplicationID = "test-app"
+	action.ConfigApplicationName = "Test Application"
+	action.ConfigApplicationVersion = "1.0.0"
+	mockTenantSvc := &MockTenantSvc{}
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
+
+	version := "1.0.0"
+	extract := &models.RegistryExtract{
+		Modules: &models.ProxyModulesByRegistry{
+			FolioModules: []*models.ProxyModule{
+				{
+					ID: "mod-test-1.0.0",
+					Metadata: models.ProxyModuleMetadata{
+						Name:        "mod-test",
+						Version:     &version,
+						SidecarName: "mod-test-sc",
+					},
+				},
+			},
+			EurekaModules: []*models.ProxyModule{},
+		},
+		BackendModules: map[string]models.BackendModule{
+			"mod-test": {
+				DeployModule: true,
+				PrivatePort:  8080,
+			},
+		},
+		FrontendModules:   map[string]models.FrontendModule{},
+		ModuleDescriptors: map[string]any{},
+	}
+
+	// Act
+	payload, err := svc.BuildApplicationPayload(context.Background(), extract)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "test-app", payload.Descriptor["id"])
+	assert.Equal(t, "Test Application", payload.Descriptor["name"])
+	assert.Len(t, payload.BackendModules, 1)
+	assert.Equal(t, "mod-test", payload.BackendModules[0]["name"])
+	assert.Empty(t, payload.FrontendModules)
+	assert.Len(t, payload.DiscoveryModules, 1)
+	mockHTTP.AssertNotCalled(t, "PostReturnStruct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestCreateApplication_WithEurekaModules(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2329,7 +2564,7 @@ func TestCreateApplication_WithEurekaModules(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2340,12 +2575,12 @@ func TestCreateApplication_DiscoveryPostError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2407,7 +2642,7 @@ func TestCreateApplication_DiscoveryPostError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.Error(t, err)
@@ -2419,13 +2654,13 @@ func TestCreateApplication_WithModuleURLs(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	action.ConfigApplicationFetchDescriptors = false // Don't fetch descriptors
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2501,7 +2736,7 @@ func TestCreateApplication_WithModuleURLs(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2512,13 +2747,13 @@ func TestCreateApplication_FrontendModuleWithFetchDescriptors(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	action.ConfigApplicationFetchDescriptors = true
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2591,7 +2826,7 @@ func TestCreateApplication_FrontendModuleWithFetchDescriptors(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2602,13 +2837,13 @@ func TestCreateApplication_FrontendModuleWithURL(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	action.ConfigApplicationFetchDescriptors = false // Don't fetch
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2669,7 +2904,7 @@ func TestCreateApplication_FrontendModuleWithURL(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2680,12 +2915,12 @@ func TestCreateApplication_FrontendVersionOverride(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	originalVersion := "1.0.0"
 	overrideVersion := "3.0.0"
@@ -2747,7 +2982,7 @@ func TestCreateApplication_FrontendVersionOverride(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2758,12 +2993,12 @@ func TestCreateApplication_MixedBackendAndFrontend(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	version := "1.0.0"
 	extract := &models.RegistryExtract{
@@ -2852,7 +3087,7 @@ func TestCreateApplication_MixedBackendAndFrontend(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2863,12 +3098,12 @@ func TestCreateApplication_BothModulesBackendVersionOverride(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	originalVersion := "1.0.0"
 	backendOverrideVersion := "2.0.0"
@@ -2953,7 +3188,7 @@ func TestCreateApplication_BothModulesBackendVersionOverride(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -2964,12 +3199,12 @@ func TestCreateApplication_BothModulesFrontendVersionOverride(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	originalVersion := "1.0.0"
 	frontendOverrideVersion := "3.0.0"
@@ -3055,7 +3290,7 @@ func TestCreateApplication_BothModulesFrontendVersionOverride(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3066,12 +3301,12 @@ func TestCreateApplication_BothModulesBothVersionOverrides(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	action.ConfigApplicationName = "Test Application"
 	action.ConfigApplicationVersion = "1.0.0"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	originalVersion := "1.0.0"
 	backendOverrideVersion := "2.5.0"
@@ -3158,7 +3393,7 @@ func TestCreateApplication_BothModulesBothVersionOverrides(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3169,7 +3404,7 @@ func TestFetchModuleDescriptor_RemoteModule_Success(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		ModuleDescriptors: make(map[string]any),
@@ -3193,7 +3428,7 @@ func TestFetchModuleDescriptor_RemoteModule_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.FetchModuleDescriptor(extract, moduleID, moduleDescriptorURL, "", false)
+	err := svc.FetchModuleDescriptor(context.Background(), extract, moduleID, moduleDescriptorURL, "", false)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3206,7 +3441,7 @@ func TestFetchModuleDescriptor_RemoteModule_HTTPError(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		ModuleDescriptors: make(map[string]any),
@@ -3222,7 +3457,7 @@ func TestFetchModuleDescriptor_RemoteModule_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.FetchModuleDescriptor(extract, moduleID, moduleDescriptorURL, "", false)
+	err := svc.FetchModuleDescriptor(context.Background(), extract, moduleID, moduleDescriptorURL, "", false)
 
 	// Assert
 	assert.Error(t, err)
@@ -3236,7 +3471,7 @@ func TestFetchModuleDescriptor_LocalBackendModule_Success(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		ModuleDescriptors: make(map[string]any),
@@ -3249,7 +3484,7 @@ func TestFetchModuleDescriptor_LocalBackendModule_Success(t *testing.T) {
 	})
 
 	// Act
-	err := svc.FetchModuleDescriptor(extract, moduleID, "", testFile, true)
+	err := svc.FetchModuleDescriptor(context.Background(), extract, moduleID, "", testFile, true)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3264,7 +3499,7 @@ func TestFetchModuleDescriptor_LocalFrontendModule_Success(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		ModuleDescriptors: make(map[string]any),
@@ -3277,7 +3512,7 @@ func TestFetchModuleDescriptor_LocalFrontendModule_Success(t *testing.T) {
 	})
 
 	// Act
-	err := svc.FetchModuleDescriptor(extract, moduleID, "", testFile, true)
+	err := svc.FetchModuleDescriptor(context.Background(), extract, moduleID, "", testFile, true)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3292,7 +3527,7 @@ func TestFetchModuleDescriptor_LocalModule_FileNotFound(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		ModuleDescriptors: make(map[string]any),
@@ -3300,7 +3535,7 @@ func TestFetchModuleDescriptor_LocalModule_FileNotFound(t *testing.T) {
 	moduleID := "mod-test-1.0.0"
 
 	// Act
-	err := svc.FetchModuleDescriptor(extract, moduleID, "", "/nonexistent/path.json", true)
+	err := svc.FetchModuleDescriptor(context.Background(), extract, moduleID, "", "/nonexistent/path.json", true)
 
 	// Assert
 	assert.Error(t, err)
@@ -3312,7 +3547,7 @@ func TestFetchModuleDescriptor_LocalModule_InvalidJSON(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		ModuleDescriptors: make(map[string]any),
@@ -3321,7 +3556,7 @@ func TestFetchModuleDescriptor_LocalModule_InvalidJSON(t *testing.T) {
 	testFile := testhelpers.CreateTempFile(t, "invalid json content {")
 
 	// Act
-	err := svc.FetchModuleDescriptor(extract, moduleID, "", testFile, true)
+	err := svc.FetchModuleDescriptor(context.Background(), extract, moduleID, "", testFile, true)
 
 	// Assert
 	assert.Error(t, err)
@@ -3334,9 +3569,9 @@ func TestGetTenantEntitlements_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	tenantName := "test-tenant"
 	includeModules := true
@@ -3370,7 +3605,7 @@ func TestGetTenantEntitlements_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetTenantEntitlements(tenantName, includeModules)
+	result, err := svc.GetTenantEntitlements(ctx, tenantName, includeModules)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3385,9 +3620,9 @@ func TestGetTenantEntitlements_WithoutModules(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	tenantName := "test-tenant"
 	includeModules := false
@@ -3417,7 +3652,7 @@ func TestGetTenantEntitlements_WithoutModules(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetTenantEntitlements(tenantName, includeModules)
+	result, err := svc.GetTenantEntitlements(ctx, tenantName, includeModules)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3430,12 +3665,11 @@ func TestGetTenantEntitlements_HeaderError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Invalid token
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	result, err := svc.GetTenantEntitlements("test-tenant", true)
+	result, err := svc.GetTenantEntitlements(context.Background(), "test-tenant", true)
 
 	// Assert
 	assert.Error(t, err)
@@ -3446,16 +3680,16 @@ func TestGetTenantEntitlements_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("network error")
 	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetTenantEntitlements("test-tenant", true)
+	result, err := svc.GetTenantEntitlements(ctx, "test-tenant", true)
 
 	// Assert
 	assert.Error(t, err)
@@ -3468,9 +3702,9 @@ func TestGetTenantEntitlements_EmptyResponse(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedResponse := models.TenantEntitlementResponse{
 		TotalRecords: 0,
@@ -3485,7 +3719,7 @@ func TestGetTenantEntitlements_EmptyResponse(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetTenantEntitlements("test-tenant", false)
+	result, err := svc.GetTenantEntitlements(ctx, "test-tenant", false)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3500,10 +3734,10 @@ func TestGetLatestApplication_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationName = "test-app"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedApp := map[string]any{
 		"id":      "test-app-1.0.0",
@@ -3534,7 +3768,7 @@ func TestGetLatestApplication_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetLatestApplication()
+	result, err := svc.GetLatestApplication(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3549,12 +3783,11 @@ func TestGetLatestApplication_HeaderError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "" // Invalid token
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	// Act
-	result, err := svc.GetLatestApplication()
+	result, err := svc.GetLatestApplication(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -3565,17 +3798,17 @@ func TestGetLatestApplication_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationName = "test-app"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("network error")
 	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
 	// Act
-	result, err := svc.GetLatestApplication()
+	result, err := svc.GetLatestApplication(ctx)
 
 	// Assert
 	assert.Error(t, err)
@@ -3588,10 +3821,10 @@ func TestGetLatestApplication_MultipleVersions(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationName = "test-app"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	latestApp := map[string]any{
 		"id":      "test-app-2.0.0",
@@ -3611,7 +3844,7 @@ func TestGetLatestApplication_MultipleVersions(t *testing.T) {
 		Return(nil)
 
 	// Act
-	result, err := svc.GetLatestApplication()
+	result, err := svc.GetLatestApplication(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3624,10 +3857,10 @@ func TestUpgradeTenantEntitlement_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{"tenant1": map[string]any{"name": "tenant1"}}
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{"tenant1": {}}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockTenantSvc.On("GetEntitlementTenantParameters", "consortium1").Return("param1=value1", nil)
 
@@ -3655,7 +3888,7 @@ func TestUpgradeTenantEntitlement_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.UpgradeTenantEntitlement("consortium1", constant.Member, "new-app-id")
+	err := svc.UpgradeTenantEntitlement(ctx, "consortium1", constant.Member, "new-app-id")
 
 	// Assert
 	assert.NoError(t, err)
@@ -3668,13 +3901,13 @@ func TestUpgradeTenantEntitlement_TenantParametersError(t *testing.T) {
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("failed to get parameters")
 	mockTenantSvc.On("GetEntitlementTenantParameters", "consortium1").Return("", expectedError)
 
 	// Act
-	err := svc.UpgradeTenantEntitlement("consortium1", constant.Member, "new-app-id")
+	err := svc.UpgradeTenantEntitlement(context.Background(), "consortium1", constant.Member, "new-app-id")
 
 	// Assert
 	assert.Error(t, err)
@@ -3687,10 +3920,10 @@ func TestUpgradeTenantEntitlement_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{"tenant1": map[string]any{}}
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{"tenant1": {}}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockTenantSvc.On("GetEntitlementTenantParameters", "consortium1").Return("params", nil)
 
@@ -3706,7 +3939,7 @@ func TestUpgradeTenantEntitlement_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.UpgradeTenantEntitlement("consortium1", constant.Member, "new-app-id")
+	err := svc.UpgradeTenantEntitlement(ctx, "consortium1", constant.Member, "new-app-id")
 
 	// Assert
 	assert.Error(t, err)
@@ -3719,9 +3952,9 @@ func TestCreateNewApplication_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	request := &models.ApplicationUpgradeRequest{
 		ApplicationName:       "test-app",
@@ -3747,7 +3980,7 @@ func TestCreateNewApplication_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateNewApplication(request)
+	err := svc.CreateNewApplication(ctx, request)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3758,9 +3991,9 @@ func TestCreateNewApplication_WithBuild(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	request := &models.ApplicationUpgradeRequest{
 		ApplicationName:              "test-app",
@@ -3788,7 +4021,7 @@ func TestCreateNewApplication_WithBuild(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateNewApplication(request)
+	err := svc.CreateNewApplication(ctx, request)
 
 	// Assert
 	assert.NoError(t, err)
@@ -3799,9 +4032,8 @@ func TestCreateNewApplication_HeaderError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = ""
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	request := &models.ApplicationUpgradeRequest{
 		ApplicationName:       "test-app",
@@ -3810,7 +4042,7 @@ func TestCreateNewApplication_HeaderError(t *testing.T) {
 	}
 
 	// Act
-	err := svc.CreateNewApplication(request)
+	err := svc.CreateNewApplication(context.Background(), request)
 
 	// Assert
 	assert.Error(t, err)
@@ -3822,9 +4054,9 @@ func TestCreateNewApplication_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	request := &models.ApplicationUpgradeRequest{
 		ApplicationName:       "test-app",
@@ -3838,7 +4070,7 @@ func TestCreateNewApplication_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateNewApplication(request)
+	err := svc.CreateNewApplication(ctx, request)
 
 	// Assert
 	assert.Error(t, err)
@@ -3850,9 +4082,9 @@ func TestRemoveApplications_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetReturnStruct",
 		mock.MatchedBy(func(url string) bool { return strings.Contains(url, "/applications") }),
@@ -3879,7 +4111,7 @@ func TestRemoveApplications_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.RemoveApplications("test-app", "ignore-app")
+	err := svc.RemoveApplications(ctx, "test-app", "ignore-app")
 
 	// Assert
 	assert.NoError(t, err)
@@ -3893,16 +4125,16 @@ func TestRemoveApplications_GetApplicationsError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	expectedError := errors.New("failed to get applications")
 	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveApplications("test-app", "ignore-app")
+	err := svc.RemoveApplications(ctx, "test-app", "ignore-app")
 
 	// Assert
 	assert.Error(t, err)
@@ -3910,38 +4142,13 @@ func TestRemoveApplications_GetApplicationsError(t *testing.T) {
 	mockHTTP.AssertNotCalled(t, "Delete")
 }
 
-func TestRemoveApplications_HeaderError(t *testing.T) {
-	// Arrange
-	mockHTTP := &testhelpers.MockHTTPClient{}
-	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
-
-	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).
-		Run(func(args mock.Arguments) {
-			target := args.Get(2).(*models.ApplicationsResponse)
-			target.ApplicationDescriptors = []map[string]any{{"id": "app-1"}}
-		}).
-		Return(nil)
-
-	// Act - set token to empty after GetApplications succeeds
-	action.KeycloakMasterAccessToken = ""
-	err := svc.RemoveApplications("test-app", "ignore-app")
-
-	// Assert
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "access token")
-	mockHTTP.AssertNotCalled(t, "Delete")
-}
-
 func TestRemoveApplications_DeleteError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).
 		Run(func(args mock.Arguments) {
@@ -3955,7 +4162,7 @@ func TestRemoveApplications_DeleteError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.RemoveApplications("test-app", "ignore-app")
+	err := svc.RemoveApplications(ctx, "test-app", "ignore-app")
 
 	// Assert
 	assert.Error(t, err)
@@ -3967,9 +4174,9 @@ func TestCreateNewModuleDiscovery_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	discoveryModules := []map[string]string{
 		{"id": "mod-1-1.0.0", "name": "mod-1", "version": "1.0.0", "location": "http://mod-1:8080"},
@@ -3992,7 +4199,7 @@ func TestCreateNewModuleDiscovery_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateNewModuleDiscovery(discoveryModules)
+	err := svc.CreateNewModuleDiscovery(ctx, discoveryModules)
 
 	// Assert
 	assert.NoError(t, err)
@@ -4003,9 +4210,9 @@ func TestCreateNewModuleDiscovery_EmptyModules(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	discoveryModules := []map[string]string{}
 
@@ -4017,7 +4224,7 @@ func TestCreateNewModuleDiscovery_EmptyModules(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateNewModuleDiscovery(discoveryModules)
+	err := svc.CreateNewModuleDiscovery(ctx, discoveryModules)
 
 	// Assert
 	assert.NoError(t, err)
@@ -4028,14 +4235,13 @@ func TestCreateNewModuleDiscovery_HeaderError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = ""
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	discoveryModules := []map[string]string{{"id": "mod-1"}}
 
 	// Act
-	err := svc.CreateNewModuleDiscovery(discoveryModules)
+	err := svc.CreateNewModuleDiscovery(context.Background(), discoveryModules)
 
 	// Assert
 	assert.Error(t, err)
@@ -4047,9 +4253,9 @@ func TestCreateNewModuleDiscovery_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	discoveryModules := []map[string]string{{"id": "mod-1", "name": "mod-1"}}
 	expectedError := errors.New("HTTP request failed")
@@ -4058,7 +4264,7 @@ func TestCreateNewModuleDiscovery_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.CreateNewModuleDiscovery(discoveryModules)
+	err := svc.CreateNewModuleDiscovery(ctx, discoveryModules)
 
 	// Assert
 	assert.Error(t, err)
@@ -4072,14 +4278,14 @@ func TestCreateTenants_TenantAlreadyExists_Skipped(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{
-			"consortium": "test-consortium",
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {
+			Consortium: "test-consortium",
 		},
 	}
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockHTTP.On("GetRetryReturnStruct",
 		mock.MatchedBy(func(url string) bool {
@@ -4094,7 +4300,7 @@ func TestCreateTenants_TenantAlreadyExists_Skipped(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateTenants()
+	err := svc.CreateTenants(ctx)
 
 	// Assert
 	assert.NoError(t, err)
@@ -4105,10 +4311,10 @@ func TestCreateApplication_AlreadyExists_Skipped(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
 	action.ConfigApplicationID = "test-app"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	extract := &models.RegistryExtract{
 		Modules: &models.ProxyModulesByRegistry{
@@ -4133,7 +4339,7 @@ func TestCreateApplication_AlreadyExists_Skipped(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateApplication(extract)
+	err := svc.CreateApplication(ctx, extract)
 
 	// Assert
 	assert.NoError(t, err)
@@ -4144,13 +4350,13 @@ func TestCreateTenantEntitlement_AlreadyEntitled_Skipped(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakMasterAccessToken = "test-token"
-	action.ConfigTenants = map[string]any{
-		"test-tenant": map[string]any{},
+	ctx := helpers.WithMasterAccessToken(context.Background(), "test-token")
+	action.ConfigTenants = map[string]config.Tenant{
+		"test-tenant": {},
 	}
 	action.ConfigApplicationID = "app-123"
 	mockTenantSvc := &MockTenantSvc{}
-	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc, &testhelpers.MockReadinessSvc{})
 
 	mockTenantSvc.On("GetEntitlementTenantParameters", "test-consortium").
 		Return("param1=value1", nil)
@@ -4182,10 +4388,235 @@ func TestCreateTenantEntitlement_AlreadyEntitled_Skipped(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.CreateTenantEntitlement("test-consortium", constant.TenantType(constant.Member))
+	err := svc.CreateTenantEntitlement(ctx, "test-consortium", constant.TenantType(constant.Member))
 
 	// Assert
 	assert.NoError(t, err)
 	mockHTTP.AssertNotCalled(t, "PostReturnStruct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	mockTenantSvc.AssertExpectations(t)
 }
+
+// ==================== Module Dependency Tests ====================
+
+func newTestModuleDescriptor(id string, provides, requires, optional []any) map[string]any {
+	return map[string]any{"id": id, "provides": provides, "requires": requires, "optional": optional}
+}
+
+func newTestInterface(id, version string) map[string]any {
+	return map[string]any{"id": id, "version": version}
+}
+
+func TestCheckModuleDependencies_AllSatisfied(t *testing.T) {
+	// Arrange
+	svc := managementsvc.New(testhelpers.NewMockAction(), &testhelpers.MockHTTPClient{}, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	moduleDescriptors := []any{
+		newTestModuleDescriptor("mod-orders-13.0.0", nil, []any{newTestInterface("users", "15.0 16.1"), newTestInterface("finance.funds", "3.2.1")}, nil),
+		newTestModuleDescriptor("mod-users-19.5.0", []any{newTestInterface("users", "16.4")}, nil, nil),
+	}
+	parentModuleDescriptors := []any{newTestModuleDescriptor("mod-finance-5.0.0", []any{newTestInterface("finance.funds", "3.2.4")}, nil, nil)}
+
+	// Act
+	result := svc.CheckModuleDependencies(moduleDescriptors, parentModuleDescriptors)
+
+	// Assert
+	assert.Empty(t, result)
+}
+
+func TestCheckModuleDependencies_ReportsUnsatisfiedInterfaces(t *testing.T) {
+	// Arrange
+	svc := managementsvc.New(testhelpers.NewMockAction(), &testhelpers.MockHTTPClient{}, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	moduleDescriptors := []any{
+		newTestModuleDescriptor("mod-orders-13.0.0",
+			nil,
+			[]any{newTestInterface("orders-storage.po-lines", "12.0"), newTestInterface("finance.funds", "3.0"), newTestInterface("users", "16.5")},
+			[]any{newTestInterface("acquisitions-units", "1.0"), newTestInterface("configuration", "3.0")}),
+		newTestModuleDescriptor("mod-orders-storage-13.0.0", []any{newTestInterface("orders-storage.po-lines", "11.1")}, nil, nil),
+		newTestModuleDescriptor("mod-users-19.5.0", []any{newTestInterface("users", "16.4")}, nil, nil),
+		newTestModuleDescriptor("mod-configuration-5.0.0", []any{newTestInterface("configuration", "2.0")}, nil, nil),
+	}
+
+	// Act
+	result := svc.CheckModuleDependencies(moduleDescriptors, nil)
+
+	// Assert
+	assert.Equal(t, []models.UnsatisfiedInterface{
+		{ModuleID: "mod-orders-13.0.0", Interface: "configuration", Version: "3.0", Optional: true, Provided: []string{"2.0"}},
+		{ModuleID: "mod-orders-13.0.0", Interface: "finance.funds", Version: "3.0", Provided: []string{}},
+		{ModuleID: "mod-orders-13.0.0", Interface: "orders-storage.po-lines", Version: "12.0", Provided: []string{"11.1"}},
+		{ModuleID: "mod-orders-13.0.0", Interface: "users", Version: "16.5", Provided: []string{"16.4"}},
+	}, result)
+}
+
+func TestResolveModuleDependencies_FollowsRequiredInterfaces(t *testing.T) {
+	// Arrange
+	svc := managementsvc.New(testhelpers.NewMockAction(), &testhelpers.MockHTTPClient{}, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	moduleDescriptors := []any{
+		newTestModuleDescriptor("mod-orders-13.0.0", nil,
+			[]any{newTestInterface("orders-storage.po-lines", "12.0"), newTestInterface("users", "16.0")},
+			[]any{newTestInterface("configuration", "2.0")}),
+		newTestModuleDescriptor("mod-orders-storage-13.0.0", []any{newTestInterface("orders-storage.po-lines", "12.1")}, []any{newTestInterface("users", "16.0")}, nil),
+		newTestModuleDescriptor("mod-users-19.5.0", []any{newTestInterface("users", "16.4")}, nil, nil),
+		newTestModuleDescriptor("mod-users-bl-8.0.0", []any{newTestInterface("users", "16.4")}, nil, nil),
+		newTestModuleDescriptor("mod-configuration-5.0.0", []any{newTestInterface("configuration", "2.0")}, nil, nil),
+	}
+
+	// Act
+	result, unsatisfied := svc.ResolveModuleDependencies(moduleDescriptors, []string{"mod-orders"}, nil)
+
+	// Assert
+	assert.Equal(t, []string{"mod-orders", "mod-orders-storage", "mod-users"}, result)
+	assert.Empty(t, unsatisfied)
+}
+
+func TestResolveModuleDependencies_PrefersResolvedAndPreferredModules(t *testing.T) {
+	// Arrange
+	svc := managementsvc.New(testhelpers.NewMockAction(), &testhelpers.MockHTTPClient{}, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	moduleDescriptors := []any{
+		newTestModuleDescriptor("mod-orders-13.0.0", nil, []any{newTestInterface("users", "16.0"), newTestInterface("login", "7.0")}, nil),
+		newTestModuleDescriptor("mod-login-7.3.0", []any{newTestInterface("login", "7.3")}, nil, nil),
+		newTestModuleDescriptor("mod-login-keycloak-3.0.0", []any{newTestInterface("login", "7.3")}, nil, nil),
+		newTestModuleDescriptor("mod-users-19.5.0", []any{newTestInterface("users", "16.4")}, nil, nil),
+		newTestModuleDescriptor("mod-users-keycloak-3.0.0", []any{newTestInterface("users", "16.4"), newTestInterface("users-keycloak", "3.0")}, nil, nil),
+	}
+
+	// Act
+	result, unsatisfied := svc.ResolveModuleDependencies(moduleDescriptors, []string{"mod-users-keycloak", "mod-orders"}, []string{"mod-login-keycloak"})
+
+	// Assert
+	assert.Equal(t, []string{"mod-users-keycloak", "mod-orders", "mod-login-keycloak"}, result)
+	assert.Empty(t, unsatisfied)
+}
+
+func TestResolveModuleDependencies_ReportsUnsatisfiedInterfaces(t *testing.T) {
+	// Arrange
+	svc := managementsvc.New(testhelpers.NewMockAction(), &testhelpers.MockHTTPClient{}, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	moduleDescriptors := []any{
+		newTestModuleDescriptor("mod-orders-13.0.0", nil, []any{newTestInterface("finance.funds", "3.0"), newTestInterface("users", "17.0")}, nil),
+		newTestModuleDescriptor("mod-users-19.5.0", []any{newTestInterface("users", "16.4")}, nil, nil),
+	}
+
+	// Act
+	result, unsatisfied := svc.ResolveModuleDependencies(moduleDescriptors, []string{"mod-orders"}, nil)
+
+	// Assert
+	assert.Equal(t, []string{"mod-orders"}, result)
+	assert.Equal(t, []models.UnsatisfiedInterface{
+		{ModuleID: "mod-orders-13.0.0", Interface: "finance.funds", Version: "3.0", Provided: []string{}},
+		{ModuleID: "mod-orders-13.0.0", Interface: "users", Version: "17.0", Provided: []string{"16.4"}},
+	}, unsatisfied)
+}
+
+func TestGetApplicationModuleDescriptors_ReusesLoadedDescriptors(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	svc := managementsvc.New(testhelpers.NewMockAction(), mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	loadedDescriptor := map[string]any{"id": "mod-users-19.5.0"}
+	fetchedDescriptor := map[string]any{"id": "folio_users-12.0.0"}
+	extract := &models.RegistryExtract{
+		BackendModules:    map[string]models.BackendModule{"mod-users": {}},
+		FrontendModules:   map[string]models.FrontendModule{"folio_users": {}},
+		ModuleDescriptors: map[string]any{"mod-users-19.5.0": loadedDescriptor},
+	}
+	applicationPayload := &models.ApplicationPayload{
+		BackendModules:  []map[string]string{{"id": "mod-users-19.5.0", "name": "mod-users"}},
+		FrontendModules: []map[string]string{{"id": "folio_users-12.0.0", "name": "folio_users"}},
+	}
+	mockHTTP.On("GetRetryReturnStruct", mock.MatchedBy(func(url string) bool {
+		return strings.HasSuffix(url, "/_/proxy/modules/folio_users-12.0.0")
+	}), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*any)
+			*target = fetchedDescriptor
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.GetApplicationModuleDescriptors(context.Background(), extract, applicationPayload)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []any{loadedDescriptor, fetchedDescriptor}, result)
+	mockHTTP.AssertNumberOfCalls(t, "GetRetryReturnStruct", 1)
+}
+
+func TestGetManagementModuleDescriptors_DeployedManagementModules(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	svc := managementsvc.New(testhelpers.NewMockAction(), mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	pinnedVersion := "4.1.0"
+	modules := &models.ProxyModulesByRegistry{
+		EurekaModules: []*models.ProxyModule{
+			{ID: "mgr-applications-4.0.0", Metadata: models.ProxyModuleMetadata{Name: "mgr-applications"}},
+			{ID: "mgr-tenants-4.0.0", Metadata: models.ProxyModuleMetadata{Name: "mgr-tenants"}},
+			{ID: "mgr-tenant-entitlements-4.0.0", Metadata: models.ProxyModuleMetadata{Name: "mgr-tenant-entitlements"}},
+			{ID: "mod-users-keycloak-3.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users-keycloak"}},
+		},
+	}
+	localDescriptorPath := testhelpers.CreateTempJSONFile(t, map[string]any{"id": "mgr-tenants-4.0.0"})
+	managementModules := map[string]models.BackendModule{
+		"mgr-applications":        {DeployModule: true, ModuleVersion: &pinnedVersion},
+		"mgr-tenants":             {DeployModule: true, LocalDescriptorPath: localDescriptorPath},
+		"mgr-tenant-entitlements": {DeployModule: false},
+	}
+	mockHTTP.On("GetRetryReturnStruct", mock.MatchedBy(func(url string) bool {
+		return strings.HasSuffix(url, "/_/proxy/modules/mgr-applications-4.1.0")
+	}), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*any)
+			*target = map[string]any{"id": "mgr-applications-4.1.0"}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.GetManagementModuleDescriptors(context.Background(), modules, managementModules)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"id": "mgr-applications-4.1.0"},
+		map[string]any{"id": "mgr-tenants-4.0.0"},
+	}, result)
+	mockHTTP.AssertNumberOfCalls(t, "GetRetryReturnStruct", 1)
+}
+
+func TestGetParentModuleDescriptors_Success(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithMasterAccessToken(context.Background(), "token")
+	svc := managementsvc.New(action, mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	mockHTTP.On("GetRetryReturnStruct", mock.MatchedBy(func(url string) bool {
+		return strings.HasSuffix(url, "/applications/app-combined-1.0.0?full=true")
+	}), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*map[string]any)
+			*target = map[string]any{"moduleDescriptors": []any{map[string]any{"id": "mod-users-19.5.0"}}}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.GetParentModuleDescriptors(ctx, []string{"app-combined-1.0.0"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"id": "mod-users-19.5.0"}}, result)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetParentModuleDescriptors_NotFound(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	ctx := helpers.WithMasterAccessToken(context.Background(), "token")
+	svc := managementsvc.New(action, mockHTTP, &MockTenantSvc{}, &testhelpers.MockReadinessSvc{})
+	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(apperrors.ErrHTTP404NotFound)
+
+	// Act
+	result, err := svc.GetParentModuleDescriptors(ctx, []string{"app-combined-1.0.0"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, apperrors.ErrDeploymentFailed))
+	assert.Contains(t, err.Error(), "app-combined-1.0.0")
+}
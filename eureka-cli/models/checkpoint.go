@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Checkpoint represents the persisted progress of a deployment pipeline
+type Checkpoint struct {
+	Profile   string           `json:"profile"`
+	Action    string           `json:"action"`
+	StartedAt time.Time        `json:"startedAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+	Steps     []CheckpointStep `json:"steps"`
+}
+
+// CheckpointStep represents a single completed step of a deployment pipeline
+type CheckpointStep struct {
+	Name        string    `json:"name"`
+	CompletedAt time.Time `json:"completedAt"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "fmt"
+
+// ConfigProblem represents a problem found at a precise location of a config file
+type ConfigProblem struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+	// Warning problems, e.g. a deprecated key, are reported without failing the validation
+	Warning bool `json:"warning,omitempty"`
+}
+
+func (p ConfigProblem) String() string {
+	if p.Warning {
+		return fmt.Sprintf("%s:%d:%d: %s: warning: %s", p.File, p.Line, p.Column, p.Path, p.Message)
+	}
+
+	return fmt.Sprintf("%s:%d:%d: %s: %s", p.File, p.Line, p.Column, p.Path, p.Message)
+}
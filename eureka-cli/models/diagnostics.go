@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// DiagnosticsFile represents a single file of the diagnostics bundle
+type DiagnosticsFile struct {
+	Name    string
+	Content []byte
+}
+
+// HostFacts represents the host and Docker daemon facts of the diagnostics bundle
+type HostFacts struct {
+	CollectedAt   time.Time `json:"collectedAt"`
+	Profile       string    `json:"profile"`
+	CLIVersion    string    `json:"cliVersion"`
+	CLICommit     string    `json:"cliCommit"`
+	OS            string    `json:"os"`
+	Arch          string    `json:"arch"`
+	CPUs          int       `json:"cpus"`
+	DockerVersion string    `json:"dockerVersion,omitempty"`
+	DockerMemory  int64     `json:"dockerMemory,omitempty"`
+}
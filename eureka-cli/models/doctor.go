@@ -0,0 +1,9 @@
+package models
+
+// DoctorCheck represents the result of a single host prerequisite check
+type DoctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// JournalEntry represents a resource created by the current run
+type JournalEntry struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Tenant string `json:"tenant,omitempty"`
+}
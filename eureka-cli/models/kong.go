@@ -19,3 +19,12 @@ type KongRoutesResponse struct {
 	Data []KongRoute `json:"data"`
 	Next string      `json:"next,omitempty"`
 }
+
+// ModuleRouteCoverage represents the Kong routes of a module compared with the handlers of its module descriptor
+type ModuleRouteCoverage struct {
+	ModuleID string   `json:"moduleId"`
+	Expected int      `json:"expected"`
+	Actual   int      `json:"actual"`
+	Missing  []string `json:"missing"`
+	Extra    []string `json:"extra"`
+}
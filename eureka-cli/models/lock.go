@@ -0,0 +1,32 @@
+package models
+
+// Lockfile pins the module versions, the sidecar version and the image digests of the environment of a profile
+type Lockfile struct {
+	Profile  string         `yaml:"profile"`
+	Platform LockedPlatform `yaml:"platform"`
+	Sidecar  LockedModule   `yaml:"sidecar"`
+	Modules  []LockedModule `yaml:"modules"`
+}
+
+// LockedPlatform identifies the LSP platform descriptor the module versions were resolved from
+type LockedPlatform struct {
+	URL     string `yaml:"url"`
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+// LockedModule pins a module ID and the digest of its image, frontend modules and locally built images have no digest
+type LockedModule struct {
+	ID      string `yaml:"id"`
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Image   string `yaml:"image,omitempty"`
+	Digest  string `yaml:"digest,omitempty"`
+}
+
+// LockChange represents a pin that is added, removed or changed when a lockfile is refreshed
+type LockChange struct {
+	Name     string `json:"name"`
+	Locked   string `json:"locked"`
+	Resolved string `json:"resolved"`
+}
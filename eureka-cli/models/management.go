@@ -1,5 +1,10 @@
 package models
 
+import (
+	"fmt"
+	"strings"
+)
+
 // ==================== Tenant Management ====================
 
 // TenantCreateRequest represents the payload for creating a new tenant
@@ -57,6 +62,14 @@ type ApplicationCreateRequest struct {
 	UIModuleDescriptors []any               `json:"uiModuleDescriptors"`
 }
 
+// ApplicationPayload represents the application descriptor and module discovery built from a registry extract
+type ApplicationPayload struct {
+	Descriptor       map[string]any      `json:"descriptor"`
+	BackendModules   []map[string]string `json:"-"`
+	FrontendModules  []map[string]string `json:"-"`
+	DiscoveryModules []map[string]string `json:"discovery"`
+}
+
 // ApplicationModule represents a module within an application
 type ApplicationModule struct {
 	ID      string `json:"id"`
@@ -113,6 +126,31 @@ type ModuleDiscovery struct {
 	Location string `json:"location"`
 }
 
+// ==================== Module Dependencies ====================
+
+// UnsatisfiedInterface represents an interface required by a module that no module provides in a compatible version,
+// the provided versions are the incompatible versions of the interface that are available
+type UnsatisfiedInterface struct {
+	ModuleID  string   `json:"moduleId"`
+	Interface string   `json:"interface"`
+	Version   string   `json:"version"`
+	Optional  bool     `json:"optional"`
+	Provided  []string `json:"provided"`
+}
+
+// String describes the interface and the module that needs it
+func (u UnsatisfiedInterface) String() string {
+	kind := "requires"
+	if u.Optional {
+		kind = "optionally requires"
+	}
+	if len(u.Provided) == 0 {
+		return fmt.Sprintf("%s %s %s %s, not provided", u.ModuleID, kind, u.Interface, u.Version)
+	}
+
+	return fmt.Sprintf("%s %s %s %s, provided %s", u.ModuleID, kind, u.Interface, u.Version, strings.Join(u.Provided, ", "))
+}
+
 // ==================== LSP Platform Descriptor ====================
 
 // PlatformDescriptor represents the LSP platform descriptor JSON payload
@@ -136,3 +174,11 @@ type PlatformApplication struct {
 	Name    string `json:"name"`
 	Version string `json:"version"`
 }
+
+// PlatformModuleDescriptors contains the name and version of the platform descriptor and the module descriptors of its applications
+type PlatformModuleDescriptors struct {
+	Name                string `json:"name"`
+	Version             string `json:"version"`
+	ModuleDescriptors   []any  `json:"moduleDescriptors"`
+	UIModuleDescriptors []any  `json:"uiModuleDescriptors"`
+}
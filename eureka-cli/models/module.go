@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/api/types/network"
@@ -75,7 +76,7 @@ type BackendModuleProperties struct {
 	PrivatePort         *int
 	Env                 map[string]any
 	SidecarEnv          map[string]any
-	Resources           map[string]any
+	Resources           config.Resources
 	Volumes             []string
 }
 
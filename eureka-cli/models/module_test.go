@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,7 +22,7 @@ func TestNewBackendModuleWithSidecar_Success_WithDeployModule(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "19.2.3"
 	port := 8081
@@ -41,8 +43,8 @@ func TestNewBackendModuleWithSidecar_Success_WithDeployModule(t *testing.T) {
 		Env: map[string]any{
 			"JAVA_OPTIONS": "-Xmx512m",
 		},
-		Resources: map[string]any{
-			"memory": "1Gi",
+		Resources: config.Resources{
+			Memory: helpers.Int64Ptr(1024),
 		},
 		Volumes: []string{"/data:/data"},
 	}
@@ -83,7 +85,7 @@ func TestNewBackendModuleWithSidecar_Success_WithoutDeployModule(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "20.1.0"
 	port := 8090
@@ -98,7 +100,7 @@ func TestNewBackendModuleWithSidecar_Success_WithoutDeployModule(t *testing.T) {
 		Port:          &port,
 		PrivatePort:   &privatePort,
 		Env:           map[string]any{},
-		Resources:     map[string]any{},
+		Resources:     config.Resources{},
 	}
 
 	// Act
@@ -124,7 +126,7 @@ func TestNewBackendModuleWithSidecar_Error_NoFreePorts(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "1.0.0"
 	port := 8000
@@ -139,7 +141,7 @@ func TestNewBackendModuleWithSidecar_Error_NoFreePorts(t *testing.T) {
 		Port:          &port,
 		PrivatePort:   &privatePort,
 		Env:           map[string]any{},
-		Resources:     map[string]any{},
+		Resources:     config.Resources{},
 	}
 
 	// Act
@@ -161,7 +163,7 @@ func TestNewBackendModuleWithSidecar_Success_WithEmptyEnvAndResources(t *testing
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	port := 8100
 	privatePort := 8099
@@ -198,7 +200,7 @@ func TestNewBackendModule_Success(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "23.5.0"
 	port := 9801
@@ -217,8 +219,8 @@ func TestNewBackendModule_Success(t *testing.T) {
 		Env: map[string]any{
 			"DB_HOST": "postgres",
 		},
-		Resources: map[string]any{
-			"memory": "750m",
+		Resources: config.Resources{
+			Memory: helpers.Int64Ptr(750),
 		},
 		Volumes: []string{"/logs:/logs"},
 	}
@@ -256,7 +258,7 @@ func TestNewBackendModule_Error_NoFreePorts(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "1.0.0"
 	port := 8000
@@ -289,7 +291,7 @@ func TestNewBackendModule_Success_MinimalConfiguration(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	port := 8200
 	privatePort := 8199
@@ -326,7 +328,7 @@ func TestNewBackendModule_Success_WithMultipleVolumes(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "5.0.0"
 	port := 8300
@@ -369,7 +371,7 @@ func TestBackendModule_WithSidecarVsWithout_StructureDifference(t *testing.T) {
 	})
 
 	params := &action.Param{}
-	act := action.New("test-action", "http://localhost:%s", params)
+	act := action.New("test-action", "http://localhost:%s", params, nil)
 
 	version := "1.0.0"
 	port := 8400
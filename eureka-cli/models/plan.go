@@ -0,0 +1,48 @@
+package models
+
+// DeploymentPlan represents everything a deployment would create, resolved without touching Docker or the management APIs
+type DeploymentPlan struct {
+	Profile       string              `json:"profile"`
+	ApplicationID string              `json:"applicationId"`
+	SidecarImage  string              `json:"sidecarImage,omitempty"`
+	Modules       []PlannedModule     `json:"modules"`
+	Application   *ApplicationPayload `json:"application,omitempty"`
+	Tenants       []PlannedTenant     `json:"tenants,omitempty"`
+	Roles         []PlannedRole       `json:"roles,omitempty"`
+	Users         []PlannedUser       `json:"users,omitempty"`
+}
+
+// PlannedModule represents a backend module container and its optional sidecar that would be deployed
+type PlannedModule struct {
+	Name              string `json:"name"`
+	Version           string `json:"version"`
+	Image             string `json:"image"`
+	Management        bool   `json:"management"`
+	ServerPort        int    `json:"serverPort"`
+	DebugPort         int    `json:"debugPort"`
+	PrivatePort       int    `json:"privatePort"`
+	Sidecar           bool   `json:"sidecar"`
+	SidecarServerPort int    `json:"sidecarServerPort,omitempty"`
+	SidecarDebugPort  int    `json:"sidecarDebugPort,omitempty"`
+	MemoryMib         int64  `json:"memoryMib"`
+}
+
+// PlannedTenant represents a tenant that would be created
+type PlannedTenant struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// PlannedRole represents a role that would be created
+type PlannedRole struct {
+	Name           string   `json:"name"`
+	Tenant         string   `json:"tenant"`
+	CapabilitySets []string `json:"capabilitySets"`
+}
+
+// PlannedUser represents a user that would be created
+type PlannedUser struct {
+	Name   string   `json:"name"`
+	Tenant string   `json:"tenant"`
+	Roles  []string `json:"roles"`
+}
@@ -0,0 +1,23 @@
+package models
+
+// ProfileStats represents the resource usage of the module and sidecar containers of a profile and its total footprint
+type ProfileStats struct {
+	Profile          string           `json:"profile"`
+	Containers       []ContainerStats `json:"containers"`
+	TotalCPUPercent  float64          `json:"totalCpuPercent"`
+	TotalMemoryUsage int64            `json:"totalMemoryUsage"`
+	TotalMemoryLimit int64            `json:"totalMemoryLimit"`
+}
+
+// ContainerStats represents the resource usage of a module or sidecar container against its configured memory limit
+type ContainerStats struct {
+	Name          string   `json:"name"`
+	Module        string   `json:"module"`
+	Sidecar       bool     `json:"sidecar,omitempty"`
+	CPUPercent    float64  `json:"cpuPercent"`
+	MemoryUsage   int64    `json:"memoryUsage"`
+	MemoryLimit   int64    `json:"memoryLimit"`
+	MemoryPercent float64  `json:"memoryPercent"`
+	OOMKills      int      `json:"oomKills,omitempty"`
+	Warnings      []string `json:"warnings,omitempty"`
+}
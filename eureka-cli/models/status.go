@@ -0,0 +1,68 @@
+package models
+
+// EnvironmentStatus represents the consolidated state of the system containers, modules, applications,
+// tenants and Kong routes of a profile
+type EnvironmentStatus struct {
+	Profile      string              `json:"profile"`
+	Healthy      bool                `json:"healthy"`
+	System       []ContainerStatus   `json:"system"`
+	Modules      []ContainerStatus   `json:"modules"`
+	Applications []ApplicationStatus `json:"applications"`
+	Tenants      []TenantStatus      `json:"tenants"`
+	Problems     []string            `json:"problems,omitempty"`
+}
+
+// ContainerStatus represents the state of a system, module or sidecar container
+type ContainerStatus struct {
+	Name    string   `json:"name"`
+	Service string   `json:"service,omitempty"`
+	Module  string   `json:"module,omitempty"`
+	Sidecar bool     `json:"sidecar,omitempty"`
+	State   string   `json:"state"`
+	Health  string   `json:"health,omitempty"`
+	Healthy bool     `json:"healthy"`
+	Ports   []string `json:"ports,omitempty"`
+	Routes  *int     `json:"routes,omitempty"`
+}
+
+// ApplicationStatus represents a registered application
+type ApplicationStatus struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// TenantStatus represents a tenant and the applications it is entitled to
+type TenantStatus struct {
+	Name         string   `json:"name"`
+	Applications []string `json:"applications"`
+}
+
+// OutdatedModule represents the deployed version of a module compared with its latest version in the LSP platform
+// descriptor and in the module registry
+type OutdatedModule struct {
+	Module       string `json:"module"`
+	Deployed     string `json:"deployed"`
+	LSP          string `json:"lsp"`
+	Registry     string `json:"registry"`
+	BuildsBehind int    `json:"buildsBehind"`
+	Outdated     bool   `json:"outdated"`
+}
+
+// ComposeService represents a single service of the `docker compose ps --format json` output
+type ComposeService struct {
+	Name       string                    `json:"Name"`
+	Service    string                    `json:"Service"`
+	State      string                    `json:"State"`
+	Health     string                    `json:"Health"`
+	ExitCode   int                       `json:"ExitCode"`
+	Publishers []ComposeServicePublisher `json:"Publishers"`
+}
+
+// ComposeServicePublisher represents a port published by a compose service
+type ComposeServicePublisher struct {
+	URL           string `json:"URL"`
+	TargetPort    int    `json:"TargetPort"`
+	PublishedPort int    `json:"PublishedPort"`
+	Protocol      string `json:"Protocol"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// TimingReport represents the per-step timings of a single command run
+type TimingReport struct {
+	Profile    string        `json:"profile"`
+	Action     string        `json:"action"`
+	StartedAt  time.Time     `json:"startedAt"`
+	DurationMs int64         `json:"durationMs"`
+	Entries    []TimingEntry `json:"entries"`
+}
+
+// TimingEntry represents the duration of a step, module or tenant operation within a run
+type TimingEntry struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	DurationMs int64  `json:"durationMs"`
+	Failed     bool   `json:"failed,omitempty"`
+}
+
+// TimingComparison represents the timings of the same operation across several runs
+type TimingComparison struct {
+	Kind        string   `json:"kind"`
+	Name        string   `json:"name"`
+	DurationsMs []*int64 `json:"durationsMs"`
+}
+
+// TimingHistory represents the comparison of the last runs of a profile
+type TimingHistory struct {
+	Profile string             `json:"profile"`
+	Runs    []TimingRun        `json:"runs"`
+	Timings []TimingComparison `json:"timings"`
+}
+
+// TimingRun represents a single run within a timing history
+type TimingRun struct {
+	Action     string    `json:"action"`
+	StartedAt  time.Time `json:"startedAt"`
+	DurationMs int64     `json:"durationMs"`
+}
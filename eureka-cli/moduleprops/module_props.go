@@ -9,9 +9,9 @@ import (
 	"strings"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
@@ -39,12 +39,12 @@ func (mp *ModuleProps) ReadBackendModules(isManagement bool, verbose bool) (map[
 		return modules, nil
 	}
 
-	for name, value := range mp.Action.ConfigBackendModules {
+	for name, module := range mp.Action.ConfigBackendModules {
 		if isManagement && !mp.isManagementModule(name) || !isManagement && mp.isManagementModule(name) {
 			continue
 		}
 
-		p, err := mp.createBackendProperties(name, value)
+		p, err := mp.createBackendProperties(name, module)
 		if err != nil {
 			return nil, err
 		}
@@ -66,14 +66,6 @@ func (mp *ModuleProps) ReadBackendModules(isManagement bool, verbose bool) (map[
 	return modules, nil
 }
 
-func (mp *ModuleProps) createBackendProperties(name string, value any) (models.BackendModuleProperties, error) {
-	if value == nil {
-		return mp.createDefaultBackendProperties(name)
-	} else {
-		return mp.createConfigurableBackendProperties(value, name)
-	}
-}
-
 func (mp *ModuleProps) createBackendModule(properties models.BackendModuleProperties) (*models.BackendModule, error) {
 	mergedVolumes, err := mp.mergeExtraVolumes(properties.Volumes)
 	if err != nil {
@@ -93,12 +85,7 @@ func (mp *ModuleProps) mergeExtraVolumes(moduleVolumes []string) ([]string, erro
 		return moduleVolumes, nil
 	}
 
-	extraVolumesRaw := make([]any, 0, len(mp.Action.ConfigExtraVolumes))
-	for _, volume := range mp.Action.ConfigExtraVolumes {
-		extraVolumesRaw = append(extraVolumesRaw, volume)
-	}
-
-	extraVolumes, err := mp.getVolumes(map[string]any{field.ModuleVolumesEntry: extraVolumesRaw})
+	extraVolumes, err := mp.getVolumes(mp.Action.ConfigExtraVolumes)
 	if err != nil {
 		return nil, err
 	}
@@ -109,25 +96,6 @@ func (mp *ModuleProps) mergeExtraVolumes(moduleVolumes []string) ([]string, erro
 	return merged, nil
 }
 
-func (mp *ModuleProps) createDefaultBackendProperties(name string) (p models.BackendModuleProperties, err error) {
-	p.DeployModule = true
-	if !mp.isManagementModule(name) && !mp.isEdgeModule(name) {
-		p.DeploySidecar = helpers.BoolPtr(true)
-	}
-
-	p.Port, err = mp.getDefaultPort()
-	if err != nil {
-		return models.BackendModuleProperties{}, err
-	}
-	p.PrivatePort = mp.getDefaultPrivatePort()
-	p.Env = make(map[string]any)
-	p.SidecarEnv = make(map[string]any)
-	p.Resources = make(map[string]any)
-	p.Volumes = []string{}
-
-	return p, nil
-}
-
 func (mp *ModuleProps) isManagementModule(name string) bool {
 	return strings.HasPrefix(name, constant.ManagementModulePattern)
 }
@@ -136,38 +104,33 @@ func (mp *ModuleProps) isEdgeModule(name string) bool {
 	return strings.HasPrefix(name, constant.EdgeModulePattern)
 }
 
-func (mp *ModuleProps) createConfigurableBackendProperties(value any, name string) (p models.BackendModuleProperties, err error) {
-	entry, ok := value.(map[string]any)
-	if !ok {
-		return models.BackendModuleProperties{}, errors.Newf("invalid configuration for module %s: expected map but got %T", name, value)
-	}
-
-	p.DeployModule = helpers.GetBoolOrDefault(entry, field.ModuleDeployModuleEntry, true)
-	if !strings.HasPrefix(name, constant.ManagementModulePattern) && !strings.HasPrefix(name, constant.EdgeModulePattern) {
-		p.DeploySidecar = mp.getDeploySidecar(entry)
+func (mp *ModuleProps) createBackendProperties(name string, module config.BackendModule) (p models.BackendModuleProperties, err error) {
+	p.DeployModule = module.IsDeployModule()
+	if !mp.isManagementModule(name) && !mp.isEdgeModule(name) {
+		p.DeploySidecar = helpers.BoolPtr(module.IsDeploySidecar())
 	}
 
-	p.UseVault = helpers.GetBool(entry, field.ModuleUseVaultEntry)
-	p.DisableSystemUser = helpers.GetBool(entry, field.ModuleDisableSystemUserEntry)
-	p.UseOkapiURL = helpers.GetBool(entry, field.ModuleUseOkapiURLEntry)
-	p.LocalDescriptorPath = helpers.GetString(entry, field.ModuleLocalDescriptorPathEntry)
+	p.UseVault = module.UseVault
+	p.DisableSystemUser = module.DisableSystemUser
+	p.UseOkapiURL = module.UseOkapiURL
+	p.LocalDescriptorPath = module.LocalDescriptorPath
 	if p.LocalDescriptorPath != "" {
 		if _, err := os.Stat(p.LocalDescriptorPath); os.IsNotExist(err) {
 			return models.BackendModuleProperties{}, errors.LocalDescriptorNotFound(p.LocalDescriptorPath, name)
 		}
 	}
 
-	p.Version = mp.getVersion(entry)
-	p.Port, err = mp.getPort(entry, p.DeployModule)
+	p.Version = module.Version
+	p.Port, err = mp.getPort(module.Port, p.DeployModule)
 	if err != nil {
 		return models.BackendModuleProperties{}, err
 	}
 
-	p.PrivatePort = mp.getPrivatePort(entry)
-	p.Env = helpers.GetMap(entry, field.ModuleEnvEntry)
-	p.SidecarEnv = helpers.GetMap(entry, field.ModuleSidecarEnvEntry)
-	p.Resources = helpers.GetMap(entry, field.ModuleResourceEntry)
-	p.Volumes, err = mp.getVolumes(entry)
+	p.PrivatePort = mp.getPrivatePort(module.GetPrivatePort())
+	p.Env = getEnvironment(module.Environment)
+	p.SidecarEnv = getEnvironment(module.SidecarEnvironment)
+	p.Resources = module.Resources
+	p.Volumes, err = mp.getVolumes(module.Volumes)
 	if err != nil {
 		return models.BackendModuleProperties{}, err
 	}
@@ -175,40 +138,21 @@ func (mp *ModuleProps) createConfigurableBackendProperties(value any, name strin
 	return p, nil
 }
 
-func (mp *ModuleProps) getDeploySidecar(entry map[string]any) *bool {
-	if boolPtr := helpers.GetBoolPtr(entry, field.ModuleDeploySidecarEntry); boolPtr != nil {
-		return boolPtr
-	}
-	return helpers.BoolPtr(true)
-}
-
-func (mp *ModuleProps) getVersion(entry map[string]any) *string {
-	rawVersion, exists := entry[field.ModuleVersionEntry]
-	if !exists {
-		return nil
-	}
-
-	floatValue, ok := rawVersion.(float64)
-	if ok {
-		value := strconv.FormatFloat(floatValue, 'f', -1, 64)
-		return helpers.StringPtr(value)
+func getEnvironment(environment map[string]any) map[string]any {
+	if environment == nil {
+		return make(map[string]any)
 	}
 
-	value, ok := rawVersion.(string)
-	if ok {
-		return helpers.StringPtr(value)
-	}
-
-	return nil
+	return environment
 }
 
-func (mp *ModuleProps) getPort(entry map[string]any, deployModule bool) (*int, error) {
+func (mp *ModuleProps) getPort(port *int, deployModule bool) (*int, error) {
 	if !deployModule {
 		return helpers.IntPtr(0), nil
 	}
 
-	if portPtr := helpers.GetIntPtr(entry, field.ModulePortEntry); portPtr != nil {
-		return portPtr, nil
+	if port != nil {
+		return port, nil
 	}
 
 	return mp.getDefaultPort()
@@ -223,8 +167,8 @@ func (mp *ModuleProps) getDefaultPort() (*int, error) {
 	return helpers.IntPtr(port), nil
 }
 
-func (mp *ModuleProps) getPrivatePort(entry map[string]any) *int {
-	if privatePort := helpers.GetConfiguredPrivatePort(entry); privatePort != nil {
+func (mp *ModuleProps) getPrivatePort(privatePort *int) *int {
+	if privatePort != nil {
 		return privatePort
 	}
 
@@ -237,8 +181,7 @@ func (mp *ModuleProps) getDefaultPrivatePort() *int {
 	return helpers.IntPtr(defaultServerPort)
 }
 
-func (mp *ModuleProps) getVolumes(entry map[string]any) ([]string, error) {
-	volumesList := helpers.GetStringSlice(entry, field.ModuleVolumesEntry)
+func (mp *ModuleProps) getVolumes(volumesList []string) ([]string, error) {
 	if len(volumesList) == 0 {
 		return []string{}, nil
 	}
@@ -275,43 +218,29 @@ func (mp *ModuleProps) getVolumes(entry map[string]any) ([]string, error) {
 
 func (mp *ModuleProps) ReadFrontendModules(verbose bool) (map[string]models.FrontendModule, error) {
 	modules := make(map[string]models.FrontendModule)
-	combinedConfigModules := []map[string]any{mp.Action.ConfigFrontendModules, mp.Action.ConfigCustomFrontendModules}
+	combinedConfigModules := []map[string]config.FrontendModule{mp.Action.ConfigFrontendModules, mp.Action.ConfigCustomFrontendModules}
 	if len(combinedConfigModules) == 0 {
 		slog.Info(mp.Action.Name, "text", "No frontend modules were read")
 		return modules, nil
 	}
 
 	for _, configModules := range combinedConfigModules {
-		for name, value := range configModules {
-			var (
-				deployModule        = true
-				version             *string
-				localDescriptorPath = ""
-			)
-			if value != nil {
-				entry, ok := value.(map[string]any)
-				if !ok {
-					continue
-				}
-
-				deployModule = helpers.GetBoolOrDefault(entry, field.ModuleDeployModuleEntry, true)
-				if v := helpers.GetString(entry, field.ModuleVersionEntry); v != "" {
-					version = helpers.StringPtr(v)
-				}
-
-				localDescriptorPath = helpers.GetString(entry, field.ModuleLocalDescriptorPathEntry)
-				if localDescriptorPath != "" {
-					if _, err := os.Stat(localDescriptorPath); os.IsNotExist(err) {
-						return nil, errors.LocalDescriptorNotFound(localDescriptorPath, name)
-					}
+		for name, module := range configModules {
+			var version *string
+			if module.Version != "" {
+				version = helpers.StringPtr(module.Version)
+			}
+			if module.LocalDescriptorPath != "" {
+				if _, err := os.Stat(module.LocalDescriptorPath); os.IsNotExist(err) {
+					return nil, errors.LocalDescriptorNotFound(module.LocalDescriptorPath, name)
 				}
 			}
 
 			modules[name] = models.FrontendModule{
-				DeployModule:        deployModule,
+				DeployModule:        module.IsDeployModule(),
 				ModuleName:          name,
 				ModuleVersion:       version,
-				LocalDescriptorPath: localDescriptorPath,
+				LocalDescriptorPath: module.LocalDescriptorPath,
 			}
 			if verbose {
 				if version == nil {
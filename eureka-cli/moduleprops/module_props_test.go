@@ -7,7 +7,7 @@ import (
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/moduleprops"
@@ -40,8 +40,8 @@ func TestReadBackendModules_PortExhaustion(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   8000, // No range
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": nil,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {},
 			},
 		}
 		mp := moduleprops.New(act)
@@ -63,7 +63,7 @@ func TestReadBackendModules_EmptyConfig(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name:                 "test-action",
-			ConfigBackendModules: map[string]any{},
+			ConfigBackendModules: map[string]config.BackendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -86,10 +86,10 @@ func TestReadBackendModules_Management(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mgr-tenants":   nil,
-				"mgr-users":     nil,
-				"mod-inventory": nil,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mgr-tenants":   {},
+				"mgr-users":     {},
+				"mod-inventory": {},
 			},
 		}
 		mp := moduleprops.New(act)
@@ -113,10 +113,10 @@ func TestReadBackendModules_Management(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mgr-tenants":   nil,
-				"mod-inventory": nil,
-				"mod-users":     nil,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mgr-tenants":   {},
+				"mod-inventory": {},
+				"mod-users":     {},
 			},
 		}
 		mp := moduleprops.New(act)
@@ -142,9 +142,9 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVersionEntry: "1.0.0",
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Version: helpers.StringPtr("1.0.0"),
 				},
 			},
 		}
@@ -161,33 +161,6 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 		assert.Equal(t, "1.0.0", *module.ModuleVersion)
 	})
 
-	t.Run("TestReadBackendModules_ConfigurableProperties_WithNumericVersion", func(t *testing.T) {
-		// Arrange
-		act := &action.Action{
-			Name:                       "test-action",
-			Param:                      &action.Param{},
-			ReservedPorts:              []int{},
-			ConfigApplicationPortStart: 8000,
-			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVersionEntry: float64(2.5),
-				},
-			},
-		}
-		mp := moduleprops.New(act)
-
-		// Act
-		result, err := mp.ReadBackendModules(false, false)
-
-		// Assert
-		assert.NoError(t, err)
-		require.Len(t, result, 1)
-		module := result["mod-inventory"]
-		assert.NotNil(t, module.ModuleVersion)
-		assert.Equal(t, "2.5", *module.ModuleVersion)
-	})
-
 	t.Run("TestReadBackendModules_ConfigurableProperties_WithCustomPort", func(t *testing.T) {
 		// Arrange
 		act := &action.Action{
@@ -196,9 +169,9 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModulePortEntry: 9000,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Port: helpers.IntPtr(9000),
 				},
 			},
 		}
@@ -222,9 +195,9 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModulePrivatePortEntry: 8090,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					PrivatePort: helpers.IntPtr(8090),
 				},
 			},
 		}
@@ -248,11 +221,11 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModulePrivatePortEntry: 0,    // Can be any value, port-server takes precedence
-					field.ModulePortServerEntry:  8091, // This should be used
-				},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					PrivatePort: helpers.IntPtr(0),
+					PortServer:  helpers.IntPtr(8091),
+				}, // Can be any value, port-server takes precedence// This should be used
 			},
 		}
 		mp := moduleprops.New(act)
@@ -275,10 +248,10 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModulePrivatePortEntry: 8090,
-					field.ModulePortServerEntry:  8095,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					PrivatePort: helpers.IntPtr(8090),
+					PortServer:  helpers.IntPtr(8095),
 				},
 			},
 		}
@@ -302,9 +275,9 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleDeployModuleEntry: false,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					DeployModule: helpers.BoolPtr(false),
 				},
 			},
 		}
@@ -328,9 +301,9 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleDeploySidecarEntry: false,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					DeploySidecar: helpers.BoolPtr(false),
 				},
 			},
 		}
@@ -354,11 +327,11 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleUseVaultEntry:          true,
-					field.ModuleDisableSystemUserEntry: true,
-					field.ModuleUseOkapiURLEntry:       true,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					UseVault:          true,
+					DisableSystemUser: true,
+					UseOkapiURL:       true,
 				},
 			},
 		}
@@ -384,13 +357,13 @@ func TestReadBackendModules_ConfigurableProperties(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleEnvEntry: map[string]any{
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Environment: map[string]any{
 						"DB_HOST": "localhost",
 					},
-					field.ModuleResourceEntry: map[string]any{
-						"memory": "512m",
+					Resources: config.Resources{
+						Memory: helpers.Int64Ptr(512),
 					},
 				},
 			},
@@ -418,9 +391,9 @@ func TestReadBackendModules_SidecarEnvironment(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-scheduler": map[string]any{
-					field.ModuleSidecarEnvEntry: map[string]any{
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-scheduler": {
+					SidecarEnvironment: map[string]any{
 						"ROUTING_DYNAMIC_ENABLED":          "true",
 						"SIDECAR_FORWARD_UNKNOWN_REQUESTS": "false",
 					},
@@ -449,8 +422,8 @@ func TestReadBackendModules_SidecarEnvironment(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": nil,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {},
 			},
 		}
 		mp := moduleprops.New(act)
@@ -480,9 +453,9 @@ func TestReadBackendModules_LocalDescriptor(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleLocalDescriptorPathEntry: tmpFile,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					LocalDescriptorPath: tmpFile,
 				},
 			},
 		}
@@ -503,9 +476,9 @@ func TestReadBackendModules_LocalDescriptor(t *testing.T) {
 		act := &action.Action{
 			Name:  "test-action",
 			Param: &action.Param{},
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleLocalDescriptorPathEntry: "/nonexistent/path/descriptor.json",
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					LocalDescriptorPath: "/nonexistent/path/descriptor.json",
 				},
 			},
 		}
@@ -534,9 +507,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{tmpDir},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{tmpDir},
 				},
 			},
 		}
@@ -564,9 +537,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"edge-orders": map[string]any{
-					field.ModuleVolumesEntry: []any{volumeMount},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"edge-orders": {
+					Volumes: []string{volumeMount},
 				},
 			},
 		}
@@ -594,9 +567,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"edge-orders": map[string]any{
-					field.ModuleVolumesEntry: []any{volumeMount},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"edge-orders": {
+					Volumes: []string{volumeMount},
 				},
 			},
 		}
@@ -633,9 +606,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{"$EUREKA/eureka-test-vol"},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{"$EUREKA/eureka-test-vol"},
 				},
 			},
 		}
@@ -661,9 +634,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{},
 				},
 			},
 		}
@@ -691,9 +664,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
 			ConfigExtraVolumes:         []string{extraDir},
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{moduleDir},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{moduleDir},
 				},
 			},
 		}
@@ -722,8 +695,8 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
 			ConfigExtraVolumes:         []string{missingDir},
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": nil,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {},
 			},
 		}
 		mp := moduleprops.New(act)
@@ -755,9 +728,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{"$HOME/eureka-home-test-vol"},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{"$HOME/eureka-home-test-vol"},
 				},
 			},
 		}
@@ -783,9 +756,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{"$HOME/non-existent-directory-for-test"},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{"$HOME/non-existent-directory-for-test"},
 				},
 			},
 		}
@@ -811,9 +784,9 @@ func TestReadBackendModules_Volumes(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"mod-inventory": map[string]any{
-					field.ModuleVolumesEntry: []any{"$EUREKA/non-existent-eureka-directory-for-test"},
+			ConfigBackendModules: map[string]config.BackendModule{
+				"mod-inventory": {
+					Volumes: []string{"$EUREKA/non-existent-eureka-directory-for-test"},
 				},
 			},
 		}
@@ -837,8 +810,8 @@ func TestReadBackendModules_EdgeModules(t *testing.T) {
 			ReservedPorts:              []int{},
 			ConfigApplicationPortStart: 8000,
 			ConfigApplicationPortEnd:   9000,
-			ConfigBackendModules: map[string]any{
-				"edge-oai-pmh": nil,
+			ConfigBackendModules: map[string]config.BackendModule{
+				"edge-oai-pmh": {},
 			},
 		}
 		mp := moduleprops.New(act)
@@ -862,8 +835,8 @@ func TestReadFrontendModules_EmptyConfig(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name:                        "test-action",
-			ConfigFrontendModules:       map[string]any{},
-			ConfigCustomFrontendModules: map[string]any{},
+			ConfigFrontendModules:       map[string]config.FrontendModule{},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -882,8 +855,8 @@ func TestReadFrontendModules_DefaultProperties(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name:                        "test-action",
-			ConfigFrontendModules:       map[string]any{"folio_inventory": nil},
-			ConfigCustomFrontendModules: map[string]any{},
+			ConfigFrontendModules:       map[string]config.FrontendModule{"folio_inventory": {}},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -904,12 +877,12 @@ func TestReadFrontendModules_DefaultProperties(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name: "test-action",
-			ConfigFrontendModules: map[string]any{
-				"folio_inventory": map[string]any{
-					field.ModuleVersionEntry: "2.0.0",
+			ConfigFrontendModules: map[string]config.FrontendModule{
+				"folio_inventory": {
+					Version: "2.0.0",
 				},
 			},
-			ConfigCustomFrontendModules: map[string]any{},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -928,12 +901,12 @@ func TestReadFrontendModules_DefaultProperties(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name: "test-action",
-			ConfigFrontendModules: map[string]any{
-				"folio_inventory": map[string]any{
-					field.ModuleDeployModuleEntry: false,
+			ConfigFrontendModules: map[string]config.FrontendModule{
+				"folio_inventory": {
+					DeployModule: helpers.BoolPtr(false),
 				},
 			},
-			ConfigCustomFrontendModules: map[string]any{},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -955,12 +928,12 @@ func TestReadFrontendModules_DefaultProperties(t *testing.T) {
 
 		act := &action.Action{
 			Name: "test-action",
-			ConfigFrontendModules: map[string]any{
-				"folio_inventory": map[string]any{
-					field.ModuleLocalDescriptorPathEntry: tmpFile,
+			ConfigFrontendModules: map[string]config.FrontendModule{
+				"folio_inventory": {
+					LocalDescriptorPath: tmpFile,
 				},
 			},
-			ConfigCustomFrontendModules: map[string]any{},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -978,12 +951,12 @@ func TestReadFrontendModules_DefaultProperties(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name: "test-action",
-			ConfigFrontendModules: map[string]any{
-				"folio_inventory": map[string]any{
-					field.ModuleLocalDescriptorPathEntry: "/nonexistent/frontend.json",
+			ConfigFrontendModules: map[string]config.FrontendModule{
+				"folio_inventory": {
+					LocalDescriptorPath: "/nonexistent/frontend.json",
 				},
 			},
-			ConfigCustomFrontendModules: map[string]any{},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{},
 		}
 		mp := moduleprops.New(act)
 
@@ -1002,8 +975,8 @@ func TestReadFrontendModules_CustomModules(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name:                        "test-action",
-			ConfigFrontendModules:       map[string]any{"folio_inventory": nil},
-			ConfigCustomFrontendModules: map[string]any{"custom_module": nil},
+			ConfigFrontendModules:       map[string]config.FrontendModule{"folio_inventory": {}},
+			ConfigCustomFrontendModules: map[string]config.FrontendModule{"custom_module": {}},
 		}
 		mp := moduleprops.New(act)
 
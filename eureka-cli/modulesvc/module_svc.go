@@ -9,7 +9,6 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/dockerclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
-	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/moduleenv"
@@ -23,6 +22,8 @@ type ModuleProcessor interface {
 	ModuleProvisioner
 	ModuleManager
 	ModuleCustomizer
+	ModuleHostInspector
+	ModuleStatsReader
 }
 
 // ModuleProvisioner defines the interface for module provisioning operations
@@ -81,20 +82,18 @@ func (ms *ModuleSvc) GetModuleImageVersion(backendModule models.BackendModule, m
 }
 
 func (ms *ModuleSvc) GetSidecarImage(modules []*models.ProxyModule) (string, bool, error) {
-	configSidecarVersion := ms.Action.ConfigSidecarModule[field.SidecarModuleVersionEntry]
-	sidecarImageVersion, err := ms.getSidecarImageVersion(modules, configSidecarVersion)
+	sidecarImageVersion, err := ms.getSidecarImageVersion(modules, ms.Action.ConfigSidecarModule.Version)
 	if err != nil {
 		return "", false, err
 	}
 
-	image := helpers.GetString(ms.Action.ConfigSidecarModule, field.SidecarModuleImageEntry)
+	image := ms.Action.ConfigSidecarModule.Image
 	if image == "" {
 		return "", false, errors.SidecarImageBlank()
 	}
 	finalImage := fmt.Sprintf("%s:%s", image, sidecarImageVersion)
 
-	customNamespace := helpers.GetBool(ms.Action.ConfigSidecarModule, field.SidecarModuleCustomNamespaceEntry)
-	if customNamespace {
+	if ms.Action.ConfigSidecarModule.CustomNamespace {
 		return finalImage, true, nil
 	}
 	namespace := ms.RegistrySvc.GetNamespace(sidecarImageVersion)
@@ -102,12 +101,9 @@ func (ms *ModuleSvc) GetSidecarImage(modules []*models.ProxyModule) (string, boo
 	return fmt.Sprintf("%s/%s", namespace, finalImage), true, nil
 }
 
-func (ms *ModuleSvc) getSidecarImageVersion(modules []*models.ProxyModule, rawConfigSidecarVersion any) (string, error) {
-	if rawConfigSidecarVersion != nil {
-		configSidecarVersion, ok := rawConfigSidecarVersion.(string)
-		if ok {
-			return configSidecarVersion, nil
-		}
+func (ms *ModuleSvc) getSidecarImageVersion(modules []*models.ProxyModule, configSidecarVersion string) (string, error) {
+	if configSidecarVersion != "" {
+		return configSidecarVersion, nil
 	}
 
 	registrySidecarVersion, exists := ms.findRegistrySidecarImageVersion(modules)
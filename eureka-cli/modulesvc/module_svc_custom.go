@@ -1,6 +1,7 @@
 package modulesvc
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -14,23 +15,23 @@ import (
 
 // ModuleCustomizer defines the interface for managing custom module deployment and lifecycle
 type ModuleCustomizer interface {
-	UndeployModuleAndSidecarPair(client *client.Client, pair *ModulePair) error
-	DeployCustomModule(client *client.Client, pair *ModulePair) error
-	DeployCustomSidecar(client *client.Client, pair *ModulePair) error
-	CheckModuleAndSidecarReadiness(pair *ModulePair) error
+	UndeployModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *ModulePair) error
+	DeployCustomModule(ctx context.Context, client *client.Client, pair *ModulePair) error
+	DeployCustomSidecar(ctx context.Context, client *client.Client, pair *ModulePair) error
+	CheckModuleAndSidecarReadiness(ctx context.Context, pair *ModulePair) error
 }
 
-func (ms *ModuleSvc) UndeployModuleAndSidecarPair(client *client.Client, pair *ModulePair) error {
+func (ms *ModuleSvc) UndeployModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *ModulePair) error {
 	slog.Info(ms.Action.Name, "text", "UNDEPLOYING MODULE AND SIDECAR PAIR")
 	pattern := fmt.Sprintf(constant.SingleModuleOrSidecarContainerPattern, ms.Action.ConfigProfileName, ms.Action.Param.ModuleName)
-	if err := ms.UndeployModuleByNamePattern(client, pattern); err != nil {
+	if err := ms.UndeployModuleByNamePattern(ctx, client, pattern); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (ms *ModuleSvc) DeployCustomModule(client *client.Client, pair *ModulePair) error {
+func (ms *ModuleSvc) DeployCustomModule(ctx context.Context, client *client.Client, pair *ModulePair) error {
 	version := ms.GetModuleImageVersion(*pair.BackendModule, pair.Module)
 
 	var imageName string
@@ -42,7 +43,7 @@ func (ms *ModuleSvc) DeployCustomModule(client *client.Client, pair *ModulePair)
 	}
 	slog.Info(ms.Action.Name, "text", "Using module image", "image", imageName)
 
-	return ms.DeployModule(client, &models.Container{
+	return ms.DeployModule(ctx, client, &models.Container{
 		Name: pair.Module.Metadata.Name,
 		Config: &container.Config{
 			Image:        imageName,
@@ -62,13 +63,13 @@ func (ms *ModuleSvc) DeployCustomModule(client *client.Client, pair *ModulePair)
 	})
 }
 
-func (ms *ModuleSvc) DeployCustomSidecar(client *client.Client, pair *ModulePair) error {
+func (ms *ModuleSvc) DeployCustomSidecar(ctx context.Context, client *client.Client, pair *ModulePair) error {
 	image, pullImage, err := ms.GetSidecarImage(pair.Containers.Modules.EurekaModules)
 	if err != nil {
 		return err
 	}
 
-	return ms.DeployModule(client, &models.Container{
+	return ms.DeployModule(ctx, client, &models.Container{
 		Name: pair.Module.Metadata.SidecarName,
 		Config: &container.Config{
 			Image:        image,
@@ -79,7 +80,7 @@ func (ms *ModuleSvc) DeployCustomSidecar(client *client.Client, pair *ModulePair
 		HostConfig: &container.HostConfig{
 			PortBindings:  *pair.BackendModule.SidecarPortBindings,
 			RestartPolicy: *helpers.GetRestartPolicy(),
-			Resources:     *helpers.CreateResources(false, ms.Action.ConfigSidecarModuleResources),
+			Resources:     *helpers.CreateResources(false, ms.Action.ConfigSidecarModule.Resources),
 		},
 		NetworkConfig: helpers.GetModuleNetworkConfig(),
 		Platform:      helpers.GetPlatform(),
@@ -87,21 +88,21 @@ func (ms *ModuleSvc) DeployCustomSidecar(client *client.Client, pair *ModulePair
 	})
 }
 
-func (ms *ModuleSvc) CheckModuleAndSidecarReadiness(pair *ModulePair) error {
+func (ms *ModuleSvc) CheckModuleAndSidecarReadiness(ctx context.Context, pair *ModulePair) error {
 	slog.Info(ms.Action.Name, "text", "WAITING FOR MODULE AND SIDECAR TO INITIALIZE", "module", pair.ModuleName)
 	var interceptModuleWG sync.WaitGroup
 	errCh := make(chan error, 2)
 
 	interceptModuleWG.Add(2)
 	if pair.ModuleURL != "" {
-		go ms.CheckModuleReadinessByURL(&interceptModuleWG, errCh, pair.ModuleName, pair.ModuleURL)
+		go ms.CheckModuleReadinessByURL(ctx, &interceptModuleWG, errCh, pair.ModuleName, pair.ModuleURL)
 	} else {
-		go ms.CheckModuleReadiness(&interceptModuleWG, errCh, pair.ModuleName, pair.BackendModule.ModuleExposedServerPort)
+		go ms.CheckModuleReadiness(ctx, &interceptModuleWG, errCh, pair.ModuleName, pair.BackendModule.ModuleExposedServerPort)
 	}
 	if pair.SidecarURL != "" {
-		go ms.CheckModuleReadinessByURL(&interceptModuleWG, errCh, helpers.GetSidecarName(pair.ModuleName), pair.SidecarURL)
+		go ms.CheckModuleReadinessByURL(ctx, &interceptModuleWG, errCh, helpers.GetSidecarName(pair.ModuleName), pair.SidecarURL)
 	} else {
-		go ms.CheckModuleReadiness(&interceptModuleWG, errCh, helpers.GetSidecarName(pair.ModuleName), pair.BackendModule.SidecarExposedServerPort)
+		go ms.CheckModuleReadiness(ctx, &interceptModuleWG, errCh, helpers.GetSidecarName(pair.ModuleName), pair.BackendModule.SidecarExposedServerPort)
 	}
 	interceptModuleWG.Wait()
 	close(errCh)
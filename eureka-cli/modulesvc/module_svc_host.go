@@ -0,0 +1,95 @@
+package modulesvc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/containerd/errdefs"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+// ModuleHostInspector defines the interface for Docker host inspection operations
+type ModuleHostInspector interface {
+	GetDockerMemory(ctx context.Context, client *client.Client) (int64, error)
+	GetDockerVersion(ctx context.Context, client *client.Client) (string, error)
+	ImageExists(ctx context.Context, client *client.Client, imageName string) (bool, error)
+	GetImageDigest(ctx context.Context, client *client.Client, imageName string) (string, error)
+	InspectContainer(ctx context.Context, client *client.Client, containerName string) (container.InspectResponse, error)
+}
+
+// GetDockerMemory returns the total memory in bytes available to the Docker daemon, e.g. the memory of the Rancher Desktop VM
+func (ms *ModuleSvc) GetDockerMemory(ctx context.Context, dockerClient *client.Client) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	info, err := dockerClient.Info(ctx, client.InfoOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Info.MemTotal, nil
+}
+
+func (ms *ModuleSvc) GetDockerVersion(ctx context.Context, dockerClient *client.Client) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	version, err := dockerClient.ServerVersion(ctx, client.ServerVersionOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return version.Version, nil
+}
+
+func (ms *ModuleSvc) ImageExists(ctx context.Context, dockerClient *client.Client, imageName string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	if _, err := dockerClient.ImageInspect(ctx, imageName); err != nil {
+		if errdefs.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetImageDigest returns the registry digest of a local image, an image that is missing locally or that was built
+// locally has none
+func (ms *ModuleSvc) GetImageDigest(ctx context.Context, dockerClient *client.Client, imageName string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	result, err := dockerClient.ImageInspect(ctx, imageName)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	repository, _ := helpers.SplitImageTag(imageName)
+	for _, repoDigest := range result.RepoDigests {
+		if digest, found := strings.CutPrefix(repoDigest, repository+"@"); found {
+			return digest, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (ms *ModuleSvc) InspectContainer(ctx context.Context, dockerClient *client.Client, containerName string) (container.InspectResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	result, err := dockerClient.ContainerInspect(ctx, containerName, client.ContainerInspectOptions{})
+	if err != nil {
+		return container.InspectResponse{}, err
+	}
+
+	return result.Container, nil
+}
@@ -9,10 +9,12 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/containerd/errdefs"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/moby/moby/api/types/container"
@@ -21,16 +23,17 @@ import (
 
 // ModuleManager defines the interface for managing module deployment and lifecycle
 type ModuleManager interface {
-	GetDeployedModules(client *client.Client, filters client.Filters) ([]container.Summary, error)
-	GetModule(client *client.Client, moduleName string) ([]container.Summary, error)
-	PullModule(client *client.Client, imageName string) error
-	DeployModules(client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, int, error)
-	DeployModule(client *client.Client, container *models.Container) error
-	UndeployModuleByNamePattern(client *client.Client, pattern string) error
+	GetDeployedModules(ctx context.Context, client *client.Client, filters client.Filters) ([]container.Summary, error)
+	GetModule(ctx context.Context, client *client.Client, moduleName string) ([]container.Summary, error)
+	PullModule(ctx context.Context, client *client.Client, imageName string) error
+	PinImage(ctx context.Context, client *client.Client, imageName string, digest string) error
+	DeployModules(ctx context.Context, client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, int, error)
+	DeployModule(ctx context.Context, client *client.Client, container *models.Container) error
+	UndeployModuleByNamePattern(ctx context.Context, client *client.Client, pattern string) error
 }
 
-func (ms *ModuleSvc) GetDeployedModules(dockerClient *client.Client, filters client.Filters) ([]container.Summary, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+func (ms *ModuleSvc) GetDeployedModules(ctx context.Context, dockerClient *client.Client, filters client.Filters) ([]container.Summary, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
 	defer cancel()
 
 	deployedModules, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{
@@ -44,17 +47,17 @@ func (ms *ModuleSvc) GetDeployedModules(dockerClient *client.Client, filters cli
 	return deployedModules.Items, nil
 }
 
-func (ms *ModuleSvc) GetModule(dockerClient *client.Client, moduleName string) ([]container.Summary, error) {
+func (ms *ModuleSvc) GetModule(ctx context.Context, dockerClient *client.Client, moduleName string) ([]container.Summary, error) {
 	containerName := fmt.Sprintf("eureka-%s-%s", ms.Action.ConfigProfileName, moduleName)
 	if strings.HasPrefix(moduleName, constant.ManagementModulePattern) {
 		containerName = fmt.Sprintf("eureka-%s", moduleName)
 	}
 
-	return ms.GetDeployedModules(dockerClient, make(client.Filters).Add("name", fmt.Sprintf("^%s$", containerName)))
+	return ms.GetDeployedModules(ctx, dockerClient, make(client.Filters).Add("name", fmt.Sprintf("^%s$", containerName)))
 }
 
-func (ms *ModuleSvc) PullModule(dockerClient *client.Client, imageName string) error {
-	_, err := dockerClient.ImageInspect(context.Background(), imageName)
+func (ms *ModuleSvc) PullModule(ctx context.Context, dockerClient *client.Client, imageName string) error {
+	_, err := dockerClient.ImageInspect(ctx, imageName)
 	if err == nil {
 		slog.Info(ms.Action.Name, "text", "Image already exists locally", "image", imageName)
 		return nil
@@ -62,10 +65,10 @@ func (ms *ModuleSvc) PullModule(dockerClient *client.Client, imageName string) e
 	if !errdefs.IsNotFound(err) {
 		return err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerImagePull)
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerImagePull)
 	defer cancel()
 
-	authorizationToken, err := ms.RegistrySvc.GetAuthorizationToken()
+	authorizationToken, err := ms.RegistrySvc.GetAuthorizationToken(ctx)
 	if err != nil {
 		return err
 	}
@@ -98,7 +101,34 @@ func (ms *ModuleSvc) PullModule(dockerClient *client.Client, imageName string) e
 	return nil
 }
 
-func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, int, error) {
+// PinImage points the tag of an image to the image of a digest, pulling it by digest when the local image differs,
+// so that the deployment resolves the tag to the pinned image without pulling it again
+func (ms *ModuleSvc) PinImage(ctx context.Context, dockerClient *client.Client, imageName string, digest string) error {
+	if digest == "" {
+		return nil
+	}
+	localDigest, err := ms.GetImageDigest(ctx, dockerClient, imageName)
+	if err != nil {
+		return err
+	}
+	if localDigest == digest {
+		return nil
+	}
+
+	repository, _ := helpers.SplitImageTag(imageName)
+	pinnedImageName := fmt.Sprintf("%s@%s", repository, digest)
+	if err := ms.PullModule(ctx, dockerClient, pinnedImageName); err != nil {
+		return err
+	}
+	if _, err := dockerClient.ImageTag(ctx, client.ImageTagOptions{Source: pinnedImageName, Target: imageName}); err != nil {
+		return err
+	}
+	slog.Info(ms.Action.Name, "text", "Pinned image to the locked digest", "image", imageName, "digest", digest)
+
+	return nil
+}
+
+func (ms *ModuleSvc) DeployModules(ctx context.Context, client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, int, error) {
 	newlyDeployed := make(map[string]int)
 	totalMatched := 0
 
@@ -117,7 +147,7 @@ func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Con
 			backendModule := containers.BackendModules[module.Metadata.Name]
 			totalMatched++
 
-			existingContainers, err := ms.GetModule(client, module.Metadata.Name)
+			existingContainers, err := ms.GetModule(ctx, client, module.Metadata.Name)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -138,7 +168,7 @@ func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Con
 				"port3", backendModule.SidecarExposedServerPort,
 				"port4", backendModule.SidecarExposedDebugPort)
 
-			if err := ms.DeployModule(client, &models.Container{
+			if err := ms.DeployModule(ctx, client, &models.Container{
 				Name: module.Metadata.Name,
 				Config: &container.Config{
 					Image:        ms.GetModuleImage(module),
@@ -162,7 +192,7 @@ func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Con
 
 			if backendModule.DeploySidecar && sidecarImage != "" {
 				sidecarWG.Add(1)
-				go ms.deploySidecarAsync(&sidecarWG, sidecarErrCh, &models.SidecarRequest{
+				go ms.deploySidecarAsync(ctx, &sidecarWG, sidecarErrCh, &models.SidecarRequest{
 					Client:           client,
 					Containers:       containers,
 					Module:           module,
@@ -195,7 +225,7 @@ func (ms *ModuleSvc) shouldDeployModule(module *models.ProxyModule, backendModul
 	return exists && backendModule.DeployModule
 }
 
-func (ms *ModuleSvc) deploySidecarAsync(wg *sync.WaitGroup, errCh chan<- error, r *models.SidecarRequest) {
+func (ms *ModuleSvc) deploySidecarAsync(ctx context.Context, wg *sync.WaitGroup, errCh chan<- error, r *models.SidecarRequest) {
 	defer wg.Done()
 
 	container := &models.Container{
@@ -216,7 +246,7 @@ func (ms *ModuleSvc) deploySidecarAsync(wg *sync.WaitGroup, errCh chan<- error,
 		Platform:      helpers.GetPlatform(),
 		PullImage:     false,
 	}
-	if err := ms.DeployModule(r.Client, container); err != nil {
+	if err := ms.DeployModule(ctx, r.Client, container); err != nil {
 		err := appErrors.SidecarDeployFailed(r.Module.Metadata.SidecarName, err)
 		select {
 		case errCh <- err:
@@ -225,12 +255,13 @@ func (ms *ModuleSvc) deploySidecarAsync(wg *sync.WaitGroup, errCh chan<- error,
 	}
 }
 
-func (ms *ModuleSvc) DeployModule(dockerClient *client.Client, c *models.Container) error {
-	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerDeploy)
+func (ms *ModuleSvc) DeployModule(ctx context.Context, dockerClient *client.Client, c *models.Container) error {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerDeploy)
 	defer cancel()
 
 	if c.PullImage {
-		err := ms.PullModule(dockerClient, c.Config.Image)
+		err := ms.PullModule(ctx, dockerClient, c.Config.Image)
 		if err != nil {
 			return err
 		}
@@ -256,6 +287,7 @@ func (ms *ModuleSvc) DeployModule(dockerClient *client.Client, c *models.Contain
 		return err
 	}
 	slog.Info(ms.Action.Name, "text", "Deployed module", "module", containerName)
+	ms.Action.EmitEvent(events.Event{Type: events.ModuleDeployed, Module: containerName, DurationMs: time.Since(start).Milliseconds()})
 
 	return nil
 }
@@ -268,14 +300,14 @@ func (ms *ModuleSvc) getContainerName(container *models.Container) string {
 	return fmt.Sprintf("eureka-%s-%s", ms.Action.ConfigProfileName, container.Name)
 }
 
-func (ms *ModuleSvc) UndeployModuleByNamePattern(dockerClient *client.Client, pattern string) error {
-	deployedModules, err := ms.GetDeployedModules(dockerClient, make(client.Filters).Add("name", pattern))
+func (ms *ModuleSvc) UndeployModuleByNamePattern(ctx context.Context, dockerClient *client.Client, pattern string) error {
+	deployedModules, err := ms.GetDeployedModules(ctx, dockerClient, make(client.Filters).Add("name", pattern))
 	if err != nil {
 		return err
 	}
 
 	for _, deployedModule := range deployedModules {
-		err = ms.undeployModule(dockerClient, deployedModule)
+		err = ms.undeployModule(ctx, dockerClient, deployedModule)
 		if err != nil {
 			return err
 		}
@@ -284,8 +316,8 @@ func (ms *ModuleSvc) UndeployModuleByNamePattern(dockerClient *client.Client, pa
 	return nil
 }
 
-func (ms *ModuleSvc) undeployModule(dockerClient *client.Client, deployedModule container.Summary) error {
-	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerUndeploy)
+func (ms *ModuleSvc) undeployModule(ctx context.Context, dockerClient *client.Client, deployedModule container.Summary) error {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerUndeploy)
 	defer cancel()
 
 	_, err := dockerClient.NetworkDisconnect(ctx, constant.NetworkID, client.NetworkDisconnectOptions{
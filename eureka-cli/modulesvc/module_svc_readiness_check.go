@@ -1,6 +1,7 @@
 package modulesvc
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -10,43 +11,53 @@ import (
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 )
 
 // ModuleReadinessChecker defines the interface for module readiness check operations
 type ModuleReadinessChecker interface {
-	CheckModuleReadiness(wg *sync.WaitGroup, errCh chan<- error, moduleName string, port int)
+	CheckModuleReadiness(ctx context.Context, wg *sync.WaitGroup, errCh chan<- error, moduleName string, port int)
 }
 
-func (ms *ModuleSvc) CheckModuleReadiness(wg *sync.WaitGroup, errCh chan<- error, moduleName string, port int) {
+func (ms *ModuleSvc) CheckModuleReadiness(ctx context.Context, wg *sync.WaitGroup, errCh chan<- error, moduleName string, port int) {
 	requestURL := ms.Action.GetRequestURL(strconv.Itoa(port), "/admin/health")
-	ms.checkReadiness(wg, errCh, moduleName, requestURL)
+	ms.checkReadiness(ctx, wg, errCh, moduleName, requestURL)
 }
 
-func (ms *ModuleSvc) CheckModuleReadinessByURL(wg *sync.WaitGroup, errCh chan<- error, moduleName string, baseURL string) {
+func (ms *ModuleSvc) CheckModuleReadinessByURL(ctx context.Context, wg *sync.WaitGroup, errCh chan<- error, moduleName string, baseURL string) {
 	requestURL := strings.TrimRight(baseURL, "/") + "/admin/health"
-	ms.checkReadiness(wg, errCh, moduleName, requestURL)
+	ms.checkReadiness(ctx, wg, errCh, moduleName, requestURL)
 }
 
-func (ms *ModuleSvc) checkReadiness(wg *sync.WaitGroup, errCh chan<- error, moduleName string, requestURL string) {
+func (ms *ModuleSvc) checkReadiness(ctx context.Context, wg *sync.WaitGroup, errCh chan<- error, moduleName string, requestURL string) {
 	defer wg.Done()
 
 	slog.Info(ms.Action.Name, "text", "Preparing module readiness check", "module", moduleName, "url", requestURL)
+	start := time.Now()
 	maxRetries := helpers.DefaultInt(ms.ReadinessMaxRetries, constant.ModuleReadinessMaxRetries)
 	waitDuration := helpers.DefaultDuration(ms.ReadinessWait, constant.ModuleReadinessWait)
 	for retryCount := range maxRetries {
-		statusCode, _ := ms.HTTPClient.Ping(requestURL)
+		statusCode, _ := ms.HTTPClient.Ping(ctx, requestURL)
 		if statusCode == http.StatusOK {
 			slog.Info(ms.Action.Name, "text", "Module is ready", "module", moduleName)
+			ms.Action.EmitEvent(events.Event{Type: events.ModuleReady, Module: moduleName, DurationMs: time.Since(start).Milliseconds()})
 			return
 		}
 
 		slog.Warn(ms.Action.Name, "text", "Module is unready", "module", moduleName, "count", retryCount, "max", maxRetries)
-		time.Sleep(waitDuration)
+		if err := helpers.Sleep(ctx, waitDuration); err != nil {
+			sendReadinessError(errCh, err)
+			return
+		}
 	}
 
+	sendReadinessError(errCh, errors.ModuleNotReady(moduleName))
+}
+
+func sendReadinessError(errCh chan<- error, err error) {
 	select {
-	case errCh <- errors.ModuleNotReady(moduleName):
+	case errCh <- err:
 	default:
 	}
 }
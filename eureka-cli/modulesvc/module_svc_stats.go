@@ -0,0 +1,95 @@
+package modulesvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/client"
+)
+
+// ModuleStatsReader defines the interface for container resource usage operations
+type ModuleStatsReader interface {
+	GetContainerStats(ctx context.Context, client *client.Client, containerNames []string) (map[string]container.StatsResponse, error)
+	CountOOMKills(ctx context.Context, client *client.Client, since time.Time) (map[string]int, error)
+}
+
+// GetContainerStats samples the resource usage of the containers in parallel, every sample
+// includes the previous one so that the CPU usage can be calculated
+func (ms *ModuleSvc) GetContainerStats(ctx context.Context, dockerClient *client.Client, containerNames []string) (map[string]container.StatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		stats    = make(map[string]container.StatsResponse, len(containerNames))
+	)
+	for _, containerName := range containerNames {
+		wg.Go(func() {
+			containerStats, err := getContainerStats(ctx, dockerClient, containerName)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			stats[containerName] = containerStats
+		})
+	}
+	wg.Wait()
+
+	return stats, firstErr
+}
+
+func getContainerStats(ctx context.Context, dockerClient *client.Client, containerName string) (container.StatsResponse, error) {
+	result, err := dockerClient.ContainerStats(ctx, containerName, client.ContainerStatsOptions{IncludePreviousSample: true})
+	if err != nil {
+		return container.StatsResponse{}, err
+	}
+	defer helpers.CloseReader(result.Body)
+
+	var stats container.StatsResponse
+	if err := json.NewDecoder(result.Body).Decode(&stats); err != nil {
+		return container.StatsResponse{}, err
+	}
+
+	return stats, nil
+}
+
+// CountOOMKills returns the number of times every container was killed by the kernel OOM killer since a point in time
+func (ms *ModuleSvc) CountOOMKills(ctx context.Context, dockerClient *client.Client, since time.Time) (map[string]int, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	result := dockerClient.Events(ctx, client.EventsListOptions{
+		Since:   strconv.FormatInt(since.Unix(), 10),
+		Until:   strconv.FormatInt(time.Now().Unix(), 10),
+		Filters: make(client.Filters).Add("type", string(events.ContainerEventType)).Add("event", string(events.ActionOOM)),
+	})
+
+	oomKills := make(map[string]int)
+	for {
+		select {
+		case message := <-result.Messages:
+			oomKills[message.Actor.Attributes["name"]]++
+		case err := <-result.Err:
+			if err == nil || errors.Is(err, io.EOF) {
+				return oomKills, nil
+			}
+			return nil, err
+		}
+	}
+}
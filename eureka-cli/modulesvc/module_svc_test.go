@@ -1,6 +1,7 @@
 package modulesvc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
@@ -203,10 +205,10 @@ func TestGetModuleImageVersion_UseProxyModuleVersion(t *testing.T) {
 func TestGetSidecarImage_CustomNamespace(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
-	action.ConfigSidecarModule = map[string]any{
-		field.SidecarModuleVersionEntry:         "3.0.0",
-		field.SidecarModuleCustomNamespaceEntry: true,
-		field.SidecarModuleImageEntry:           "my-custom-sidecar",
+	action.ConfigSidecarModule = config.SidecarModule{
+		Version:         "3.0.0",
+		CustomNamespace: true,
+		Image:           "my-custom-sidecar",
 	}
 	svc := New(action, nil, nil, nil, nil)
 
@@ -232,10 +234,10 @@ func TestGetSidecarImage_CustomNamespace(t *testing.T) {
 func TestGetSidecarImage_RegistryImage(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
-	action.ConfigSidecarModule = map[string]any{
-		field.SidecarModuleVersionEntry:         "3.0.0",
-		field.SidecarModuleCustomNamespaceEntry: false,
-		field.SidecarModuleImageEntry:           "mgr-tenant-entitlement",
+	action.ConfigSidecarModule = config.SidecarModule{
+		Version:         "3.0.0",
+		CustomNamespace: false,
+		Image:           "mgr-tenant-entitlement",
 	}
 
 	mockRegistry := new(testhelpers.MockRegistrySvc)
@@ -266,9 +268,8 @@ func TestGetSidecarImage_RegistryImage(t *testing.T) {
 func TestGetSidecarImage_NoSidecarVersionFound(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
-	action.ConfigSidecarModule = map[string]any{
-		field.SidecarModuleVersionEntry: nil,
-		field.SidecarModuleImageEntry:   "mgr-tenant-entitlement",
+	action.ConfigSidecarModule = config.SidecarModule{
+		Image: "mgr-tenant-entitlement",
 	}
 
 	svc := New(action, nil, nil, nil, nil)
@@ -296,9 +297,9 @@ func TestGetSidecarImage_NoSidecarVersionFound(t *testing.T) {
 func TestGetSidecarImage_BlankImageName(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
-	action.ConfigSidecarModule = map[string]any{
-		field.SidecarModuleVersionEntry: "3.0.0",
-		field.SidecarModuleImageEntry:   "",
+	action.ConfigSidecarModule = config.SidecarModule{
+		Version: "3.0.0",
+		Image:   "",
 	}
 
 	svc := New(action, nil, nil, nil, nil)
@@ -326,9 +327,8 @@ func TestGetSidecarImage_BlankImageName(t *testing.T) {
 func TestGetSidecarImage_EmptyVersionString(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
-	action.ConfigSidecarModule = map[string]any{
-		field.SidecarModuleVersionEntry: nil,
-		field.SidecarModuleImageEntry:   "mgr-tenant-entitlement",
+	action.ConfigSidecarModule = config.SidecarModule{
+		Image: "mgr-tenant-entitlement",
 	}
 
 	svc := New(action, nil, nil, nil, nil)
@@ -353,39 +353,6 @@ func TestGetSidecarImage_EmptyVersionString(t *testing.T) {
 	assert.False(t, shouldPull)
 }
 
-func TestGetSidecarImage_InvalidVersionType(t *testing.T) {
-	// Arrange
-	action := testhelpers.NewMockAction()
-	action.ConfigSidecarModule = map[string]any{
-		field.SidecarModuleVersionEntry: 123, // Invalid type (int instead of string)
-		field.SidecarModuleImageEntry:   "mgr-tenant-entitlement",
-	}
-
-	mockRegistry := new(testhelpers.MockRegistrySvc)
-	mockRegistry.On("GetNamespace", "3.0.0").Return("docker.io/folioorg")
-
-	svc := New(action, nil, nil, mockRegistry, nil)
-
-	sidecarVersion := "3.0.0"
-	modules := []*models.ProxyModule{
-		{
-			Metadata: models.ProxyModuleMetadata{
-				Name:    constant.SidecarProjectName,
-				Version: &sidecarVersion,
-			},
-		},
-	}
-
-	// Act
-	image, shouldPull, err := svc.GetSidecarImage(modules)
-
-	// Assert - Falls back to registry version when config version is invalid type
-	assert.NoError(t, err)
-	assert.Equal(t, "docker.io/folioorg/mgr-tenant-entitlement:3.0.0", image)
-	assert.True(t, shouldPull)
-	mockRegistry.AssertExpectations(t)
-}
-
 func TestGetModuleImage(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
@@ -665,7 +632,7 @@ func TestCheckModuleReadiness_Success(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -699,7 +666,7 @@ func TestCheckModuleReadiness_HTTPError(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -710,6 +677,33 @@ func TestCheckModuleReadiness_HTTPError(t *testing.T) {
 	mockHTTP.AssertExpectations(t)
 }
 
+func TestCheckModuleReadiness_Cancelled(t *testing.T) {
+	// Arrange
+	mockHTTP := new(testhelpers.MockHTTPClient)
+	ctx, cancel := context.WithCancel(context.Background())
+	action := testhelpers.NewMockAction()
+	svc := New(action, mockHTTP, nil, nil, nil)
+	svc.ReadinessMaxRetries = 3
+	svc.ReadinessWait = time.Minute
+
+	mockHTTP.On("Ping", mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(http.StatusServiceUnavailable, nil).Once()
+
+	wg := &sync.WaitGroup{}
+	errCh := make(chan error, 1)
+	wg.Add(1)
+
+	// Act
+	go svc.CheckModuleReadiness(ctx, wg, errCh, "test-module", 8080)
+	wg.Wait()
+	close(errCh)
+
+	// Assert
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+	mockHTTP.AssertExpectations(t)
+}
+
 func TestCheckModuleReadiness_NilResponse(t *testing.T) {
 	// Arrange
 	mockHTTP := new(testhelpers.MockHTTPClient)
@@ -730,7 +724,7 @@ func TestCheckModuleReadiness_NilResponse(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -761,7 +755,7 @@ func TestCheckModuleReadiness_NonOKStatusCode(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -800,7 +794,7 @@ func TestCheckModuleReadiness_EventualSuccess(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -842,7 +836,7 @@ func TestCheckModuleReadiness_MultipleModulesConcurrent(t *testing.T) {
 	// Act
 	for _, mod := range modules {
 		wg.Add(1)
-		go svc.CheckModuleReadiness(wg, errCh, mod.name, mod.port)
+		go svc.CheckModuleReadiness(context.Background(), wg, errCh, mod.name, mod.port)
 	}
 	wg.Wait()
 	close(errCh)
@@ -878,7 +872,7 @@ func TestCheckModuleReadiness_ErrorChannelFull(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 
 	// Assert
@@ -908,7 +902,7 @@ func TestCheckModuleReadiness_VerifyRetryLogic(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -942,7 +936,7 @@ func TestCheckModuleReadiness_PortInURL(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 9999)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 9999)
 	wg.Wait()
 	close(errCh)
 
@@ -972,7 +966,7 @@ func TestCheckModuleReadiness_DefaultMaxRetries(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadiness(wg, errCh, "test-module", 8080)
+	go svc.CheckModuleReadiness(context.Background(), wg, errCh, "test-module", 8080)
 	wg.Wait()
 	close(errCh)
 
@@ -1004,7 +998,7 @@ func TestCheckModuleReadinessByURL_Success(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadinessByURL(wg, errCh, "test-module", "http://192.168.122.1:8082")
+	go svc.CheckModuleReadinessByURL(context.Background(), wg, errCh, "test-module", "http://192.168.122.1:8082")
 	wg.Wait()
 	close(errCh)
 
@@ -1033,7 +1027,7 @@ func TestCheckModuleReadinessByURL_Failure(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadinessByURL(wg, errCh, "test-module", "http://192.168.122.1:8082")
+	go svc.CheckModuleReadinessByURL(context.Background(), wg, errCh, "test-module", "http://192.168.122.1:8082")
 	wg.Wait()
 	close(errCh)
 
@@ -1060,7 +1054,7 @@ func TestCheckModuleReadinessByURL_TrailingSlash(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadinessByURL(wg, errCh, "test-module", "http://192.168.122.1:8082/")
+	go svc.CheckModuleReadinessByURL(context.Background(), wg, errCh, "test-module", "http://192.168.122.1:8082/")
 	wg.Wait()
 	close(errCh)
 
@@ -1091,7 +1085,7 @@ func TestCheckModuleReadinessByURL_EventualSuccess(t *testing.T) {
 	wg.Add(1)
 
 	// Act
-	go svc.CheckModuleReadinessByURL(wg, errCh, "test-module", "http://192.168.122.1:8082")
+	go svc.CheckModuleReadinessByURL(context.Background(), wg, errCh, "test-module", "http://192.168.122.1:8082")
 	wg.Wait()
 	close(errCh)
 
@@ -1130,7 +1124,7 @@ func TestCheckModuleAndSidecarReadiness_WithURLs(t *testing.T) {
 		Return(http.StatusOK, nil)
 
 	// Act
-	err := svc.CheckModuleAndSidecarReadiness(pair)
+	err := svc.CheckModuleAndSidecarReadiness(context.Background(), pair)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1160,7 +1154,7 @@ func TestCheckModuleAndSidecarReadiness_WithoutURLs(t *testing.T) {
 		Return(http.StatusOK, nil)
 
 	// Act
-	err := svc.CheckModuleAndSidecarReadiness(pair)
+	err := svc.CheckModuleAndSidecarReadiness(context.Background(), pair)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1191,7 +1185,7 @@ func TestCheckModuleAndSidecarReadiness_MixedURLs(t *testing.T) {
 		Return(http.StatusOK, nil)
 
 	// Act
-	err := svc.CheckModuleAndSidecarReadiness(pair)
+	err := svc.CheckModuleAndSidecarReadiness(context.Background(), pair)
 
 	// Assert
 	assert.NoError(t, err)
@@ -1405,7 +1399,7 @@ func TestGetModule_RegularModule_Found(t *testing.T) {
 	svc := New(action, nil, nil, nil, nil)
 
 	// Act
-	result, err := svc.GetModule(dockerClient, "mod-test")
+	result, err := svc.GetModule(context.Background(), dockerClient, "mod-test")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1433,7 +1427,7 @@ func TestGetModule_ManagementModule_SkipsProfilePrefix(t *testing.T) {
 	svc := New(action, nil, nil, nil, nil)
 
 	// Act
-	result, err := svc.GetModule(dockerClient, "mgr-tenants")
+	result, err := svc.GetModule(context.Background(), dockerClient, "mgr-tenants")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1451,7 +1445,7 @@ func TestGetModule_NotFound_ReturnsEmpty(t *testing.T) {
 	svc := New(action, nil, nil, nil, nil)
 
 	// Act
-	result, err := svc.GetModule(dockerClient, "mod-missing")
+	result, err := svc.GetModule(context.Background(), dockerClient, "mod-missing")
 
 	// Assert
 	assert.NoError(t, err)
@@ -1466,9 +1460,78 @@ func TestGetModule_DockerError_Propagates(t *testing.T) {
 	svc := New(action, nil, nil, nil, nil)
 
 	// Act
-	result, err := svc.GetModule(dockerClient, "mod-test")
+	result, err := svc.GetModule(context.Background(), dockerClient, "mod-test")
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
 }
+
+func TestGetContainerStats_ReturnsSamplePerContainer(t *testing.T) {
+	// Arrange
+	var oneShot []string
+	var mu sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		oneShot = append(oneShot, r.URL.Query().Get("one-shot"))
+		mu.Unlock()
+		name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1.41/containers/"), "/stats")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(dockertypes.StatsResponse{Name: "/" + name, MemoryStats: dockertypes.MemoryStats{Usage: 1024}})
+	}))
+	t.Cleanup(ts.Close)
+	dockerClient, err := client.New(client.WithHost(ts.URL), client.WithAPIVersion("1.41"))
+	require.NoError(t, err)
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+
+	// Act
+	stats, err := svc.GetContainerStats(context.Background(), dockerClient, []string{"eureka-mgr-tenants", "eureka-combined-mod-orders"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, "/eureka-combined-mod-orders", stats["eureka-combined-mod-orders"].Name)
+	assert.Equal(t, uint64(1024), stats["eureka-mgr-tenants"].MemoryStats.Usage)
+	assert.Equal(t, []string{"", ""}, oneShot)
+}
+
+func TestGetContainerStats_DockerError(t *testing.T) {
+	// Arrange
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(ts.Close)
+	dockerClient, err := client.New(client.WithHost(ts.URL), client.WithAPIVersion("1.41"))
+	require.NoError(t, err)
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+
+	// Act
+	_, err = svc.GetContainerStats(context.Background(), dockerClient, []string{"eureka-mgr-tenants"})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestCountOOMKills(t *testing.T) {
+	// Arrange
+	var capturedFilters string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedFilters = r.URL.Query().Get("filters")
+		w.Header().Set("Content-Type", "application/json")
+		for _, name := range []string{"eureka-combined-mod-orders", "eureka-combined-mod-orders", "eureka-mgr-tenants"} {
+			_, _ = w.Write([]byte(`{"Type":"container","Action":"oom","Actor":{"Attributes":{"name":"` + name + `"}}}` + "\n"))
+		}
+	}))
+	t.Cleanup(ts.Close)
+	dockerClient, err := client.New(client.WithHost(ts.URL), client.WithAPIVersion("1.41"))
+	require.NoError(t, err)
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+
+	// Act
+	oomKills, err := svc.CountOOMKills(context.Background(), dockerClient, time.Now().Add(-time.Hour))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"eureka-combined-mod-orders": 2, "eureka-mgr-tenants": 1}, oomKills)
+	assert.Contains(t, capturedFilters, "oom")
+}
@@ -2,8 +2,6 @@ package modulesvc
 
 import (
 	"context"
-	"encoding/binary"
-	"log/slog"
 	"strings"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
@@ -13,11 +11,11 @@ import (
 
 // ModuleVaultHandler defines the interface for module Vault operations
 type ModuleVaultHandler interface {
-	GetVaultRootToken(client *client.Client) (string, error)
+	GetVaultRootToken(ctx context.Context, client *client.Client) (string, error)
 }
 
-func (ms *ModuleSvc) GetVaultRootToken(dockerClient *client.Client) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutVaultContainerLogs)
+func (ms *ModuleSvc) GetVaultRootToken(ctx context.Context, dockerClient *client.Client) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutVaultContainerLogs)
 	defer cancel()
 
 	logStream, err := dockerClient.ContainerLogs(ctx, constant.VaultContainer, client.ContainerLogsOptions{
@@ -29,20 +27,12 @@ func (ms *ModuleSvc) GetVaultRootToken(dockerClient *client.Client) (string, err
 	}
 	defer helpers.CloseReader(logStream)
 
-	buffer := make([]byte, constant.DockerLogHeaderSize)
 	for {
-		_, err := logStream.Read(buffer)
+		_, rawLogLine, err := helpers.ReadDockerLogFrame(logStream)
 		if err != nil {
 			return "", err
 		}
 
-		count := binary.BigEndian.Uint32(buffer[constant.DockerLogSizeOffset:])
-		rawLogLine := make([]byte, count)
-		_, err = logStream.Read(rawLogLine)
-		if err != nil {
-			slog.Error(ms.Action.Name, "error", err)
-		}
-
 		parsedLogLine := string(rawLogLine)
 		if strings.Contains(parsedLogLine, constant.VaultRootTokenPattern) {
 			vaultRootToken := helpers.GetVaultRootTokenFromLogs(parsedLogLine)
@@ -0,0 +1,162 @@
+package readinesssvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/execsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
+	"github.com/folio-org/eureka-setup/eureka-cli/kafkasvc"
+)
+
+// ReadinessProcessor defines the interface for system container readiness probes
+type ReadinessProcessor interface {
+	WaitForSystem(ctx context.Context) error
+	WaitForPostgres(ctx context.Context) error
+	WaitForKafka(ctx context.Context) error
+	WaitForVault(ctx context.Context) error
+	WaitForKeycloak(ctx context.Context) error
+	WaitForKeycloakRealm(ctx context.Context, realm string) error
+	WaitForKong(ctx context.Context) error
+	WaitForCapabilities(ctx context.Context, tenant string) error
+}
+
+// ReadinessSvc provides functionality for probing system containers until they become ready
+type ReadinessSvc struct {
+	Action         *action.Action
+	ExecSvc        execsvc.CommandRunner
+	HTTPClient     httpclient.HTTPClientRunner
+	KafkaSvc       kafkasvc.KafkaProcessor
+	Timeout        time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+type vaultSealStatus struct {
+	Sealed bool `json:"sealed"`
+}
+
+// New creates a new ReadinessSvc instance
+func New(action *action.Action, execSvc execsvc.CommandRunner, httpClient httpclient.HTTPClientRunner, kafkaSvc kafkasvc.KafkaProcessor) *ReadinessSvc {
+	return &ReadinessSvc{
+		Action:     action,
+		ExecSvc:    execSvc,
+		HTTPClient: httpClient,
+		KafkaSvc:   kafkaSvc,
+	}
+}
+
+func (rs *ReadinessSvc) WaitForSystem(ctx context.Context) error {
+	for _, probe := range []func(context.Context) error{rs.WaitForPostgres, rs.WaitForKafka, rs.WaitForVault, rs.WaitForKeycloak, rs.WaitForKong} {
+		if err := probe(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rs *ReadinessSvc) WaitForPostgres(ctx context.Context) error {
+	return rs.waitFor(ctx, constant.PostgreSQLContainer, func() error {
+		_, _, err := rs.ExecSvc.ExecReturnOutput(exec.CommandContext(ctx, "docker", "exec", "-i", constant.PostgreSQLContainer, "pg_isready", "-U", "postgres"))
+		if err != nil {
+			return errors.PostgresNotReady(err)
+		}
+
+		return nil
+	})
+}
+
+func (rs *ReadinessSvc) WaitForKafka(ctx context.Context) error {
+	return rs.waitFor(ctx, constant.KafkaContainer, rs.KafkaSvc.CheckBrokerReadiness)
+}
+
+func (rs *ReadinessSvc) WaitForVault(ctx context.Context) error {
+	return rs.waitFor(ctx, constant.VaultContainer, func() error {
+		var status vaultSealStatus
+		requestURL := rs.Action.GetRequestURL(constant.VaultServerPort, "/v1/sys/seal-status")
+		if err := rs.HTTPClient.GetReturnStruct(ctx, requestURL, map[string]string{}, &status); err != nil {
+			return err
+		}
+		if status.Sealed {
+			return errors.VaultSealed()
+		}
+
+		return nil
+	})
+}
+
+func (rs *ReadinessSvc) WaitForKeycloak(ctx context.Context) error {
+	return rs.WaitForKeycloakRealm(ctx, constant.KeycloakMasterRealm)
+}
+
+func (rs *ReadinessSvc) WaitForKeycloakRealm(ctx context.Context, realm string) error {
+	requestURL := fmt.Sprintf("%s/realms/%s", constant.KeycloakHTTP, realm)
+	return rs.waitFor(ctx, fmt.Sprintf("keycloak realm %s", realm), func() error {
+		return rs.ping(ctx, requestURL)
+	})
+}
+
+func (rs *ReadinessSvc) WaitForKong(ctx context.Context) error {
+	requestURL := rs.Action.GetRequestURL(constant.KongAdminPort, "/status")
+	return rs.waitFor(ctx, constant.KongContainer, func() error {
+		return rs.ping(ctx, requestURL)
+	})
+}
+
+// WaitForCapabilities waits until the capability consumer group has no lag on the topic of the tenant,
+// i.e. the capabilities created by an entitlement are available to be attached
+func (rs *ReadinessSvc) WaitForCapabilities(ctx context.Context, tenant string) error {
+	topicConfigTenant := rs.Action.GetKafkaTopicConfigTenant(tenant)
+	return rs.waitFor(ctx, fmt.Sprintf("capabilities of tenant %s", tenant), func() error {
+		return rs.KafkaSvc.CheckConsumerGroupLag(ctx, topicConfigTenant)
+	})
+}
+
+func (rs *ReadinessSvc) ping(ctx context.Context, requestURL string) error {
+	statusCode, err := rs.HTTPClient.Ping(ctx, requestURL)
+	if err != nil {
+		return errors.PingFailed(requestURL, err)
+	}
+	if statusCode != http.StatusOK {
+		return errors.PingFailedWithStatus(requestURL, statusCode)
+	}
+
+	return nil
+}
+
+// waitFor retries a probe with exponential backoff until it passes or the timeout elapses
+func (rs *ReadinessSvc) waitFor(ctx context.Context, probe string, check func() error) error {
+	var (
+		timeout    = helpers.DefaultDuration(rs.Timeout, constant.ReadinessProbeTimeout)
+		backoff    = helpers.DefaultDuration(rs.InitialBackoff, constant.ReadinessProbeInitialBackoff)
+		maxBackoff = helpers.DefaultDuration(rs.MaxBackoff, constant.ReadinessProbeMaxBackoff)
+		deadline   = time.Now().Add(timeout)
+	)
+	for attempt := 1; ; attempt++ {
+		err := check()
+		if err == nil {
+			slog.Info(rs.Action.Name, "text", "Readiness probe passed", "probe", probe, "attempt", attempt)
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return errors.ReadinessProbeTimeout(probe, timeout, err)
+		}
+
+		slog.Warn(rs.Action.Name, "text", "Readiness probe failed, retrying", "probe", probe, "attempt", attempt, "backoff", backoff, "error", err)
+		if err := helpers.Sleep(ctx, min(backoff, remaining)); err != nil {
+			return err
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
@@ -0,0 +1,299 @@
+package readinesssvc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/kafkasvc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestSvc() (*ReadinessSvc, *testhelpers.MockCommandExecutor, *testhelpers.MockHTTPClient) {
+	action := testhelpers.NewMockAction()
+	mockExec := new(testhelpers.MockCommandExecutor)
+	mockHTTP := new(testhelpers.MockHTTPClient)
+	svc := New(action, mockExec, mockHTTP, kafkasvc.New(action, mockExec))
+	svc.Timeout = 50 * time.Millisecond
+	svc.InitialBackoff = time.Millisecond
+	svc.MaxBackoff = 5 * time.Millisecond
+
+	return svc, mockExec, mockHTTP
+}
+
+func isDockerExec(container string) any {
+	return mock.MatchedBy(func(cmd *exec.Cmd) bool {
+		return len(cmd.Args) >= 4 && cmd.Args[0] == "docker" && cmd.Args[1] == "exec" && cmd.Args[3] == container
+	})
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	mockExec := new(testhelpers.MockCommandExecutor)
+	mockHTTP := new(testhelpers.MockHTTPClient)
+	kafkaSvc := kafkasvc.New(action, mockExec)
+
+	// Act
+	svc := New(action, mockExec, mockHTTP, kafkaSvc)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+	assert.Equal(t, mockExec, svc.ExecSvc)
+	assert.Equal(t, mockHTTP, svc.HTTPClient)
+	assert.Equal(t, kafkaSvc, svc.KafkaSvc)
+}
+
+// ==================== WaitForPostgres Tests ====================
+
+func TestWaitForPostgres_RetriesUntilReady(t *testing.T) {
+	// Arrange
+	svc, mockExec, _ := newTestSvc()
+	mockExec.On("ExecReturnOutput", isDockerExec("postgres")).
+		Return(bytes.Buffer{}, bytes.Buffer{}, assert.AnError).Twice()
+	mockExec.On("ExecReturnOutput", isDockerExec("postgres")).
+		Return(*bytes.NewBufferString("accepting connections"), bytes.Buffer{}, nil).Once()
+
+	// Act
+	err := svc.WaitForPostgres(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockExec.AssertNumberOfCalls(t, "ExecReturnOutput", 3)
+}
+
+func TestWaitForPostgres_Timeout(t *testing.T) {
+	// Arrange
+	svc, mockExec, _ := newTestSvc()
+	mockExec.On("ExecReturnOutput", isDockerExec("postgres")).
+		Return(bytes.Buffer{}, bytes.Buffer{}, assert.AnError)
+
+	// Act
+	err := svc.WaitForPostgres(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrTimeout)
+	assert.ErrorIs(t, err, errors.ErrNotReady)
+	assert.Contains(t, err.Error(), "postgres readiness probe exceeded")
+}
+
+// ==================== WaitForKafka Tests ====================
+
+func TestWaitForKafka_Success(t *testing.T) {
+	// Arrange
+	svc, mockExec, _ := newTestSvc()
+	mockExec.On("ExecReturnOutput", isDockerExec("kafka-tools")).
+		Return(*bytes.NewBufferString("broker version info"), bytes.Buffer{}, nil).Once()
+
+	// Act
+	err := svc.WaitForKafka(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockExec.AssertExpectations(t)
+}
+
+// ==================== WaitForCapabilities Tests ====================
+
+func isConsumerGroupLag(tenant string) any {
+	return mock.MatchedBy(func(cmd *exec.Cmd) bool {
+		return strings.Contains(cmd.String(), "kafka-consumer-groups.sh") &&
+			strings.Contains(cmd.String(), constant.ConsumerGroupSuffix) &&
+			strings.Contains(cmd.String(), "grep "+tenant)
+	})
+}
+
+func TestWaitForCapabilities_RetriesUntilNoLag(t *testing.T) {
+	// Arrange
+	svc, mockExec, _ := newTestSvc()
+	mockExec.On("ExecReturnOutput", isConsumerGroupLag("test-tenant")).
+		Return(*bytes.NewBufferString("3"), bytes.Buffer{}, nil).Once()
+	mockExec.On("ExecReturnOutput", isConsumerGroupLag("test-tenant")).
+		Return(bytes.Buffer{}, *bytes.NewBufferString(constant.ErrRebalancing), nil).Once()
+	mockExec.On("ExecReturnOutput", isConsumerGroupLag("test-tenant")).
+		Return(*bytes.NewBufferString("0"), bytes.Buffer{}, nil).Once()
+
+	// Act
+	err := svc.WaitForCapabilities(context.Background(), "test-tenant")
+
+	// Assert
+	assert.NoError(t, err)
+	mockExec.AssertExpectations(t)
+}
+
+func TestWaitForCapabilities_Timeout(t *testing.T) {
+	// Arrange
+	svc, mockExec, _ := newTestSvc()
+	mockExec.On("ExecReturnOutput", isConsumerGroupLag("test-tenant")).
+		Return(*bytes.NewBufferString("3"), bytes.Buffer{}, nil)
+
+	// Act
+	err := svc.WaitForCapabilities(context.Background(), "test-tenant")
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrTimeout)
+	assert.ErrorIs(t, err, errors.ErrNotReady)
+	assert.Contains(t, err.Error(), "capabilities of tenant test-tenant readiness probe exceeded")
+}
+
+func TestWaitForCapabilities_Cancelled(t *testing.T) {
+	// Arrange
+	svc, mockExec, _ := newTestSvc()
+	svc.Timeout = time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	mockExec.On("ExecReturnOutput", isConsumerGroupLag("test-tenant")).
+		Run(func(args mock.Arguments) { cancel() }).
+		Return(*bytes.NewBufferString("3"), bytes.Buffer{}, nil).Once()
+
+	// Act
+	err := svc.WaitForCapabilities(ctx, "test-tenant")
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	mockExec.AssertExpectations(t)
+}
+
+// ==================== WaitForVault Tests ====================
+
+func TestWaitForVault_RetriesWhileSealed(t *testing.T) {
+	// Arrange
+	svc, _, mockHTTP := newTestSvc()
+	mockHTTP.On("GetReturnStruct", "http://localhost:8200/v1/sys/seal-status", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*vaultSealStatus).Sealed = true
+		}).
+		Return(nil).Once()
+	mockHTTP.On("GetReturnStruct", "http://localhost:8200/v1/sys/seal-status", mock.Anything, mock.Anything).
+		Return(nil).Once()
+
+	// Act
+	err := svc.WaitForVault(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestWaitForVault_SealedTimeout(t *testing.T) {
+	// Arrange
+	svc, _, mockHTTP := newTestSvc()
+	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*vaultSealStatus).Sealed = true
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.WaitForVault(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrTimeout)
+	assert.Contains(t, err.Error(), "vault is sealed")
+}
+
+// ==================== WaitForKeycloak Tests ====================
+
+func TestWaitForKeycloak_ProbesMasterRealm(t *testing.T) {
+	// Arrange
+	svc, _, mockHTTP := newTestSvc()
+	mockHTTP.On("Ping", "http://keycloak.eureka:8080/realms/master").Return(http.StatusOK, nil).Once()
+
+	// Act
+	err := svc.WaitForKeycloak(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestWaitForKeycloakRealm_RetriesUntilRealmExists(t *testing.T) {
+	// Arrange
+	svc, _, mockHTTP := newTestSvc()
+	mockHTTP.On("Ping", "http://keycloak.eureka:8080/realms/diku").Return(http.StatusNotFound, nil).Once()
+	mockHTTP.On("Ping", "http://keycloak.eureka:8080/realms/diku").Return(http.StatusOK, nil).Once()
+
+	// Act
+	err := svc.WaitForKeycloakRealm(context.Background(), "diku")
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+// ==================== WaitForKong Tests ====================
+
+func TestWaitForKong_Timeout(t *testing.T) {
+	// Arrange
+	svc, _, mockHTTP := newTestSvc()
+	mockHTTP.On("Ping", "http://localhost:8001/status").Return(0, assert.AnError)
+
+	// Act
+	err := svc.WaitForKong(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrTimeout)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "kong readiness probe exceeded")
+}
+
+func TestWaitForKong_Cancelled(t *testing.T) {
+	// Arrange
+	svc, _, mockHTTP := newTestSvc()
+	ctx, cancel := context.WithCancel(context.Background())
+	svc.Timeout = time.Minute
+	svc.InitialBackoff = time.Minute
+	mockHTTP.On("Ping", "http://localhost:8001/status").Run(func(mock.Arguments) { cancel() }).Return(0, assert.AnError).Once()
+
+	// Act
+	err := svc.WaitForKong(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	mockHTTP.AssertExpectations(t)
+}
+
+// ==================== WaitForSystem Tests ====================
+
+func TestWaitForSystem_Success(t *testing.T) {
+	// Arrange
+	svc, mockExec, mockHTTP := newTestSvc()
+	mockExec.On("ExecReturnOutput", isDockerExec("postgres")).
+		Return(bytes.Buffer{}, bytes.Buffer{}, nil).Once()
+	mockExec.On("ExecReturnOutput", isDockerExec("kafka-tools")).
+		Return(*bytes.NewBufferString("broker version info"), bytes.Buffer{}, nil).Once()
+	mockHTTP.On("GetReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockHTTP.On("Ping", "http://keycloak.eureka:8080/realms/master").Return(http.StatusOK, nil).Once()
+	mockHTTP.On("Ping", "http://localhost:8001/status").Return(http.StatusOK, nil).Once()
+
+	// Act
+	err := svc.WaitForSystem(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	mockExec.AssertExpectations(t)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestWaitForSystem_StopsAtFirstFailure(t *testing.T) {
+	// Arrange
+	svc, mockExec, mockHTTP := newTestSvc()
+	mockExec.On("ExecReturnOutput", isDockerExec("postgres")).
+		Return(bytes.Buffer{}, bytes.Buffer{}, assert.AnError)
+
+	// Act
+	err := svc.WaitForSystem(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrTimeout)
+	mockHTTP.AssertNotCalled(t, "Ping", mock.Anything)
+	mockHTTP.AssertNotCalled(t, "GetReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
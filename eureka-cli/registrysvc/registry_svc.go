@@ -1,6 +1,8 @@
 package registrysvc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -20,16 +22,22 @@ import (
 // RegistryProcessor defines the interface for registry-related operations
 type RegistryProcessor interface {
 	GetNamespace(version string) string
-	GetModules(verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error)
+	GetModules(ctx context.Context, verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error)
+	FetchModules(ctx context.Context, verbose bool) (*models.ProxyModulesByRegistry, error)
+	GetModuleDescriptors(ctx context.Context, forceRefresh bool) (*models.PlatformModuleDescriptors, error)
+	GetPlatformDescriptor(ctx context.Context) (*models.PlatformDescriptor, error)
 	ResolveModuleMetadata(modules *models.ProxyModulesByRegistry)
-	GetAuthorizationToken() (string, error)
+	PinModuleVersions(modules []models.ApplicationModule)
+	GetAuthorizationToken(ctx context.Context) (string, error)
 }
 
 // RegistrySvc provides functionality for interacting with module registries
 type RegistrySvc struct {
-	Action     *action.Action
-	HTTPClient httpclient.HTTPClientRunner
-	AWSSvc     awssvc.AWSProcessor
+	Action        *action.Action
+	HTTPClient    httpclient.HTTPClientRunner
+	AWSSvc        awssvc.AWSProcessor
+	pinnedModules []models.ApplicationModule
+	platform      *models.PlatformDescriptor
 }
 
 // New creates a new RegistrySvc instance
@@ -37,8 +45,8 @@ func New(action *action.Action, httpClient httpclient.HTTPClientRunner, awsSvc a
 	return &RegistrySvc{Action: action, HTTPClient: httpClient, AWSSvc: awsSvc}
 }
 
-func (rs *RegistrySvc) GetAuthorizationToken() (string, error) {
-	return rs.AWSSvc.GetAuthorizationToken()
+func (rs *RegistrySvc) GetAuthorizationToken(ctx context.Context) (string, error) {
+	return rs.AWSSvc.GetAuthorizationToken(ctx)
 }
 
 func (rs *RegistrySvc) GetNamespace(version string) string {
@@ -76,12 +84,30 @@ func (rs *RegistrySvc) getSidecarName(module *models.ProxyModule) string {
 	}
 }
 
-func (rs *RegistrySvc) GetModules(verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error) {
-	moduleVersions, err := rs.getFlattenedModuleVersions(forceRefresh)
+func (rs *RegistrySvc) GetModules(ctx context.Context, verbose bool, forceRefresh bool) (*models.ProxyModulesByRegistry, error) {
+	moduleVersions, err := rs.getFlattenedModuleVersions(ctx, forceRefresh)
 	if err != nil {
 		return nil, err
 	}
 
+	return rs.splitModules(moduleVersions, verbose), nil
+}
+
+// FetchModules returns the module versions of a fresh LSP platform descriptor without writing them to the local file
+// of module versions, so that comparing against the registry does not change what the next deployment uses
+func (rs *RegistrySvc) FetchModules(ctx context.Context, verbose bool) (*models.ProxyModulesByRegistry, error) {
+	if rs.Action.Param.SkipRegistry {
+		return rs.GetModules(ctx, verbose, false)
+	}
+	moduleVersions, err := rs.fetchModuleVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.splitModules(moduleVersions, verbose), nil
+}
+
+func (rs *RegistrySvc) splitModules(moduleVersions []models.ApplicationModule, verbose bool) *models.ProxyModulesByRegistry {
 	var folioModules, eurekaModules []*models.ProxyModule
 	for _, m := range moduleVersions {
 		proxy := &models.ProxyModule{
@@ -102,10 +128,21 @@ func (rs *RegistrySvc) GetModules(verbose bool, forceRefresh bool) (*models.Prox
 	return &models.ProxyModulesByRegistry{
 		FolioModules:  folioModules,
 		EurekaModules: eurekaModules,
-	}, nil
+	}
 }
 
-func (rs *RegistrySvc) getFlattenedModuleVersions(forceRefresh bool) ([]models.ApplicationModule, error) {
+// PinModuleVersions makes the module versions of a lockfile the only module versions, the registry and the local file
+// of module versions are then ignored
+func (rs *RegistrySvc) PinModuleVersions(modules []models.ApplicationModule) {
+	rs.pinnedModules = modules
+}
+
+func (rs *RegistrySvc) getFlattenedModuleVersions(ctx context.Context, forceRefresh bool) ([]models.ApplicationModule, error) {
+	if rs.pinnedModules != nil {
+		slog.Info(rs.Action.Name, "text", "Using module versions pinned in the lockfile", "count", len(rs.pinnedModules))
+		return rs.pinnedModules, nil
+	}
+
 	homeDir, err := helpers.GetHomeDirPath()
 	if err != nil {
 		return nil, err
@@ -121,7 +158,72 @@ func (rs *RegistrySvc) getFlattenedModuleVersions(forceRefresh bool) ([]models.A
 		}
 	}
 
-	return rs.fetchAndPersistModuleVersions(filePath)
+	return rs.fetchAndPersistModuleVersions(ctx, filePath)
+}
+
+// GetModuleDescriptors returns the module descriptors of the platform applications, they are fetched with the full
+// application descriptors only when the local file is missing, the deployment never fetches them
+func (rs *RegistrySvc) GetModuleDescriptors(ctx context.Context, forceRefresh bool) (*models.PlatformModuleDescriptors, error) {
+	homeDir, err := helpers.GetHomeDirPath()
+	if err != nil {
+		return nil, err
+	}
+	filePath := filepath.Join(homeDir, constant.ModuleDescriptorsFile)
+
+	if rs.Action.Param.SkipRegistry {
+		return rs.readModuleDescriptorsLocalFile(filePath)
+	}
+	if !forceRefresh {
+		if info, statErr := os.Stat(filePath); statErr == nil && info.Mode().IsRegular() {
+			return rs.readModuleDescriptorsLocalFile(filePath)
+		}
+	}
+
+	return rs.fetchAndPersistModuleDescriptors(ctx, filePath)
+}
+
+// GetPlatformDescriptor returns the LSP platform descriptor without its applications being fetched, the descriptor
+// fetched for the module versions is reused, the local file of module descriptors is read when the registry is skipped
+// and the platform is left unnamed without it
+func (rs *RegistrySvc) GetPlatformDescriptor(ctx context.Context) (*models.PlatformDescriptor, error) {
+	if rs.platform != nil {
+		return rs.platform, nil
+	}
+	if rs.Action.Param.SkipRegistry {
+		homeDir, err := helpers.GetHomeDirPath()
+		if err != nil {
+			return nil, err
+		}
+		moduleDescriptors, err := rs.readModuleDescriptorsLocalFile(filepath.Join(homeDir, constant.ModuleDescriptorsFile))
+		if errors.Is(err, appErrors.ErrNotFound) {
+			return &models.PlatformDescriptor{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		return &models.PlatformDescriptor{Name: moduleDescriptors.Name, Version: moduleDescriptors.Version}, nil
+	}
+
+	descriptor, _, err := rs.fetchPlatformDescriptor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return descriptor, nil
+}
+
+func (rs *RegistrySvc) readModuleDescriptorsLocalFile(path string) (*models.PlatformModuleDescriptors, error) {
+	if err := helpers.IsRegularFile(path); err != nil {
+		return nil, appErrors.LocalInstallFileNotFound(err)
+	}
+
+	var moduleDescriptors models.PlatformModuleDescriptors
+	if err := helpers.ReadJSONFromFile(path, &moduleDescriptors); err != nil {
+		return nil, err
+	}
+	slog.Info(rs.Action.Name, "text", "Read module descriptors from a local file", "file", constant.ModuleDescriptorsFile)
+
+	return &moduleDescriptors, nil
 }
 
 func (rs *RegistrySvc) readModulesLocalFile(path string) ([]models.ApplicationModule, error) {
@@ -141,15 +243,24 @@ func (rs *RegistrySvc) readModulesLocalFile(path string) ([]models.ApplicationMo
 	return modules, nil
 }
 
-func (rs *RegistrySvc) fetchAndPersistModuleVersions(filePath string) ([]models.ApplicationModule, error) {
-	var descriptor models.PlatformDescriptor
-	if err := rs.HTTPClient.GetRetryReturnStruct(rs.Action.ConfigLspURL, map[string]string{}, &descriptor); err != nil {
+func (rs *RegistrySvc) fetchAndPersistModuleVersions(ctx context.Context, filePath string) ([]models.ApplicationModule, error) {
+	modules, err := rs.fetchModuleVersions(ctx)
+	if err != nil {
 		return nil, err
 	}
-	slog.Info(rs.Action.Name, "text", "Fetched LSP platform descriptor", "name", descriptor.Name, "version", descriptor.Version)
+	if err := helpers.WriteJSONToFile(filePath, modules); err != nil {
+		return nil, err
+	}
+	slog.Info(rs.Action.Name, "text", "Persisted module versions to a local file", "file", constant.ModulesFile)
 
-	applications := append(descriptor.Applications.Required, descriptor.Applications.Optional...)
-	applications = append(applications, descriptor.Applications.Experimental...)
+	return modules, nil
+}
+
+func (rs *RegistrySvc) fetchModuleVersions(ctx context.Context) ([]models.ApplicationModule, error) {
+	descriptor, applications, err := rs.fetchPlatformDescriptor(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	var modules []models.ApplicationModule
 	for _, component := range descriptor.EurekaComponents {
@@ -160,10 +271,83 @@ func (rs *RegistrySvc) fetchAndPersistModuleVersions(filePath string) ([]models.
 		})
 	}
 
+	appDescriptors, err := rs.fetchApplicationDescriptors(ctx, applications, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, appDescriptor := range appDescriptors {
+		for _, key := range []string{"modules", "uiModules"} {
+			for _, raw := range helpers.GetAnySlice(appDescriptor, key) {
+				entry, ok := raw.(map[string]any)
+				if !ok {
+					continue
+				}
+				modules = append(modules, models.ApplicationModule{
+					ID:      helpers.GetString(entry, "id"),
+					Name:    helpers.GetString(entry, "name"),
+					Version: helpers.GetString(entry, "version"),
+				})
+			}
+		}
+	}
+
+	if modules == nil {
+		modules = make([]models.ApplicationModule, 0)
+	}
+
+	return modules, nil
+}
+
+func (rs *RegistrySvc) fetchAndPersistModuleDescriptors(ctx context.Context, filePath string) (*models.PlatformModuleDescriptors, error) {
+	descriptor, applications, err := rs.fetchPlatformDescriptor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the full application descriptors embed the module descriptors
+	appDescriptors, err := rs.fetchApplicationDescriptors(ctx, applications, true)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleDescriptors := models.PlatformModuleDescriptors{
+		Name:                descriptor.Name,
+		Version:             descriptor.Version,
+		ModuleDescriptors:   []any{},
+		UIModuleDescriptors: []any{},
+	}
+	for _, appDescriptor := range appDescriptors {
+		moduleDescriptors.ModuleDescriptors = append(moduleDescriptors.ModuleDescriptors, helpers.GetAnySlice(appDescriptor, "moduleDescriptors")...)
+		moduleDescriptors.UIModuleDescriptors = append(moduleDescriptors.UIModuleDescriptors, helpers.GetAnySlice(appDescriptor, "uiModuleDescriptors")...)
+	}
+
+	if err := helpers.WriteJSONToFile(filePath, moduleDescriptors); err != nil {
+		return nil, err
+	}
+	slog.Info(rs.Action.Name, "text", "Persisted module descriptors to a local file", "file", constant.ModuleDescriptorsFile)
+
+	return &moduleDescriptors, nil
+}
+
+func (rs *RegistrySvc) fetchPlatformDescriptor(ctx context.Context) (*models.PlatformDescriptor, []models.PlatformApplication, error) {
+	var descriptor models.PlatformDescriptor
+	if err := rs.HTTPClient.GetRetryReturnStruct(ctx, rs.Action.ConfigLspURL, map[string]string{}, &descriptor); err != nil {
+		return nil, nil, err
+	}
+	slog.Info(rs.Action.Name, "text", "Fetched LSP platform descriptor", "name", descriptor.Name, "version", descriptor.Version)
+	rs.platform = &descriptor
+
+	applications := append(descriptor.Applications.Required, descriptor.Applications.Optional...)
+	applications = append(applications, descriptor.Applications.Experimental...)
+
+	return &descriptor, applications, nil
+}
+
+func (rs *RegistrySvc) fetchApplicationDescriptors(ctx context.Context, applications []models.PlatformApplication, full bool) ([]map[string]any, error) {
 	type result struct {
-		modules []models.ApplicationModule
-		err     error
-		appID   string
+		appDescriptors []map[string]any
+		err            error
+		appID          string
 	}
 
 	results := make([]result, len(applications))
@@ -176,52 +360,30 @@ func (rs *RegistrySvc) fetchAndPersistModuleVersions(filePath string) ([]models.
 			defer wg.Done()
 			appID := fmt.Sprintf("%s-%s", innerApp.Name, innerApp.Version)
 			farURL := fmt.Sprintf("%s/applications?query=id==%s", rs.Action.ConfigFarURL, appID)
+			if full {
+				farURL += "&full=true"
+			}
 
 			var response models.ApplicationsResponse
-			if err := rs.HTTPClient.GetRetryReturnStruct(farURL, map[string]string{}, &response); err != nil {
+			if err := rs.HTTPClient.GetRetryReturnStruct(ctx, farURL, map[string]string{}, &response); err != nil {
 				results[innerIdx] = result{appID: appID, err: err}
 				return
 			}
 			slog.Info(rs.Action.Name, "text", "Fetched FAR application descriptor", "appId", appID)
-
-			var appModules []models.ApplicationModule
-			for _, appDescriptor := range response.ApplicationDescriptors {
-				for _, key := range []string{"modules", "uiModules"} {
-					for _, raw := range helpers.GetAnySlice(appDescriptor, key) {
-						entry, ok := raw.(map[string]any)
-						if !ok {
-							continue
-						}
-						appModules = append(appModules, models.ApplicationModule{
-							ID:      helpers.GetString(entry, "id"),
-							Name:    helpers.GetString(entry, "name"),
-							Version: helpers.GetString(entry, "version"),
-						})
-					}
-				}
-			}
-			results[innerIdx] = result{appID: appID, modules: appModules}
+			results[innerIdx] = result{appID: appID, appDescriptors: response.ApplicationDescriptors}
 		}(idx, app)
 	}
 	wg.Wait()
 
+	var appDescriptors []map[string]any
 	for _, r := range results {
 		if r.err != nil {
 			return nil, appErrors.FARFetchFailed(r.appID, r.err)
 		}
-		modules = append(modules, r.modules...)
+		appDescriptors = append(appDescriptors, r.appDescriptors...)
 	}
 
-	if modules == nil {
-		modules = make([]models.ApplicationModule, 0)
-	}
-
-	if err := helpers.WriteJSONToFile(filePath, modules); err != nil {
-		return nil, err
-	}
-	slog.Info(rs.Action.Name, "text", "Persisted module versions to a local file", "file", constant.ModulesFile)
-
-	return modules, nil
+	return appDescriptors, nil
 }
 
 func isEurekaModule(name string) bool {
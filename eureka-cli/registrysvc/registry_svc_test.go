@@ -1,6 +1,7 @@
 package registrysvc_test
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -21,7 +22,7 @@ type MockAWSSvc struct {
 	mock.Mock
 }
 
-func (m *MockAWSSvc) GetAuthorizationToken() (string, error) {
+func (m *MockAWSSvc) GetAuthorizationToken(ctx context.Context) (string, error) {
 	args := m.Called()
 	return args.String(0), args.Error(1)
 }
@@ -58,7 +59,7 @@ func TestGetAuthorizationToken_Success(t *testing.T) {
 	mockAWS.On("GetAuthorizationToken").Return(expectedToken, nil)
 
 	// Act
-	token, err := svc.GetAuthorizationToken()
+	token, err := svc.GetAuthorizationToken(context.Background())
 
 	// Assert
 	assert.NoError(t, err)
@@ -76,7 +77,7 @@ func TestGetAuthorizationToken_Error(t *testing.T) {
 	mockAWS.On("GetAuthorizationToken").Return("", errors.New("AWS error"))
 
 	// Act
-	token, err := svc.GetAuthorizationToken()
+	token, err := svc.GetAuthorizationToken(context.Background())
 
 	// Assert
 	assert.Error(t, err)
@@ -200,7 +201,7 @@ func TestGetModules_Success(t *testing.T) {
 		map[string]any{"id": "mod-users-2.0.0", "name": "mod-users", "version": "2.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -228,7 +229,7 @@ func TestGetModules_Verbose(t *testing.T) {
 		map[string]any{"id": "mod-inventory-1.0.0", "name": "mod-inventory", "version": "1.0.0"},
 	})
 
-	result, err := svc.GetModules(true, true)
+	result, err := svc.GetModules(context.Background(), true, true)
 
 	assert.NoError(t, err)
 	assert.Len(t, result.FolioModules, 1)
@@ -248,7 +249,7 @@ func TestGetModules_LSPFetchError(t *testing.T) {
 	mockHTTP.On("GetRetryReturnStruct", act.ConfigLspURL, mock.Anything, mock.AnythingOfType("*models.PlatformDescriptor")).
 		Return(errors.New("LSP unreachable"))
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -276,7 +277,7 @@ func TestGetModules_FARFetchError(t *testing.T) {
 	mockHTTP.On("GetRetryReturnStruct", farURL, mock.Anything, mock.AnythingOfType("*models.ApplicationsResponse")).
 		Return(errors.New("FAR timeout"))
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -300,7 +301,7 @@ func TestGetModules_EurekaComponentsIncluded(t *testing.T) {
 	})
 	stubLSP(mockHTTP, act.ConfigLspURL, descriptor)
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.Empty(t, result.FolioModules)
@@ -332,7 +333,7 @@ func TestGetModules_ModulePartitioning(t *testing.T) {
 		map[string]any{"id": "mod-scheduler-2.0.0", "name": "mod-scheduler", "version": "2.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.Len(t, result.FolioModules, 1)
@@ -364,7 +365,7 @@ func TestGetModules_RequiredAndOptionalMerged(t *testing.T) {
 		map[string]any{"id": "mod-beta-2.0.0", "name": "mod-beta", "version": "2.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.Len(t, result.FolioModules, 2)
@@ -396,7 +397,7 @@ func TestGetModules_ExperimentalAppsIncluded(t *testing.T) {
 		map[string]any{"id": "mod-inn-reach-3.0.0", "name": "mod-inn-reach", "version": "3.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.Len(t, result.FolioModules, 3)
@@ -429,7 +430,7 @@ func TestGetModules_UIModulesIncluded(t *testing.T) {
 		},
 	)
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.Len(t, result.FolioModules, 3)
@@ -452,7 +453,7 @@ func TestGetModules_EmptyApplications(t *testing.T) {
 	})
 	stubLSP(mockHTTP, act.ConfigLspURL, descriptor)
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 
 	assert.NoError(t, err)
 	assert.Empty(t, result.FolioModules)
@@ -481,7 +482,7 @@ func TestIsEurekaModule_KeycloakSuffix(t *testing.T) {
 		map[string]any{"id": "mod-auth-keycloak-1.0.0", "name": "mod-auth-keycloak", "version": "1.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 	assert.NoError(t, err)
 	assert.Empty(t, result.FolioModules)
 	assert.Len(t, result.EurekaModules, 1)
@@ -507,7 +508,7 @@ func TestIsEurekaModule_MgrPrefix(t *testing.T) {
 		map[string]any{"id": "mgr-tenants-1.0.0", "name": "mgr-tenants", "version": "1.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 	assert.NoError(t, err)
 	assert.Empty(t, result.FolioModules)
 	assert.Len(t, result.EurekaModules, 2)
@@ -534,7 +535,7 @@ func TestIsEurekaModule_ExactMatches(t *testing.T) {
 		map[string]any{"id": "mod-scheduler-2.0.0", "name": "mod-scheduler", "version": "2.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 	assert.NoError(t, err)
 	assert.Empty(t, result.FolioModules)
 	assert.Len(t, result.EurekaModules, 3)
@@ -561,7 +562,7 @@ func TestIsEurekaModule_RegularFolioModuleNotEureka(t *testing.T) {
 		map[string]any{"id": "edge-patron-1.0.0", "name": "edge-patron", "version": "1.0.0"},
 	})
 
-	result, err := svc.GetModules(false, true)
+	result, err := svc.GetModules(context.Background(), false, true)
 	assert.NoError(t, err)
 	assert.Len(t, result.FolioModules, 3)
 	assert.Empty(t, result.EurekaModules)
@@ -868,7 +869,7 @@ func TestGetModules_SkipRegistry_ReadsLocalFile(t *testing.T) {
 	act.Param.SkipRegistry = true
 	svc := registrysvc.New(act, mockHTTP, mockAWS)
 
-	result, err := svc.GetModules(false, false)
+	result, err := svc.GetModules(context.Background(), false, false)
 
 	assert.NoError(t, err)
 	require.NotNil(t, result)
@@ -879,6 +880,40 @@ func TestGetModules_SkipRegistry_ReadsLocalFile(t *testing.T) {
 	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestFetchModules_LeavesLocalFileUnchanged(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+
+	filePath := modulesFilePath(t)
+	known := []models.ApplicationModule{{ID: "mod-inventory-1.0.0", Name: "mod-inventory", Version: "1.0.0"}}
+	require.NoError(t, helpers.WriteJSONToFile(filePath, known))
+	before, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	act.ConfigFarURL = "http://far.example.com"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+	stubLSP(mockHTTP, act.ConfigLspURL, buildLSPResponse(
+		[]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}},
+		nil, nil, nil,
+	))
+	stubFAR(mockHTTP, act.ConfigFarURL, "app-core", "1.0.0", []any{
+		map[string]any{"id": "mod-inventory-2.0.0", "name": "mod-inventory", "version": "2.0.0"},
+	})
+
+	result, err := svc.FetchModules(context.Background(), false)
+
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.FolioModules, 1)
+	assert.Equal(t, "mod-inventory-2.0.0", result.FolioModules[0].ID)
+	after, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, string(before), string(after))
+}
+
 func TestGetModules_SkipRegistry_MissingFile(t *testing.T) {
 	testhelpers.SetTempConfigDir(t)
 
@@ -897,7 +932,7 @@ func TestGetModules_SkipRegistry_MissingFile(t *testing.T) {
 	act.Param.SkipRegistry = true
 	svc := registrysvc.New(act, mockHTTP, mockAWS)
 
-	result, err := svc.GetModules(false, false)
+	result, err := svc.GetModules(context.Background(), false, false)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -928,7 +963,7 @@ func TestGetModules_FetchAndPersistWritesFile(t *testing.T) {
 		map[string]any{"id": "mod-users-2.0.0", "name": "mod-users", "version": "2.0.0"},
 	})
 
-	_, err := svc.GetModules(false, true)
+	_, err := svc.GetModules(context.Background(), false, true)
 	require.NoError(t, err)
 
 	var persisted []models.ApplicationModule
@@ -938,6 +973,7 @@ func TestGetModules_FetchAndPersistWritesFile(t *testing.T) {
 	assert.Equal(t, "mod-inventory", persisted[0].Name)
 	assert.Equal(t, "1.0.0", persisted[0].Version)
 	assert.Equal(t, "mod-users-2.0.0", persisted[1].ID)
+	assert.NoFileExists(t, moduleDescriptorsFilePath(t))
 	mockHTTP.AssertExpectations(t)
 }
 
@@ -961,7 +997,7 @@ func TestGetModules_PreferLocalWhenFileExists(t *testing.T) {
 	// SkipRegistry=false, forceRefresh=false — intercept/upgrade path
 	svc := registrysvc.New(act, mockHTTP, mockAWS)
 
-	result, err := svc.GetModules(false, false)
+	result, err := svc.GetModules(context.Background(), false, false)
 
 	assert.NoError(t, err)
 	require.NotNil(t, result)
@@ -971,3 +1007,217 @@ func TestGetModules_PreferLocalWhenFileExists(t *testing.T) {
 	assert.Equal(t, "mgr-applications-1.5.0", result.EurekaModules[0].ID)
 	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
 }
+
+func TestGetModules_PinnedModuleVersions_IgnoresRegistry(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	act.ConfigFarURL = "http://far.example.com"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+	svc.PinModuleVersions([]models.ApplicationModule{
+		{ID: "mod-orders-13.1.0", Name: "mod-orders", Version: "13.1.0"},
+		{ID: "folio-module-sidecar-3.0.0", Name: "folio-module-sidecar", Version: "3.0.0"},
+	})
+
+	result, err := svc.GetModules(context.Background(), false, true)
+
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Len(t, result.FolioModules, 1)
+	assert.Len(t, result.EurekaModules, 1)
+	assert.Equal(t, "mod-orders-13.1.0", result.FolioModules[0].ID)
+	assert.Equal(t, "folio-module-sidecar-3.0.0", result.EurekaModules[0].ID)
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ==================== GetModuleDescriptors Tests ====================
+
+func moduleDescriptorsFilePath(t *testing.T) string {
+	t.Helper()
+	homeDir, err := helpers.GetHomeDirPath()
+	require.NoError(t, err)
+	return filepath.Join(homeDir, constant.ModuleDescriptorsFile)
+}
+
+func TestGetModuleDescriptors_FetchAndPersistWritesFile(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+
+	filePath := moduleDescriptorsFilePath(t)
+	t.Cleanup(func() {
+		_ = os.Remove(filePath)
+		_ = os.Remove(modulesFilePath(t))
+	})
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	act.ConfigFarURL = "http://far.example.com"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	descriptor := buildLSPResponse(
+		[]models.PlatformApplication{{Name: "app-acquisitions", Version: "1.0.0"}},
+		nil, nil, nil,
+	)
+	descriptor.Name, descriptor.Version = "platform-lsp", "R1-2025"
+	stubLSP(mockHTTP, act.ConfigLspURL, descriptor)
+	resp := models.ApplicationsResponse{ApplicationDescriptors: []map[string]any{{
+		"modules":             []any{map[string]any{"id": "mod-orders-13.0.0", "name": "mod-orders", "version": "13.0.0"}},
+		"uiModules":           []any{map[string]any{"id": "folio_orders-8.0.0", "name": "folio_orders", "version": "8.0.0"}},
+		"moduleDescriptors":   []any{map[string]any{"id": "mod-orders-13.0.0"}},
+		"uiModuleDescriptors": []any{map[string]any{"id": "folio_orders-8.0.0"}},
+	}}}
+	mockHTTP.On("GetRetryReturnStruct", act.ConfigFarURL+"/applications?query=id==app-acquisitions-1.0.0&full=true", mock.Anything, mock.AnythingOfType("*models.ApplicationsResponse")).
+		Run(func(args mock.Arguments) {
+			ptr := args.Get(2).(*models.ApplicationsResponse)
+			*ptr = resp
+		}).Return(nil)
+
+	result, err := svc.GetModuleDescriptors(context.Background(), true)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "platform-lsp", result.Name)
+	assert.Equal(t, "R1-2025", result.Version)
+	assert.Equal(t, []any{map[string]any{"id": "mod-orders-13.0.0"}}, result.ModuleDescriptors)
+	assert.Equal(t, []any{map[string]any{"id": "folio_orders-8.0.0"}}, result.UIModuleDescriptors)
+	var persisted models.PlatformModuleDescriptors
+	require.NoError(t, helpers.ReadJSONFromFile(filePath, &persisted))
+	assert.Len(t, persisted.ModuleDescriptors, 1)
+	assert.Len(t, persisted.UIModuleDescriptors, 1)
+	assert.NoFileExists(t, modulesFilePath(t))
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetModuleDescriptors_SkipRegistry_ReadsLocalFile(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+
+	filePath := moduleDescriptorsFilePath(t)
+	known := models.PlatformModuleDescriptors{
+		ModuleDescriptors:   []any{map[string]any{"id": "mod-search-5.0.0"}},
+		UIModuleDescriptors: []any{},
+	}
+	require.NoError(t, helpers.WriteJSONToFile(filePath, known))
+	t.Cleanup(func() { _ = os.Remove(filePath) })
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.Param.SkipRegistry = true
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	result, err := svc.GetModuleDescriptors(context.Background(), true)
+
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, []any{map[string]any{"id": "mod-search-5.0.0"}}, result.ModuleDescriptors)
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetModuleDescriptors_SkipRegistry_MissingFile(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+
+	filePath := moduleDescriptorsFilePath(t)
+	_ = os.Remove(filePath)
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.Param.SkipRegistry = true
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	result, err := svc.GetModuleDescriptors(context.Background(), false)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to find local install file")
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ==================== GetPlatformDescriptor Tests ====================
+
+func TestGetPlatformDescriptor_FetchesOnlyLSP(t *testing.T) {
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	descriptor := buildLSPResponse([]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}}, nil, nil, nil)
+	descriptor.Name, descriptor.Version = "platform-lsp", "R1-2025"
+	stubLSP(mockHTTP, act.ConfigLspURL, descriptor)
+
+	result, err := svc.GetPlatformDescriptor(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "platform-lsp", result.Name)
+	assert.Equal(t, "R1-2025", result.Version)
+	mockHTTP.AssertNumberOfCalls(t, "GetRetryReturnStruct", 1)
+}
+
+func TestGetPlatformDescriptor_ReusesFetchedDescriptor(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+	t.Cleanup(func() { _ = os.Remove(modulesFilePath(t)) })
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	act.ConfigFarURL = "http://far.example.com"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	descriptor := buildLSPResponse([]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}}, nil, nil, nil)
+	descriptor.Name, descriptor.Version = "platform-lsp", "R1-2025"
+	stubLSP(mockHTTP, act.ConfigLspURL, descriptor)
+	stubFAR(mockHTTP, act.ConfigFarURL, "app-core", "1.0.0", []any{})
+	_, err := svc.GetModules(context.Background(), false, true)
+	require.NoError(t, err)
+
+	result, err := svc.GetPlatformDescriptor(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "R1-2025", result.Version)
+	mockHTTP.AssertNumberOfCalls(t, "GetRetryReturnStruct", 2)
+}
+
+func TestGetPlatformDescriptor_SkipRegistry_ReadsLocalFile(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+
+	filePath := moduleDescriptorsFilePath(t)
+	require.NoError(t, helpers.WriteJSONToFile(filePath, models.PlatformModuleDescriptors{Name: "platform-lsp", Version: "R1-2025"}))
+	t.Cleanup(func() { _ = os.Remove(filePath) })
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.Param.SkipRegistry = true
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	result, err := svc.GetPlatformDescriptor(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "platform-lsp", result.Name)
+	assert.Equal(t, "R1-2025", result.Version)
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetPlatformDescriptor_SkipRegistry_MissingFile(t *testing.T) {
+	testhelpers.SetTempConfigDir(t)
+	_ = os.Remove(moduleDescriptorsFilePath(t))
+
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.Param.SkipRegistry = true
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	result, err := svc.GetPlatformDescriptor(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Name)
+	assert.Empty(t, result.Version)
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
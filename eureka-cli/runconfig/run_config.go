@@ -5,6 +5,8 @@ import (
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/awssvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/checkpointsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/consortiumsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/dockerclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -12,16 +14,21 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/gitclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/interceptmodulesvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/kafkasvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/keycloaksvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/kongsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/locksvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/logsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/managementsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/moduleenv"
 	"github.com/folio-org/eureka-setup/eureka-cli/moduleprops"
 	"github.com/folio-org/eureka-setup/eureka-cli/modulesvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/readinesssvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/registrysvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/searchsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/tenantsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/timingsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/uisvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/upgrademodulesvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/usersvc"
@@ -62,6 +69,13 @@ type Services struct {
 	SearchSvc          searchsvc.SearchProcessor
 	InterceptModuleSvc interceptmodulesvc.InterceptModuleProcessor
 	UpgradeModuleSvc   upgrademodulesvc.UpgradeModuleProcessor
+	CheckpointSvc      checkpointsvc.CheckpointProcessor
+	ReadinessSvc       readinesssvc.ReadinessProcessor
+	TimingSvc          timingsvc.TimingProcessor
+	JournalSvc         journalsvc.JournalProcessor
+	LogSvc             logsvc.LogProcessor
+	ConfigSvc          configsvc.ConfigProcessor
+	LockSvc            locksvc.LockProcessor
 }
 
 func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
@@ -83,7 +97,9 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 	userSvc := usersvc.New(action, httpClient)
 	consortiumSvc := consortiumsvc.New(action, httpClient, userSvc)
 	tenantSvc := tenantsvc.New(action, consortiumSvc)
-	managementSvc := managementsvc.New(action, httpClient, tenantSvc)
+	kafkaSvc := kafkasvc.New(action, execSvc)
+	readinessSvc := readinesssvc.New(action, execSvc, httpClient, kafkaSvc)
+	managementSvc := managementsvc.New(action, httpClient, tenantSvc, readinessSvc)
 
 	return &RunConfig{
 		Infrastructure: &Infrastructure{
@@ -98,7 +114,7 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 		Services: &Services{
 			AWSSvc:             awsSvc,
 			KongSvc:            kongsvc.New(action, httpClient),
-			KafkaSvc:           kafkasvc.New(action, execSvc),
+			KafkaSvc:           kafkaSvc,
 			KeycloakSvc:        keycloaksvc.New(action, httpClient, vaultClient, managementSvc),
 			RegistrySvc:        registrySvc,
 			ModuleProps:        moduleprops.New(action),
@@ -112,6 +128,13 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 			SearchSvc:          searchsvc.New(action, httpClient),
 			InterceptModuleSvc: interceptmodulesvc.New(action, moduleSvc, managementSvc),
 			UpgradeModuleSvc:   upgrademodulesvc.New(action, execSvc, moduleSvc, managementSvc),
+			CheckpointSvc:      checkpointsvc.New(action),
+			ReadinessSvc:       readinessSvc,
+			TimingSvc:          timingsvc.New(action),
+			JournalSvc:         journalsvc.New(action),
+			LogSvc:             logsvc.New(action),
+			ConfigSvc:          configsvc.New(action),
+			LockSvc:            locksvc.New(action),
 		},
 	}, nil
 }
@@ -46,6 +46,13 @@ func TestNew_Success(t *testing.T) {
 	assert.NotNil(t, config.UISvc)
 	assert.NotNil(t, config.SearchSvc)
 	assert.NotNil(t, config.InterceptModuleSvc)
+	assert.NotNil(t, config.CheckpointSvc)
+	assert.NotNil(t, config.ReadinessSvc)
+	assert.NotNil(t, config.TimingSvc)
+	assert.NotNil(t, config.JournalSvc)
+	assert.NotNil(t, config.LogSvc)
+	assert.NotNil(t, config.ConfigSvc)
+	assert.NotNil(t, config.LockSvc)
 }
 
 func TestNew_NilAction(t *testing.T) {
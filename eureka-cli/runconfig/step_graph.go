@@ -0,0 +1,220 @@
+package runconfig
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+)
+
+// Step is a unit of work executed by a StepGraph
+type Step struct {
+	Name string
+	// Needs lists the steps that must complete before this step starts
+	Needs []string
+	// Repeatable steps run on every attempt and are not recorded as completed
+	Repeatable bool
+	Run        func(ctx context.Context) error
+}
+
+// StepHook observes step execution, Before can skip a step and After can replace its error
+type StepHook struct {
+	Before func(step *Step) bool
+	After  func(step *Step, duration time.Duration, err error) error
+}
+
+// StepGraph runs steps in dependency order, executing independent steps
+// concurrently on a bounded worker pool
+type StepGraph struct {
+	Action  *action.Action
+	Workers int
+	Hooks   []StepHook
+	steps   []*Step
+	index   map[string]int
+	errs    []error
+}
+
+type stepResult struct {
+	index int
+	err   error
+}
+
+// NewStepGraph creates a new StepGraph instance
+func NewStepGraph(action *action.Action, workers int, hooks ...StepHook) *StepGraph {
+	return &StepGraph{
+		Action:  action,
+		Workers: max(workers, 1),
+		Hooks:   hooks,
+		index:   make(map[string]int),
+	}
+}
+
+func (sg *StepGraph) Add(step Step) *StepGraph {
+	if _, exists := sg.index[step.Name]; exists {
+		sg.errs = append(sg.errs, apperrors.StepDuplicate(step.Name))
+		return sg
+	}
+	step.Needs = slices.Compact(slices.Sorted(slices.Values(step.Needs)))
+	sg.index[step.Name] = len(sg.steps)
+	sg.steps = append(sg.steps, &step)
+
+	return sg
+}
+
+func (sg *StepGraph) GetStepNames() []string {
+	names := make([]string, 0, len(sg.steps))
+	for _, step := range sg.steps {
+		names = append(names, step.Name)
+	}
+
+	return names
+}
+
+func (sg *StepGraph) Run(ctx context.Context) error {
+	if err := sg.validate(); err != nil {
+		return err
+	}
+
+	pending, dependents := sg.getDependencies()
+	var ready []int
+	for i := range sg.steps {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	var (
+		results = make(chan stepResult)
+		running int
+		errs    []error
+	)
+	for {
+		// Stop scheduling new steps after the first failure or an interruption, steps in flight are left to finish
+		ctxErr := ctx.Err()
+		if len(errs) == 0 && ctxErr == nil {
+			for len(ready) > 0 && running < sg.Workers {
+				index := ready[0]
+				step := sg.steps[index]
+				ready = ready[1:]
+				running++
+				go func() {
+					results <- stepResult{index: index, err: sg.execute(ctx, step)}
+				}()
+			}
+		}
+		if running == 0 {
+			if len(errs) == 0 && ctxErr != nil && len(ready) > 0 {
+				step := sg.steps[ready[0]]
+				slog.Warn(sg.Action.Name, "text", "Step interrupted", "step", step.Name)
+				errs = append(errs, apperrors.StepInterrupted(step.Name, ctxErr))
+			}
+			break
+		}
+
+		result := <-results
+		running--
+		step := sg.steps[result.index]
+		if result.err != nil {
+			if errors.Is(result.err, context.Canceled) {
+				slog.Warn(sg.Action.Name, "text", "Step interrupted", "step", step.Name)
+				errs = append(errs, apperrors.StepInterrupted(step.Name, result.err))
+				continue
+			}
+			errs = append(errs, apperrors.StepFailed(step.Name, result.err))
+			if running > 0 {
+				slog.Warn(sg.Action.Name, "text", "Step failed, waiting for running steps to finish", "step", step.Name, "running", running)
+			}
+			continue
+		}
+		for _, dependent := range dependents[result.index] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+		// Ready steps are scheduled in declaration order to keep runs reproducible
+		slices.Sort(ready)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (sg *StepGraph) execute(ctx context.Context, step *Step) error {
+	for _, hook := range sg.Hooks {
+		if hook.Before != nil && hook.Before(step) {
+			return nil
+		}
+	}
+
+	start := time.Now()
+	err := step.Run(ctx)
+	duration := time.Since(start)
+	for _, hook := range sg.Hooks {
+		if hook.After != nil {
+			err = hook.After(step, duration, err)
+		}
+	}
+
+	return err
+}
+
+func (sg *StepGraph) validate() error {
+	if len(sg.errs) > 0 {
+		return errors.Join(sg.errs...)
+	}
+	for _, step := range sg.steps {
+		for _, need := range step.Needs {
+			if _, exists := sg.index[need]; !exists {
+				return apperrors.StepDependencyNotFound(step.Name, need)
+			}
+		}
+	}
+
+	pending, dependents := sg.getDependencies()
+	var queue []int
+	for i := range sg.steps {
+		if pending[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		index := queue[0]
+		queue = queue[1:]
+		for _, dependent := range dependents[index] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	var cycle []string
+	for i, step := range sg.steps {
+		if pending[i] > 0 {
+			cycle = append(cycle, step.Name)
+		}
+	}
+	if len(cycle) > 0 {
+		return apperrors.StepDependencyCycle(cycle)
+	}
+
+	return nil
+}
+
+func (sg *StepGraph) getDependencies() ([]int, [][]int) {
+	pending := make([]int, len(sg.steps))
+	dependents := make([][]int, len(sg.steps))
+	for i, step := range sg.steps {
+		pending[i] = len(step.Needs)
+		for _, need := range step.Needs {
+			dependency := sg.index[need]
+			dependents[dependency] = append(dependents[dependency], i)
+		}
+	}
+
+	return pending, dependents
+}
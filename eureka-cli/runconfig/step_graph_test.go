@@ -0,0 +1,340 @@
+package runconfig_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+type stepRecorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *stepRecorder) step(name string, needs ...string) runconfig.Step {
+	return runconfig.Step{
+		Name:  name,
+		Needs: needs,
+		Run: func(context.Context) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.order = append(r.order, name)
+			return nil
+		},
+	}
+}
+
+func (r *stepRecorder) indexOf(name string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, step := range r.order {
+		if step == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestNewStepGraph_MinimumOneWorker(t *testing.T) {
+	// Act
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 0)
+
+	// Assert
+	assert.Equal(t, 1, graph.Workers)
+}
+
+// ==================== Run Tests ====================
+
+func TestStepGraphRun_RespectsDependencies(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 4).
+		Add(recorder.step("system")).
+		Add(recorder.step("management", "system")).
+		Add(recorder.step("modules", "management")).
+		Add(recorder.step("ui", "modules")).
+		Add(recorder.step("clients", "modules"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, recorder.order, 5)
+	assert.Less(t, recorder.indexOf("system"), recorder.indexOf("management"))
+	assert.Less(t, recorder.indexOf("management"), recorder.indexOf("modules"))
+	assert.Less(t, recorder.indexOf("modules"), recorder.indexOf("ui"))
+	assert.Less(t, recorder.indexOf("modules"), recorder.indexOf("clients"))
+}
+
+func TestStepGraphRun_IndependentStepsRunConcurrently(t *testing.T) {
+	// Arrange
+	var (
+		started = make(chan struct{}, 2)
+		release = make(chan struct{})
+	)
+	blocking := func(name string) runconfig.Step {
+		return runconfig.Step{Name: name, Run: func(context.Context) error {
+			started <- struct{}{}
+			<-release
+			return nil
+		}}
+	}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 2).
+		Add(blocking("tenant-a")).
+		Add(blocking("tenant-b"))
+
+	// Act
+	errCh := make(chan error, 1)
+	go func() { errCh <- graph.Run(context.Background()) }()
+
+	// Assert
+	for range 2 {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("independent steps did not start concurrently")
+		}
+	}
+	close(release)
+	assert.NoError(t, <-errCh)
+}
+
+func TestStepGraphRun_BoundedByWorkers(t *testing.T) {
+	// Arrange
+	var running, peak atomic.Int32
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 2)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		graph.Add(runconfig.Step{Name: name, Run: func(context.Context) error {
+			current := running.Add(1)
+			for {
+				previous := peak.Load()
+				if current <= previous || peak.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			running.Add(-1)
+			return nil
+		}})
+	}
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestStepGraphRun_FailureStopsDependents(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1).
+		Add(recorder.step("system")).
+		Add(runconfig.Step{Name: "modules", Needs: []string{"system"}, Run: func(context.Context) error { return assert.AnError }}).
+		Add(recorder.step("tenants", "modules")).
+		Add(recorder.step("ui", "system"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "step modules failed")
+	assert.Equal(t, -1, recorder.indexOf("tenants"))
+	assert.Equal(t, -1, recorder.indexOf("ui"))
+}
+
+func TestStepGraphRun_InterruptedStep(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+	action := testhelpers.NewMockAction()
+	graph := runconfig.NewStepGraph(action, 1).
+		Add(recorder.step("system")).
+		Add(runconfig.Step{Name: "modules", Needs: []string{"system"}, Run: func(context.Context) error {
+			cancel()
+			return ctx.Err()
+		}}).
+		Add(recorder.step("tenants", "modules"))
+
+	// Act
+	err := graph.Run(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "step modules interrupted")
+	assert.Equal(t, -1, recorder.indexOf("tenants"))
+}
+
+func TestStepGraphRun_InterruptedBetweenSteps(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+	action := testhelpers.NewMockAction()
+	graph := runconfig.NewStepGraph(action, 1).
+		Add(runconfig.Step{Name: "system", Run: func(context.Context) error {
+			cancel()
+			return nil
+		}}).
+		Add(recorder.step("modules", "system"))
+
+	// Act
+	err := graph.Run(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "step modules interrupted")
+	assert.Equal(t, -1, recorder.indexOf("modules"))
+}
+
+func TestStepGraphRun_CombinesConcurrentFailures(t *testing.T) {
+	// Arrange
+	var (
+		started  sync.WaitGroup
+		firstErr = errors.New("first failed")
+		otherErr = errors.New("second failed")
+	)
+	started.Add(2)
+	failing := func(name string, err error) runconfig.Step {
+		return runconfig.Step{Name: name, Run: func(context.Context) error {
+			started.Done()
+			started.Wait()
+			return err
+		}}
+	}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 2).
+		Add(failing("first", firstErr)).
+		Add(failing("second", otherErr))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, firstErr)
+	assert.ErrorIs(t, err, otherErr)
+}
+
+func TestStepGraphRun_Hooks(t *testing.T) {
+	// Arrange
+	var (
+		recorder = &stepRecorder{}
+		after    []string
+		mu       sync.Mutex
+	)
+	hook := runconfig.StepHook{
+		Before: func(step *runconfig.Step) bool {
+			return step.Name == "completed"
+		},
+		After: func(step *runconfig.Step, duration time.Duration, err error) error {
+			mu.Lock()
+			defer mu.Unlock()
+			after = append(after, step.Name)
+			return err
+		},
+	}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1, hook).
+		Add(recorder.step("completed")).
+		Add(recorder.step("next", "completed"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"next"}, recorder.order)
+	assert.Equal(t, []string{"next"}, after)
+}
+
+func TestStepGraphRun_AfterHookCanFailStep(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	hook := runconfig.StepHook{
+		After: func(step *runconfig.Step, duration time.Duration, err error) error {
+			return assert.AnError
+		},
+	}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1, hook).
+		Add(recorder.step("system")).
+		Add(recorder.step("modules", "system"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, []string{"system"}, recorder.order)
+}
+
+// ==================== Validation Tests ====================
+
+func TestStepGraphRun_DuplicateStep(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1).
+		Add(recorder.step("system")).
+		Add(recorder.step("system"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Empty(t, recorder.order)
+}
+
+func TestStepGraphRun_UnknownDependency(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1).
+		Add(recorder.step("modules", "system"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "needs undeclared step system")
+	assert.Empty(t, recorder.order)
+}
+
+func TestStepGraphRun_Cycle(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1).
+		Add(recorder.step("system")).
+		Add(recorder.step("a", "system", "b")).
+		Add(recorder.step("b", "a"))
+
+	// Act
+	err := graph.Run(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "steps a, b have a dependency cycle")
+	assert.Empty(t, recorder.order)
+}
+
+func TestStepGraph_GetStepNames(t *testing.T) {
+	// Arrange
+	recorder := &stepRecorder{}
+	graph := runconfig.NewStepGraph(testhelpers.NewMockAction(), 1).
+		Add(recorder.step("system")).
+		Add(recorder.step("modules", "system"))
+
+	// Act
+	names := graph.GetStepNames()
+
+	// Assert
+	assert.Equal(t, []string{"system", "modules"}, names)
+}
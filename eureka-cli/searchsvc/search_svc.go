@@ -1,6 +1,7 @@
 package searchsvc
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 
@@ -14,8 +15,8 @@ import (
 
 // SearchProcessor defines the interface for search and reindexing operations
 type SearchProcessor interface {
-	ReindexInventoryRecords(tenantName string) error
-	ReindexInstanceRecords(tenantName string) error
+	ReindexInventoryRecords(ctx context.Context, tenantName string) error
+	ReindexInstanceRecords(ctx context.Context, tenantName string) error
 }
 
 // SearchSvc provides functionality for managing search indices and reindexing operations
@@ -29,9 +30,9 @@ func New(action *action.Action, httpClient httpclient.HTTPClientRunner) *SearchS
 	return &SearchSvc{Action: action, HTTPClient: httpClient}
 }
 
-func (ss *SearchSvc) ReindexInventoryRecords(tenantName string) error {
+func (ss *SearchSvc) ReindexInventoryRecords(ctx context.Context, tenantName string) error {
 	requestURL := ss.Action.GetRequestURL(constant.KongPort, "/search/index/inventory/reindex")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ss.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
@@ -47,7 +48,7 @@ func (ss *SearchSvc) ReindexInventoryRecords(tenantName string) error {
 		}
 
 		var job models.ReindexJobResponse
-		if err := ss.HTTPClient.PostReturnStruct(requestURL, payload, headers, &job); err != nil {
+		if err := ss.HTTPClient.PostReturnStruct(ctx, requestURL, payload, headers, &job); err != nil {
 			slog.Warn(ss.Action.Name, "text", err)
 			continue
 		}
@@ -77,18 +78,18 @@ func (ss *SearchSvc) validateInventoryRecordsResponse(job models.ReindexJobRespo
 	return nil
 }
 
-func (ss *SearchSvc) ReindexInstanceRecords(tenantName string) error {
+func (ss *SearchSvc) ReindexInstanceRecords(ctx context.Context, tenantName string) error {
 	payload, err := json.Marshal(map[string]any{})
 	if err != nil {
 		return err
 	}
 
 	requestURL := ss.Action.GetRequestURL(constant.KongPort, "/search/index/instance-records/reindex/full")
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ss.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return err
 	}
-	if err := ss.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+	if err := ss.HTTPClient.PostReturnNoContent(ctx, requestURL, payload, headers); err != nil {
 		return err
 	}
 	slog.Info(ss.Action.Name, "text", "Reindexed instance records for tenant", "tenant", tenantName)
@@ -1,11 +1,13 @@
 package searchsvc_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/searchsvc"
@@ -31,7 +33,7 @@ func TestReindexInventoryRecords_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -54,7 +56,7 @@ func TestReindexInventoryRecords_Success(t *testing.T) {
 			}),
 			mock.MatchedBy(func(headers map[string]string) bool {
 				return headers[constant.OkapiTenantHeader] == tenantName &&
-					headers[constant.OkapiTokenHeader] == action.KeycloakAccessToken &&
+					headers[constant.OkapiTokenHeader] == "test-token" &&
 					headers[constant.ContentTypeHeader] == constant.ApplicationJSON
 			}),
 			mock.Anything).
@@ -66,7 +68,7 @@ func TestReindexInventoryRecords_Success(t *testing.T) {
 	}
 
 	// Act
-	err := svc.ReindexInventoryRecords(tenantName)
+	err := svc.ReindexInventoryRecords(ctx, tenantName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -77,11 +79,10 @@ func TestReindexInventoryRecords_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token will cause header creation to fail
 	svc := searchsvc.New(action, mockHTTP)
 
 	// Act
-	err := svc.ReindexInventoryRecords("test-tenant")
+	err := svc.ReindexInventoryRecords(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -93,11 +94,11 @@ func TestReindexInventoryRecords_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	// Act
-	err := svc.ReindexInventoryRecords("")
+	err := svc.ReindexInventoryRecords(ctx, "")
 
 	// Assert
 	assert.Error(t, err)
@@ -109,11 +110,10 @@ func TestReindexInstanceRecords_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token will cause header creation to fail
 	svc := searchsvc.New(action, mockHTTP)
 
 	// Act
-	err := svc.ReindexInstanceRecords("test-tenant")
+	err := svc.ReindexInstanceRecords(context.Background(), "test-tenant")
 
 	// Assert
 	assert.Error(t, err)
@@ -125,11 +125,11 @@ func TestReindexInstanceRecords_BlankTenantName(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	// Act
-	err := svc.ReindexInstanceRecords("")
+	err := svc.ReindexInstanceRecords(ctx, "")
 
 	// Assert
 	assert.Error(t, err)
@@ -141,7 +141,7 @@ func TestReindexInventoryRecords_HTTPErrorContinues(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -173,7 +173,7 @@ func TestReindexInventoryRecords_HTTPErrorContinues(t *testing.T) {
 	}
 
 	// Act
-	err := svc.ReindexInventoryRecords(tenantName)
+	err := svc.ReindexInventoryRecords(ctx, tenantName)
 
 	// Assert
 	assert.NoError(t, err) // Function continues on error
@@ -184,7 +184,7 @@ func TestReindexInventoryRecords_ValidationErrorContinues(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -227,7 +227,7 @@ func TestReindexInventoryRecords_ValidationErrorContinues(t *testing.T) {
 	}
 
 	// Act
-	err := svc.ReindexInventoryRecords(tenantName)
+	err := svc.ReindexInventoryRecords(ctx, tenantName)
 
 	// Assert
 	assert.NoError(t, err) // Function continues on validation error
@@ -238,7 +238,7 @@ func TestReindexInventoryRecords_BlankIDErrorContinues(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -278,7 +278,7 @@ func TestReindexInventoryRecords_BlankIDErrorContinues(t *testing.T) {
 	}
 
 	// Act
-	err := svc.ReindexInventoryRecords(tenantName)
+	err := svc.ReindexInventoryRecords(ctx, tenantName)
 
 	// Assert
 	assert.NoError(t, err) // Function continues on blank ID error
@@ -340,7 +340,7 @@ func TestReindexInstanceRecords_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -354,13 +354,13 @@ func TestReindexInstanceRecords_Success(t *testing.T) {
 		}),
 		mock.MatchedBy(func(headers map[string]string) bool {
 			return headers[constant.OkapiTenantHeader] == tenantName &&
-				headers[constant.OkapiTokenHeader] == action.KeycloakAccessToken &&
+				headers[constant.OkapiTokenHeader] == "test-token" &&
 				headers[constant.ContentTypeHeader] == constant.ApplicationJSON
 		})).
 		Return(nil)
 
 	// Act
-	err := svc.ReindexInstanceRecords(tenantName)
+	err := svc.ReindexInstanceRecords(ctx, tenantName)
 
 	// Assert
 	assert.NoError(t, err)
@@ -371,7 +371,7 @@ func TestReindexInstanceRecords_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := searchsvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -384,7 +384,7 @@ func TestReindexInstanceRecords_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	err := svc.ReindexInstanceRecords(tenantName)
+	err := svc.ReindexInstanceRecords(ctx, tenantName)
 
 	// Assert
 	assert.Error(t, err)
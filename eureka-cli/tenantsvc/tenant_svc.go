@@ -8,8 +8,6 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/consortiumsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
-	"github.com/folio-org/eureka-setup/eureka-cli/field"
-	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 )
 
 // TenantProcessor defines the interface for tenant-related operations
@@ -43,14 +41,20 @@ func (ts *TenantSvc) GetEntitlementTenantParameters(consortiumName string) (stri
 }
 
 func (ts *TenantSvc) SetConfigTenantParams(tenantName string) error {
-	if ts.Action.ConfigTenants == nil || ts.Action.ConfigTenants[tenantName] == nil {
+	configTenant, exists := ts.Action.ConfigTenants[tenantName]
+	if !exists {
 		return errors.TenantNotFound(tenantName)
 	}
 
-	var configTenant = ts.Action.ConfigTenants[tenantName].(map[string]any)
-	helpers.SetBool(configTenant, field.TenantsSingleTenantEntry, &ts.Action.Param.SingleTenant)
-	helpers.SetBool(configTenant, field.TenantsEnableEcsRequestEntry, &ts.Action.Param.EnableECSRequests)
-	helpers.SetString(configTenant, field.TenantsPlatformLspURLEntry, &ts.Action.Param.PlatformLspURL)
+	if configTenant.SingleTenant != nil {
+		ts.Action.Param.SingleTenant = *configTenant.SingleTenant
+	}
+	if configTenant.EnableEcsRequest != nil {
+		ts.Action.Param.EnableECSRequests = *configTenant.EnableEcsRequest
+	}
+	if configTenant.PlatformLspURL != nil {
+		ts.Action.Param.PlatformLspURL = *configTenant.PlatformLspURL
+	}
 	slog.Info(ts.Action.Name, "text", "Setting default tenant config params", "tenant", tenantName)
 
 	return nil
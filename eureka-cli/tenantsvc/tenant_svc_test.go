@@ -1,12 +1,15 @@
 package tenantsvc_test
 
 import (
+	"context"
 	stderrors "errors"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/tenantsvc"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +23,7 @@ type MockConsortiumSvc struct {
 }
 
 // ConsortiumManager interface methods
-func (m *MockConsortiumSvc) GetConsortiumByName(centralTenant string, consortiumName string) (any, error) {
+func (m *MockConsortiumSvc) GetConsortiumByName(ctx context.Context, centralTenant string, consortiumName string) (any, error) {
 	args := m.Called(centralTenant, consortiumName)
 	return args.Get(0), args.Error(1)
 }
@@ -30,17 +33,17 @@ func (m *MockConsortiumSvc) GetConsortiumCentralTenant(consortiumName string) st
 	return args.String(0)
 }
 
-func (m *MockConsortiumSvc) GetConsortiumUsers(consortiumName string) map[string]any {
+func (m *MockConsortiumSvc) GetConsortiumUsers(consortiumName string) map[string]config.User {
 	args := m.Called(consortiumName)
-	return args.Get(0).(map[string]any)
+	return args.Get(0).(map[string]config.User)
 }
 
-func (m *MockConsortiumSvc) GetAdminUsername(centralTenant string, consortiumUsers map[string]any) string {
+func (m *MockConsortiumSvc) GetAdminUsername(centralTenant string, consortiumUsers map[string]config.User) string {
 	args := m.Called(centralTenant, consortiumUsers)
 	return args.String(0)
 }
 
-func (m *MockConsortiumSvc) CreateConsortium(centralTenant string, consortiumName string) (string, error) {
+func (m *MockConsortiumSvc) CreateConsortium(ctx context.Context, centralTenant string, consortiumName string) (string, error) {
 	args := m.Called(centralTenant, consortiumName)
 	return args.String(0), args.Error(1)
 }
@@ -51,13 +54,13 @@ func (m *MockConsortiumSvc) GetSortedConsortiumTenants(consortiumName string) mo
 	return args.Get(0).(models.SortedConsortiumTenants)
 }
 
-func (m *MockConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error {
+func (m *MockConsortiumSvc) CreateConsortiumTenants(ctx context.Context, centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error {
 	args := m.Called(centralTenant, consortiumID, consortiumTenants, adminUsername)
 	return args.Error(0)
 }
 
 // ConsortiumCentralOrderingManager interface methods
-func (m *MockConsortiumSvc) EnableCentralOrdering(centralTenant string) error {
+func (m *MockConsortiumSvc) EnableCentralOrdering(ctx context.Context, centralTenant string) error {
 	args := m.Called(centralTenant)
 	return args.Error(0)
 }
@@ -148,11 +151,11 @@ func TestSetConfigTenantParams_Success(t *testing.T) {
 		act := &action.Action{
 			Name:  "test-action",
 			Param: &action.Param{},
-			ConfigTenants: map[string]any{
-				"diku": map[string]any{
-					"single-tenant":      true,
-					"enable-ecs-request": true,
-					"platform-lsp-url":   "http://localhost:8080",
+			ConfigTenants: map[string]config.Tenant{
+				"diku": {
+					SingleTenant:     helpers.BoolPtr(true),
+					EnableEcsRequest: helpers.BoolPtr(true),
+					PlatformLspURL:   helpers.StringPtr("http://localhost:8080"),
 				},
 			},
 		}
@@ -174,9 +177,9 @@ func TestSetConfigTenantParams_Success(t *testing.T) {
 		act := &action.Action{
 			Name:  "test-action",
 			Param: &action.Param{},
-			ConfigTenants: map[string]any{
-				"diku": map[string]any{
-					"single-tenant": false,
+			ConfigTenants: map[string]config.Tenant{
+				"diku": {
+					SingleTenant: helpers.BoolPtr(false),
 				},
 			},
 		}
@@ -196,8 +199,8 @@ func TestSetConfigTenantParams_Success(t *testing.T) {
 		act := &action.Action{
 			Name:  "test-action",
 			Param: &action.Param{},
-			ConfigTenants: map[string]any{
-				"diku": map[string]any{},
+			ConfigTenants: map[string]config.Tenant{
+				"diku": {},
 			},
 		}
 		mockConsortiumSvc := new(MockConsortiumSvc)
@@ -234,8 +237,8 @@ func TestSetConfigTenantParams_TenantNotFound(t *testing.T) {
 		// Arrange
 		act := &action.Action{
 			Name: "test-action",
-			ConfigTenants: map[string]any{
-				"diku": map[string]any{},
+			ConfigTenants: map[string]config.Tenant{
+				"diku": {},
 			},
 		}
 		mockConsortiumSvc := new(MockConsortiumSvc)
@@ -249,24 +252,4 @@ func TestSetConfigTenantParams_TenantNotFound(t *testing.T) {
 		assert.True(t, stderrors.Is(err, errors.ErrNotFound))
 		assert.Contains(t, err.Error(), "tenant nonexistent in config")
 	})
-
-	t.Run("TestSetConfigTenantParams_TenantNotFound_TenantIsNil", func(t *testing.T) {
-		// Arrange
-		act := &action.Action{
-			Name: "test-action",
-			ConfigTenants: map[string]any{
-				"diku": nil,
-			},
-		}
-		mockConsortiumSvc := new(MockConsortiumSvc)
-		svc := tenantsvc.New(act, mockConsortiumSvc)
-
-		// Act
-		err := svc.SetConfigTenantParams("diku")
-
-		// Assert
-		assert.Error(t, err)
-		assert.True(t, stderrors.Is(err, errors.ErrNotFound))
-		assert.Contains(t, err.Error(), "tenant diku in config")
-	})
 }
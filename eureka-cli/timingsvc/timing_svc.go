@@ -0,0 +1,180 @@
+package timingsvc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+const (
+	// StepKind is the timing entry kind of an orchestrated step, other entries use their event type as the kind
+	StepKind = "step"
+	// TotalKind is the comparison row kind of the whole command
+	TotalKind = "total"
+)
+
+// TimingProcessor defines the interface for step timing operations
+type TimingProcessor interface {
+	events.Sink
+	Save(filePath string, duration time.Duration) error
+	ListReports(profile string, runs int) ([]models.TimingReport, error)
+	CompareReports(reports []models.TimingReport) []models.TimingComparison
+}
+
+// TimingSvc records the duration of every step, module and tenant operation of a run
+// and persists it as a timing report next to the run log
+type TimingSvc struct {
+	Action    *action.Action
+	LogDir    string
+	startedAt time.Time
+	entries   []models.TimingEntry
+	mu        sync.Mutex
+}
+
+// New creates a new TimingSvc instance
+func New(action *action.Action) *TimingSvc {
+	return &TimingSvc{Action: action, startedAt: time.Now()}
+}
+
+// GetReportPath returns the timing report path of a run log
+func GetReportPath(logFilePath string) string {
+	return strings.TrimSuffix(logFilePath, constant.LogFileSuffix) + constant.TimingReportSuffix
+}
+
+func (ts *TimingSvc) Emit(event events.Event) {
+	entry := models.TimingEntry{Kind: string(event.Type), DurationMs: event.DurationMs}
+	switch event.Type {
+	case events.StepFinished, events.StepFailed:
+		entry.Kind = StepKind
+		entry.Name = event.Step
+		entry.Failed = event.Type == events.StepFailed
+	case events.ModuleDeployed, events.ModuleReady:
+		entry.Name = event.Module
+	case events.TenantEntitled:
+		entry.Name = event.Tenant
+	case events.CapabilitySetsAttached:
+		entry.Name = fmt.Sprintf("%s/%s", event.Tenant, event.Role)
+	default:
+		return
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.entries = append(ts.entries, entry)
+}
+
+func (ts *TimingSvc) Save(filePath string, duration time.Duration) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return helpers.WriteJSONToFile(filePath, models.TimingReport{
+		Profile:    ts.Action.ConfigProfileName,
+		Action:     ts.Action.Name,
+		StartedAt:  ts.startedAt,
+		DurationMs: duration.Milliseconds(),
+		Entries:    slices.Clone(ts.entries),
+	})
+}
+
+// ListReports returns the timing reports of the last runs of a profile, oldest first
+func (ts *TimingSvc) ListReports(profile string, runs int) ([]models.TimingReport, error) {
+	logDir, err := ts.getLogDir()
+	if err != nil {
+		return nil, err
+	}
+	dirEntries, err := os.ReadDir(logDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Report names are <profile>-<timestamp>.timings.json, the timestamp is parsed so that
+	// a profile such as ecs does not pick up the reports of ecs-migration
+	var fileNames []string
+	prefix := profile + "-"
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, constant.TimingReportSuffix) {
+			continue
+		}
+		timestamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix), constant.TimingReportSuffix)
+		if _, err := time.Parse(constant.LogTimestampFormat, timestamp); err != nil {
+			continue
+		}
+		fileNames = append(fileNames, name)
+	}
+	slices.Sort(fileNames)
+	if runs > 0 && len(fileNames) > runs {
+		fileNames = fileNames[len(fileNames)-runs:]
+	}
+
+	reports := make([]models.TimingReport, 0, len(fileNames))
+	for _, name := range fileNames {
+		var report models.TimingReport
+		if err := helpers.ReadJSONFromFile(filepath.Join(logDir, name), &report); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// CompareReports lines up the same operations across reports, an operation missing from a run has a nil duration
+func (ts *TimingSvc) CompareReports(reports []models.TimingReport) []models.TimingComparison {
+	total := models.TimingComparison{Kind: TotalKind, DurationsMs: make([]*int64, len(reports))}
+	var (
+		comparisons []*models.TimingComparison
+		index       = make(map[string]*models.TimingComparison)
+	)
+	for i, report := range reports {
+		total.DurationsMs[i] = &report.DurationMs
+		for _, entry := range report.Entries {
+			key := entry.Kind + "\x00" + entry.Name
+			comparison, exists := index[key]
+			if !exists {
+				comparison = &models.TimingComparison{Kind: entry.Kind, Name: entry.Name, DurationsMs: make([]*int64, len(reports))}
+				index[key] = comparison
+				comparisons = append(comparisons, comparison)
+			}
+			// Repeated operations within a run, e.g. a module deployed twice, are summed
+			durationMs := entry.DurationMs
+			if comparison.DurationsMs[i] != nil {
+				durationMs += *comparison.DurationsMs[i]
+			}
+			comparison.DurationsMs[i] = &durationMs
+		}
+	}
+
+	result := []models.TimingComparison{total}
+	for _, comparison := range comparisons {
+		result = append(result, *comparison)
+	}
+
+	return result
+}
+
+func (ts *TimingSvc) getLogDir() (string, error) {
+	if ts.LogDir != "" {
+		return ts.LogDir, nil
+	}
+
+	homeDir, err := helpers.GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, constant.LogDir), nil
+}
@@ -0,0 +1,145 @@
+package timingsvc_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/events"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/timingsvc"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSvc(t *testing.T) *timingsvc.TimingSvc {
+	t.Helper()
+
+	action := testhelpers.NewMockAction()
+	action.ConfigProfileName = "ecs"
+	svc := timingsvc.New(action)
+	svc.LogDir = t.TempDir()
+
+	return svc
+}
+
+func writeReport(t *testing.T, svc *timingsvc.TimingSvc, name string, durationMs int64, entries ...models.TimingEntry) {
+	t.Helper()
+
+	for _, entry := range entries {
+		svc.Emit(events.Event{Type: events.ModuleDeployed, Module: entry.Name, DurationMs: entry.DurationMs})
+	}
+	assert.NoError(t, svc.Save(filepath.Join(svc.LogDir, name), time.Duration(durationMs)*time.Millisecond))
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+
+	// Act
+	svc := timingsvc.New(action)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+}
+
+func TestGetReportPath(t *testing.T) {
+	// Act
+	result := timingsvc.GetReportPath(filepath.Join("logs", "ecs-20260102-030405.log"))
+
+	// Assert
+	assert.Equal(t, filepath.Join("logs", "ecs-20260102-030405.timings.json"), result)
+}
+
+func TestEmitAndSave_RecordsTimedEvents(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	filePath := filepath.Join(svc.LogDir, "ecs-20260102-030405.timings.json")
+
+	// Act
+	svc.Emit(events.Event{Type: events.StepStarted, Step: "Deploy System"})
+	svc.Emit(events.Event{Type: events.StepFinished, Step: "Deploy System", DurationMs: 1000})
+	svc.Emit(events.Event{Type: events.StepFailed, Step: "Deploy Modules", DurationMs: 2000})
+	svc.Emit(events.Event{Type: events.ModuleReady, Module: "mod-orders", DurationMs: 300})
+	svc.Emit(events.Event{Type: events.TenantEntitled, Tenant: "diku", DurationMs: 400})
+	svc.Emit(events.Event{Type: events.CapabilitySetsAttached, Tenant: "diku", Role: "admin", DurationMs: 500})
+	err := svc.Save(filePath, 5*time.Second)
+
+	// Assert
+	assert.NoError(t, err)
+	reports, err := svc.ListReports("ecs", 0)
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+	report := reports[0]
+	assert.Equal(t, "ecs", report.Profile)
+	assert.Equal(t, int64(5000), report.DurationMs)
+	assert.Equal(t, []models.TimingEntry{
+		{Kind: timingsvc.StepKind, Name: "Deploy System", DurationMs: 1000},
+		{Kind: timingsvc.StepKind, Name: "Deploy Modules", DurationMs: 2000, Failed: true},
+		{Kind: "moduleReady", Name: "mod-orders", DurationMs: 300},
+		{Kind: "tenantEntitled", Name: "diku", DurationMs: 400},
+		{Kind: "capabilitySetsAttached", Name: "diku/admin", DurationMs: 500},
+	}, report.Entries)
+}
+
+func TestListReports_FiltersProfileAndKeepsLastRuns(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	writeReport(t, svc, "ecs-20260101-000000.timings.json", 1000)
+	writeReport(t, svc, "ecs-20260103-000000.timings.json", 3000)
+	writeReport(t, svc, "ecs-20260102-000000.timings.json", 2000)
+	writeReport(t, svc, "ecs-migration-20260104-000000.timings.json", 4000)
+	writeReport(t, svc, "ecs-20260105-000000.log", 5000)
+
+	// Act
+	reports, err := svc.ListReports("ecs", 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, int64(2000), reports[0].DurationMs)
+	assert.Equal(t, int64(3000), reports[1].DurationMs)
+}
+
+func TestListReports_MissingLogDir(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	svc.LogDir = filepath.Join(svc.LogDir, "missing")
+
+	// Act
+	reports, err := svc.ListReports("ecs", 5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+}
+
+func TestCompareReports_LinesUpOperations(t *testing.T) {
+	// Arrange
+	svc := newTestSvc(t)
+	reports := []models.TimingReport{
+		{DurationMs: 1000, Entries: []models.TimingEntry{
+			{Kind: "moduleDeployed", Name: "mod-orders", DurationMs: 100},
+			{Kind: "moduleDeployed", Name: "mod-orders", DurationMs: 50},
+			{Kind: "moduleDeployed", Name: "mod-users", DurationMs: 200},
+		}},
+		{DurationMs: 900, Entries: []models.TimingEntry{
+			{Kind: "moduleDeployed", Name: "mod-orders", DurationMs: 120},
+		}},
+	}
+
+	// Act
+	result := svc.CompareReports(reports)
+
+	// Assert
+	assert.Equal(t, []models.TimingComparison{
+		{Kind: timingsvc.TotalKind, DurationsMs: []*int64{int64Ptr(1000), int64Ptr(900)}},
+		{Kind: "moduleDeployed", Name: "mod-orders", DurationsMs: []*int64{int64Ptr(150), int64Ptr(120)}},
+		{Kind: "moduleDeployed", Name: "mod-users", DurationsMs: []*int64{int64Ptr(200), nil}},
+	}, result)
+}
@@ -1,6 +1,7 @@
 package upgrademodulesvc
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -22,7 +23,7 @@ type UpgradeModuleProcessor interface {
 
 // UpgradeModuleDeploymentManager defines the interface for deploying upgraded modules and their sidecars
 type UpgradeModuleDeploymentManager interface {
-	DeployModuleAndSidecarPair(client *client.Client, pair *modulesvc.ModulePair) error
+	DeployModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error
 }
 
 // UpgradeModuleSvc defines the service for upgrading or downgrading modules
@@ -38,8 +39,8 @@ func New(action *action.Action, execSvc execsvc.CommandRunner, ModuleSvc modules
 	return &UpgradeModuleSvc{Action: action, ExecSvc: execSvc, ModuleSvc: ModuleSvc, ManagementSvc: managementSvc}
 }
 
-func (um *UpgradeModuleSvc) DeployModuleAndSidecarPair(client *client.Client, pair *modulesvc.ModulePair) error {
-	if err := um.ModuleSvc.UndeployModuleAndSidecarPair(client, pair); err != nil {
+func (um *UpgradeModuleSvc) DeployModuleAndSidecarPair(ctx context.Context, client *client.Client, pair *modulesvc.ModulePair) error {
+	if err := um.ModuleSvc.UndeployModuleAndSidecarPair(ctx, client, pair); err != nil {
 		return err
 	}
 	if err := um.prepareModuleAndSidecarPairNetwork(pair); err != nil {
@@ -47,14 +48,14 @@ func (um *UpgradeModuleSvc) DeployModuleAndSidecarPair(client *client.Client, pa
 	}
 
 	slog.Info(um.Action.Name, "text", "DEPLOYING DEFAULT MODULE AND SIDECAR PAIR")
-	if err := um.ModuleSvc.DeployCustomModule(client, pair); err != nil {
+	if err := um.ModuleSvc.DeployCustomModule(ctx, client, pair); err != nil {
 		return err
 	}
-	if err := um.ModuleSvc.DeployCustomSidecar(client, pair); err != nil {
+	if err := um.ModuleSvc.DeployCustomSidecar(ctx, client, pair); err != nil {
 		return err
 	}
 
-	return um.ModuleSvc.CheckModuleAndSidecarReadiness(pair)
+	return um.ModuleSvc.CheckModuleAndSidecarReadiness(ctx, pair)
 }
 
 func (um *UpgradeModuleSvc) prepareModuleAndSidecarPairNetwork(pair *modulesvc.ModulePair) error {
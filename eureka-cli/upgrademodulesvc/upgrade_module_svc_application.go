@@ -74,10 +74,8 @@ func (um *UpgradeModuleSvc) UpdateBackendModules(moduleName, newModuleVersion st
 // getConfiguredPrivatePort resolves the sidecar discovery port from the module's private-port config entry,
 // matching the port the deploy flow registered
 func (um *UpgradeModuleSvc) getConfiguredPrivatePort(moduleName string) (int, error) {
-	if entry, ok := um.Action.ConfigBackendModules[moduleName].(map[string]any); ok {
-		if privatePort := helpers.GetConfiguredPrivatePort(entry); privatePort != nil {
-			return *privatePort, nil
-		}
+	if privatePort := um.Action.ConfigBackendModules[moduleName].GetPrivatePort(); privatePort != nil {
+		return *privatePort, nil
 	}
 
 	return strconv.Atoi(constant.PrivateServerPort)
@@ -3,6 +3,8 @@ package upgrademodulesvc
 import (
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/config"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/stretchr/testify/assert"
 )
@@ -10,8 +12,8 @@ import (
 func TestUpdateBackendModules_DiscoveryUsesConfiguredPrivatePort(t *testing.T) {
 	// Arrange
 	mockAction := testhelpers.NewMockAction()
-	mockAction.ConfigBackendModules = map[string]any{
-		"mod-agreements": map[string]any{"private-port": 8080},
+	mockAction.ConfigBackendModules = map[string]config.BackendModule{
+		"mod-agreements": {PrivatePort: helpers.IntPtr(8080)},
 	}
 	svc := &UpgradeModuleSvc{Action: mockAction}
 	modules := []any{
@@ -30,8 +32,8 @@ func TestUpdateBackendModules_DiscoveryUsesConfiguredPrivatePort(t *testing.T) {
 func TestUpdateBackendModules_DiscoveryUsesPortServerAlias(t *testing.T) {
 	// Arrange
 	mockAction := testhelpers.NewMockAction()
-	mockAction.ConfigBackendModules = map[string]any{
-		"mod-agreements": map[string]any{"private-port": 8080, "port-server": 9090},
+	mockAction.ConfigBackendModules = map[string]config.BackendModule{
+		"mod-agreements": {PrivatePort: helpers.IntPtr(8080), PortServer: helpers.IntPtr(9090)},
 	}
 	svc := &UpgradeModuleSvc{Action: mockAction}
 	modules := []any{
@@ -1,6 +1,7 @@
 package usersvc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
@@ -13,7 +14,7 @@ import (
 
 // UserProcessor defines the interface for user-related operations
 type UserProcessor interface {
-	Get(tenantName string, username string) (*models.User, error)
+	Get(ctx context.Context, tenantName string, username string) (*models.User, error)
 }
 
 // UserSvc provides functionality for managing users
@@ -27,15 +28,15 @@ func New(action *action.Action, httpClient httpclient.HTTPClientRunner) *UserSvc
 	return &UserSvc{Action: action, HTTPClient: httpClient}
 }
 
-func (us *UserSvc) Get(tenantName string, username string) (*models.User, error) {
+func (us *UserSvc) Get(ctx context.Context, tenantName string, username string) (*models.User, error) {
 	requestURL := us.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/users?query=username==%s&limit=1", username))
-	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, us.Action.KeycloakAccessToken)
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, helpers.GetAccessToken(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	var decodedResponse models.UserResponse
-	if err := us.HTTPClient.GetReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+	if err := us.HTTPClient.GetReturnStruct(ctx, requestURL, headers, &decodedResponse); err != nil {
 		return nil, err
 	}
 	if len(decodedResponse.Users) == 0 {
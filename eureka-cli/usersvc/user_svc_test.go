@@ -1,10 +1,12 @@
 package usersvc_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/usersvc"
@@ -30,7 +32,7 @@ func TestGet_Success(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := usersvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -55,7 +57,7 @@ func TestGet_Success(t *testing.T) {
 		}),
 		mock.MatchedBy(func(headers map[string]string) bool {
 			return headers[constant.OkapiTenantHeader] == tenantName &&
-				headers[constant.OkapiTokenHeader] == action.KeycloakAccessToken &&
+				headers[constant.OkapiTokenHeader] == "test-token" &&
 				headers[constant.ContentTypeHeader] == constant.ApplicationJSON
 		}),
 		mock.Anything).
@@ -66,7 +68,7 @@ func TestGet_Success(t *testing.T) {
 		Return(nil)
 
 	// Act
-	user, err := svc.Get(tenantName, username)
+	user, err := svc.Get(ctx, tenantName, username)
 
 	// Assert
 	assert.NoError(t, err)
@@ -82,7 +84,7 @@ func TestGet_UserNotFound(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := usersvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -104,7 +106,7 @@ func TestGet_UserNotFound(t *testing.T) {
 		Return(nil)
 
 	// Act
-	user, err := svc.Get(tenantName, username)
+	user, err := svc.Get(ctx, tenantName, username)
 
 	// Assert
 	assert.Error(t, err)
@@ -119,14 +121,13 @@ func TestGet_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "" // Empty token will cause header creation to fail
 	svc := usersvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
 	username := "testuser"
 
 	// Act
-	user, err := svc.Get(tenantName, username)
+	user, err := svc.Get(context.Background(), tenantName, username)
 
 	// Assert
 	assert.Error(t, err)
@@ -140,7 +141,7 @@ func TestGet_HTTPError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := usersvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -154,7 +155,7 @@ func TestGet_HTTPError(t *testing.T) {
 		Return(expectedError)
 
 	// Act
-	user, err := svc.Get(tenantName, username)
+	user, err := svc.Get(ctx, tenantName, username)
 
 	// Assert
 	assert.Error(t, err)
@@ -167,7 +168,7 @@ func TestGet_WithPersonalInfo(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
 	action := testhelpers.NewMockAction()
-	action.KeycloakAccessToken = "test-token"
+	ctx := helpers.WithAccessToken(context.Background(), "test-token")
 	svc := usersvc.New(action, mockHTTP)
 
 	tenantName := "test-tenant"
@@ -207,7 +208,7 @@ func TestGet_WithPersonalInfo(t *testing.T) {
 		Return(nil)
 
 	// Act
-	user, err := svc.Get(tenantName, username)
+	user, err := svc.Get(ctx, tenantName, username)
 
 	// Assert
 	assert.NoError(t, err)